@@ -0,0 +1,202 @@
+// Package failpoint injects faults at named points of the code, so tests can hit the error paths and crash
+// points which are hard to reach otherwise.
+//
+// A failpoint is a call of Eval in the code:
+//
+//	if err := failpoint.Eval("raftstore/after-write-raft-state"); err != nil {
+//		return err
+//	}
+//
+// It does nothing until the failpoint is enabled by Enable, or by the HTTP handler of the status servers, with
+// one of the terms:
+//
+//	return(msg)   Eval returns an error with the message, the message is optional.
+//	panic(msg)    Eval panics with the message, the message is optional.
+//	sleep(d)      Eval sleeps for the duration d, such as sleep(100ms), and returns nil.
+//	pause         Eval blocks until the failpoint is disabled or enabled again, and returns nil.
+//
+// The term may be prefixed with a count such as 2*return(msg), the failpoint is triggered that many times only.
+// An Eval costs a single atomic load if no failpoint is enabled.
+package failpoint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type action int
+
+const (
+	actionReturn action = iota
+	actionPanic
+	actionSleep
+	actionPause
+)
+
+type failpoint struct {
+	term   string
+	action action
+	msg    string
+	delay  time.Duration
+	// remaining is the times left to trigger the failpoint, or -1 if it is unlimited.
+	remaining int
+	// release is closed once the failpoint is disabled or replaced, to wake up the paused callers.
+	release chan struct{}
+}
+
+var (
+	// enabled is the number of the enabled failpoints, it lets Eval skip the lookup if no failpoint is enabled.
+	enabled    int32
+	mu         sync.Mutex
+	failpoints = make(map[string]*failpoint)
+)
+
+// Enable enables the failpoint with the term, it replaces the term if the failpoint is enabled already.
+func Enable(name, term string) error {
+	if name == "" {
+		return fmt.Errorf("failpoint: empty name")
+	}
+	fp, err := parse(term)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if old, ok := failpoints[name]; ok {
+		close(old.release)
+	} else {
+		atomic.AddInt32(&enabled, 1)
+	}
+	failpoints[name] = fp
+	return nil
+}
+
+// Disable disables the failpoint and releases the callers paused by it.
+func Disable(name string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	fp, ok := failpoints[name]
+	if !ok {
+		return fmt.Errorf("failpoint: %s is not enabled", name)
+	}
+	close(fp.release)
+	delete(failpoints, name)
+	atomic.AddInt32(&enabled, -1)
+	return nil
+}
+
+// Status returns the term of the failpoint, or false if the failpoint is not enabled.
+func Status(name string) (string, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	if fp, ok := failpoints[name]; ok {
+		return fp.term, true
+	}
+	return "", false
+}
+
+// List returns the names of the enabled failpoints in order.
+func List() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(failpoints))
+	for name := range failpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Eval triggers the failpoint if it is enabled. It returns the error of a return term, and nil otherwise.
+func Eval(name string) error {
+	if atomic.LoadInt32(&enabled) == 0 {
+		return nil
+	}
+	fp := take(name)
+	if fp == nil {
+		return nil
+	}
+	switch fp.action {
+	case actionReturn:
+		return fp.error(name)
+	case actionPanic:
+		panic(fp.error(name).Error())
+	case actionSleep:
+		time.Sleep(fp.delay)
+	case actionPause:
+		<-fp.release
+	}
+	return nil
+}
+
+func (fp *failpoint) error(name string) error {
+	if fp.msg == "" {
+		return fmt.Errorf("failpoint %s", name)
+	}
+	return fmt.Errorf("failpoint %s: %s", name, fp.msg)
+}
+
+// take returns the failpoint to trigger, or nil if it is not enabled or has been triggered enough times. The
+// exhausted failpoint stays enabled, so its paused callers are still released by Disable.
+func take(name string) *failpoint {
+	mu.Lock()
+	defer mu.Unlock()
+	fp, ok := failpoints[name]
+	if !ok || fp.remaining == 0 {
+		return nil
+	}
+	if fp.remaining > 0 {
+		fp.remaining--
+	}
+	return fp
+}
+
+func parse(term string) (*failpoint, error) {
+	fp := &failpoint{term: term, remaining: -1, release: make(chan struct{})}
+	t := strings.TrimSpace(term)
+	// The message may contain '*', so only the part before the argument is searched for the count.
+	head := t
+	if i := strings.Index(t, "("); i >= 0 {
+		head = t[:i]
+	}
+	if i := strings.Index(head, "*"); i >= 0 {
+		n, err := strconv.Atoi(t[:i])
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("failpoint: invalid count in %q", term)
+		}
+		fp.remaining = n
+		t = t[i+1:]
+	}
+	name, arg := t, ""
+	if i := strings.Index(t, "("); i >= 0 {
+		if !strings.HasSuffix(t, ")") {
+			return nil, fmt.Errorf("failpoint: unclosed argument in %q", term)
+		}
+		name, arg = t[:i], t[i+1:len(t)-1]
+	}
+	switch name {
+	case "return":
+		fp.action, fp.msg = actionReturn, arg
+	case "panic":
+		fp.action, fp.msg = actionPanic, arg
+	case "sleep":
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failpoint: invalid duration in %q", term)
+		}
+		fp.action, fp.delay = actionSleep, d
+	case "pause":
+		if arg != "" {
+			return nil, fmt.Errorf("failpoint: pause takes no argument in %q", term)
+		}
+		fp.action = actionPause
+	default:
+		return nil, fmt.Errorf("failpoint: unknown action in %q", term)
+	}
+	return fp, nil
+}
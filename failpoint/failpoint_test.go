@@ -0,0 +1,93 @@
+package failpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	require.Nil(t, Eval("test/point"))
+
+	require.Nil(t, Enable("test/point", "return(a*b)"))
+	require.EqualError(t, Eval("test/point"), "failpoint test/point: a*b")
+	require.EqualError(t, Eval("test/point"), "failpoint test/point: a*b")
+	require.Equal(t, []string{"test/point"}, List())
+
+	require.Nil(t, Enable("test/point", "return"))
+	require.EqualError(t, Eval("test/point"), "failpoint test/point")
+
+	require.Nil(t, Enable("test/point", "panic(boom)"))
+	require.PanicsWithValue(t, "failpoint test/point: boom", func() { Eval("test/point") })
+
+	require.Nil(t, Enable("test/point", "sleep(20ms)"))
+	start := time.Now()
+	require.Nil(t, Eval("test/point"))
+	require.True(t, time.Since(start) >= 20*time.Millisecond)
+
+	require.Nil(t, Disable("test/point"))
+	require.NotNil(t, Disable("test/point"))
+	require.Nil(t, Eval("test/point"))
+	require.Empty(t, List())
+}
+
+func TestCount(t *testing.T) {
+	require.Nil(t, Enable("test/count", "2*return"))
+	defer Disable("test/count")
+	require.NotNil(t, Eval("test/count"))
+	require.NotNil(t, Eval("test/count"))
+	require.Nil(t, Eval("test/count"))
+	term, ok := Status("test/count")
+	require.True(t, ok)
+	require.Equal(t, "2*return", term)
+}
+
+func TestPause(t *testing.T) {
+	require.Nil(t, Enable("test/pause", "pause"))
+	done := make(chan error)
+	go func() {
+		done <- Eval("test/pause")
+	}()
+	select {
+	case <-done:
+		t.Fatal("the failpoint is not paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Nil(t, Disable("test/pause"))
+	require.Nil(t, <-done)
+}
+
+func TestParse(t *testing.T) {
+	for _, term := range []string{"", "off", "0*return", "x*return", "return(", "sleep(1)", "pause(1)"} {
+		require.NotNil(t, Enable("test/parse", term), term)
+	}
+	require.NotNil(t, Enable("", "return"))
+	require.Empty(t, List())
+}
+
+func TestHandler(t *testing.T) {
+	ts := httptest.NewServer(http.StripPrefix("/failpoints", Handler()))
+	defer ts.Close()
+
+	do := func(method, path, body string) int {
+		req, err := http.NewRequest(method, ts.URL+"/failpoints"+path, strings.NewReader(body))
+		require.Nil(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusNoContent, do(http.MethodPut, "/test/http", "1*return(x)"))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodPut, "/test/http", "unknown"))
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/test/http", ""))
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/", ""))
+	require.Equal(t, http.StatusMethodNotAllowed, do(http.MethodDelete, "/", ""))
+	require.EqualError(t, Eval("test/http"), "failpoint test/http: x")
+	require.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/test/http", ""))
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/test/http", ""))
+	require.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/test/http", ""))
+}
@@ -0,0 +1,72 @@
+package failpoint
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Handler returns the HTTP handler to control the failpoints, it should be mounted with http.StripPrefix so the
+// path is the name of the failpoint. It serves:
+//
+//	GET    /        the terms of the enabled failpoints, as a JSON object.
+//	GET    /{name}  the term of the failpoint.
+//	PUT    /{name}  enable the failpoint, the body is the term such as return(msg).
+//	DELETE /{name}  disable the failpoint and release the callers paused by it.
+func Handler() http.Handler {
+	return http.HandlerFunc(serveHTTP)
+}
+
+func serveHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(r.URL.Path, "/")
+	if name == "" {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		terms := make(map[string]string)
+		for _, name := range List() {
+			if term, ok := Status(name); ok {
+				terms[name] = term
+			}
+		}
+		data, err := json.MarshalIndent(terms, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		term, ok := Status(name)
+		if !ok {
+			http.Error(w, "failpoint is not enabled", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(term))
+	case http.MethodPut, http.MethodPost:
+		term, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := Enable(name, string(term)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if err := Disable(name); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -3,12 +3,14 @@ module github.com/pingcap-incubator/tinykv
 require (
 	github.com/BurntSushi/toml v0.3.1
 	github.com/Connor1996/badger v1.5.1-0.20200306031920-9bbcbd8ba570
+	github.com/chzyer/readline v0.0.0-20171208011716-f6d7a1f6fbf3
 	github.com/coocood/badger v1.5.1-0.20191220113928-eaffd0ec7a8c // indirect
 	github.com/coreos/pkg v0.0.0-20180928190104-399ea9e2e55f
 	github.com/docker/go-units v0.4.0
 	github.com/gogo/protobuf v1.2.1
 	github.com/golang/protobuf v1.3.2
 	github.com/google/btree v1.0.0
+	github.com/gorilla/mux v1.6.2
 	github.com/juju/errors v0.0.0-20181118221551-089d3ea4e4d5
 	github.com/juju/loggo v0.0.0-20180524022052-584905176618 // indirect
 	github.com/juju/testing v0.0.0-20180920084828-472a3e8b2073 // indirect
@@ -23,9 +25,12 @@ require (
 	github.com/pingcap/parser v0.0.0-20190903084634-0daf3f706c76
 	github.com/pingcap/tidb v1.1.0-beta.0.20190904060835-0872b65ff1f9
 	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v0.9.0
 	github.com/shirou/gopsutil v2.18.10+incompatible
 	github.com/sirupsen/logrus v1.2.0
+	github.com/spf13/cobra v0.0.5
 	github.com/stretchr/testify v1.3.0
+	github.com/unrolled/render v0.0.0-20180914162206-b9786414de4d
 	go.etcd.io/etcd v0.0.0-20190320044326-77d4b742cdbf
 	go.uber.org/zap v1.9.1
 	golang.org/x/net v0.0.0-20190620200207-3b0461eec859
@@ -0,0 +1,245 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+)
+
+// fileSizeLimit is the size of the keys and values of a data file above which the rest of the data of a region is
+// written to a new file.
+const fileSizeLimit = 4 * 1024 * 1024
+
+// Backup backs up the data of the range of req in each region led by the store of innerServer, and sends the result
+// of each region to the stream.
+func Backup(req *kvrpcpb.BackupRequest, innerServer inner_server.InnerServer, stream tinykvpb.TinyKv_BackupServer) error {
+	manager, ok := innerServer.(inner_server.RegionManager)
+	if !ok {
+		return errors.New("backup is not supported by the store")
+	}
+	storage, err := NewStorage(req.GetPath())
+	if err != nil {
+		return err
+	}
+	regions, err := manager.LeaderRegions()
+	if err != nil {
+		return err
+	}
+	for _, region := range regions {
+		startKey, endKey, ok := intersect(req.GetStartKey(), req.GetEndKey(), region.Region.GetStartKey(), region.Region.GetEndKey())
+		if !ok {
+			continue
+		}
+		resp, err := backupRegion(innerServer, region, startKey, endKey, req.GetBackupTs(), storage)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backupRegion writes the data of [startKey, endKey) of the region visible at backupTs to the storage.
+func backupRegion(innerServer inner_server.InnerServer, region inner_server.LeaderRegion, startKey, endKey []byte,
+	backupTs uint64, storage ExternalStorage) (*kvrpcpb.BackupResponse, error) {
+	resp := &kvrpcpb.BackupResponse{
+		RegionId: region.Region.GetId(),
+		StartKey: startKey,
+		EndKey:   endKey,
+	}
+	reader, err := innerServer.Reader(region.Context())
+	if err != nil {
+		if regionErr, ok := err.(*raft_server.RegionError); ok {
+			resp.RegionError = regionErr.RequestErr
+			return resp, nil
+		}
+		return nil, err
+	}
+	defer reader.Close()
+
+	lock, err := findLock(reader, startKey, endKey, backupTs)
+	if err != nil {
+		return nil, err
+	}
+	if lock != nil {
+		resp.Error = &kvrpcpb.KeyError{Locked: lock}
+		return resp, nil
+	}
+
+	w := &fileWriter{regionID: region.Region.GetId(), storage: storage, startKey: startKey}
+	err = scanVersions(reader, startKey, endKey, backupTs, func(key []byte, write *mvcc.Write, commitTs uint64, value []byte) error {
+		if w.size >= fileSizeLimit {
+			if err := w.flush(key); err != nil {
+				return err
+			}
+		}
+		w.add(key, write, commitTs, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.flush(endKey); err != nil {
+		return nil, err
+	}
+	resp.Files = w.files
+	log.Infof("backup region %d [%q, %q) at %d to %d files", resp.RegionId, startKey, endKey, backupTs, len(resp.Files))
+	return resp, nil
+}
+
+// findLock returns a lock of a key in [startKey, endKey) at or before backupTs. The transaction of the lock may commit
+// at or before backupTs, so the lock must be resolved before the data is backed up.
+func findLock(reader inner_server.DBReader, startKey, endKey []byte, backupTs uint64) (*kvrpcpb.LockInfo, error) {
+	iter := reader.IterCF(engine_util.CfLock)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if bytes.Compare(item.Key(), startKey) < 0 {
+			continue
+		}
+		if engine_util.ExceedEndKey(item.Key(), endKey) {
+			break
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		lock, err := mvcc.ParseLock(value)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Ts <= backupTs {
+			return lock.Info(item.KeyCopy(nil)), nil
+		}
+	}
+	return nil, nil
+}
+
+// scanVersions calls f with the version of each key in [startKey, endKey) visible at backupTs, in order of the keys.
+// The deleted keys are skipped.
+func scanVersions(reader inner_server.DBReader, startKey, endKey []byte, backupTs uint64,
+	f func(key []byte, write *mvcc.Write, commitTs uint64, value []byte) error) error {
+	iter := reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
+	// The versions of a key are in order of the commit ts descending, the key is done once a version is found.
+	var doneKey []byte
+	for ; iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey := mvcc.DecodeUserKey(item.Key())
+		if bytes.Compare(userKey, startKey) < 0 {
+			continue
+		}
+		if engine_util.ExceedEndKey(userKey, endKey) {
+			break
+		}
+		if doneKey != nil && bytes.Equal(userKey, doneKey) {
+			continue
+		}
+		commitTs := mvcc.DecodeTimestamp(item.Key())
+		if commitTs > backupTs {
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return err
+		}
+		write, err := mvcc.ParseWrite(value)
+		if err != nil {
+			return err
+		}
+		if write.Kind == mvcc.WriteKindRollback || write.Kind == mvcc.WriteKindLock {
+			continue
+		}
+		doneKey = userKey
+		if write.Kind == mvcc.WriteKindDelete {
+			continue
+		}
+		data := write.ShortValue
+		if data == nil {
+			if data, err = reader.GetCF(engine_util.CfDefault, mvcc.EncodeKey(userKey, write.StartTS)); err != nil {
+				return err
+			}
+		}
+		if err := f(userKey, write, commitTs, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileWriter splits the data of a region into files.
+type fileWriter struct {
+	regionID uint64
+	storage  ExternalStorage
+	files    []*kvrpcpb.BackupFile
+	// The start key of the current file.
+	startKey []byte
+	// The entries of the current file, the default CF ones sort before the write CF ones.
+	defaults []cfEntry
+	writes   []cfEntry
+	size     int
+}
+
+func (w *fileWriter) add(key []byte, write *mvcc.Write, commitTs uint64, value []byte) {
+	// An inlined value is restored with the write.
+	if write.ShortValue == nil {
+		w.defaults = append(w.defaults, cfEntry{cf: engine_util.CfDefault, key: mvcc.EncodeKey(key, write.StartTS), value: value})
+	}
+	w.writes = append(w.writes, cfEntry{cf: engine_util.CfWrite, key: mvcc.EncodeKey(key, commitTs), value: write.ToBytes()})
+	w.size += 2*len(key) + len(value)
+}
+
+// flush writes the current file, which ends at endKey, to the storage.
+func (w *fileWriter) flush(endKey []byte) error {
+	if len(w.writes) == 0 {
+		return nil
+	}
+	data, err := buildSST(append(w.defaults, w.writes...))
+	if err != nil {
+		return err
+	}
+	checksum := sha256.Sum256(data)
+	file := &kvrpcpb.BackupFile{
+		Name:       fmt.Sprintf("%d_%s.sst", w.regionID, hex.EncodeToString(checksum[:8])),
+		StartKey:   w.startKey,
+		EndKey:     endKey,
+		Sha256:     checksum[:],
+		TotalKvs:   uint64(len(w.writes)),
+		TotalBytes: uint64(len(data)),
+	}
+	if err := w.storage.Write(file.Name, data); err != nil {
+		return err
+	}
+	w.files = append(w.files, file)
+	w.startKey = endKey
+	w.defaults, w.writes, w.size = nil, nil, 0
+	return nil
+}
+
+// intersect returns the intersection of [startKey1, endKey1) and [startKey2, endKey2), an empty end key means the
+// end of the key space. It returns false if the intersection is empty.
+func intersect(startKey1, endKey1, startKey2, endKey2 []byte) ([]byte, []byte, bool) {
+	startKey, endKey := startKey1, endKey1
+	if bytes.Compare(startKey2, startKey) > 0 {
+		startKey = startKey2
+	}
+	if len(endKey) == 0 || (len(endKey2) > 0 && bytes.Compare(endKey2, endKey) < 0) {
+		endKey = endKey2
+	}
+	if len(endKey) > 0 && bytes.Compare(startKey, endKey) >= 0 {
+		return nil, nil, false
+	}
+	return startKey, endKey, true
+}
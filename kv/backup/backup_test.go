@@ -0,0 +1,104 @@
+package backup
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntersect(t *testing.T) {
+	cases := []struct {
+		start1, end1, start2, end2 string
+		start, end                 string
+		ok                         bool
+	}{
+		{"", "", "", "", "", "", true},
+		{"a", "c", "", "", "a", "c", true},
+		{"", "", "b", "d", "b", "d", true},
+		{"a", "c", "b", "d", "b", "c", true},
+		{"a", "", "b", "d", "b", "d", true},
+		{"a", "b", "b", "d", "", "", false},
+		{"c", "", "a", "c", "", "", false},
+	}
+	for _, c := range cases {
+		start, end, ok := intersect([]byte(c.start1), []byte(c.end1), []byte(c.start2), []byte(c.end2))
+		assert.Equal(t, c.ok, ok, "%v", c)
+		if ok {
+			assert.Equal(t, c.start, string(start), "%v", c)
+			assert.Equal(t, c.end, string(end), "%v", c)
+		}
+	}
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tinykv_backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	storage, err := NewStorage(localScheme + dir)
+	require.Nil(t, err)
+
+	entries := []cfEntry{
+		{cf: engine_util.CfDefault, key: []byte("k1"), value: []byte("v1")},
+		{cf: engine_util.CfDefault, key: []byte("k2"), value: []byte("v2")},
+		{cf: engine_util.CfWrite, key: []byte("k1"), value: []byte("w1")},
+	}
+	data, err := buildSST(entries)
+	require.Nil(t, err)
+	require.Nil(t, storage.Write("1.sst", data))
+	checksum := sha256.Sum256(data)
+
+	read, err := storage.Read("1.sst")
+	require.Nil(t, err)
+	assert.Equal(t, checksum, sha256.Sum256(read))
+	readEntries, err := readSST(read)
+	require.Nil(t, err)
+	assert.Equal(t, entries, readEntries)
+
+	_, err = NewStorage("s3://bucket")
+	assert.NotNil(t, err)
+}
+
+func TestDataFileBuildSST(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tinykv_backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	storage, err := NewStorage(dir)
+	require.Nil(t, err)
+
+	var entries []cfEntry
+	for _, cf := range []string{engine_util.CfDefault, engine_util.CfWrite} {
+		for _, key := range []string{"a", "b", "c"} {
+			entries = append(entries, cfEntry{cf: cf, key: mvcc.EncodeKey([]byte(key), 10), value: []byte(key)})
+		}
+	}
+	data, err := buildSST(entries)
+	require.Nil(t, err)
+	require.Nil(t, storage.Write("1.sst", data))
+	checksum := sha256.Sum256(data)
+	file := &kvrpcpb.BackupFile{Name: "1.sst", Sha256: checksum[:]}
+	dataFile, err := ReadDataFile(storage, file)
+	require.Nil(t, err)
+
+	_, meta, err := dataFile.BuildSST([]byte("b"), nil)
+	require.Nil(t, err)
+	assert.Equal(t, mvcc.EncodeKey([]byte("b"), 10), meta.GetStartKey())
+	assert.Equal(t, mvcc.EncodeKey([]byte("c"), 10), meta.GetEndKey())
+	_, meta, err = dataFile.BuildSST([]byte("a"), []byte("b"))
+	require.Nil(t, err)
+	assert.Equal(t, mvcc.EncodeKey([]byte("a"), 10), meta.GetEndKey())
+	// No entry is in the range.
+	_, meta, err = dataFile.BuildSST([]byte("d"), nil)
+	require.Nil(t, err)
+	assert.Nil(t, meta)
+
+	file.Sha256 = make([]byte, len(checksum))
+	_, err = ReadDataFile(storage, file)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,41 @@
+package backup
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// ManifestName is the name of the manifest file of a backup in the storage.
+const ManifestName = "backup.manifest"
+
+// Manifest describes a backup. The files cover [StartKey, EndKey) in order of their ranges.
+type Manifest struct {
+	StartKey []byte                `json:"start_key"`
+	EndKey   []byte                `json:"end_key"`
+	BackupTs uint64                `json:"backup_ts"`
+	Files    []*kvrpcpb.BackupFile `json:"files"`
+}
+
+// WriteManifest writes the manifest to the storage, it should be written after all files of the backup.
+func WriteManifest(storage ExternalStorage, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return storage.Write(ManifestName, data)
+}
+
+// ReadManifest reads the manifest from the storage.
+func ReadManifest(storage ExternalStorage) (*Manifest, error) {
+	data, err := storage.Read(ManifestName)
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Annotatef(err, "parse %s", ManifestName)
+	}
+	return manifest, nil
+}
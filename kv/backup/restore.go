@@ -0,0 +1,133 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/pingcap-incubator/tinykv/kv/importer"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// restoreBatchSize is the number of the entries written by a Raft command of a restore.
+const restoreBatchSize = 256
+
+// RestoreFile writes the data of the file of req in the range of req to the region of req through Raft. The file is
+// checked against its checksum first. The data goes through the normal write path, a restore of a whole backup
+// ingests the files by DataFile.BuildSST instead.
+func RestoreFile(req *kvrpcpb.RestoreFileRequest, innerServer inner_server.InnerServer) (*kvrpcpb.RestoreFileResponse, error) {
+	resp := new(kvrpcpb.RestoreFileResponse)
+	entries, err := readFile(req.GetPath(), req.GetFile())
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	var batch []inner_server.Modify
+	write := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := innerServer.Write(req.GetContext(), batch)
+		batch = batch[:0]
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.inRange(req.GetStartKey(), req.GetEndKey()) {
+			continue
+		}
+		batch = append(batch, inner_server.Modify{
+			Type: inner_server.ModifyTypePut,
+			Data: inner_server.Put{Cf: entry.cf, Key: entry.key, Value: entry.value},
+		})
+		if entry.cf == engine_util.CfWrite {
+			resp.RestoredKvs++
+		}
+		if len(batch) >= restoreBatchSize {
+			err = write()
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = write()
+	}
+	if err != nil {
+		if regionErr, ok := err.(*raft_server.RegionError); ok {
+			return &kvrpcpb.RestoreFileResponse{RegionError: regionErr.RequestErr}, nil
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DataFile is the data of a backup file to restore.
+type DataFile struct {
+	entries []cfEntry
+}
+
+// ReadDataFile reads the file from the storage, the file is checked against its checksum.
+func ReadDataFile(storage ExternalStorage, file *kvrpcpb.BackupFile) (*DataFile, error) {
+	entries, err := readStorageFile(storage, file)
+	if err != nil {
+		return nil, err
+	}
+	return &DataFile{entries: entries}, nil
+}
+
+// BuildSST builds a file of the entries in [startKey, endKey) to be ingested by an IngestSST command, see
+// importer.SSTBuilder. The caller fills the uuid and the region of the meta. It returns a nil meta if there is no
+// entry in the range.
+func (f *DataFile) BuildSST(startKey, endKey []byte) ([]byte, *import_sstpb.SSTMeta, error) {
+	var builder *importer.SSTBuilder
+	for _, entry := range f.entries {
+		if !entry.inRange(startKey, endKey) {
+			continue
+		}
+		if builder == nil {
+			var err error
+			if builder, err = importer.NewSSTBuilder(); err != nil {
+				return nil, nil, err
+			}
+		}
+		if err := builder.Add(entry.cf, entry.key, entry.value); err != nil {
+			builder.Close()
+			return nil, nil, err
+		}
+	}
+	if builder == nil {
+		return nil, nil, nil
+	}
+	return builder.Finish()
+}
+
+// inRange returns whether the user key of the entry is in [startKey, endKey).
+func (e *cfEntry) inRange(startKey, endKey []byte) bool {
+	userKey := mvcc.DecodeUserKey(e.key)
+	return bytes.Compare(userKey, startKey) >= 0 && !engine_util.ExceedEndKey(userKey, endKey)
+}
+
+// readFile reads the entries of the file from the storage at path.
+func readFile(path string, file *kvrpcpb.BackupFile) ([]cfEntry, error) {
+	storage, err := NewStorage(path)
+	if err != nil {
+		return nil, err
+	}
+	return readStorageFile(storage, file)
+}
+
+func readStorageFile(storage ExternalStorage, file *kvrpcpb.BackupFile) ([]cfEntry, error) {
+	data, err := storage.Read(file.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if checksum := sha256.Sum256(data); !bytes.Equal(checksum[:], file.GetSha256()) {
+		return nil, errors.Errorf("checksum of file %s mismatch", file.GetName())
+	}
+	return readSST(data)
+}
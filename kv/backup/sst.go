@@ -0,0 +1,84 @@
+package backup
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	"github.com/Connor1996/badger"
+	"github.com/Connor1996/badger/options"
+	"github.com/Connor1996/badger/table"
+	"github.com/Connor1996/badger/y"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap/errors"
+)
+
+// cfEntry is a key/value pair of a column family in a data file.
+type cfEntry struct {
+	cf    string
+	key   []byte
+	value []byte
+}
+
+// buildSST returns an SST file of the entries in the format of the snapshot files, i.e. the keys are prefixed with
+// their column families. The entries must be in order of the prefixed keys.
+func buildSST(entries []cfEntry) ([]byte, error) {
+	file, err := ioutil.TempFile("", "backup_sst")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer os.Remove(file.Name())
+	builder := table.NewExternalTableBuilder(file, nil, badger.DefaultOptions.TableBuilderOptions)
+	for _, entry := range entries {
+		if err := builder.Add(engine_util.KeyWithCF(entry.cf, entry.key), y.ValueStruct{Value: entry.value}); err != nil {
+			builder.Close()
+			return nil, err
+		}
+	}
+	err = builder.Finish()
+	builder.Close()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(file.Name())
+	return data, errors.WithStack(err)
+}
+
+// readSST returns the entries of an SST file built by buildSST.
+func readSST(data []byte) ([]cfEntry, error) {
+	dir, err := ioutil.TempDir("", "backup_sst")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer os.RemoveAll(dir)
+	// badger only opens tables whose file name is a table id.
+	path := table.NewFilename(1, dir)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	fd, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tbl, err := table.OpenTable(fd, options.LoadToRAM, badger.DefaultOptions.TableBuilderOptions.Compression, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Close()
+
+	var entries []cfEntry
+	it := tbl.NewIterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := it.RawKey()
+		sep := bytes.IndexByte(key, '_')
+		if sep < 0 {
+			return nil, errors.Errorf("key %q has no column family", key)
+		}
+		entries = append(entries, cfEntry{
+			cf:    string(key[:sep]),
+			key:   y.SafeCopy(nil, key[sep+1:]),
+			value: y.SafeCopy(nil, it.Value().Value),
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,62 @@
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// ExternalStorage is where the files of a backup are kept. The stores and the driver of a backup must see the same
+// storage.
+type ExternalStorage interface {
+	// Write creates or replaces the file with the data.
+	Write(name string, data []byte) error
+	// Read returns the data of the file.
+	Read(name string) ([]byte, error)
+}
+
+const localScheme = "local://"
+
+// NewStorage creates the storage at path. The path is a local directory, optionally prefixed with local://, e.g. a
+// directory mounted by every store.
+func NewStorage(path string) (ExternalStorage, error) {
+	if strings.Contains(path, "://") && !strings.HasPrefix(path, localScheme) {
+		return nil, errors.Errorf("unsupported storage %s", path)
+	}
+	path = strings.TrimPrefix(path, localScheme)
+	if path == "" {
+		return nil, errors.New("storage path is empty")
+	}
+	return NewLocalStorage(path)
+}
+
+// LocalStorage is an ExternalStorage in a local directory.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage creates a LocalStorage in dir, the directory is created if it does not exist.
+func NewLocalStorage(dir string) (*LocalStorage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &LocalStorage{dir: dir}, nil
+}
+
+// Write writes to a temporary file first and renames it, so a reader never sees a partially written file.
+func (s *LocalStorage) Write(name string, data []byte) error {
+	path := filepath.Join(s.dir, name)
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmpPath, path))
+}
+
+func (s *LocalStorage) Read(name string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	return data, errors.WithStack(err)
+}
@@ -0,0 +1,285 @@
+package cdc
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+)
+
+// pendingLock is a lock of a subscribed key which is neither committed nor rolled back.
+type pendingLock struct {
+	ts    uint64
+	op    kvrpcpb.Op
+	value []byte
+}
+
+// delegate is a subscription to the changes of a key range of a region. It turns the applied commands into events
+// and tracks the locks of the range to compute the resolved ts.
+//
+// The delegate is registered before the snapshot of the incremental scan is taken, the commands applied meanwhile are
+// kept and handled after the scan, so a change may be sent twice but is never lost.
+type delegate struct {
+	regionID     uint64
+	epoch        *metapb.RegionEpoch
+	startKey     []byte
+	endKey       []byte
+	checkpointTs uint64
+	// notify is signaled when there is something to send.
+	notify chan struct{}
+
+	mu struct {
+		sync.Mutex
+		initialized bool
+		// The commands applied before the incremental scan finishes.
+		pendingCmds [][]*raft_cmdpb.Request
+		// user key -> lock
+		locks      map[string]pendingLock
+		events     []*kvrpcpb.Event
+		resolvedTs uint64
+		// The resolved ts which is not sent yet, 0 if there is none.
+		unsentResolvedTs uint64
+		// The error which stops the subscription.
+		regionErr *errorpb.Error
+	}
+}
+
+func newDelegate(req *kvrpcpb.ChangeDataRequest) *delegate {
+	d := &delegate{
+		regionID:     req.GetContext().GetRegionId(),
+		epoch:        req.GetContext().GetRegionEpoch(),
+		startKey:     req.GetStartKey(),
+		endKey:       req.GetEndKey(),
+		checkpointTs: req.GetCheckpointTs(),
+		notify:       make(chan struct{}, 1),
+	}
+	d.mu.locks = make(map[string]pendingLock)
+	return d
+}
+
+func (d *delegate) inRange(key []byte) bool {
+	return bytes.Compare(key, d.startKey) >= 0 && !engine_util.ExceedEndKey(key, d.endKey)
+}
+
+func (d *delegate) signal() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+// onApplyCmd handles the requests of an applied command.
+func (d *delegate) onApplyCmd(requests []*raft_cmdpb.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.mu.regionErr != nil {
+		return
+	}
+	if !d.mu.initialized {
+		d.mu.pendingCmds = append(d.mu.pendingCmds, requests)
+		return
+	}
+	if d.handleCmd(requests) {
+		d.signal()
+	}
+}
+
+// onRegionChanged stops the subscription if the epoch of the region has changed, the client should subscribe to the
+// new regions of the range.
+func (d *delegate) onRegionChanged(region *metapb.Region) {
+	epoch := region.GetRegionEpoch()
+	if epoch.GetVersion() == d.epoch.GetVersion() && epoch.GetConfVer() == d.epoch.GetConfVer() {
+		return
+	}
+	d.stop(&errorpb.Error{
+		Message:       "region epoch changed",
+		EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: []*metapb.Region{region}},
+	})
+}
+
+// stop stops the subscription with the region error.
+func (d *delegate) stop(regionErr *errorpb.Error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.mu.regionErr == nil {
+		d.mu.regionErr = regionErr
+		d.signal()
+	}
+}
+
+// handleCmd turns the requests of a command into events and updates the locks, it returns whether there is a new
+// event. The caller must hold d.mu.
+func (d *delegate) handleCmd(requests []*raft_cmdpb.Request) bool {
+	// The values of a prewrite are in the default CF of the same command.
+	values := make(map[string][]byte)
+	for _, req := range requests {
+		if put := req.GetPut(); put != nil && isDefaultCF(put.GetCf()) {
+			values[string(put.GetKey())] = put.GetValue()
+		}
+	}
+
+	count := len(d.mu.events)
+	// The writes are handled first since a commit takes the value from the lock it removes.
+	for _, req := range requests {
+		put := req.GetPut()
+		if put == nil || put.GetCf() != engine_util.CfWrite {
+			continue
+		}
+		key := mvcc.DecodeUserKey(put.GetKey())
+		if !d.inRange(key) {
+			continue
+		}
+		write, err := mvcc.ParseWrite(put.GetValue())
+		if err != nil {
+			log.Warnf("cdc: region %d: skip write of key %q: %v", d.regionID, key, err)
+			continue
+		}
+		if write.Kind == mvcc.WriteKindLock {
+			// The transaction only locked the key without changing it.
+			continue
+		}
+		d.mu.events = append(d.mu.events, d.writeEvent(key, mvcc.DecodeTimestamp(put.GetKey()), write))
+	}
+	for _, req := range requests {
+		switch req.GetCmdType() {
+		case raft_cmdpb.CmdType_Put:
+			put := req.GetPut()
+			if put.GetCf() != engine_util.CfLock || !d.inRange(put.GetKey()) {
+				continue
+			}
+			lock, err := mvcc.ParseLock(put.GetValue())
+			if err != nil {
+				log.Warnf("cdc: region %d: skip lock of key %q: %v", d.regionID, put.GetKey(), err)
+				continue
+			}
+			if lock.Kind == mvcc.WriteKindLock {
+				continue
+			}
+			value := lock.ShortValue
+			if value == nil {
+				value = values[string(mvcc.EncodeKey(put.GetKey(), lock.Ts))]
+			}
+			d.mu.events = append(d.mu.events, d.trackLock(put.GetKey(), lock, value))
+		case raft_cmdpb.CmdType_Delete:
+			if del := req.GetDelete(); del.GetCf() == engine_util.CfLock {
+				delete(d.mu.locks, string(del.GetKey()))
+			}
+		case raft_cmdpb.CmdType_DeleteRange:
+			// The locks of a deleted range are gone without a commit or a rollback.
+			if del := req.GetDeleteRange(); del.GetCf() == engine_util.CfLock {
+				for key := range d.mu.locks {
+					if bytes.Compare([]byte(key), del.GetStartKey()) >= 0 && !engine_util.ExceedEndKey([]byte(key), del.GetEndKey()) {
+						delete(d.mu.locks, key)
+					}
+				}
+			}
+		}
+	}
+	return len(d.mu.events) > count
+}
+
+// trackLock records the lock of the key and returns its prewrite event. The caller must hold d.mu.
+func (d *delegate) trackLock(key []byte, lock *mvcc.Lock, value []byte) *kvrpcpb.Event {
+	op := kvrpcpb.Op_Put
+	if lock.Kind == mvcc.WriteKindDelete {
+		op, value = kvrpcpb.Op_Del, nil
+	}
+	d.mu.locks[string(key)] = pendingLock{ts: lock.Ts, op: op, value: value}
+	return &kvrpcpb.Event{
+		Type:    kvrpcpb.EventType_Prewrite,
+		Key:     key,
+		Value:   value,
+		Op:      op,
+		StartTs: lock.Ts,
+		Primary: lock.Primary,
+		LockTtl: lock.Ttl,
+	}
+}
+
+// writeEvent returns the event of a write of the key, the value of a commit is taken from the lock of the
+// transaction. The caller must hold d.mu.
+func (d *delegate) writeEvent(key []byte, commitTs uint64, write *mvcc.Write) *kvrpcpb.Event {
+	if write.Kind == mvcc.WriteKindRollback {
+		return &kvrpcpb.Event{Type: kvrpcpb.EventType_RollbackLock, Key: key, StartTs: write.StartTS}
+	}
+	event := &kvrpcpb.Event{
+		Type:     kvrpcpb.EventType_Commit,
+		Key:      key,
+		Op:       kvrpcpb.Op_Put,
+		StartTs:  write.StartTS,
+		CommitTs: commitTs,
+	}
+	if write.Kind == mvcc.WriteKindDelete {
+		event.Op = kvrpcpb.Op_Del
+	} else if write.ShortValue != nil {
+		event.Value = write.ShortValue
+	} else if lock, ok := d.mu.locks[string(key)]; ok && lock.ts == write.StartTS {
+		event.Value = lock.value
+	}
+	return event
+}
+
+// initialize sends the events of the incremental scan and handles the commands applied during the scan. locks are the
+// locks of the snapshot.
+func (d *delegate) initialize(events []*kvrpcpb.Event, locks map[string]pendingLock) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.mu.regionErr != nil {
+		return
+	}
+	d.mu.locks = locks
+	d.mu.events = append(d.mu.events, events...)
+	d.mu.events = append(d.mu.events, &kvrpcpb.Event{Type: kvrpcpb.EventType_Initialized})
+	// The commands applied before the snapshot are handled again, it leaves the locks the same as the snapshot since
+	// the last change of a key wins.
+	for _, requests := range d.mu.pendingCmds {
+		d.handleCmd(requests)
+	}
+	d.mu.pendingCmds = nil
+	d.mu.initialized = true
+	d.signal()
+}
+
+// resolve advances the resolved ts with the current ts. Every transaction which commits later in the region has a
+// commit ts greater than both the current ts, since it is allocated after the transaction is prewritten, and the
+// start ts of the pending locks. It holds only while the peer is the leader, which has applied every write acknowledged
+// in the region, so the subscription is stopped once the peer steps down.
+func (d *delegate) resolve(currentTs uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.mu.initialized || d.mu.regionErr != nil {
+		return
+	}
+	resolvedTs := currentTs
+	for _, lock := range d.mu.locks {
+		if lock.ts < resolvedTs {
+			resolvedTs = lock.ts
+		}
+	}
+	// A transaction started before the last resolved ts may prewrite after it, its commit ts is still greater than
+	// the last resolved ts, so the resolved ts never goes back.
+	if resolvedTs > d.mu.resolvedTs {
+		d.mu.resolvedTs = resolvedTs
+		d.mu.unsentResolvedTs = resolvedTs
+		d.signal()
+	}
+}
+
+// take returns the events, the resolved ts and the region error to send.
+func (d *delegate) take() ([]*kvrpcpb.Event, uint64, *errorpb.Error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	events, resolvedTs := d.mu.events, d.mu.unsentResolvedTs
+	d.mu.events, d.mu.unsentResolvedTs = nil, 0
+	return events, resolvedTs, d.mu.regionErr
+}
+
+func isDefaultCF(cf string) bool {
+	return cf == "" || cf == engine_util.CfDefault
+}
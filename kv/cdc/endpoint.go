@@ -0,0 +1,154 @@
+package cdc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
+)
+
+// TSOClient allocates the timestamps which advance the resolved ts.
+type TSOClient interface {
+	GetTS(ctx context.Context) (int64, int64, error)
+}
+
+// Endpoint serves the change data subscriptions of a store. It observes the commands applied by the store and
+// dispatches the changes to the subscriptions of the regions.
+type Endpoint struct {
+	tso                TSOClient
+	resolvedTsInterval time.Duration
+
+	mu struct {
+		sync.Mutex
+		// region id -> subscriptions of the region
+		delegates map[uint64]map[*delegate]struct{}
+	}
+}
+
+var _ raftstore.CmdObserver = new(Endpoint)
+
+// NewEndpoint creates an Endpoint which sends the resolved ts to the subscribers every resolvedTsInterval.
+func NewEndpoint(tso TSOClient, resolvedTsInterval time.Duration) *Endpoint {
+	e := &Endpoint{tso: tso, resolvedTsInterval: resolvedTsInterval}
+	e.mu.delegates = make(map[uint64]map[*delegate]struct{})
+	return e
+}
+
+func (e *Endpoint) OnApplyCmd(region *metapb.Region, requests []*raft_cmdpb.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for d := range e.mu.delegates[region.GetId()] {
+		d.onApplyCmd(requests)
+	}
+}
+
+func (e *Endpoint) OnRegionChanged(region *metapb.Region) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for d := range e.mu.delegates[region.GetId()] {
+		d.onRegionChanged(region)
+	}
+}
+
+// OnRoleChanged stops the subscriptions of the region once the peer is not the leader. A peer which is not the leader
+// may not have applied the writes acknowledged by the leader, so it can't tell which transactions are resolved.
+func (e *Endpoint) OnRoleChanged(region *metapb.Region, isLeader bool) {
+	if isLeader {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for d := range e.mu.delegates[region.GetId()] {
+		d.stop(&errorpb.Error{
+			Message:   "peer is not leader",
+			NotLeader: &errorpb.NotLeader{RegionId: region.GetId()},
+		})
+	}
+}
+
+func (e *Endpoint) register(d *delegate) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.mu.delegates[d.regionID] == nil {
+		e.mu.delegates[d.regionID] = make(map[*delegate]struct{})
+	}
+	e.mu.delegates[d.regionID][d] = struct{}{}
+}
+
+func (e *Endpoint) deregister(d *delegate) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.mu.delegates[d.regionID], d)
+	if len(e.mu.delegates[d.regionID]) == 0 {
+		delete(e.mu.delegates, d.regionID)
+	}
+}
+
+// EventFeed serves a subscription until the client goes away or the region changes. The incremental scan reads a
+// snapshot of the region from innerServer.
+func (e *Endpoint) EventFeed(req *kvrpcpb.ChangeDataRequest, innerServer inner_server.InnerServer,
+	stream tinykvpb.TinyKv_EventFeedServer) error {
+	d := newDelegate(req)
+	e.register(d)
+	defer e.deregister(d)
+
+	// The snapshot is taken after the delegate is registered, so the commands applied after it are observed.
+	reader, err := innerServer.Reader(req.GetContext())
+	if err != nil {
+		if regionErr, ok := err.(*raft_server.RegionError); ok {
+			return stream.Send(&kvrpcpb.ChangeDataEvent{RegionId: d.regionID, RegionError: regionErr.RequestErr})
+		}
+		return err
+	}
+	events, locks, err := d.incrementalScan(reader)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+	d.initialize(events, locks)
+	log.Infof("cdc: region %d: subscribed [%q, %q) from ts %d, %d events scanned", d.regionID, d.startKey,
+		d.endKey, d.checkpointTs, len(events))
+
+	ticker := time.NewTicker(e.resolvedTsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-d.notify:
+		case <-ticker.C:
+			physical, logical, err := e.tso.GetTS(stream.Context())
+			if err != nil {
+				log.Warnf("cdc: region %d: get ts failed: %v", d.regionID, err)
+				continue
+			}
+			d.resolve(uint64(physical)<<tsoutil.PhysicalShiftBits + uint64(logical))
+		}
+
+		events, resolvedTs, regionErr := d.take()
+		if len(events) > 0 {
+			if err := stream.Send(&kvrpcpb.ChangeDataEvent{RegionId: d.regionID, Events: events}); err != nil {
+				return err
+			}
+		}
+		if resolvedTs > 0 {
+			if err := stream.Send(&kvrpcpb.ChangeDataEvent{RegionId: d.regionID, ResolvedTs: resolvedTs}); err != nil {
+				return err
+			}
+		}
+		if regionErr != nil {
+			log.Infof("cdc: region %d: subscription stopped: %v", d.regionID, regionErr)
+			return stream.Send(&kvrpcpb.ChangeDataEvent{RegionId: d.regionID, RegionError: regionErr})
+		}
+	}
+}
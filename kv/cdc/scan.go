@@ -0,0 +1,130 @@
+package cdc
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// incrementalScan reads the locks of the subscribed range and the writes committed after the checkpoint ts from the
+// snapshot. It returns the prewrite events of the locks followed by the commit events in order of the keys, the
+// versions of a key are in order of the commit ts.
+func (d *delegate) incrementalScan(reader inner_server.DBReader) ([]*kvrpcpb.Event, map[string]pendingLock, error) {
+	var events []*kvrpcpb.Event
+	locks := make(map[string]pendingLock)
+
+	lockIter := reader.IterCF(engine_util.CfLock)
+	defer lockIter.Close()
+	for ; lockIter.Valid(); lockIter.Next() {
+		item := lockIter.Item()
+		if !d.inRange(item.Key()) {
+			if engine_util.ExceedEndKey(item.Key(), d.endKey) {
+				break
+			}
+			continue
+		}
+		key := item.KeyCopy(nil)
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		lock, err := mvcc.ParseLock(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lock.Kind == mvcc.WriteKindLock {
+			// The key is not changed.
+			continue
+		}
+		var lockValue []byte
+		if lock.ShortValue != nil {
+			lockValue = lock.ShortValue
+		} else if lock.Kind == mvcc.WriteKindPut {
+			if lockValue, err = getCF(reader, engine_util.CfDefault, mvcc.EncodeKey(key, lock.Ts)); err != nil {
+				return nil, nil, err
+			}
+		}
+		op := kvrpcpb.Op_Put
+		if lock.Kind == mvcc.WriteKindDelete {
+			op = kvrpcpb.Op_Del
+		}
+		locks[string(key)] = pendingLock{ts: lock.Ts, op: op, value: lockValue}
+		events = append(events, &kvrpcpb.Event{
+			Type:    kvrpcpb.EventType_Prewrite,
+			Key:     key,
+			Value:   lockValue,
+			Op:      op,
+			StartTs: lock.Ts,
+			Primary: lock.Primary,
+			LockTtl: lock.Ttl,
+		})
+	}
+
+	// The versions of a key are stored in order of the commit ts descending.
+	var versions []*kvrpcpb.Event
+	flush := func() {
+		for i := len(versions) - 1; i >= 0; i-- {
+			events = append(events, versions[i])
+		}
+		versions = versions[:0]
+	}
+	writeIter := reader.IterCF(engine_util.CfWrite)
+	defer writeIter.Close()
+	for ; writeIter.Valid(); writeIter.Next() {
+		item := writeIter.Item()
+		userKey := mvcc.DecodeUserKey(item.Key())
+		if !d.inRange(userKey) {
+			if engine_util.ExceedEndKey(userKey, d.endKey) {
+				break
+			}
+			continue
+		}
+		commitTs := mvcc.DecodeTimestamp(item.Key())
+		if commitTs <= d.checkpointTs {
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		write, err := mvcc.ParseWrite(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if write.Kind == mvcc.WriteKindRollback || write.Kind == mvcc.WriteKindLock {
+			continue
+		}
+		if len(versions) > 0 && !bytes.Equal(versions[0].Key, userKey) {
+			flush()
+		}
+		event := &kvrpcpb.Event{
+			Type:     kvrpcpb.EventType_Commit,
+			Key:      userKey,
+			Op:       kvrpcpb.Op_Put,
+			StartTs:  write.StartTS,
+			CommitTs: commitTs,
+		}
+		if write.Kind == mvcc.WriteKindDelete {
+			event.Op = kvrpcpb.Op_Del
+		} else if write.ShortValue != nil {
+			event.Value = write.ShortValue
+		} else if event.Value, err = getCF(reader, engine_util.CfDefault, mvcc.EncodeKey(userKey, write.StartTS)); err != nil {
+			return nil, nil, err
+		}
+		versions = append(versions, event)
+	}
+	flush()
+	return events, locks, nil
+}
+
+// getCF reads the value of the key, nil if the key does not exist.
+func getCF(reader inner_server.DBReader, cf string, key []byte) ([]byte, error) {
+	value, err := reader.GetCF(cf, key)
+	if err == engine_util.ErrKeyNotFound {
+		return nil, nil
+	}
+	return value, err
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap/errors"
+)
+
+// BackoffType is the kind of failure a request backs off on, every kind has its own backoff policy.
+type BackoffType int
+
+const (
+	// BoPDRPC backs off on a failed request to the scheduler.
+	BoPDRPC BackoffType = iota
+	// BoStoreRPC backs off on a failed request to a store, e.g. the store is down or unreachable.
+	BoStoreRPC
+	// BoRegionMiss backs off on a region which is not found, or does not contain the key, in the store.
+	BoRegionMiss
+	// BoNotLeader backs off on a region whose leader is unknown or has changed, e.g. the region is electing.
+	BoNotLeader
+	// BoStaleCmd backs off on a command which is stale because of a leader change before it is applied.
+	BoStaleCmd
+	// BoTxnLock backs off on a key locked by a transaction which is neither committed nor expired.
+	BoTxnLock
+)
+
+func (t BackoffType) String() string {
+	switch t {
+	case BoPDRPC:
+		return "pdRPC"
+	case BoStoreRPC:
+		return "storeRPC"
+	case BoRegionMiss:
+		return "regionMiss"
+	case BoNotLeader:
+		return "notLeader"
+	case BoStaleCmd:
+		return "staleCommand"
+	case BoTxnLock:
+		return "txnLock"
+	}
+	return fmt.Sprintf("unknown(%d)", int(t))
+}
+
+// policy returns the base and the cap of the sleep time in milliseconds.
+func (t BackoffType) policy() (base, cap int) {
+	switch t {
+	case BoPDRPC:
+		return 500, 3000
+	case BoStoreRPC:
+		return 100, 2000
+	case BoRegionMiss:
+		return 2, 500
+	case BoNotLeader:
+		return 2, 500
+	case BoStaleCmd:
+		return 2, 1000
+	case BoTxnLock:
+		return 200, 3000
+	}
+	return 2, 1000
+}
+
+// Max total sleep time in milliseconds of a request.
+const (
+	rawkvMaxBackoff = 20000
+	tsoMaxBackoff   = 15000
+	getMaxBackoff   = 40000
+	scanMaxBackoff  = 40000
+	// prewrite may wait for the locks of other transactions to expire.
+	prewriteMaxBackoff = 40000
+	commitMaxBackoff   = 40000
+	cleanupMaxBackoff  = 20000
+	// backup may wait for the locks of other transactions to expire.
+	backupMaxBackoff  = 60000
+	restoreMaxBackoff = 60000
+	importMaxBackoff  = 60000
+)
+
+// Backoffer sleeps between the retries of a request with the exponential backoff policy of each kind of failure, and
+// gives up once the total sleep time exceeds the limit.
+type Backoffer struct {
+	ctx context.Context
+
+	attempts   map[BackoffType]int
+	maxSleep   int
+	totalSleep int
+	errors     []error
+}
+
+// NewBackoffer creates a Backoffer which sleeps at most maxSleep milliseconds in total.
+func NewBackoffer(ctx context.Context, maxSleep int) *Backoffer {
+	return &Backoffer{
+		ctx:      ctx,
+		attempts: make(map[BackoffType]int),
+		maxSleep: maxSleep,
+	}
+}
+
+// Context returns the context of the request.
+func (b *Backoffer) Context() context.Context {
+	return b.ctx
+}
+
+// Backoff sleeps for the failure err of kind typ. It returns an error containing all failures so far if the request
+// should not be retried any more, because the total sleep time exceeds the limit or the context is done.
+func (b *Backoffer) Backoff(typ BackoffType, err error) error {
+	select {
+	case <-b.ctx.Done():
+		return errors.Trace(b.ctx.Err())
+	default:
+	}
+
+	b.errors = append(b.errors, errors.Errorf("%s at %s: %v", typ, time.Now().Format(time.RFC3339Nano), err))
+	if b.maxSleep > 0 && b.totalSleep >= b.maxSleep {
+		return errors.Errorf("backoff exceeds the limit of %dms, errors: %v", b.maxSleep, b.errors)
+	}
+
+	// Equal jitter: sleep for half of the exponential time plus a random part of the other half.
+	base, cap := typ.policy()
+	attempts := b.attempts[typ]
+	b.attempts[typ] = attempts + 1
+	sleep := int(math.Min(float64(cap), float64(base)*math.Pow(2, float64(attempts))))
+	sleep = sleep/2 + rand.Intn(sleep/2+1)
+	log.Debugf("backoff %s for %dms: %v", typ, sleep, err)
+
+	select {
+	case <-time.After(time.Duration(sleep) * time.Millisecond):
+	case <-b.ctx.Done():
+		return errors.Trace(b.ctx.Err())
+	}
+	b.totalSleep += sleep
+	return nil
+}
+
+// Fork returns a Backoffer with the remaining sleep time of b, which is used by a concurrent part of the request.
+func (b *Backoffer) Fork() *Backoffer {
+	maxSleep := b.maxSleep
+	if maxSleep > 0 {
+		maxSleep -= b.totalSleep
+		if maxSleep <= 0 {
+			maxSleep = 1
+		}
+	}
+	return NewBackoffer(b.ctx, maxSleep)
+}
+
+// TotalSleep returns the total sleep time in milliseconds so far.
+func (b *Backoffer) TotalSleep() int {
+	return b.totalSleep
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// keyRange is [startKey, endKey), an empty endKey means the end of the key space.
+type keyRange struct {
+	startKey []byte
+	endKey   []byte
+}
+
+// regionScatterer is implemented by the scheduler clients which can scatter regions, e.g. scheduler/client.Client.
+type regionScatterer interface {
+	ScatterRegion(ctx context.Context, regionID uint64) error
+}
+
+// Backup backs up the data of [startKey, endKey) visible at backupTs to the storage at path, which every store must
+// see, e.g. a directory mounted by all of them. The leaders of the regions write the data files, then the manifest of
+// the files is written. A new timestamp is allocated if backupTs is 0.
+func (c *TxnClient) Backup(ctx context.Context, startKey, endKey []byte, backupTs uint64, path string) (*backup.Manifest, error) {
+	storage, err := backup.NewStorage(path)
+	if err != nil {
+		return nil, err
+	}
+	bo := NewBackoffer(ctx, backupMaxBackoff)
+	if backupTs == 0 {
+		if backupTs, err = c.getTimestamp(bo); err != nil {
+			return nil, err
+		}
+	}
+
+	// The backed up ranges in order, they do not overlap.
+	var done []*kvrpcpb.BackupResponse
+	pending := []keyRange{{startKey, endKey}}
+	for len(pending) > 0 {
+		var locks []*kvrpcpb.LockInfo
+		for _, r := range pending {
+			resps, err := c.backupRange(bo, r, backupTs, path)
+			if err != nil {
+				return nil, err
+			}
+			for _, resp := range resps {
+				if resp.GetRegionError() != nil {
+					continue
+				}
+				if keyErr := resp.GetError(); keyErr != nil {
+					if keyErr.GetLocked() == nil {
+						return nil, errors.Errorf("backup region %d: %v", resp.GetRegionId(), keyErr)
+					}
+					locks = append(locks, keyErr.GetLocked())
+					continue
+				}
+				done = addBackedUpRange(done, resp)
+			}
+		}
+		if pending = uncoveredRanges(done, startKey, endKey); len(pending) == 0 {
+			break
+		}
+		if len(locks) > 0 {
+			resolved, err := c.lockResolver.ResolveLocks(bo, locks)
+			if err != nil {
+				return nil, err
+			}
+			if resolved {
+				continue
+			}
+			err = errors.Errorf("key %q is locked by txn %d", locks[0].GetKey(), locks[0].GetLockVersion())
+			if err := bo.Backoff(BoTxnLock, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// The regions are changing or electing leaders.
+		if err := bo.Backoff(BoRegionMiss, errors.Errorf("%d ranges are not backed up", len(pending))); err != nil {
+			return nil, err
+		}
+	}
+
+	manifest := &backup.Manifest{StartKey: startKey, EndKey: endKey, BackupTs: backupTs}
+	for _, resp := range done {
+		manifest.Files = append(manifest.Files, resp.GetFiles()...)
+	}
+	if err := backup.WriteManifest(storage, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// backupRange sends the backup request of the range to the stores of the leaders of its regions, and returns the
+// responses of the regions. The failures of the stores are logged only, the caller backs up the missing ranges again.
+func (c *TxnClient) backupRange(bo *Backoffer, r keyRange, backupTs uint64, path string) ([]*kvrpcpb.BackupResponse, error) {
+	var resps []*kvrpcpb.BackupResponse
+	for {
+		_, peers, err := c.pdClient.ScanRegions(bo.Context(), r.startKey, r.endKey, 0)
+		if err == nil {
+			addrs := make(map[string]struct{})
+			for _, peer := range peers {
+				if peer.GetStoreId() == 0 {
+					continue
+				}
+				addr, err := c.regionCache.GetStoreAddr(bo, peer.GetStoreId())
+				if err != nil {
+					return nil, err
+				}
+				addrs[addr] = struct{}{}
+			}
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			req := &kvrpcpb.BackupRequest{StartKey: r.startKey, EndKey: r.endKey, BackupTs: backupTs, Path: path}
+			for addr := range addrs {
+				wg.Add(1)
+				go func(addr string) {
+					defer wg.Done()
+					storeResps, err := c.backupStore(bo.Context(), addr, req)
+					if err != nil {
+						log.Warnf("backup [%q, %q) on store %s failed: %v", r.startKey, r.endKey, addr, err)
+					}
+					mu.Lock()
+					resps = append(resps, storeResps...)
+					mu.Unlock()
+				}(addr)
+			}
+			wg.Wait()
+			return resps, nil
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backupStore sends the backup request to the store and receives the responses until the stream ends.
+func (c *TxnClient) backupStore(ctx context.Context, addr string, req *kvrpcpb.BackupRequest) ([]*kvrpcpb.BackupResponse, error) {
+	client, err := c.pool.getClient(addr)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.Backup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var resps []*kvrpcpb.BackupResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return resps, nil
+		}
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+}
+
+// addBackedUpRange adds the range of the response to the sorted ranges, unless it overlaps one of them, e.g. a region
+// is backed up by its old leader as well as the new one.
+func addBackedUpRange(done []*kvrpcpb.BackupResponse, resp *kvrpcpb.BackupResponse) []*kvrpcpb.BackupResponse {
+	i := sort.Search(len(done), func(i int) bool {
+		return bytes.Compare(done[i].GetStartKey(), resp.GetStartKey()) >= 0
+	})
+	if i > 0 && (len(done[i-1].GetEndKey()) == 0 || bytes.Compare(done[i-1].GetEndKey(), resp.GetStartKey()) > 0) {
+		return done
+	}
+	if i < len(done) && (len(resp.GetEndKey()) == 0 || bytes.Compare(resp.GetEndKey(), done[i].GetStartKey()) > 0) {
+		return done
+	}
+	done = append(done, nil)
+	copy(done[i+1:], done[i:])
+	done[i] = resp
+	return done
+}
+
+// uncoveredRanges returns the parts of [startKey, endKey) which are not covered by the sorted ranges.
+func uncoveredRanges(done []*kvrpcpb.BackupResponse, startKey, endKey []byte) []keyRange {
+	var ranges []keyRange
+	key := startKey
+	for _, resp := range done {
+		if bytes.Compare(key, resp.GetStartKey()) < 0 {
+			ranges = append(ranges, keyRange{key, resp.GetStartKey()})
+		}
+		if len(resp.GetEndKey()) == 0 {
+			return ranges
+		}
+		key = resp.GetEndKey()
+	}
+	if len(endKey) == 0 || bytes.Compare(key, endKey) < 0 {
+		ranges = append(ranges, keyRange{key, endKey})
+	}
+	return ranges
+}
+
+// Restore restores the backup at path. The regions are split at the boundaries of the backup files and scattered
+// first, then the data of the files is imported into the regions: the data of each region is built into a file, which
+// is uploaded to the stores of the region and ingested through Raft. The stores are in the import mode during the
+// restore. It returns the manifest of the backup.
+func (c *TxnClient) Restore(ctx context.Context, path string) (*backup.Manifest, error) {
+	storage, err := backup.NewStorage(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := backup.ReadManifest(storage)
+	if err != nil {
+		return nil, err
+	}
+	bo := NewBackoffer(ctx, restoreMaxBackoff)
+	var splitKeys [][]byte
+	for _, file := range manifest.Files {
+		splitKeys = append(splitKeys, file.GetStartKey())
+	}
+	splitKeys = append(splitKeys, manifest.EndKey)
+	if err := c.splitRegions(bo, splitKeys); err != nil {
+		return nil, err
+	}
+	if err := c.SwitchImportMode(ctx, manifest.StartKey, manifest.EndKey, import_sstpb.SwitchMode_Import); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := c.SwitchImportMode(ctx, manifest.StartKey, manifest.EndKey, import_sstpb.SwitchMode_Normal); err != nil {
+			log.Warnf("switch the stores back to the normal mode failed: %v", err)
+		}
+	}()
+	for _, file := range manifest.Files {
+		if err := c.restoreFile(bo, storage, file); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// splitRegions splits the regions so that every key is the start key of a region. The new regions are scattered if
+// the scheduler client supports it.
+func (c *TxnClient) splitRegions(bo *Backoffer, keys [][]byte) error {
+	for {
+		groups := make(map[RegionVerID][][]byte)
+		for _, key := range keys {
+			if len(key) == 0 {
+				continue
+			}
+			loc, err := c.regionCache.LocateKey(bo, key)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(loc.StartKey, key) {
+				groups[loc.Region] = append(groups[loc.Region], key)
+			}
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+		for regionID, splitKeys := range groups {
+			if err := c.splitRegion(bo, regionID, splitKeys); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *TxnClient) splitRegion(bo *Backoffer, regionID RegionVerID, splitKeys [][]byte) error {
+	resp, err := c.sender().SendReq(bo, &kvrpcpb.SplitRegionRequest{SplitKeys: splitKeys}, regionID, readTimeout)
+	if err != nil {
+		return err
+	}
+	splitResp := resp.(*kvrpcpb.SplitRegionResponse)
+	if splitResp.GetRegionError() != nil {
+		// The sender has updated the cache or backed off.
+		return nil
+	}
+	if splitResp.GetError() != "" {
+		return errors.Errorf("split region %d: %s", regionID.ID, splitResp.GetError())
+	}
+	c.regionCache.InvalidateRegion(regionID)
+	if scatterer, ok := c.pdClient.(regionScatterer); ok {
+		for _, region := range splitResp.GetRegions() {
+			if err := scatterer.ScatterRegion(bo.Context(), region.GetId()); err != nil {
+				log.Warnf("scatter region %d failed: %v", region.GetId(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreFile imports the data of the file into each region of its range.
+func (c *TxnClient) restoreFile(bo *Backoffer, storage backup.ExternalStorage, file *kvrpcpb.BackupFile) error {
+	dataFile, err := backup.ReadDataFile(storage, file)
+	if err != nil {
+		return err
+	}
+	key := file.GetStartKey()
+	for {
+		loc, err := c.regionCache.LocateKey(bo, key)
+		if err != nil {
+			return err
+		}
+		endKey := file.GetEndKey()
+		if len(loc.EndKey) > 0 && (len(endKey) == 0 || bytes.Compare(loc.EndKey, endKey) < 0) {
+			endKey = loc.EndKey
+		}
+		data, meta, err := dataFile.BuildSST(key, endKey)
+		if err != nil {
+			return err
+		}
+		if meta != nil {
+			if err := c.ImportSST(bo.Context(), data, meta); err != nil {
+				if errors.Cause(err) != errSSTNotInRegion {
+					return errors.Annotatef(err, "restore file %s", file.GetName())
+				}
+				// The region has split since it is located, build the file for the new region.
+				c.regionCache.InvalidateRegion(loc.Region)
+				if err := bo.Backoff(BoRegionMiss, err); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if bytes.Equal(endKey, file.GetEndKey()) {
+			return nil
+		}
+		key = endKey
+	}
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tinykv_backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	for i := 0; i < 20; i++ {
+		require.Nil(t, txn.Set([]byte(fmt.Sprintf("k%02d", i)), []byte(fmt.Sprintf("v%02d", i))))
+	}
+	require.Nil(t, txn.Commit())
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Delete([]byte("k03")))
+	require.Nil(t, txn.Set([]byte("k04"), []byte("v04-2")))
+	require.Nil(t, txn.Commit())
+	cluster.MustSplitRegion([]byte("k10"))
+
+	// Only the primary of a transaction is committed, the backup resolves the lock of the secondary.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k05"), []byte("v05-2")))
+	require.Nil(t, txn.Set([]byte("k15"), []byte("v15-2")))
+	committer := newTwoPhaseCommitter(txn)
+	require.Nil(t, committer.prewriteKeys(NewBackoffer(ctx, prewriteMaxBackoff), committer.keys))
+	committer.commitTS, err = client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	require.Nil(t, committer.commitKeys(NewBackoffer(ctx, commitMaxBackoff), [][]byte{committer.primary()}))
+
+	backupTs, err := client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	// A transaction committed after the backup ts.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k06"), []byte("v06-2")))
+	require.Nil(t, txn.Commit())
+
+	manifest, err := client.Backup(ctx, []byte("k01"), []byte("k18"), backupTs, dir)
+	require.Nil(t, err)
+	assert.Equal(t, backupTs, manifest.BackupTs)
+	require.Equal(t, 2, len(manifest.Files))
+	assert.Equal(t, []byte("k01"), manifest.Files[0].StartKey)
+	assert.Equal(t, []byte("k10"), manifest.Files[0].EndKey)
+	assert.Equal(t, []byte("k10"), manifest.Files[1].StartKey)
+	assert.Equal(t, []byte("k18"), manifest.Files[1].EndKey)
+	assert.Equal(t, uint64(8), manifest.Files[0].TotalKvs)
+	assert.Equal(t, uint64(8), manifest.Files[1].TotalKvs)
+	shutdown()
+
+	// Restore to a new cluster.
+	_, client, shutdown = newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	_, err = client.Restore(ctx, dir)
+	require.Nil(t, err)
+	loc, err := client.regionCache.LocateKey(NewBackoffer(ctx, getMaxBackoff), []byte("k10"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("k10"), loc.StartKey)
+
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	iter, err := txn.Iter(nil, nil)
+	require.Nil(t, err)
+	defer iter.Close()
+	var pairs []string
+	for ; iter.Valid(); require.Nil(t, iter.Next()) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", iter.Key(), iter.Value()))
+	}
+	expected := []string{"k01=v01", "k02=v02", "k04=v04-2", "k05=v05-2", "k06=v06", "k07=v07", "k08=v08", "k09=v09",
+		"k10=v10", "k11=v11", "k12=v12", "k13=v13", "k14=v14", "k15=v15-2", "k16=v16", "k17=v17"}
+	assert.Equal(t, expected, pairs)
+}
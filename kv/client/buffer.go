@@ -0,0 +1,67 @@
+package client
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/btree"
+)
+
+var _ btree.Item = &bufferItem{}
+
+// bufferItem is a write of a transaction, a nil value is a deletion.
+type bufferItem struct {
+	key   []byte
+	value []byte
+}
+
+// Less returns true if the key is less than the other.
+func (i *bufferItem) Less(other btree.Item) bool {
+	return bytes.Compare(i.key, other.(*bufferItem).key) < 0
+}
+
+// memBuffer keeps the writes of a transaction in order until it commits.
+type memBuffer struct {
+	items *btree.BTree
+}
+
+func newMemBuffer() *memBuffer {
+	return &memBuffer{items: btree.New(32)}
+}
+
+// Get returns the value written to the key, the deleted flag is set if the write is a deletion, ok is false if the
+// key is not written.
+func (b *memBuffer) Get(key []byte) (value []byte, deleted bool, ok bool) {
+	item := b.items.Get(&bufferItem{key: key})
+	if item == nil {
+		return nil, false, false
+	}
+	value = item.(*bufferItem).value
+	return value, value == nil, true
+}
+
+// Set writes the value of the key.
+func (b *memBuffer) Set(key, value []byte) {
+	b.items.ReplaceOrInsert(&bufferItem{key: append([]byte(nil), key...), value: append([]byte{}, value...)})
+}
+
+// Delete writes a deletion of the key.
+func (b *memBuffer) Delete(key []byte) {
+	b.items.ReplaceOrInsert(&bufferItem{key: append([]byte(nil), key...)})
+}
+
+// Len returns the number of written keys.
+func (b *memBuffer) Len() int {
+	return b.items.Len()
+}
+
+// Walk calls f with the writes in order of the keys in [startKey, endKey), an empty endKey means no upper bound. It
+// stops if f returns false.
+func (b *memBuffer) Walk(startKey, endKey []byte, f func(key, value []byte) bool) {
+	b.items.AscendGreaterOrEqual(&bufferItem{key: startKey}, func(i btree.Item) bool {
+		item := i.(*bufferItem)
+		if len(endKey) > 0 && bytes.Compare(item.key, endKey) >= 0 {
+			return false
+		}
+		return f(item.key, item.value)
+	})
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// subscribe opens a change data stream of the whole region of key from the checkpoint ts.
+func subscribe(t *testing.T, ctx context.Context, client *TxnClient, key []byte, checkpointTs uint64) tinykvpb.TinyKv_EventFeedClient {
+	bo := NewBackoffer(ctx, getMaxBackoff)
+	loc, err := client.regionCache.LocateKey(bo, key)
+	require.Nil(t, err)
+	rpcCtx, err := client.regionCache.GetRPCContext(bo, loc.Region)
+	require.Nil(t, err)
+	kvClient, err := client.pool.getClient(rpcCtx.Addr)
+	require.Nil(t, err)
+	stream, err := kvClient.EventFeed(ctx, &kvrpcpb.ChangeDataRequest{
+		Context:      rpcCtx.KvContext(),
+		StartKey:     loc.StartKey,
+		EndKey:       loc.EndKey,
+		CheckpointTs: checkpointTs,
+	})
+	require.Nil(t, err)
+	return stream
+}
+
+// recvEvents receives count events, the resolved ts received meanwhile are dropped.
+func recvEvents(t *testing.T, stream tinykvpb.TinyKv_EventFeedClient, count int) []*kvrpcpb.Event {
+	var events []*kvrpcpb.Event
+	for len(events) < count {
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		require.Nil(t, resp.GetRegionError())
+		events = append(events, resp.GetEvents()...)
+	}
+	require.Equal(t, count, len(events))
+	return events
+}
+
+// recvResolvedTs receives until a resolved ts no less than minTs, there must be no event meanwhile.
+func recvResolvedTs(t *testing.T, stream tinykvpb.TinyKv_EventFeedClient, minTs uint64) uint64 {
+	for {
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		require.Nil(t, resp.GetRegionError())
+		require.Equal(t, 0, len(resp.GetEvents()))
+		if resp.GetResolvedTs() >= minTs {
+			return resp.GetResolvedTs()
+		}
+	}
+}
+
+func TestEventFeed(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// A committed transaction and a pending one before the subscription.
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k1"), []byte("v1")))
+	require.Nil(t, txn.Commit())
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k2"), []byte("v2")))
+	pending := newTwoPhaseCommitter(txn)
+	require.Nil(t, pending.prewriteKeys(NewBackoffer(ctx, prewriteMaxBackoff), pending.keys))
+
+	// The incremental scan sends the lock and the committed write.
+	stream := subscribe(t, ctx, client, []byte("k1"), 0)
+	events := recvEvents(t, stream, 3)
+	assert.Equal(t, kvrpcpb.EventType_Prewrite, events[0].Type)
+	assert.Equal(t, []byte("k2"), events[0].Key)
+	assert.Equal(t, []byte("v2"), events[0].Value)
+	assert.Equal(t, pending.startTS, events[0].StartTs)
+	assert.Equal(t, kvrpcpb.EventType_Commit, events[1].Type)
+	assert.Equal(t, []byte("k1"), events[1].Key)
+	assert.Equal(t, []byte("v1"), events[1].Value)
+	assert.Equal(t, kvrpcpb.EventType_Initialized, events[2].Type)
+
+	// The pending lock holds back the resolved ts.
+	assert.Equal(t, pending.startTS, recvResolvedTs(t, stream, 1))
+
+	pending.commitTS, err = client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	require.Nil(t, pending.commitKeys(NewBackoffer(ctx, commitMaxBackoff), pending.keys))
+	events = recvEvents(t, stream, 1)
+	assert.Equal(t, kvrpcpb.EventType_Commit, events[0].Type)
+	assert.Equal(t, []byte("k2"), events[0].Key)
+	assert.Equal(t, []byte("v2"), events[0].Value)
+	assert.Equal(t, pending.startTS, events[0].StartTs)
+	assert.Equal(t, pending.commitTS, events[0].CommitTs)
+
+	// A rolled back transaction.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Delete([]byte("k3")))
+	rolledBack := newTwoPhaseCommitter(txn)
+	require.Nil(t, rolledBack.prewriteKeys(NewBackoffer(ctx, prewriteMaxBackoff), rolledBack.keys))
+	require.Nil(t, rolledBack.rollbackKeys(NewBackoffer(ctx, cleanupMaxBackoff), rolledBack.keys))
+	events = recvEvents(t, stream, 2)
+	assert.Equal(t, kvrpcpb.EventType_Prewrite, events[0].Type)
+	assert.Equal(t, kvrpcpb.Op_Del, events[0].Op)
+	assert.Equal(t, kvrpcpb.EventType_RollbackLock, events[1].Type)
+	assert.Equal(t, []byte("k3"), events[1].Key)
+	assert.Equal(t, rolledBack.startTS, events[1].StartTs)
+
+	// Without locks the resolved ts passes the last commit.
+	recvResolvedTs(t, stream, rolledBack.startTS+1)
+
+	// A split stops the subscription.
+	cluster.MustSplitRegion([]byte("k2"))
+	for {
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		if regionErr := resp.GetRegionError(); regionErr != nil {
+			assert.NotNil(t, regionErr.GetEpochNotMatch())
+			break
+		}
+	}
+}
+
+func TestEventFeedLeaderChange(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k1"), []byte("v1")))
+	require.Nil(t, txn.Commit())
+	stream := subscribe(t, ctx, client, []byte("k1"), 0)
+	events := recvEvents(t, stream, 2)
+	assert.Equal(t, kvrpcpb.EventType_Initialized, events[1].Type)
+
+	// The old leader may miss the writes acknowledged by the new one, so its subscription stops with NotLeader.
+	region := cluster.GetRegion([]byte("k1"))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	for _, peer := range region.GetPeers() {
+		if peer.GetStoreId() != leader.GetStoreId() {
+			cluster.PDClient().TransferLeader(region.GetId(), peer)
+			break
+		}
+	}
+	for {
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		if regionErr := resp.GetRegionError(); regionErr != nil {
+			assert.NotNil(t, regionErr.GetNotLeader())
+			break
+		}
+	}
+
+	// The subscription on the new leader goes on.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k2"), []byte("v2")))
+	require.Nil(t, txn.Commit())
+	stream = subscribe(t, ctx, client, []byte("k1"), 0)
+	events = recvEvents(t, stream, 3)
+	assert.Equal(t, kvrpcpb.EventType_Initialized, events[2].Type)
+}
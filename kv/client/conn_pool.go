@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// connsPerStore is the number of connections to every store, the requests to a store are spread over them.
+	connsPerStore = 4
+	dialTimeout   = 5 * time.Second
+)
+
+// connArray is the connections to a store.
+type connArray struct {
+	index uint32
+	conns []*grpc.ClientConn
+}
+
+func newConnArray(addr string, size int) (*connArray, error) {
+	a := &connArray{conns: make([]*grpc.ClientConn, 0, size)}
+	for i := 0; i < size; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+		conn, err := grpc.DialContext(ctx, addr,
+			grpc.WithInsecure(),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:    10 * time.Second,
+				Timeout: 3 * time.Second,
+			}))
+		cancel()
+		if err != nil {
+			a.close()
+			return nil, errors.Annotatef(err, "dial %s", addr)
+		}
+		a.conns = append(a.conns, conn)
+	}
+	return a, nil
+}
+
+// get returns the connections in turn.
+func (a *connArray) get() *grpc.ClientConn {
+	next := atomic.AddUint32(&a.index, 1) % uint32(len(a.conns))
+	return a.conns[next]
+}
+
+func (a *connArray) close() {
+	for _, conn := range a.conns {
+		conn.Close()
+	}
+}
+
+// connPool keeps the connections to the stores by their addresses.
+type connPool struct {
+	sync.RWMutex
+	conns  map[string]*connArray
+	closed bool
+}
+
+func newConnPool() *connPool {
+	return &connPool{conns: make(map[string]*connArray)}
+}
+
+// getClient returns a client of the store at addr, it connects to the store for the first time.
+func (p *connPool) getClient(addr string) (tinykvpb.TinyKvClient, error) {
+	conn, err := p.getConn(addr)
+	if err != nil {
+		return nil, err
+	}
+	return tinykvpb.NewTinyKvClient(conn), nil
+}
+
+// getImportClient returns a client of the ImportSST service of the store at addr.
+func (p *connPool) getImportClient(addr string) (import_sstpb.ImportSSTClient, error) {
+	conn, err := p.getConn(addr)
+	if err != nil {
+		return nil, err
+	}
+	return import_sstpb.NewImportSSTClient(conn), nil
+}
+
+func (p *connPool) getConn(addr string) (*grpc.ClientConn, error) {
+	p.RLock()
+	if p.closed {
+		p.RUnlock()
+		return nil, errors.New("connection pool is closed")
+	}
+	array, ok := p.conns[addr]
+	p.RUnlock()
+	if ok {
+		return array.get(), nil
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		return nil, errors.New("connection pool is closed")
+	}
+	if array, ok = p.conns[addr]; !ok {
+		var err error
+		if array, err = newConnArray(addr, connsPerStore); err != nil {
+			return nil, err
+		}
+		p.conns[addr] = array
+	}
+	return array.get(), nil
+}
+
+func (p *connPool) close() {
+	p.Lock()
+	defer p.Unlock()
+	p.closed = true
+	for _, array := range p.conns {
+		array.close()
+	}
+	p.conns = nil
+}
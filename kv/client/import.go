@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/errors"
+)
+
+// uploadChunkSize is the size of the data in an upload message.
+const uploadChunkSize = 1 << 20
+
+// errSSTNotInRegion is returned by ImportSST if the keys of the file are not in one region.
+var errSSTNotInRegion = errors.New("sst is not in one region")
+
+// SwitchImportMode switches the stores of the regions in [startKey, endKey) to the mode. The split checks of the
+// stores are paused and their raft logs are compacted less often in the import mode, so the regions should be split
+// before the import.
+func (c *TxnClient) SwitchImportMode(ctx context.Context, startKey, endKey []byte, mode import_sstpb.SwitchMode) error {
+	bo := NewBackoffer(ctx, importMaxBackoff)
+	var regions []*metapb.Region
+	for {
+		var err error
+		if regions, _, err = c.pdClient.ScanRegions(bo.Context(), startKey, endKey, 0); err == nil {
+			break
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return err
+		}
+	}
+	switched := make(map[uint64]bool)
+	for _, region := range regions {
+		for _, peer := range region.GetPeers() {
+			if switched[peer.GetStoreId()] {
+				continue
+			}
+			addr, err := c.regionCache.GetStoreAddr(bo, peer.GetStoreId())
+			if err != nil {
+				return err
+			}
+			client, err := c.pool.getImportClient(addr)
+			if err != nil {
+				return err
+			}
+			if _, err := client.SwitchMode(ctx, &import_sstpb.SwitchModeRequest{Mode: mode}); err != nil {
+				return errors.Annotatef(err, "switch store %d to %s mode", peer.GetStoreId(), mode)
+			}
+			switched[peer.GetStoreId()] = true
+		}
+	}
+	return nil
+}
+
+// ImportSST loads a file built by importer.SSTBuilder into the region of its keys without the transactional write
+// path, all the keys of the file must be in one region. The file is uploaded to the stores of all the peers of the
+// region, then the leader ingests it through Raft. The file is uploaded again if the region changes before that.
+func (c *TxnClient) ImportSST(ctx context.Context, data []byte, meta *import_sstpb.SSTMeta) error {
+	bo := NewBackoffer(ctx, importMaxBackoff)
+	for {
+		loc, err := c.regionCache.LocateKey(bo, meta.GetStartKey())
+		if err != nil {
+			return err
+		}
+		if !loc.Contains(meta.GetEndKey()) {
+			return errors.Annotatef(errSSTNotInRegion, "the range [%q, %q] of the file is not in region %d [%q, %q)",
+				meta.GetStartKey(), meta.GetEndKey(), loc.Region.ID, loc.StartKey, loc.EndKey)
+		}
+		rpcCtx, err := c.regionCache.GetRPCContext(bo, loc.Region)
+		if err != nil {
+			return err
+		}
+		if rpcCtx == nil {
+			continue
+		}
+
+		sst := *meta
+		sst.Uuid = make([]byte, 16)
+		if _, err := rand.Read(sst.Uuid); err != nil {
+			return errors.WithStack(err)
+		}
+		sst.RegionId = rpcCtx.Meta.GetId()
+		sst.RegionEpoch = rpcCtx.Meta.GetRegionEpoch()
+		if err := c.uploadSST(bo, rpcCtx.Meta, data, &sst); err != nil {
+			if err := bo.Backoff(BoStoreRPC, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if done, err := c.ingestSST(bo, rpcCtx, &sst); done || err != nil {
+			return err
+		}
+	}
+}
+
+// uploadSST uploads the file to the stores of all the peers of the region.
+func (c *TxnClient) uploadSST(bo *Backoffer, region *metapb.Region, data []byte, sst *import_sstpb.SSTMeta) error {
+	for _, peer := range region.GetPeers() {
+		addr, err := c.regionCache.GetStoreAddr(bo, peer.GetStoreId())
+		if err != nil {
+			return err
+		}
+		if err := c.uploadSSTToStore(bo.Context(), addr, data, sst); err != nil {
+			c.regionCache.InvalidateStore(peer.GetStoreId())
+			return errors.Annotatef(err, "upload sst to store %d at %s", peer.GetStoreId(), addr)
+		}
+	}
+	return nil
+}
+
+func (c *TxnClient) uploadSSTToStore(ctx context.Context, addr string, data []byte, sst *import_sstpb.SSTMeta) error {
+	client, err := c.pool.getImportClient(addr)
+	if err != nil {
+		return err
+	}
+	stream, err := client.Upload(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&import_sstpb.UploadRequest{Chunk: &import_sstpb.UploadRequest_Meta{Meta: sst}}); err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		n := uploadChunkSize
+		if n > len(data) {
+			n = len(data)
+		}
+		if err := stream.Send(&import_sstpb.UploadRequest{Chunk: &import_sstpb.UploadRequest_Data{Data: data[:n]}}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// ingestSST asks the leader of the region to ingest the uploaded file, it returns false if the file must be uploaded
+// again for the new version of the region.
+func (c *TxnClient) ingestSST(bo *Backoffer, rpcCtx *RPCContext, sst *import_sstpb.SSTMeta) (bool, error) {
+	sender := c.sender()
+	for rpcCtx != nil {
+		client, err := c.pool.getImportClient(rpcCtx.Addr)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Ingest(bo.Context(), &import_sstpb.IngestRequest{Context: rpcCtx.KvContext(), Sst: sst})
+		if err != nil {
+			if err := sender.onSendFail(bo, rpcCtx, err); err != nil {
+				return false, err
+			}
+		} else if resp.GetError() == nil {
+			return true, nil
+		} else if retry, err := sender.onRegionError(bo, rpcCtx, resp.GetError()); err != nil || !retry {
+			return false, err
+		}
+		if rpcCtx, err = c.regionCache.GetRPCContext(bo, rpcCtx.Region); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
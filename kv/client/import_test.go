@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildImportSST builds a file of the committed values of the keys.
+func buildImportSST(t *testing.T, keys []string, startTs, commitTs uint64) ([]byte, *import_sstpb.SSTMeta) {
+	builder, err := importer.NewSSTBuilder()
+	require.Nil(t, err)
+	for _, key := range keys {
+		value := []byte(fmt.Sprintf("%s-imported", key))
+		require.Nil(t, builder.Add(engine_util.CfDefault, mvcc.EncodeKey([]byte(key), startTs), value))
+	}
+	write := mvcc.Write{StartTS: startTs, Kind: mvcc.WriteKindPut}
+	for _, key := range keys {
+		require.Nil(t, builder.Add(engine_util.CfWrite, mvcc.EncodeKey([]byte(key), commitTs), write.ToBytes()))
+	}
+	data, meta, err := builder.Finish()
+	require.Nil(t, err)
+	return data, meta
+}
+
+func TestImportSST(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	cluster.MustSplitRegion([]byte("k10"))
+	require.Nil(t, client.SwitchImportMode(ctx, nil, nil, import_sstpb.SwitchMode_Import))
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k05"), []byte("v05")))
+	require.Nil(t, txn.Commit())
+
+	startTs, err := client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	commitTs, err := client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, fmt.Sprintf("k%02d", i))
+	}
+	data, meta := buildImportSST(t, keys, startTs, commitTs)
+	require.Nil(t, client.ImportSST(ctx, data, meta))
+
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	for _, key := range keys {
+		assert.Equal(t, key+"-imported", mustGetTxn(t, txn, key))
+	}
+	// Every replica ingests the file.
+	readTs, err := client.getTimestamp(NewBackoffer(ctx, tsoMaxBackoff))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"k05-imported", "k05-imported", "k05-imported"}, mustStaleGet(t, client, []byte("k05"), readTs))
+
+	// The keys of a file must be in one region.
+	data, meta = buildImportSST(t, []string{"k09", "k11"}, startTs, commitTs)
+	assert.NotNil(t, client.ImportSST(ctx, data, meta))
+	require.Nil(t, client.SwitchImportMode(ctx, nil, nil, import_sstpb.SwitchMode_Normal))
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// TxnStatus is the status of a transaction given by the primary key. It is committed if the commit ts is not 0,
+// still locked if the ttl is not 0, or rolled back otherwise.
+type TxnStatus struct {
+	CommitTS uint64
+	TTL      uint64
+}
+
+// IsCommitted returns whether the transaction is committed.
+func (s TxnStatus) IsCommitted() bool {
+	return s.CommitTS > 0
+}
+
+// IsLocked returns whether the transaction is neither committed nor rolled back.
+func (s TxnStatus) IsLocked() bool {
+	return s.CommitTS == 0 && s.TTL > 0
+}
+
+// LockResolver resolves the locks left by other transactions: a lock is committed or rolled back following the
+// status of its primary key, which is rolled back if the lock expires.
+type LockResolver struct {
+	client *TxnClient
+
+	mu struct {
+		sync.Mutex
+		// start ts -> status of the committed or rolled back transactions
+		resolved map[uint64]TxnStatus
+	}
+}
+
+func newLockResolver(client *TxnClient) *LockResolver {
+	lr := &LockResolver{client: client}
+	lr.mu.resolved = make(map[uint64]TxnStatus)
+	return lr
+}
+
+// ResolveLocks tries to resolve the locks. It returns false if some lock is not resolved since its transaction is
+// alive, the caller should back off and try again.
+func (lr *LockResolver) ResolveLocks(bo *Backoffer, locks []*kvrpcpb.LockInfo) (bool, error) {
+	if len(locks) == 0 {
+		return true, nil
+	}
+	currentTS, err := lr.client.getTimestamp(bo)
+	if err != nil {
+		return false, err
+	}
+
+	allResolved := true
+	// The regions resolved for each transaction, ResolveLock resolves all locks of a transaction in the region.
+	cleaned := make(map[uint64]map[RegionVerID]struct{})
+	for _, lock := range locks {
+		status, err := lr.GetTxnStatus(bo, lock.GetPrimaryLock(), lock.GetLockVersion(), currentTS)
+		if err != nil {
+			return false, err
+		}
+		if status.IsLocked() {
+			allResolved = false
+			continue
+		}
+		if cleaned[lock.GetLockVersion()] == nil {
+			cleaned[lock.GetLockVersion()] = make(map[RegionVerID]struct{})
+		}
+		if err := lr.resolveLock(bo, lock, status, cleaned[lock.GetLockVersion()]); err != nil {
+			return false, err
+		}
+	}
+	return allResolved, nil
+}
+
+// GetTxnStatus returns the status of the transaction with the primary key, it is rolled back if the primary lock
+// has expired at currentTS.
+func (lr *LockResolver) GetTxnStatus(bo *Backoffer, primary []byte, startTS, currentTS uint64) (TxnStatus, error) {
+	lr.mu.Lock()
+	status, ok := lr.mu.resolved[startTS]
+	lr.mu.Unlock()
+	if ok {
+		return status, nil
+	}
+
+	req := &kvrpcpb.CheckTxnStatusRequest{PrimaryKey: primary, LockTs: startTS, CurrentTs: currentTS}
+	resp, _, err := lr.client.sender().SendReqByKey(bo, primary, req)
+	if err != nil {
+		return status, err
+	}
+	statusResp := resp.(*kvrpcpb.CheckTxnStatusResponse)
+	status = TxnStatus{CommitTS: statusResp.GetCommitVersion(), TTL: statusResp.GetLockTtl()}
+	if !status.IsLocked() {
+		// The status of a committed or rolled back transaction never changes.
+		lr.mu.Lock()
+		lr.mu.resolved[startTS] = status
+		lr.mu.Unlock()
+	}
+	return status, nil
+}
+
+// resolveLock commits or rolls back the locks of the transaction in the region of the lock.
+func (lr *LockResolver) resolveLock(bo *Backoffer, lock *kvrpcpb.LockInfo, status TxnStatus, cleaned map[RegionVerID]struct{}) error {
+	for {
+		loc, err := lr.client.regionCache.LocateKey(bo, lock.GetKey())
+		if err != nil {
+			return err
+		}
+		if _, ok := cleaned[loc.Region]; ok {
+			return nil
+		}
+		req := &kvrpcpb.ResolveLockRequest{StartVersion: lock.GetLockVersion(), CommitVersion: status.CommitTS}
+		resp, err := lr.client.sender().SendReq(bo, req, loc.Region, readTimeout)
+		if err != nil {
+			return err
+		}
+		if resp.GetRegionError() != nil {
+			continue
+		}
+		if keyErr := resp.(*kvrpcpb.ResolveLockResponse).GetError(); keyErr != nil {
+			return errors.Errorf("resolve lock %v: %v", lock, keyErr)
+		}
+		log.Debugf("resolved locks of txn %d in region %d, commit ts %d", lock.GetLockVersion(), loc.Region.ID,
+			status.CommitTS)
+		cleaned[loc.Region] = struct{}{}
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
+	"github.com/pingcap/errors"
+)
+
+// MaxRawScanLimit is the maximum number of pairs a raw scan returns.
+const MaxRawScanLimit = 10240
+
+// RawKVClient is a client of the raw key-value API of TinyKV. It routes every request to the leader of the region of
+// the keys and retries on the region errors, a scan may cross region boundaries.
+type RawKVClient struct {
+	cf          string
+	regionCache *RegionCache
+	pool        *connPool
+	closePD     func()
+}
+
+// NewRawKVClient creates a RawKVClient of the cluster served by the scheduler at pdAddrs.
+func NewRawKVClient(pdAddrs []string, security pd.SecurityOption) (*RawKVClient, error) {
+	pdClient, err := pd.NewClient(pdAddrs, security)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	c := NewRawKVClientWithPD(pdClient)
+	c.closePD = pdClient.Close
+	return c, nil
+}
+
+// NewRawKVClientWithPD creates a RawKVClient which locates the regions by pdClient, the caller keeps the ownership of
+// pdClient.
+func NewRawKVClientWithPD(pdClient PDClient) *RawKVClient {
+	return &RawKVClient{
+		cf:          engine_util.CfDefault,
+		regionCache: NewRegionCache(pdClient),
+		pool:        newConnPool(),
+	}
+}
+
+// WithColumnFamily returns a client sharing the connections and the region cache with c which reads and writes the
+// column family cf. It must not be closed, close c instead.
+func (c *RawKVClient) WithColumnFamily(cf string) *RawKVClient {
+	client := *c
+	client.cf = cf
+	client.closePD = nil
+	return &client
+}
+
+// Close closes the connections of the client.
+func (c *RawKVClient) Close() error {
+	c.pool.close()
+	if c.closePD != nil {
+		c.closePD()
+	}
+	return nil
+}
+
+// Get returns the value of the key, it is nil if the key does not exist.
+func (c *RawKVClient) Get(key []byte) ([]byte, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	req := &kvrpcpb.RawGetRequest{Key: key, Cf: c.cf}
+	resp, _, err := c.sendReq(key, req)
+	if err != nil {
+		return nil, err
+	}
+	getResp := resp.(*kvrpcpb.RawGetResponse)
+	if getResp.GetError() != "" {
+		return nil, errors.New(getResp.GetError())
+	}
+	if getResp.GetNotFound() {
+		return nil, nil
+	}
+	return getResp.GetValue(), nil
+}
+
+// Put sets the value of the key.
+func (c *RawKVClient) Put(key, value []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		// An empty value is a deletion in the engines.
+		return errors.New("empty value is not supported")
+	}
+	req := &kvrpcpb.RawPutRequest{Key: key, Value: value, Cf: c.cf}
+	resp, _, err := c.sendReq(key, req)
+	if err != nil {
+		return err
+	}
+	if putErr := resp.(*kvrpcpb.RawPutResponse).GetError(); putErr != "" {
+		return errors.New(putErr)
+	}
+	return nil
+}
+
+// Delete deletes the key, it is not an error if the key does not exist.
+func (c *RawKVClient) Delete(key []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	req := &kvrpcpb.RawDeleteRequest{Key: key, Cf: c.cf}
+	resp, _, err := c.sendReq(key, req)
+	if err != nil {
+		return err
+	}
+	if deleteErr := resp.(*kvrpcpb.RawDeleteResponse).GetError(); deleteErr != "" {
+		return errors.New(deleteErr)
+	}
+	return nil
+}
+
+// Scan returns at most limit pairs in order whose keys are in [startKey, endKey), an empty endKey means the end of the
+// key space.
+func (c *RawKVClient) Scan(startKey, endKey []byte, limit int) (keys [][]byte, values [][]byte, err error) {
+	if limit > MaxRawScanLimit {
+		return nil, nil, errors.Errorf("limit %d exceeds the max limit %d", limit, MaxRawScanLimit)
+	}
+	for len(keys) < limit && (len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0) {
+		req := &kvrpcpb.RawScanRequest{StartKey: startKey, Limit: uint32(limit - len(keys)), Cf: c.cf}
+		resp, loc, err := c.sendReq(startKey, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		scanResp := resp.(*kvrpcpb.RawScanResponse)
+		if scanResp.GetError() != "" {
+			return nil, nil, errors.New(scanResp.GetError())
+		}
+		for _, pair := range scanResp.GetKvs() {
+			if !loc.Contains(pair.GetKey()) || (len(endKey) > 0 && bytes.Compare(pair.GetKey(), endKey) >= 0) {
+				break
+			}
+			keys = append(keys, pair.GetKey())
+			values = append(values, pair.GetValue())
+		}
+		if len(loc.EndKey) == 0 {
+			break
+		}
+		startKey = loc.EndKey
+	}
+	return keys, values, nil
+}
+
+func (c *RawKVClient) sendReq(key []byte, req interface{}) (regionResponse, *KeyLocation, error) {
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	return NewRegionRequestSender(c.regionCache, c.pool).SendReqByKey(bo, key, req)
+}
+
+// checkKey returns an error for an empty key, which the raw API does not support.
+func checkKey(key []byte) error {
+	if len(key) == 0 {
+		return errors.New("empty key is not supported")
+	}
+	return nil
+}
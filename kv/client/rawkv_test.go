@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient starts a cluster of count stores serving the TinyKv API and a client of it.
+func newTestClient(t *testing.T, count int, cfg *config.Config) (*test_raftstore.Cluster, *RawKVClient, func()) {
+	cluster := test_raftstore.NewTestCluster(count, cfg)
+	cluster.Start()
+	stop, err := cluster.ServeStores()
+	require.Nil(t, err)
+	client := NewRawKVClientWithPD(cluster.PDClient())
+	return cluster, client, func() {
+		client.Close()
+		stop()
+		cluster.Shutdown()
+	}
+}
+
+func TestRawGetPutDelete(t *testing.T) {
+	_, client, shutdown := newTestClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	require.Nil(t, client.Put([]byte("k1"), []byte("v1")))
+	value, err := client.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v1"), value)
+
+	value, err = client.Get([]byte("k2"))
+	require.Nil(t, err)
+	assert.Nil(t, value)
+
+	// Column families are separated.
+	lockClient := client.WithColumnFamily(engine_util.CfLock)
+	value, err = lockClient.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Nil(t, value)
+	require.Nil(t, lockClient.Put([]byte("k1"), []byte("lock")))
+
+	require.Nil(t, client.Delete([]byte("k1")))
+	value, err = client.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Nil(t, value)
+	value, err = lockClient.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("lock"), value)
+
+	assert.NotNil(t, client.Put(nil, []byte("v")))
+	assert.NotNil(t, client.Put([]byte("k"), nil))
+}
+
+func TestRawScanAcrossRegions(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RegionMaxSize = 800
+	cfg.RegionSplitSize = 500
+	cluster, client, shutdown := newTestClient(t, 3, cfg)
+	defer shutdown()
+
+	// The cache holds the only region before the split, the requests after it must follow the new regions.
+	require.Nil(t, client.Put([]byte("k000"), []byte("v000")))
+	for i := 1; i < 100; i++ {
+		key, value := []byte(fmt.Sprintf("k%03d", i)), []byte(fmt.Sprintf("v%03d", i))
+		require.Nil(t, client.Put(key, value))
+	}
+	for i := 0; i < 100; i++ {
+		regions, _, err := cluster.PDClient().ScanRegions(context.TODO(), nil, nil, 0)
+		require.Nil(t, err)
+		if len(regions) > 1 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	regions, _, err := cluster.PDClient().ScanRegions(context.TODO(), nil, nil, 0)
+	require.Nil(t, err)
+	require.True(t, len(regions) > 1, "the region is not split")
+
+	for i := 0; i < 100; i++ {
+		value, err := client.Get([]byte(fmt.Sprintf("k%03d", i)))
+		require.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("v%03d", i)), value)
+	}
+
+	keys, values, err := client.Scan(nil, nil, 1000)
+	require.Nil(t, err)
+	require.Len(t, keys, 100)
+	for i := range keys {
+		assert.Equal(t, []byte(fmt.Sprintf("k%03d", i)), keys[i])
+		assert.Equal(t, []byte(fmt.Sprintf("v%03d", i)), values[i])
+	}
+
+	keys, _, err = client.Scan([]byte("k010"), []byte("k090"), 1000)
+	require.Nil(t, err)
+	require.Len(t, keys, 80)
+	assert.Equal(t, []byte("k010"), keys[0])
+	assert.Equal(t, []byte("k089"), keys[79])
+
+	keys, _, err = client.Scan([]byte("k050"), nil, 30)
+	require.Nil(t, err)
+	require.Len(t, keys, 30)
+	assert.Equal(t, []byte("k079"), keys[29])
+
+	_, _, err = client.Scan(nil, nil, MaxRawScanLimit+1)
+	assert.NotNil(t, err)
+}
+
+func TestRawLeaderChange(t *testing.T) {
+	cluster, client, shutdown := newTestClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	require.Nil(t, client.Put([]byte("k1"), []byte("v1")))
+
+	// Move the leader away from the cached one, the client follows NotLeader to the new leader.
+	region := cluster.GetRegion([]byte("k1"))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	for _, peer := range region.GetPeers() {
+		if peer.GetStoreId() != leader.GetStoreId() {
+			cluster.PDClient().TransferLeader(region.GetId(), peer)
+			for i := 0; i < 100 && cluster.LeaderOfRegion(region.GetId()).GetId() != peer.GetId(); i++ {
+				time.Sleep(50 * time.Millisecond)
+			}
+			require.Equal(t, peer.GetId(), cluster.LeaderOfRegion(region.GetId()).GetId())
+			break
+		}
+	}
+	require.Nil(t, client.Put([]byte("k1"), []byte("v2")))
+	value, err := client.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v2"), value)
+
+	// A stopped store is skipped.
+	leader = cluster.LeaderOfRegion(region.GetId())
+	cluster.StopServer(leader.GetStoreId())
+	value, err = client.Get([]byte("k1"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v2"), value)
+}
@@ -0,0 +1,453 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/btree"
+	"github.com/pingcap/errors"
+)
+
+// PDClient is the part of the scheduler client, i.e. scheduler/client.Client, which the clients use to locate the
+// regions and allocate timestamps.
+type PDClient interface {
+	GetTS(ctx context.Context) (int64, int64, error)
+	GetRegion(ctx context.Context, key []byte) (*metapb.Region, *metapb.Peer, error)
+	GetRegionByID(ctx context.Context, regionID uint64) (*metapb.Region, *metapb.Peer, error)
+	ScanRegions(ctx context.Context, key, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error)
+	GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error)
+}
+
+// RegionVerID identifies a version of a region, it changes when the region splits, merges or changes its peers.
+type RegionVerID struct {
+	ID      uint64
+	ConfVer uint64
+	Ver     uint64
+}
+
+func newRegionVerID(region *metapb.Region) RegionVerID {
+	return RegionVerID{
+		ID:      region.GetId(),
+		ConfVer: region.GetRegionEpoch().GetConfVer(),
+		Ver:     region.GetRegionEpoch().GetVersion(),
+	}
+}
+
+// KeyLocation is the region a key is located in.
+type KeyLocation struct {
+	Region   RegionVerID
+	StartKey []byte
+	EndKey   []byte
+}
+
+// Contains returns whether the key is in the region.
+func (l *KeyLocation) Contains(key []byte) bool {
+	return bytes.Compare(l.StartKey, key) <= 0 && (len(l.EndKey) == 0 || bytes.Compare(key, l.EndKey) < 0)
+}
+
+// RPCContext is where to send a request to a region.
+type RPCContext struct {
+	Region RegionVerID
+	Meta   *metapb.Region
+	Peer   *metapb.Peer
+	Addr   string
+}
+
+// KvContext returns the context of a request to the region.
+func (c *RPCContext) KvContext() *kvrpcpb.Context {
+	return &kvrpcpb.Context{
+		RegionId:    c.Meta.GetId(),
+		RegionEpoch: c.Meta.GetRegionEpoch(),
+		Peer:        c.Peer,
+	}
+}
+
+// cachedRegion is a region in the cache. The meta is immutable, the leader is guarded by the mutex of the cache.
+type cachedRegion struct {
+	meta   *metapb.Region
+	leader *metapb.Peer
+}
+
+func (r *cachedRegion) verID() RegionVerID {
+	return newRegionVerID(r.meta)
+}
+
+func (r *cachedRegion) contains(key []byte) bool {
+	return bytes.Compare(r.meta.GetStartKey(), key) <= 0 &&
+		(len(r.meta.GetEndKey()) == 0 || bytes.Compare(key, r.meta.GetEndKey()) < 0)
+}
+
+func (r *cachedRegion) location() *KeyLocation {
+	return &KeyLocation{
+		Region:   r.verID(),
+		StartKey: r.meta.GetStartKey(),
+		EndKey:   r.meta.GetEndKey(),
+	}
+}
+
+var _ btree.Item = &regionItem{}
+
+type regionItem struct {
+	region *cachedRegion
+}
+
+// Less returns true if the region start key is less than the other.
+func (r *regionItem) Less(other btree.Item) bool {
+	return bytes.Compare(r.region.meta.GetStartKey(), other.(*regionItem).region.meta.GetStartKey()) < 0
+}
+
+// RegionCache caches the regions and their leaders, and the addresses of the stores, which are loaded from the
+// scheduler on demand. An entry is invalidated when a store reports it is stale.
+type RegionCache struct {
+	pdClient PDClient
+
+	mu struct {
+		sync.RWMutex
+		regions map[uint64]*cachedRegion // region id -> region
+		sorted  *btree.BTree             // start key -> region
+	}
+	storeMu struct {
+		sync.RWMutex
+		addrs map[uint64]string // store id -> address
+	}
+}
+
+// NewRegionCache creates a RegionCache.
+func NewRegionCache(pdClient PDClient) *RegionCache {
+	c := &RegionCache{pdClient: pdClient}
+	c.mu.regions = make(map[uint64]*cachedRegion)
+	c.mu.sorted = btree.New(2)
+	c.storeMu.addrs = make(map[uint64]string)
+	return c
+}
+
+// LocateKey returns the region the key is located in.
+func (c *RegionCache) LocateKey(bo *Backoffer, key []byte) (*KeyLocation, error) {
+	c.mu.RLock()
+	region := c.searchCachedRegion(key)
+	c.mu.RUnlock()
+	if region != nil {
+		return region.location(), nil
+	}
+
+	region, err := c.loadRegion(bo, key)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.insertRegion(region)
+	c.mu.Unlock()
+	return region.location(), nil
+}
+
+// LocateRegionByID returns the location of the region with the id.
+func (c *RegionCache) LocateRegionByID(bo *Backoffer, regionID uint64) (*KeyLocation, error) {
+	c.mu.RLock()
+	region := c.mu.regions[regionID]
+	c.mu.RUnlock()
+	if region != nil {
+		return region.location(), nil
+	}
+
+	region, err := c.loadRegionByID(bo, regionID)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.insertRegion(region)
+	c.mu.Unlock()
+	return region.location(), nil
+}
+
+// GroupKeysByRegion separates the keys by the regions they are located in. The first region is the one of the first
+// key.
+func (c *RegionCache) GroupKeysByRegion(bo *Backoffer, keys [][]byte) (map[RegionVerID][][]byte, RegionVerID, error) {
+	groups := make(map[RegionVerID][][]byte)
+	var first RegionVerID
+	var loc *KeyLocation
+	for i, key := range keys {
+		if loc == nil || !loc.Contains(key) {
+			var err error
+			if loc, err = c.LocateKey(bo, key); err != nil {
+				return nil, first, err
+			}
+		}
+		if i == 0 {
+			first = loc.Region
+		}
+		groups[loc.Region] = append(groups[loc.Region], key)
+	}
+	return groups, first, nil
+}
+
+// GetRPCContext returns where to send a request to the region. It returns nil if the version of the region is no
+// longer in the cache, the request should locate the region again then.
+func (c *RegionCache) GetRPCContext(bo *Backoffer, id RegionVerID) (*RPCContext, error) {
+	c.mu.RLock()
+	region := c.getCachedRegion(id)
+	var peer *metapb.Peer
+	if region != nil {
+		peer = region.leader
+	}
+	c.mu.RUnlock()
+	if region == nil {
+		return nil, nil
+	}
+
+	addr, err := c.GetStoreAddr(bo, peer.GetStoreId())
+	if err != nil {
+		return nil, err
+	}
+	if addr == "" {
+		// The store is not available, e.g. it is removed from the cluster.
+		c.InvalidateRegion(id)
+		return nil, nil
+	}
+	return &RPCContext{
+		Region: id,
+		Meta:   region.meta,
+		Peer:   peer,
+		Addr:   addr,
+	}, nil
+}
+
+// UpdateLeader records the leader reported by a store. The region is invalidated if the leader is not one of its
+// peers, since the region must have changed its peers.
+func (c *RegionCache) UpdateLeader(id RegionVerID, leader *metapb.Peer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	region := c.getCachedRegion(id)
+	if region == nil {
+		return
+	}
+	for _, peer := range region.meta.GetPeers() {
+		if peer.GetStoreId() == leader.GetStoreId() {
+			region.leader = peer
+			return
+		}
+	}
+	log.Infof("invalidate region %d since its leader %v is not a peer of it", id.ID, leader)
+	c.removeRegion(region)
+}
+
+// SwitchPeer sends the next requests to the region to the peer after the current one, it is used when the leader of
+// the region is unknown.
+func (c *RegionCache) SwitchPeer(id RegionVerID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	region := c.getCachedRegion(id)
+	if region == nil {
+		return
+	}
+	peers := region.meta.GetPeers()
+	for i, peer := range peers {
+		if peer.GetId() == region.leader.GetId() {
+			region.leader = peers[(i+1)%len(peers)]
+			return
+		}
+	}
+}
+
+// OnSendFail handles a failed request to a store, the address of the store is reloaded and the next requests to the
+// region are sent to another peer.
+func (c *RegionCache) OnSendFail(ctx *RPCContext) {
+	c.InvalidateStore(ctx.Peer.GetStoreId())
+	c.SwitchPeer(ctx.Region)
+}
+
+// OnRegionEpochNotMatch replaces the region in ctx by the current regions reported by the store. It returns false
+// if the store reports no current region, the region is invalidated and reloaded from the scheduler then.
+func (c *RegionCache) OnRegionEpochNotMatch(ctx *RPCContext, currentRegions []*metapb.Region) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if region := c.getCachedRegion(ctx.Region); region != nil {
+		c.removeRegion(region)
+	}
+	for _, meta := range currentRegions {
+		if len(meta.GetPeers()) == 0 {
+			continue
+		}
+		region := &cachedRegion{meta: meta, leader: meta.GetPeers()[0]}
+		// The peer on the same store is likely to be the leader of a region split from the region.
+		for _, peer := range meta.GetPeers() {
+			if peer.GetStoreId() == ctx.Peer.GetStoreId() {
+				region.leader = peer
+			}
+		}
+		c.insertRegion(region)
+	}
+	return len(currentRegions) > 0
+}
+
+// InvalidateRegion removes the version of the region from the cache.
+func (c *RegionCache) InvalidateRegion(id RegionVerID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if region := c.getCachedRegion(id); region != nil {
+		c.removeRegion(region)
+	}
+}
+
+// GetStoreAddr returns the address of the store, it is empty if the store does not exist.
+func (c *RegionCache) GetStoreAddr(bo *Backoffer, storeID uint64) (string, error) {
+	c.storeMu.RLock()
+	addr, ok := c.storeMu.addrs[storeID]
+	c.storeMu.RUnlock()
+	if ok {
+		return addr, nil
+	}
+
+	for {
+		store, err := c.pdClient.GetStore(bo.Context(), storeID)
+		if err == nil && store != nil {
+			addr = store.GetAddress()
+			if store.GetState() == metapb.StoreState_Tombstone {
+				addr = ""
+			}
+			c.storeMu.Lock()
+			c.storeMu.addrs[storeID] = addr
+			c.storeMu.Unlock()
+			return addr, nil
+		}
+		if err == nil {
+			err = errors.Errorf("store %d not found", storeID)
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return "", err
+		}
+	}
+}
+
+// InvalidateStore removes the address of the store from the cache.
+func (c *RegionCache) InvalidateStore(storeID uint64) {
+	c.storeMu.Lock()
+	defer c.storeMu.Unlock()
+	delete(c.storeMu.addrs, storeID)
+}
+
+func (c *RegionCache) loadRegion(bo *Backoffer, key []byte) (*cachedRegion, error) {
+	for {
+		meta, leader, err := c.pdClient.GetRegion(bo.Context(), key)
+		if err == nil {
+			region, err1 := newCachedRegion(meta, leader)
+			if err1 == nil {
+				return region, nil
+			}
+			err = errors.Annotatef(err1, "load region of key %q", key)
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *RegionCache) loadRegionByID(bo *Backoffer, regionID uint64) (*cachedRegion, error) {
+	for {
+		meta, leader, err := c.pdClient.GetRegionByID(bo.Context(), regionID)
+		if err == nil {
+			region, err1 := newCachedRegion(meta, leader)
+			if err1 == nil {
+				return region, nil
+			}
+			err = errors.Annotatef(err1, "load region %d", regionID)
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// LoadRegionsInRange loads all regions intersecting with [startKey, endKey) into the cache with one scan, which is
+// cheaper than locating the regions one by one for a large range.
+func (c *RegionCache) LoadRegionsInRange(bo *Backoffer, startKey, endKey []byte) error {
+	for {
+		metas, leaders, err := c.pdClient.ScanRegions(bo.Context(), startKey, endKey, 0)
+		if err == nil {
+			c.mu.Lock()
+			for i, meta := range metas {
+				if region, err := newCachedRegion(meta, leaders[i]); err == nil {
+					c.insertRegion(region)
+				}
+			}
+			c.mu.Unlock()
+			return nil
+		}
+		if err = bo.Backoff(BoPDRPC, err); err != nil {
+			return err
+		}
+	}
+}
+
+func newCachedRegion(meta *metapb.Region, leader *metapb.Peer) (*cachedRegion, error) {
+	if meta == nil {
+		return nil, errors.New("region not found")
+	}
+	if len(meta.GetPeers()) == 0 {
+		return nil, errors.New("region has no peer")
+	}
+	region := &cachedRegion{meta: meta, leader: meta.GetPeers()[0]}
+	if leader.GetId() != 0 {
+		region.leader = leader
+	}
+	return region, nil
+}
+
+func (c *RegionCache) searchCachedRegion(key []byte) *cachedRegion {
+	var region *cachedRegion
+	c.mu.sorted.DescendLessOrEqual(&regionItem{region: &cachedRegion{meta: &metapb.Region{StartKey: key}}},
+		func(i btree.Item) bool {
+			region = i.(*regionItem).region
+			return false
+		})
+	if region != nil && region.contains(key) {
+		return region
+	}
+	return nil
+}
+
+func (c *RegionCache) getCachedRegion(id RegionVerID) *cachedRegion {
+	region := c.mu.regions[id.ID]
+	if region == nil || region.verID() != id {
+		return nil
+	}
+	return region
+}
+
+// insertRegion puts the region into the cache and removes the regions overlapping with it.
+func (c *RegionCache) insertRegion(region *cachedRegion) {
+	if old := c.mu.regions[region.meta.GetId()]; old != nil {
+		c.removeRegion(old)
+	}
+	var overlaps []*cachedRegion
+	start, end := region.meta.GetStartKey(), region.meta.GetEndKey()
+	if prev := c.searchCachedRegion(start); prev != nil {
+		overlaps = append(overlaps, prev)
+	}
+	c.mu.sorted.AscendGreaterOrEqual(&regionItem{region: region}, func(i btree.Item) bool {
+		r := i.(*regionItem).region
+		if len(end) > 0 && bytes.Compare(r.meta.GetStartKey(), end) >= 0 {
+			return false
+		}
+		overlaps = append(overlaps, r)
+		return true
+	})
+	for _, r := range overlaps {
+		c.removeRegion(r)
+	}
+	c.mu.regions[region.meta.GetId()] = region
+	c.mu.sorted.ReplaceOrInsert(&regionItem{region: region})
+}
+
+func (c *RegionCache) removeRegion(region *cachedRegion) {
+	if c.mu.regions[region.meta.GetId()] == region {
+		delete(c.mu.regions, region.meta.GetId())
+	}
+	item := c.mu.sorted.Get(&regionItem{region: region})
+	if item != nil && item.(*regionItem).region == region {
+		c.mu.sorted.Delete(item)
+	}
+}
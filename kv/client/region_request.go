@@ -0,0 +1,275 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+)
+
+// readTimeout is the timeout of a request to a store.
+const readTimeout = 20 * time.Second
+
+// regionResponse is a response of a request to a region, e.g. *kvrpcpb.RawGetResponse.
+type regionResponse interface {
+	GetRegionError() *errorpb.Error
+}
+
+// RegionRequestSender sends a request to the leader of a region. It retries on the failures which do not change the
+// range of the region, i.e. a leader change, a stale command or an unreachable store. On the other region errors the
+// response is returned with the region error and the caller should locate the keys of the request again.
+type RegionRequestSender struct {
+	regionCache *RegionCache
+	pool        *connPool
+}
+
+// NewRegionRequestSender creates a RegionRequestSender.
+func NewRegionRequestSender(regionCache *RegionCache, pool *connPool) *RegionRequestSender {
+	return &RegionRequestSender{
+		regionCache: regionCache,
+		pool:        pool,
+	}
+}
+
+// SendReq sends the request to the region and returns the response.
+func (s *RegionRequestSender) SendReq(bo *Backoffer, req interface{}, regionID RegionVerID, timeout time.Duration) (regionResponse, error) {
+	for {
+		ctx, err := s.regionCache.GetRPCContext(bo, regionID)
+		if err != nil {
+			return nil, err
+		}
+		if ctx == nil {
+			// The region is no longer in the cache, make the caller locate the keys again.
+			return regionMissResponse(req)
+		}
+
+		resp, retry, err := s.sendReqToRegion(bo, ctx, req, timeout)
+		if err != nil {
+			return nil, err
+		}
+		if retry {
+			continue
+		}
+
+		if regionErr := resp.GetRegionError(); regionErr != nil {
+			retry, err := s.onRegionError(bo, ctx, regionErr)
+			if err != nil {
+				return nil, err
+			}
+			if retry {
+				continue
+			}
+		}
+		return resp, nil
+	}
+}
+
+func (s *RegionRequestSender) sendReqToRegion(bo *Backoffer, ctx *RPCContext, req interface{}, timeout time.Duration) (resp regionResponse, retry bool, err error) {
+	client, err := s.pool.getClient(ctx.Addr)
+	if err == nil {
+		rpcCtx, cancel := context.WithTimeout(bo.Context(), timeout)
+		resp, err = callRPC(rpcCtx, client, ctx.KvContext(), req)
+		cancel()
+	}
+	if err != nil {
+		if errors.Cause(err) == errUnknownRequest {
+			return nil, false, err
+		}
+		if err := s.onSendFail(bo, ctx, err); err != nil {
+			return nil, false, err
+		}
+		return nil, true, nil
+	}
+	return resp, false, nil
+}
+
+func (s *RegionRequestSender) onSendFail(bo *Backoffer, ctx *RPCContext, err error) error {
+	if bo.Context().Err() != nil {
+		// The request is canceled by the caller, it is not a failure of the store.
+		return errors.Trace(bo.Context().Err())
+	}
+	// The store may be down, try another peer which may be elected as the new leader.
+	s.regionCache.OnSendFail(ctx)
+	return bo.Backoff(BoStoreRPC, errors.Annotatef(err, "send request to store %d at %s", ctx.Peer.GetStoreId(), ctx.Addr))
+}
+
+// onRegionError handles the region error of each kind and returns whether to send the request to the region again.
+func (s *RegionRequestSender) onRegionError(bo *Backoffer, ctx *RPCContext, regionErr *errorpb.Error) (retry bool, err error) {
+	if notLeader := regionErr.GetNotLeader(); notLeader != nil {
+		if leader := notLeader.GetLeader(); leader != nil {
+			// Retry on the new leader. The hint may be stale, e.g. two stores may name each other as the leader
+			// during an election, so the retries on hints back off too.
+			s.regionCache.UpdateLeader(ctx.Region, leader)
+			if err := bo.Backoff(BoNotLeader, errors.Errorf("not leader: %v, ctx: %v", notLeader, ctx)); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+		// The region is electing a leader, wait for it on the next peer.
+		s.regionCache.SwitchPeer(ctx.Region)
+		if err := bo.Backoff(BoNotLeader, errors.Errorf("not leader: %v, ctx: %v", notLeader, ctx)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if storeNotMatch := regionErr.GetStoreNotMatch(); storeNotMatch != nil {
+		// The address belongs to another store now, reload the address of the store.
+		s.regionCache.InvalidateStore(ctx.Peer.GetStoreId())
+		return true, nil
+	}
+
+	if epochNotMatch := regionErr.GetEpochNotMatch(); epochNotMatch != nil {
+		log.Debugf("region epoch not match, ctx: %v, current regions: %v", ctx, epochNotMatch.GetCurrentRegions())
+		if !s.regionCache.OnRegionEpochNotMatch(ctx, epochNotMatch.GetCurrentRegions()) {
+			// The scheduler may not know the new regions yet.
+			if err := bo.Backoff(BoRegionMiss, errors.Errorf("epoch not match: %v, ctx: %v", epochNotMatch, ctx)); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+
+	if staleCommand := regionErr.GetStaleCommand(); staleCommand != nil {
+		if err := bo.Backoff(BoStaleCmd, errors.Errorf("stale command, ctx: %v", ctx)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	// RegionNotFound, KeyNotInRegion or an unknown error, the region in the cache is stale and reloaded from the
+	// scheduler, which may not know the new regions yet.
+	log.Debugf("region error %v, ctx: %v", regionErr, ctx)
+	s.regionCache.InvalidateRegion(ctx.Region)
+	if err := bo.Backoff(BoRegionMiss, errors.New(regionErr.String())); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// SendReqByKey sends the request to the region of the key, the request is sent again to the new region of the key if
+// the region changes. It returns the response and the region which handles it.
+func (s *RegionRequestSender) SendReqByKey(bo *Backoffer, key []byte, req interface{}) (regionResponse, *KeyLocation, error) {
+	for {
+		loc, err := s.regionCache.LocateKey(bo, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := s.SendReq(bo, req, loc.Region, readTimeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.GetRegionError() != nil {
+			// The sender has updated the cache or backed off, locate the key again.
+			continue
+		}
+		return resp, loc, nil
+	}
+}
+
+var errUnknownRequest = errors.New("unknown request")
+
+// callRPC sends the request with the context to the store.
+func callRPC(ctx context.Context, client tinykvpb.TinyKvClient, kvCtx *kvrpcpb.Context, req interface{}) (regionResponse, error) {
+	switch r := req.(type) {
+	case *kvrpcpb.RawGetRequest:
+		r.Context = kvCtx
+		return client.RawGet(ctx, r)
+	case *kvrpcpb.RawPutRequest:
+		r.Context = kvCtx
+		return client.RawPut(ctx, r)
+	case *kvrpcpb.RawDeleteRequest:
+		r.Context = kvCtx
+		return client.RawDelete(ctx, r)
+	case *kvrpcpb.RawScanRequest:
+		r.Context = kvCtx
+		return client.RawScan(ctx, r)
+	case *kvrpcpb.GetRequest:
+		r.Context = kvCtx
+		return client.KvGet(ctx, r)
+	case *kvrpcpb.ScanRequest:
+		r.Context = kvCtx
+		return client.KvScan(ctx, r)
+	case *kvrpcpb.PrewriteRequest:
+		r.Context = kvCtx
+		return client.KvPrewrite(ctx, r)
+	case *kvrpcpb.CommitRequest:
+		r.Context = kvCtx
+		return client.KvCommit(ctx, r)
+	case *kvrpcpb.BatchRollbackRequest:
+		r.Context = kvCtx
+		return client.KvBatchRollback(ctx, r)
+	case *kvrpcpb.CheckTxnStatusRequest:
+		r.Context = kvCtx
+		return client.KvCheckTxnStatus(ctx, r)
+	case *kvrpcpb.ResolveLockRequest:
+		r.Context = kvCtx
+		return client.KvResolveLock(ctx, r)
+	case *kvrpcpb.BatchGetRequest:
+		r.Context = kvCtx
+		return client.KvBatchGet(ctx, r)
+	case *kvrpcpb.ScanLockRequest:
+		r.Context = kvCtx
+		return client.KvScanLock(ctx, r)
+	case *kvrpcpb.CleanupRequest:
+		r.Context = kvCtx
+		return client.KvCleanup(ctx, r)
+	case *kvrpcpb.DeleteRangeRequest:
+		r.Context = kvCtx
+		return client.KvDeleteRange(ctx, r)
+	case *kvrpcpb.RestoreFileRequest:
+		r.Context = kvCtx
+		return client.RestoreFile(ctx, r)
+	case *kvrpcpb.SplitRegionRequest:
+		r.Context = kvCtx
+		return client.SplitRegion(ctx, r)
+	}
+	return nil, errors.Annotatef(errUnknownRequest, "%T", req)
+}
+
+// regionMissResponse returns an empty response of the request with a region error, so the caller locates the keys of
+// the request again.
+func regionMissResponse(req interface{}) (regionResponse, error) {
+	regionErr := &errorpb.Error{EpochNotMatch: &errorpb.EpochNotMatch{}}
+	switch req.(type) {
+	case *kvrpcpb.RawGetRequest:
+		return &kvrpcpb.RawGetResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.RawPutRequest:
+		return &kvrpcpb.RawPutResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.RawDeleteRequest:
+		return &kvrpcpb.RawDeleteResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.RawScanRequest:
+		return &kvrpcpb.RawScanResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.GetRequest:
+		return &kvrpcpb.GetResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.ScanRequest:
+		return &kvrpcpb.ScanResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.PrewriteRequest:
+		return &kvrpcpb.PrewriteResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.CommitRequest:
+		return &kvrpcpb.CommitResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.BatchRollbackRequest:
+		return &kvrpcpb.BatchRollbackResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.CheckTxnStatusRequest:
+		return &kvrpcpb.CheckTxnStatusResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.ResolveLockRequest:
+		return &kvrpcpb.ResolveLockResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.BatchGetRequest:
+		return &kvrpcpb.BatchGetResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.ScanLockRequest:
+		return &kvrpcpb.ScanLockResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.CleanupRequest:
+		return &kvrpcpb.CleanupResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.DeleteRangeRequest:
+		return &kvrpcpb.DeleteRangeResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.RestoreFileRequest:
+		return &kvrpcpb.RestoreFileResponse{RegionError: regionErr}, nil
+	case *kvrpcpb.SplitRegionRequest:
+		return &kvrpcpb.SplitRegionResponse{RegionError: regionErr}, nil
+	}
+	return nil, errors.Annotatef(errUnknownRequest, "%T", req)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStaleLeaderHints tests that the sender gives up if two stores keep naming each other as the leader.
+func TestStaleLeaderHints(t *testing.T) {
+	sender := NewRegionRequestSender(NewRegionCache(nil), nil)
+	bo := NewBackoffer(context.Background(), 100)
+	region := &metapb.Region{Id: 1, Peers: []*metapb.Peer{{Id: 1, StoreId: 1}, {Id: 2, StoreId: 2}}}
+	for i := 0; ; i++ {
+		require.True(t, i < 100, "retried too many times")
+		peer := region.Peers[i%2]
+		ctx := &RPCContext{Region: newRegionVerID(region), Meta: region, Peer: peer}
+		regionErr := &errorpb.Error{NotLeader: &errorpb.NotLeader{RegionId: 1, Leader: region.Peers[(i+1)%2]}}
+		retry, err := sender.onRegionError(bo, ctx, regionErr)
+		if err != nil {
+			break
+		}
+		require.True(t, retry)
+	}
+}
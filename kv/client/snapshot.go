@@ -0,0 +1,183 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// scanBatchSize is the number of pairs a snapshot reads from a region at a time.
+const scanBatchSize = 256
+
+// Snapshot reads the data committed before a timestamp.
+type Snapshot struct {
+	client *TxnClient
+	ts     uint64
+}
+
+func newSnapshot(client *TxnClient, ts uint64) *Snapshot {
+	return &Snapshot{client: client, ts: ts}
+}
+
+// Get returns the value of the key, it is nil if the key does not exist.
+func (s *Snapshot) Get(key []byte) ([]byte, error) {
+	bo := NewBackoffer(context.Background(), getMaxBackoff)
+	req := &kvrpcpb.GetRequest{Key: key, Version: s.ts}
+	for {
+		resp, _, err := s.client.sender().SendReqByKey(bo, key, req)
+		if err != nil {
+			return nil, err
+		}
+		getResp := resp.(*kvrpcpb.GetResponse)
+		if keyErr := getResp.GetError(); keyErr != nil {
+			if err := s.onKeyError(bo, keyErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if getResp.GetNotFound() || len(getResp.GetValue()) == 0 {
+			return nil, nil
+		}
+		return getResp.GetValue(), nil
+	}
+}
+
+// onKeyError resolves the lock of a read, it backs off if the lock is alive.
+func (s *Snapshot) onKeyError(bo *Backoffer, keyErr *kvrpcpb.KeyError) error {
+	lock := keyErr.GetLocked()
+	if lock == nil {
+		return errors.Errorf("read at %d: %v", s.ts, keyErr)
+	}
+	resolved, err := s.client.lockResolver.ResolveLocks(bo, []*kvrpcpb.LockInfo{lock})
+	if err != nil {
+		return err
+	}
+	if !resolved {
+		return bo.Backoff(BoTxnLock, errors.Errorf("key %q is locked by txn %d", lock.GetKey(), lock.GetLockVersion()))
+	}
+	return nil
+}
+
+// scan returns at most limit pairs whose keys are in [startKey, endKey) from the region of startKey, and the end key
+// of the region.
+func (s *Snapshot) scan(startKey, endKey []byte, limit int) ([]*kvrpcpb.KvPair, []byte, error) {
+	bo := NewBackoffer(context.Background(), scanMaxBackoff)
+	for {
+		req := &kvrpcpb.ScanRequest{StartKey: startKey, Limit: uint32(limit), Version: s.ts}
+		resp, loc, err := s.client.sender().SendReqByKey(bo, startKey, req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var locks []*kvrpcpb.LockInfo
+		var pairs []*kvrpcpb.KvPair
+		for _, pair := range resp.(*kvrpcpb.ScanResponse).GetPairs() {
+			key := pair.GetKey()
+			keyErr := pair.GetError()
+			if keyErr != nil && keyErr.GetLocked() != nil {
+				key = keyErr.GetLocked().GetKey()
+			}
+			if !loc.Contains(key) || (len(endKey) > 0 && bytes.Compare(key, endKey) >= 0) {
+				break
+			}
+			if keyErr != nil {
+				if keyErr.GetLocked() == nil {
+					return nil, nil, errors.Errorf("scan at %d: %v", s.ts, keyErr)
+				}
+				locks = append(locks, keyErr.GetLocked())
+				continue
+			}
+			pairs = append(pairs, pair)
+		}
+		if len(locks) > 0 {
+			resolved, err := s.client.lockResolver.ResolveLocks(bo, locks)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !resolved {
+				err = errors.Errorf("scan is blocked by the lock of key %q", locks[0].GetKey())
+				if err := bo.Backoff(BoTxnLock, err); err != nil {
+					return nil, nil, err
+				}
+			}
+			continue
+		}
+		return pairs, loc.EndKey, nil
+	}
+}
+
+// Iterator iterates the pairs of a range in order of the keys.
+type Iterator interface {
+	Valid() bool
+	Key() []byte
+	Value() []byte
+	Next() error
+	Close()
+}
+
+// snapshotIterator iterates a range of a snapshot, it reads a batch of pairs at a time.
+type snapshotIterator struct {
+	snapshot *Snapshot
+	endKey   []byte
+
+	pairs []*kvrpcpb.KvPair
+	index int
+	// The key to read the next batch from, nil if the range is exhausted.
+	nextKey []byte
+	valid   bool
+}
+
+func newSnapshotIterator(snapshot *Snapshot, startKey, endKey []byte) (*snapshotIterator, error) {
+	it := &snapshotIterator{snapshot: snapshot, endKey: endKey, nextKey: startKey}
+	if it.nextKey == nil {
+		it.nextKey = []byte{}
+	}
+	if err := it.Next(); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+func (it *snapshotIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *snapshotIterator) Key() []byte {
+	return it.pairs[it.index].GetKey()
+}
+
+func (it *snapshotIterator) Value() []byte {
+	return it.pairs[it.index].GetValue()
+}
+
+func (it *snapshotIterator) Next() error {
+	it.index++
+	for it.index >= len(it.pairs) {
+		if it.nextKey == nil || (len(it.endKey) > 0 && bytes.Compare(it.nextKey, it.endKey) >= 0) {
+			it.valid = false
+			return nil
+		}
+		pairs, regionEnd, err := it.snapshot.scan(it.nextKey, it.endKey, scanBatchSize)
+		if err != nil {
+			it.valid = false
+			return err
+		}
+		it.pairs, it.index = pairs, 0
+		if len(pairs) == scanBatchSize {
+			// The region may have more pairs, continue after the last key.
+			it.nextKey = append(append([]byte{}, pairs[len(pairs)-1].GetKey()...), 0)
+		} else if len(regionEnd) > 0 {
+			it.nextKey = regionEnd
+		} else {
+			it.nextKey = nil
+		}
+	}
+	it.valid = true
+	return nil
+}
+
+func (it *snapshotIterator) Close() {
+	it.valid = false
+}
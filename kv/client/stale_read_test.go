@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staleGet reads key at ts from the local data of every peer of its region.
+func staleGet(t *testing.T, client *TxnClient, key []byte, ts uint64) []*kvrpcpb.GetResponse {
+	return getFromPeers(t, client, key, ts, &kvrpcpb.Context{StaleRead: true})
+}
+
+// replicaGet reads key at ts by a replica read on every peer of its region.
+func replicaGet(t *testing.T, client *TxnClient, key []byte, ts uint64) []*kvrpcpb.GetResponse {
+	return getFromPeers(t, client, key, ts, &kvrpcpb.Context{ReplicaRead: true})
+}
+
+// getFromPeers sends the get of key at ts to every peer of its region, with the read flags in readCtx.
+func getFromPeers(t *testing.T, client *TxnClient, key []byte, ts uint64, readCtx *kvrpcpb.Context) []*kvrpcpb.GetResponse {
+	var resps []*kvrpcpb.GetResponse
+	region := locateRegion(t, client, key)
+	for _, peer := range region.GetPeers() {
+		resps = append(resps, getFromPeer(t, client, region, peer, key, ts, readCtx))
+	}
+	return resps
+}
+
+// locateRegion returns the region of key.
+func locateRegion(t *testing.T, client *TxnClient, key []byte) *metapb.Region {
+	bo := NewBackoffer(context.Background(), getMaxBackoff)
+	loc, err := client.regionCache.LocateKey(bo, key)
+	require.Nil(t, err)
+	rpcCtx, err := client.regionCache.GetRPCContext(bo, loc.Region)
+	require.Nil(t, err)
+	return rpcCtx.Meta
+}
+
+// getFromPeer sends the get of key at ts to the peer of the region, with the read flags in readCtx.
+func getFromPeer(t *testing.T, client *TxnClient, region *metapb.Region, peer *metapb.Peer, key []byte, ts uint64,
+	readCtx *kvrpcpb.Context) *kvrpcpb.GetResponse {
+	bo := NewBackoffer(context.Background(), getMaxBackoff)
+	addr, err := client.regionCache.GetStoreAddr(bo, peer.GetStoreId())
+	require.Nil(t, err)
+	kvClient, err := client.pool.getClient(addr)
+	require.Nil(t, err)
+	resp, err := kvClient.KvGet(context.Background(), &kvrpcpb.GetRequest{
+		Context: &kvrpcpb.Context{
+			RegionId:    region.GetId(),
+			RegionEpoch: region.GetRegionEpoch(),
+			Peer:        peer,
+			StaleRead:   readCtx.StaleRead,
+			ReplicaRead: readCtx.ReplicaRead,
+		},
+		Key:     key,
+		Version: ts,
+	})
+	require.Nil(t, err)
+	return resp
+}
+
+// mustStaleGet waits until every peer serves the stale read of key at ts and returns the values.
+func mustStaleGet(t *testing.T, client *TxnClient, key []byte, ts uint64) []string {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resps := staleGet(t, client, key, ts)
+		ready := true
+		for _, resp := range resps {
+			if regionErr := resp.GetRegionError(); regionErr != nil {
+				require.NotNil(t, regionErr.GetDataIsNotReady(), "%v", regionErr)
+				ready = false
+			}
+		}
+		if ready {
+			var values []string
+			for _, resp := range resps {
+				require.Nil(t, resp.GetError())
+				values = append(values, string(resp.GetValue()))
+			}
+			return values
+		}
+		require.True(t, time.Now().Before(deadline), "stale read at %d is not ready", ts)
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestStaleRead(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k1"), []byte("v1")))
+	require.Nil(t, txn.Commit())
+	readTs, err := client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	require.Nil(t, err)
+
+	// Every replica serves the read once its resolved ts passes the read ts.
+	assert.Equal(t, []string{"v1", "v1", "v1"}, mustStaleGet(t, client, []byte("k1"), readTs))
+
+	// A read in the future is not ready.
+	futureTs := readTs + uint64(time.Hour/time.Millisecond)<<tsoutil.PhysicalShiftBits
+	for _, resp := range staleGet(t, client, []byte("k1"), futureTs) {
+		notReady := resp.GetRegionError().GetDataIsNotReady()
+		require.NotNil(t, notReady)
+		assert.True(t, notReady.SafeTs >= readTs && notReady.SafeTs < futureTs)
+	}
+
+	// A pending lock holds back the resolved ts until the transaction commits.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k2"), []byte("v2")))
+	committer := newTwoPhaseCommitter(txn)
+	require.Nil(t, committer.prewriteKeys(NewBackoffer(context.Background(), prewriteMaxBackoff), committer.keys))
+	committer.commitTS, err = client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	require.Nil(t, err)
+	time.Sleep(500 * time.Millisecond)
+	for _, resp := range staleGet(t, client, []byte("k2"), committer.commitTS) {
+		notReady := resp.GetRegionError().GetDataIsNotReady()
+		require.NotNil(t, notReady)
+		assert.True(t, notReady.SafeTs <= committer.startTS)
+	}
+	require.Nil(t, committer.commitKeys(NewBackoffer(context.Background(), commitMaxBackoff), committer.keys))
+	assert.Equal(t, []string{"v2", "v2", "v2"}, mustStaleGet(t, client, []byte("k2"), committer.commitTS))
+
+	// The resolved ts of an idle region stops advancing when no stale read waits on the leader.
+	time.Sleep(2 * time.Second)
+	safeTs := followerSafeTs(t, cluster, client, []byte("k2"), futureTs)
+	time.Sleep(time.Second)
+	assert.Equal(t, safeTs, followerSafeTs(t, cluster, client, []byte("k2"), futureTs))
+}
+
+// followerSafeTs returns the resolved ts of a follower of the region of key, which refuses the stale read at ts. The
+// leader is not read, so the read does not make it advance the resolved ts.
+func followerSafeTs(t *testing.T, cluster *test_raftstore.Cluster, client *TxnClient, key []byte, ts uint64) uint64 {
+	region := locateRegion(t, client, key)
+	leader := cluster.LeaderOfRegion(region.GetId())
+	for _, peer := range region.GetPeers() {
+		if peer.GetId() == leader.GetId() {
+			continue
+		}
+		resp := getFromPeer(t, client, region, peer, key, ts, &kvrpcpb.Context{StaleRead: true})
+		notReady := resp.GetRegionError().GetDataIsNotReady()
+		require.NotNil(t, notReady)
+		return notReady.SafeTs
+	}
+	require.FailNow(t, "region has no follower", "region %d", region.GetId())
+	return 0
+}
+
+func TestReplicaRead(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	// Every replica serves the latest data at once, without waiting for the resolved ts.
+	for i := 0; i < 10; i++ {
+		value := fmt.Sprintf("v%d", i)
+		txn, err := client.Begin()
+		require.Nil(t, err)
+		require.Nil(t, txn.Set([]byte("k1"), []byte(value)))
+		require.Nil(t, txn.Commit())
+		readTs, err := client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+		require.Nil(t, err)
+		for _, resp := range replicaGet(t, client, []byte("k1"), readTs) {
+			require.Nil(t, resp.GetRegionError())
+			require.Nil(t, resp.GetError())
+			assert.Equal(t, value, string(resp.GetValue()))
+		}
+	}
+
+	// A follower cut off from the leader can't get the read index, the read fails with a region error to retry.
+	readTs, err := client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	require.Nil(t, err)
+	region := cluster.GetRegion([]byte("k1"))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	var follower uint64
+	for _, peer := range region.GetPeers() {
+		if peer.GetStoreId() != leader.GetStoreId() {
+			follower = peer.GetStoreId()
+		}
+	}
+	cluster.AddFilter(isolateFilter(follower))
+	failed := 0
+	for _, resp := range replicaGet(t, client, []byte("k1"), readTs) {
+		if regionErr := resp.GetRegionError(); regionErr != nil {
+			assert.True(t, regionErr.GetStaleCommand() != nil || regionErr.GetNotLeader() != nil, "%v", regionErr)
+			failed++
+		} else {
+			assert.Equal(t, "v9", string(resp.GetValue()))
+		}
+	}
+	assert.Equal(t, 1, failed)
+	cluster.ClearFilters()
+}
+
+// isolateFilter drops the raft messages from and to a store.
+type isolateFilter uint64
+
+func (f isolateFilter) Before(msg *raft_serverpb.RaftMessage) bool {
+	return msg.GetFromPeer().GetStoreId() != uint64(f) && msg.GetToPeer().GetStoreId() != uint64(f)
+}
+
+func (f isolateFilter) After() {}
@@ -0,0 +1,318 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+const (
+	// defaultLockTTL is the ttl in milliseconds of the locks of a transaction, they are rolled back by the readers
+	// once the ttl expires.
+	defaultLockTTL = 3000
+	// txnCommitBatchSize is the max size of the keys and values in a request.
+	txnCommitBatchSize = 16 * 1024
+)
+
+// ErrWriteConflict is returned by Commit if a key of the transaction is written by another transaction after the
+// transaction starts, the transaction can be retried with a new start ts.
+var ErrWriteConflict = errors.New("write conflict")
+
+// ErrResultUndetermined is returned by Commit if the commit of the primary key may have been applied though its
+// response is lost, e.g. the request times out. The transaction may be committed or not, the readers decide it by
+// the primary lock.
+var ErrResultUndetermined = errors.New("result of the commit is undetermined")
+
+// errCommitRejected is returned by a commit if a store reports the lock of a key is not found, i.e. the transaction
+// has been rolled back.
+var errCommitRejected = errors.New("commit rejected")
+
+// batchKeys is the keys of a request to a region.
+type batchKeys struct {
+	region RegionVerID
+	keys   [][]byte
+}
+
+// twoPhaseCommitter commits a transaction by the percolator protocol: all keys are prewritten with the first key
+// as the primary lock, then the primary key is committed, which commits the transaction, and finally the secondary
+// keys are committed asynchronously. The locks left by a failed commit are resolved by the readers.
+type twoPhaseCommitter struct {
+	client    *TxnClient
+	startTS   uint64
+	commitTS  uint64
+	keys      [][]byte
+	mutations map[string]*kvrpcpb.Mutation
+	lockTTL   uint64
+}
+
+func newTwoPhaseCommitter(txn *Txn) *twoPhaseCommitter {
+	c := &twoPhaseCommitter{
+		client:    txn.client,
+		startTS:   txn.startTS,
+		mutations: make(map[string]*kvrpcpb.Mutation),
+		lockTTL:   txn.client.lockTTL,
+	}
+	txn.buffer.Walk(nil, nil, func(key, value []byte) bool {
+		op := kvrpcpb.Op_Put
+		if value == nil {
+			op = kvrpcpb.Op_Del
+		}
+		c.keys = append(c.keys, key)
+		c.mutations[string(key)] = &kvrpcpb.Mutation{Op: op, Key: key, Value: value}
+		return true
+	})
+	return c
+}
+
+func (c *twoPhaseCommitter) primary() []byte {
+	return c.keys[0]
+}
+
+// execute runs the two phases of the commit.
+func (c *twoPhaseCommitter) execute() error {
+	if len(c.keys) == 0 {
+		return nil
+	}
+
+	prewriteBo := NewBackoffer(context.Background(), prewriteMaxBackoff)
+	if err := c.prewriteKeys(prewriteBo, c.keys); err != nil {
+		log.Debugf("prewrite of txn %d failed: %v", c.startTS, err)
+		go c.cleanup()
+		return err
+	}
+
+	commitTS, err := c.client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	if err != nil {
+		go c.cleanup()
+		return err
+	}
+	c.commitTS = commitTS
+
+	// The transaction is committed once the primary key is committed.
+	commitBo := NewBackoffer(context.Background(), commitMaxBackoff)
+	if err := c.commitPrimary(commitBo); err != nil {
+		return err
+	}
+
+	if len(c.keys) > 1 {
+		go func() {
+			bo := NewBackoffer(context.Background(), commitMaxBackoff)
+			if err := c.commitKeys(bo, c.keys[1:]); err != nil {
+				// The readers commit the locks left following the primary key.
+				log.Warnf("commit secondary keys of txn %d failed: %v", c.startTS, err)
+			}
+		}()
+	}
+	return nil
+}
+
+// groupBatches groups the keys by the regions and splits them into batches of at most txnCommitBatchSize. The batch
+// of the primary key is the first one if it is in the keys.
+func (c *twoPhaseCommitter) groupBatches(bo *Backoffer, keys [][]byte) ([]batchKeys, error) {
+	groups, first, err := c.client.regionCache.GroupKeysByRegion(bo, keys)
+	if err != nil {
+		return nil, err
+	}
+	regions := make([]RegionVerID, 0, len(groups))
+	for region := range groups {
+		if region != first {
+			regions = append(regions, region)
+		}
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i].ID < regions[j].ID })
+	regions = append([]RegionVerID{first}, regions...)
+
+	var batches []batchKeys
+	for _, region := range regions {
+		groupKeys := groups[region]
+		for start := 0; start < len(groupKeys); {
+			size, end := 0, start
+			for ; end < len(groupKeys) && (end == start || size < txnCommitBatchSize); end++ {
+				size += len(groupKeys[end]) + len(c.mutations[string(groupKeys[end])].GetValue())
+			}
+			batches = append(batches, batchKeys{region: region, keys: groupKeys[start:end]})
+			start = end
+		}
+	}
+	return batches, nil
+}
+
+// doBatches runs f on the batches. The batch of the primary key runs first if primaryFirst is set, the others run
+// in parallel.
+func (c *twoPhaseCommitter) doBatches(bo *Backoffer, batches []batchKeys, primaryFirst bool,
+	f func(*Backoffer, batchKeys) error) error {
+	if len(batches) == 0 {
+		return nil
+	}
+	if primaryFirst && bytes.Equal(batches[0].keys[0], c.primary()) {
+		if err := f(bo, batches[0]); err != nil {
+			return err
+		}
+		batches = batches[1:]
+	}
+	if len(batches) == 1 {
+		return f(bo, batches[0])
+	}
+
+	errCh := make(chan error, len(batches))
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Add(1)
+		// A backoffer is not safe for concurrent use, every batch has its own one.
+		go func(batch batchKeys, bo *Backoffer) {
+			defer wg.Done()
+			errCh <- f(bo, batch)
+		}(batch, bo.Fork())
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *twoPhaseCommitter) prewriteKeys(bo *Backoffer, keys [][]byte) error {
+	batches, err := c.groupBatches(bo, keys)
+	if err != nil {
+		return err
+	}
+	return c.doBatches(bo, batches, true, c.prewriteBatch)
+}
+
+func (c *twoPhaseCommitter) prewriteBatch(bo *Backoffer, batch batchKeys) error {
+	mutations := make([]*kvrpcpb.Mutation, len(batch.keys))
+	for i, key := range batch.keys {
+		mutations[i] = c.mutations[string(key)]
+	}
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:    mutations,
+		PrimaryLock:  c.primary(),
+		StartVersion: c.startTS,
+		LockTtl:      c.lockTTL,
+	}
+	for {
+		resp, err := c.client.sender().SendReq(bo, req, batch.region, readTimeout)
+		if err != nil {
+			return err
+		}
+		if resp.GetRegionError() != nil {
+			// The region has changed, prewrite the keys in their new regions.
+			return c.prewriteKeys(bo, batch.keys)
+		}
+
+		keyErrs := resp.(*kvrpcpb.PrewriteResponse).GetErrors()
+		if len(keyErrs) == 0 {
+			return nil
+		}
+		var locks []*kvrpcpb.LockInfo
+		for _, keyErr := range keyErrs {
+			if conflict := keyErr.GetConflict(); conflict != nil {
+				return errors.Annotatef(ErrWriteConflict, "txn %d conflicts with txn %d on key %q", c.startTS,
+					conflict.GetConflictTs(), conflict.GetKey())
+			}
+			if keyErr.GetLocked() == nil {
+				return errors.Errorf("prewrite txn %d: %v", c.startTS, keyErr)
+			}
+			locks = append(locks, keyErr.GetLocked())
+		}
+		resolved, err := c.client.lockResolver.ResolveLocks(bo, locks)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			err = errors.Errorf("prewrite of txn %d is blocked by the lock of key %q", c.startTS, locks[0].GetKey())
+			if err := bo.Backoff(BoTxnLock, err); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// commitPrimary commits the primary key. The commit succeeds even if the primary lock has been rolled back, the
+// status of the primary key is checked for it. The keys are rolled back only if the transaction is known to be
+// rolled back, a failed request may have committed the primary key, so ErrResultUndetermined is returned for it.
+func (c *twoPhaseCommitter) commitPrimary(bo *Backoffer) error {
+	if err := c.commitKeys(bo, c.keys[:1]); err != nil {
+		if errors.Cause(err) == errCommitRejected {
+			go c.cleanup()
+			return err
+		}
+		return errors.Annotatef(ErrResultUndetermined, "commit primary key of txn %d: %v", c.startTS, err)
+	}
+	status, err := c.client.lockResolver.GetTxnStatus(bo, c.primary(), c.startTS, c.commitTS)
+	if err != nil {
+		return errors.Annotatef(ErrResultUndetermined, "check status of txn %d: %v", c.startTS, err)
+	}
+	if status.CommitTS != c.commitTS {
+		go c.cleanup()
+		return errors.Errorf("txn %d is rolled back since its locks expired", c.startTS)
+	}
+	return nil
+}
+
+func (c *twoPhaseCommitter) commitKeys(bo *Backoffer, keys [][]byte) error {
+	batches, err := c.groupBatches(bo, keys)
+	if err != nil {
+		return err
+	}
+	return c.doBatches(bo, batches, false, c.commitBatch)
+}
+
+func (c *twoPhaseCommitter) commitBatch(bo *Backoffer, batch batchKeys) error {
+	req := &kvrpcpb.CommitRequest{StartVersion: c.startTS, Keys: batch.keys, CommitVersion: c.commitTS}
+	resp, err := c.client.sender().SendReq(bo, req, batch.region, readTimeout)
+	if err == nil {
+		// The response is lost after the commit is applied.
+		err = failpoint.Eval("client/commit-lost-response")
+	}
+	if err != nil {
+		return err
+	}
+	if resp.GetRegionError() != nil {
+		return c.commitKeys(bo, batch.keys)
+	}
+	if keyErr := resp.(*kvrpcpb.CommitResponse).GetError(); keyErr != nil {
+		return errors.Annotatef(errCommitRejected, "commit txn %d: %v", c.startTS, keyErr)
+	}
+	return nil
+}
+
+// cleanup rolls back the keys of a failed transaction.
+func (c *twoPhaseCommitter) cleanup() {
+	bo := NewBackoffer(context.Background(), cleanupMaxBackoff)
+	if err := c.rollbackKeys(bo, c.keys); err != nil {
+		log.Warnf("rollback txn %d failed: %v", c.startTS, err)
+	}
+}
+
+func (c *twoPhaseCommitter) rollbackKeys(bo *Backoffer, keys [][]byte) error {
+	batches, err := c.groupBatches(bo, keys)
+	if err != nil {
+		return err
+	}
+	return c.doBatches(bo, batches, false, c.rollbackBatch)
+}
+
+func (c *twoPhaseCommitter) rollbackBatch(bo *Backoffer, batch batchKeys) error {
+	req := &kvrpcpb.BatchRollbackRequest{StartVersion: c.startTS, Keys: batch.keys}
+	resp, err := c.client.sender().SendReq(bo, req, batch.region, readTimeout)
+	if err != nil {
+		return err
+	}
+	if resp.GetRegionError() != nil {
+		return c.rollbackKeys(bo, batch.keys)
+	}
+	if keyErr := resp.(*kvrpcpb.BatchRollbackResponse).GetError(); keyErr != nil {
+		return errors.Errorf("rollback txn %d: %v", c.startTS, keyErr)
+	}
+	return nil
+}
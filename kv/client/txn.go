@@ -0,0 +1,259 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
+	"github.com/pingcap/errors"
+)
+
+// TxnClient is a client of the transactional API of TinyKV. A transaction reads a snapshot at its start ts and
+// buffers its writes until it commits by two-phase commit, the timestamps are allocated by the scheduler.
+type TxnClient struct {
+	pdClient     PDClient
+	regionCache  *RegionCache
+	pool         *connPool
+	lockResolver *LockResolver
+	lockTTL      uint64
+	closePD      func()
+}
+
+// NewTxnClient creates a TxnClient of the cluster served by the scheduler at pdAddrs.
+func NewTxnClient(pdAddrs []string, security pd.SecurityOption) (*TxnClient, error) {
+	pdClient, err := pd.NewClient(pdAddrs, security)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	c := NewTxnClientWithPD(pdClient)
+	c.closePD = pdClient.Close
+	return c, nil
+}
+
+// NewTxnClientWithPD creates a TxnClient which locates the regions and allocates the timestamps by pdClient, the
+// caller keeps the ownership of pdClient.
+func NewTxnClientWithPD(pdClient PDClient) *TxnClient {
+	c := &TxnClient{
+		pdClient:    pdClient,
+		regionCache: NewRegionCache(pdClient),
+		pool:        newConnPool(),
+		lockTTL:     defaultLockTTL,
+	}
+	c.lockResolver = newLockResolver(c)
+	return c
+}
+
+// Close closes the connections of the client.
+func (c *TxnClient) Close() error {
+	c.pool.close()
+	if c.closePD != nil {
+		c.closePD()
+	}
+	return nil
+}
+
+// Begin starts a transaction.
+func (c *TxnClient) Begin() (*Txn, error) {
+	startTS, err := c.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	if err != nil {
+		return nil, err
+	}
+	return c.BeginWithTS(startTS), nil
+}
+
+// BeginWithTS starts a transaction with the start ts.
+func (c *TxnClient) BeginWithTS(startTS uint64) *Txn {
+	return &Txn{
+		client:   c,
+		startTS:  startTS,
+		snapshot: newSnapshot(c, startTS),
+		buffer:   newMemBuffer(),
+	}
+}
+
+// GetSnapshot returns a snapshot of the data committed before ts.
+func (c *TxnClient) GetSnapshot(ts uint64) *Snapshot {
+	return newSnapshot(c, ts)
+}
+
+// LockResolver returns the lock resolver of the client.
+func (c *TxnClient) LockResolver() *LockResolver {
+	return c.lockResolver
+}
+
+// getTimestamp allocates a timestamp from the scheduler.
+func (c *TxnClient) getTimestamp(bo *Backoffer) (uint64, error) {
+	for {
+		physical, logical, err := c.pdClient.GetTS(bo.Context())
+		if err == nil {
+			return uint64(physical)<<tsoutil.PhysicalShiftBits + uint64(logical), nil
+		}
+		if err = bo.Backoff(BoPDRPC, errors.Annotate(err, "get timestamp")); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *TxnClient) sender() *RegionRequestSender {
+	return NewRegionRequestSender(c.regionCache, c.pool)
+}
+
+// Txn is a transaction. It is not safe for concurrent use.
+type Txn struct {
+	client   *TxnClient
+	startTS  uint64
+	snapshot *Snapshot
+	buffer   *memBuffer
+	done     bool
+}
+
+// StartTS returns the start ts of the transaction.
+func (txn *Txn) StartTS() uint64 {
+	return txn.startTS
+}
+
+// Get returns the value of the key, it is nil if the key does not exist.
+func (txn *Txn) Get(key []byte) ([]byte, error) {
+	if value, deleted, ok := txn.buffer.Get(key); ok {
+		if deleted {
+			return nil, nil
+		}
+		return value, nil
+	}
+	return txn.snapshot.Get(key)
+}
+
+// Set writes the value of the key.
+func (txn *Txn) Set(key, value []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		// An empty value is a deletion in the engines.
+		return errors.New("empty value is not supported")
+	}
+	txn.buffer.Set(key, value)
+	return nil
+}
+
+// Delete deletes the key.
+func (txn *Txn) Delete(key []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	txn.buffer.Delete(key)
+	return nil
+}
+
+// Iter returns an iterator of the pairs whose keys are in [startKey, endKey) seen by the transaction, an empty endKey
+// means the end of the key space.
+func (txn *Txn) Iter(startKey, endKey []byte) (Iterator, error) {
+	snapshotIter, err := newSnapshotIterator(txn.snapshot, startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+	var writes []*kvrpcpb.KvPair
+	txn.buffer.Walk(startKey, endKey, func(key, value []byte) bool {
+		writes = append(writes, &kvrpcpb.KvPair{Key: key, Value: value})
+		return true
+	})
+	it := &unionIterator{snapshotIter: snapshotIter, writes: writes}
+	if err := it.skipDeleted(); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// Commit commits the writes of the transaction. ErrWriteConflict is returned if another transaction writes a key of
+// the transaction after it starts.
+func (txn *Txn) Commit() error {
+	if txn.done {
+		return errors.New("transaction is finished")
+	}
+	txn.done = true
+	return newTwoPhaseCommitter(txn).execute()
+}
+
+// Rollback discards the writes of the transaction.
+func (txn *Txn) Rollback() error {
+	if txn.done {
+		return errors.New("transaction is finished")
+	}
+	txn.done = true
+	return nil
+}
+
+// unionIterator merges the writes of a transaction into the snapshot it reads, the deleted keys are skipped.
+type unionIterator struct {
+	snapshotIter *snapshotIterator
+	writes       []*kvrpcpb.KvPair
+	// the current pair is the first write if it is set, or the current pair of the snapshot otherwise
+	curIsWrite bool
+}
+
+func (it *unionIterator) Valid() bool {
+	return len(it.writes) > 0 || it.snapshotIter.Valid()
+}
+
+// update chooses the smaller key of the first write and the snapshot, the snapshot pair is skipped if the key is
+// written.
+func (it *unionIterator) update() error {
+	if len(it.writes) == 0 {
+		it.curIsWrite = false
+		return nil
+	}
+	if !it.snapshotIter.Valid() {
+		it.curIsWrite = true
+		return nil
+	}
+	cmp := bytes.Compare(it.writes[0].Key, it.snapshotIter.Key())
+	if cmp == 0 {
+		if err := it.snapshotIter.Next(); err != nil {
+			return err
+		}
+	}
+	it.curIsWrite = cmp <= 0
+	return nil
+}
+
+func (it *unionIterator) skipDeleted() error {
+	for {
+		if err := it.update(); err != nil {
+			return err
+		}
+		if !it.curIsWrite || it.writes[0].Value != nil {
+			return nil
+		}
+		it.writes = it.writes[1:]
+	}
+}
+
+func (it *unionIterator) Key() []byte {
+	if it.curIsWrite {
+		return it.writes[0].Key
+	}
+	return it.snapshotIter.Key()
+}
+
+func (it *unionIterator) Value() []byte {
+	if it.curIsWrite {
+		return it.writes[0].Value
+	}
+	return it.snapshotIter.Value()
+}
+
+func (it *unionIterator) Next() error {
+	if it.curIsWrite {
+		it.writes = it.writes[1:]
+	} else if err := it.snapshotIter.Next(); err != nil {
+		return err
+	}
+	return it.skipDeleted()
+}
+
+func (it *unionIterator) Close() {
+	it.snapshotIter.Close()
+	it.writes = nil
+}
@@ -0,0 +1,352 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTxnClient starts a cluster of count stores serving the TinyKv API and a transactional client of it.
+// The stores keep the data in memory, so many clusters can run in a test process.
+func newTestTxnClient(t *testing.T, count int, cfg *config.Config) (*test_raftstore.Cluster, *TxnClient, func()) {
+	cfg.Engine = config.EngineMemory
+	cluster := test_raftstore.NewTestCluster(count, cfg)
+	cluster.Start()
+	stop, err := cluster.ServeStores()
+	require.Nil(t, err)
+	client := NewTxnClientWithPD(cluster.PDClient())
+	return cluster, client, func() {
+		client.Close()
+		stop()
+		cluster.Shutdown()
+	}
+}
+
+func mustGetTxn(t *testing.T, txn *Txn, key string) string {
+	value, err := txn.Get([]byte(key))
+	require.Nil(t, err)
+	return string(value)
+}
+
+func TestTxnGetSetDelete(t *testing.T) {
+	_, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k1"), []byte("v1")))
+	require.Nil(t, txn.Set([]byte("k2"), []byte("v2")))
+	// The writes are visible to the transaction itself only.
+	assert.Equal(t, "v1", mustGetTxn(t, txn, "k1"))
+	other, err := client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "", mustGetTxn(t, other, "k1"))
+	require.Nil(t, txn.Commit())
+	assert.NotNil(t, txn.Commit())
+
+	// The snapshot of a transaction started before the commit does not see the writes.
+	assert.Equal(t, "", mustGetTxn(t, other, "k1"))
+
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "v1", mustGetTxn(t, txn, "k1"))
+	require.Nil(t, txn.Delete([]byte("k1")))
+	require.Nil(t, txn.Set([]byte("k3"), []byte("v3")))
+	assert.Equal(t, "", mustGetTxn(t, txn, "k1"))
+	require.Nil(t, txn.Commit())
+
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "", mustGetTxn(t, txn, "k1"))
+	assert.Equal(t, "v2", mustGetTxn(t, txn, "k2"))
+	assert.Equal(t, "v3", mustGetTxn(t, txn, "k3"))
+
+	// A rolled back transaction writes nothing.
+	require.Nil(t, txn.Set([]byte("k4"), []byte("v4")))
+	require.Nil(t, txn.Rollback())
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "", mustGetTxn(t, txn, "k4"))
+
+	assert.NotNil(t, txn.Set(nil, []byte("v")))
+	assert.NotNil(t, txn.Set([]byte("k"), nil))
+}
+
+func TestTxnIterAcrossRegions(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	const count = 100
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	for i := 0; i < count; i++ {
+		require.Nil(t, txn.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i))))
+	}
+	require.Nil(t, txn.Commit())
+	cluster.MustSplitRegion([]byte("key030"))
+	cluster.MustSplitRegion([]byte("key060"))
+
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	// Deleted keys are skipped and the new keys are merged in order.
+	require.Nil(t, txn.Delete([]byte("key010")))
+	require.Nil(t, txn.Set([]byte("key010a"), []byte("new")))
+	it, err := txn.Iter([]byte("key005"), []byte("key090"))
+	require.Nil(t, err)
+	defer it.Close()
+	var keys []string
+	for it.Valid() {
+		keys = append(keys, string(it.Key()))
+		require.Nil(t, it.Next())
+	}
+	require.Equal(t, 85, len(keys))
+	assert.Equal(t, "key005", keys[0])
+	assert.Equal(t, "key009", keys[4])
+	assert.Equal(t, "key010a", keys[5])
+	assert.Equal(t, "key011", keys[6])
+	assert.Equal(t, "key089", keys[84])
+}
+
+func TestTxnWriteConflict(t *testing.T) {
+	_, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+
+	txn1, err := client.Begin()
+	require.Nil(t, err)
+	txn2, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn1.Set([]byte("k"), []byte("v1")))
+	require.Nil(t, txn2.Set([]byte("k"), []byte("v2")))
+	require.Nil(t, txn1.Commit())
+	err = txn2.Commit()
+	require.NotNil(t, err)
+	assert.Equal(t, ErrWriteConflict, errors.Cause(err))
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "v1", mustGetTxn(t, txn, "k"))
+}
+
+func TestTxnResolveLocks(t *testing.T) {
+	_, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	client.lockTTL = 100
+
+	// A transaction crashes after prewrite, its locks are rolled back once they expire.
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("a1"), []byte("v1")))
+	require.Nil(t, txn.Set([]byte("a2"), []byte("v2")))
+	committer := newTwoPhaseCommitter(txn)
+	require.Nil(t, committer.prewriteKeys(NewBackoffer(context.Background(), prewriteMaxBackoff), committer.keys))
+
+	reader, err := client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "", mustGetTxn(t, reader, "a2"))
+	assert.Equal(t, "", mustGetTxn(t, reader, "a1"))
+
+	// A transaction crashes after committing the primary key, its secondary locks are committed.
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("b1"), []byte("v1")))
+	require.Nil(t, txn.Set([]byte("b2"), []byte("v2")))
+	committer = newTwoPhaseCommitter(txn)
+	require.Nil(t, committer.prewriteKeys(NewBackoffer(context.Background(), prewriteMaxBackoff), committer.keys))
+	committer.commitTS, err = client.getTimestamp(NewBackoffer(context.Background(), tsoMaxBackoff))
+	require.Nil(t, err)
+	require.Nil(t, committer.commitPrimary(NewBackoffer(context.Background(), commitMaxBackoff)))
+
+	reader, err = client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "v2", mustGetTxn(t, reader, "b2"))
+	assert.Equal(t, "v1", mustGetTxn(t, reader, "b1"))
+}
+
+// TestTxnCommitResponseLost tests that a transaction is not rolled back if the response of its primary commit is
+// lost, the commit may have been applied.
+func TestTxnCommitResponseLost(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	// The secondary key is in another region, so it could be rolled back though the primary key is committed.
+	cluster.MustSplitRegion([]byte("k2"))
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("k1"), []byte("v1")))
+	require.Nil(t, txn.Set([]byte("k2"), []byte("v2")))
+	require.Nil(t, failpoint.Enable("client/commit-lost-response", "1*return(injected)"))
+	defer failpoint.Disable("client/commit-lost-response")
+	err = txn.Commit()
+	require.NotNil(t, err)
+	assert.Equal(t, ErrResultUndetermined, errors.Cause(err))
+
+	// The primary key is committed, the readers commit the secondary lock following it.
+	reader, err := client.Begin()
+	require.Nil(t, err)
+	assert.Equal(t, "v2", mustGetTxn(t, reader, "k2"))
+	assert.Equal(t, "v1", mustGetTxn(t, reader, "k1"))
+}
+
+func TestTxnBatchGetScanLockDeleteRange(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	cluster.MustSplitRegion([]byte("c"))
+	bo := NewBackoffer(context.Background(), getMaxBackoff)
+
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	for _, key := range []string{"a", "b1", "b2", "c"} {
+		require.Nil(t, txn.Set([]byte(key), []byte("v"+key)))
+	}
+	require.Nil(t, txn.Commit())
+	txn, err = client.Begin()
+	require.Nil(t, err)
+	require.Nil(t, txn.Set([]byte("b3"), []byte("vb3")))
+	committer := newTwoPhaseCommitter(txn)
+	require.Nil(t, committer.prewriteKeys(NewBackoffer(context.Background(), prewriteMaxBackoff), committer.keys))
+
+	resp, _, err := client.sender().SendReqByKey(bo, []byte("a"), &kvrpcpb.ScanLockRequest{MaxVersion: txn.StartTS()})
+	require.Nil(t, err)
+	locks := resp.(*kvrpcpb.ScanLockResponse).GetLocks()
+	require.Equal(t, 1, len(locks))
+	assert.Equal(t, []byte("b3"), locks[0].GetKey())
+
+	readTs, err := client.getTimestamp(bo)
+	require.Nil(t, err)
+	batchGet := &kvrpcpb.BatchGetRequest{Keys: [][]byte{[]byte("a"), []byte("b2"), []byte("b4")}, Version: readTs}
+	resp, _, err = client.sender().SendReqByKey(bo, []byte("a"), batchGet)
+	require.Nil(t, err)
+	pairs := resp.(*kvrpcpb.BatchGetResponse).GetPairs()
+	require.Equal(t, 2, len(pairs))
+	assert.Equal(t, []byte("vb2"), pairs[1].GetValue())
+
+	// The range ends at the end of the region, the versions and the locks of the keys in it are deleted from every
+	// replica.
+	deleteRange := &kvrpcpb.DeleteRangeRequest{StartKey: []byte("b"), EndKey: []byte("c")}
+	resp, _, err = client.sender().SendReqByKey(bo, []byte("b"), deleteRange)
+	require.Nil(t, err)
+	assert.Empty(t, resp.(*kvrpcpb.DeleteRangeResponse).GetError())
+	reader, err := client.Begin()
+	require.Nil(t, err)
+	for key, value := range map[string]string{"a": "va", "b1": "", "b2": "", "b3": "", "c": "vc"} {
+		assert.Equal(t, value, mustGetTxn(t, reader, key))
+	}
+	readTs, err = client.getTimestamp(bo)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"", "", ""}, mustStaleGet(t, client, []byte("b1"), readTs))
+}
+
+// TestBankTransfer moves money between accounts in concurrent transactions while the readers check the total never
+// changes.
+func TestBankTransfer(t *testing.T) {
+	cluster, client, shutdown := newTestTxnClient(t, 3, config.NewTestConfig())
+	defer shutdown()
+	// The accounts are in 3 regions.
+	cluster.MustSplitRegion([]byte("acct10"))
+	cluster.MustSplitRegion([]byte("acct20"))
+
+	const (
+		accounts = 30
+		balance  = 1000
+		workers  = 4
+	)
+	accountKey := func(i int) []byte {
+		return []byte(fmt.Sprintf("acct%02d", i))
+	}
+	txn, err := client.Begin()
+	require.Nil(t, err)
+	for i := 0; i < accounts; i++ {
+		require.Nil(t, txn.Set(accountKey(i), []byte(strconv.Itoa(balance))))
+	}
+	require.Nil(t, txn.Commit())
+
+	getBalance := func(txn *Txn, i int) (int, error) {
+		value, err := txn.Get(accountKey(i))
+		if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(string(value))
+	}
+	transfer := func(rnd *rand.Rand) error {
+		from, to := rnd.Intn(accounts), rnd.Intn(accounts)
+		if from == to {
+			return nil
+		}
+		txn, err := client.Begin()
+		if err != nil {
+			return err
+		}
+		fromBalance, err := getBalance(txn, from)
+		if err != nil {
+			return err
+		}
+		toBalance, err := getBalance(txn, to)
+		if err != nil {
+			return err
+		}
+		amount := rnd.Intn(fromBalance/2 + 1)
+		if err := txn.Set(accountKey(from), []byte(strconv.Itoa(fromBalance-amount))); err != nil {
+			return err
+		}
+		if err := txn.Set(accountKey(to), []byte(strconv.Itoa(toBalance+amount))); err != nil {
+			return err
+		}
+		return txn.Commit()
+	}
+	checkTotal := func() {
+		txn, err := client.Begin()
+		require.Nil(t, err)
+		it, err := txn.Iter([]byte("acct"), []byte("accu"))
+		require.Nil(t, err)
+		defer it.Close()
+		total, count := 0, 0
+		for it.Valid() {
+			value, err := strconv.Atoi(string(it.Value()))
+			require.Nil(t, err)
+			total += value
+			count++
+			require.Nil(t, it.Next())
+		}
+		require.Equal(t, accounts, count)
+		require.Equal(t, accounts*balance, total)
+	}
+
+	var committed, conflicted int64
+	var wg sync.WaitGroup
+	deadline := time.Now().Add(3 * time.Second)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			rnd := rand.New(rand.NewSource(seed))
+			for time.Now().Before(deadline) {
+				if err := transfer(rnd); err != nil {
+					// A failed transfer must not change the total, the next one retries with a new start ts.
+					atomic.AddInt64(&conflicted, 1)
+					continue
+				}
+				atomic.AddInt64(&committed, 1)
+			}
+		}(int64(w))
+	}
+	for time.Now().Before(deadline) {
+		checkTotal()
+	}
+	wg.Wait()
+	checkTotal()
+	t.Logf("%d transfers committed, %d failed", committed, conflicted)
+	assert.True(t, committed > 0)
+}
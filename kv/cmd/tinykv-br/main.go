@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/client"
+	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
+	"github.com/pingcap/errors"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := newRootCmd()
+	rootCmd.SetOutput(os.Stdout)
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "tinykv-br",
+		Short: "back up and restore the data of a tinykv cluster",
+		Long: "tinykv-br locates the regions by the scheduler and asks the stores to write the backup files. A restore " +
+			"reads the files, builds the data of each region into an SST file and has the stores ingest it. " +
+			"The storage must be seen by every store and tinykv-br at the same path, e.g. a directory mounted by all of them.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	rootCmd.PersistentFlags().String("pd", "127.0.0.1:2379", "the addresses of the scheduler, separated by commas")
+	rootCmd.PersistentFlags().String("storage", "", "the storage of the backup, e.g. local:///mnt/backup")
+	rootCmd.PersistentFlags().Duration("timeout", 10*time.Minute, "the timeout of the whole backup or restore")
+
+	rootCmd.AddCommand(
+		newBackupCmd(),
+		newRestoreCmd(),
+	)
+	return rootCmd
+}
+
+// withClient connects to the cluster given by the flags and runs f with a client of it.
+func withClient(cmd *cobra.Command, f func(ctx context.Context, c *client.TxnClient, storage string) error) error {
+	storage, _ := cmd.Flags().GetString("storage")
+	if storage == "" {
+		return errors.New("the storage must be given by --storage")
+	}
+	pdAddrs, _ := cmd.Flags().GetString("pd")
+	c, err := client.NewTxnClient(strings.Split(pdAddrs, ","), pd.SecurityOption{})
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return f(ctx, c, storage)
+}
+
+func newBackupCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "backup [--start=<key>] [--end=<key>] [--ts=<ts>]",
+		Short: "back up the data of [start, end) visible at ts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			start, _ := cmd.Flags().GetString("start")
+			end, _ := cmd.Flags().GetString("end")
+			ts, _ := cmd.Flags().GetUint64("ts")
+			return withClient(cmd, func(ctx context.Context, c *client.TxnClient, storage string) error {
+				manifest, err := c.Backup(ctx, []byte(start), []byte(end), ts, storage)
+				if err != nil {
+					return err
+				}
+				printManifest(cmd, manifest)
+				return nil
+			})
+		},
+	}
+	c.Flags().String("start", "", "the start key of the range")
+	c.Flags().String("end", "", "the end key of the range, empty means the end of the key space")
+	c.Flags().Uint64("ts", 0, "the timestamp of the backup, 0 allocates a new one")
+	return c
+}
+
+func newRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore",
+		Short: "restore the backup by ingesting it, the regions of its range are split and scattered first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withClient(cmd, func(ctx context.Context, c *client.TxnClient, storage string) error {
+				manifest, err := c.Restore(ctx, storage)
+				if err != nil {
+					return err
+				}
+				printManifest(cmd, manifest)
+				return nil
+			})
+		},
+	}
+}
+
+func printManifest(cmd *cobra.Command, manifest *backup.Manifest) {
+	var kvs, size uint64
+	for _, file := range manifest.Files {
+		kvs += file.GetTotalKvs()
+		size += file.GetTotalBytes()
+	}
+	cmd.Printf("range [%q, %q) at ts %d: %d files, %d kvs, %d bytes\n",
+		manifest.StartKey, manifest.EndKey, manifest.BackupTs, len(manifest.Files), kvs, size)
+}
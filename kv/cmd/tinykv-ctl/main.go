@@ -0,0 +1,294 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/debug"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := newRootCmd()
+	rootCmd.SetOutput(os.Stdout)
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "tinykv-ctl",
+		Short: "inspect and repair the data directory of a stopped tinykv-server",
+		Long: "tinykv-ctl opens the kv and raft engines under the data directory of a store. The store must not be " +
+			"running, all commands but tombstone open the engines read-only.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	rootCmd.PersistentFlags().String("db", "", "the data directory of the store, i.e. DBPath in the config")
+	rootCmd.PersistentFlags().String("config", "", "the config file of the store to read DBPath from")
+
+	rootCmd.AddCommand(
+		newRegionCmd(),
+		newRegionsCmd(),
+		newRaftLogCmd(),
+		newMvccCmd(),
+		newTombstoneCmd(),
+		newConsistencyCheckCmd(),
+	)
+	return rootCmd
+}
+
+// withDebugger opens the engines of the store given by the flags, runs f with a debugger of them and closes them.
+func withDebugger(cmd *cobra.Command, readOnly bool, f func(d *debug.Debugger) error) error {
+	dbPath, _ := cmd.Flags().GetString("db")
+	if configPath, _ := cmd.Flags().GetString("config"); configPath != "" && dbPath == "" {
+		conf, err := config.NewDefaultConfig().LoadFile(configPath)
+		if err != nil {
+			return err
+		}
+		dbPath = conf.DBPath
+	}
+	if dbPath == "" {
+		return errors.New("the data directory must be given by --db or --config")
+	}
+
+	kv, err := openEngine(filepath.Join(dbPath, "kv"), readOnly)
+	if err != nil {
+		return err
+	}
+	// A standalone store has no raft engine.
+	var raft engine_util.Engine = engine_util.NewMemEngine()
+	if _, err = os.Stat(filepath.Join(dbPath, "raft")); err == nil {
+		if raft, err = openEngine(filepath.Join(dbPath, "raft"), readOnly); err != nil {
+			kv.Close()
+			return err
+		}
+	}
+	engines := engine_util.NewEngines(kv, raft, filepath.Join(dbPath, "kv"), filepath.Join(dbPath, "raft"))
+	defer engines.Close()
+	return f(debug.NewDebugger(engines))
+}
+
+func openEngine(dir string, readOnly bool) (engine_util.Engine, error) {
+	if readOnly {
+		engine, err := engine_util.OpenBadgerEngineReadOnly(dir)
+		if err != nil {
+			return nil, errors.Annotatef(err, "open %s", dir)
+		}
+		return engine, nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	engine, err := engine_util.OpenBadgerEngine(dir, 0)
+	if err != nil {
+		return nil, errors.Annotatef(err, "open %s", dir)
+	}
+	return engine, nil
+}
+
+func parseRegionID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || id == 0 {
+		return 0, errors.Errorf("invalid region id: %s", s)
+	}
+	return id, nil
+}
+
+func newRegionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "region <region_id>",
+		Short: "show the region, raft and apply state of the region",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			regionID, err := parseRegionID(args[0])
+			if err != nil {
+				return err
+			}
+			return withDebugger(cmd, true, func(d *debug.Debugger) error {
+				info, err := d.RegionInfo(regionID)
+				if err != nil {
+					return err
+				}
+				cmd.Println("region state:", info.RegionLocalState)
+				cmd.Println("raft state:", info.RaftLocalState)
+				cmd.Println("apply state:", info.ApplyState)
+				return nil
+			})
+		},
+	}
+}
+
+func newRegionsCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "regions [--state=Normal,Applying,Tombstone]",
+		Short: "list the regions of the store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateNames, _ := cmd.Flags().GetStringSlice("state")
+			var states []rspb.PeerState
+			for _, name := range stateNames {
+				state, ok := rspb.PeerState_value[name]
+				if !ok {
+					return errors.Errorf("invalid state: %s", name)
+				}
+				states = append(states, rspb.PeerState(state))
+			}
+			return withDebugger(cmd, true, func(d *debug.Debugger) error {
+				regions, err := d.Regions(states...)
+				if err != nil {
+					return err
+				}
+				for _, state := range regions {
+					region := state.Region
+					cmd.Printf("region %d: state %v, range [%s, %s), epoch %v, peers %v\n", region.GetId(), state.State,
+						hex.EncodeToString(region.GetStartKey()), hex.EncodeToString(region.GetEndKey()),
+						region.GetRegionEpoch(), region.GetPeers())
+				}
+				return nil
+			})
+		},
+	}
+	c.Flags().StringSlice("state", nil, "only list the regions in the states")
+	return c
+}
+
+func newRaftLogCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "raft-log <region_id> [--from=<index>] [--to=<index>]",
+		Short: "print the raft log entries of the region in [from, to)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			regionID, err := parseRegionID(args[0])
+			if err != nil {
+				return err
+			}
+			from, _ := cmd.Flags().GetUint64("from")
+			to, _ := cmd.Flags().GetUint64("to")
+			return withDebugger(cmd, true, func(d *debug.Debugger) error {
+				if from == 0 {
+					// Start from the first entry which is not truncated.
+					info, err := d.RegionInfo(regionID)
+					if err != nil {
+						return err
+					}
+					from = info.ApplyState.GetTruncatedState().GetIndex() + 1
+				}
+				entries, err := d.RaftLog(regionID, from, to)
+				if err != nil {
+					return err
+				}
+				for _, entry := range entries {
+					msg, err := debug.DecodeEntry(entry)
+					if err != nil {
+						return err
+					}
+					cmd.Printf("index %d, term %d, type %v: %v\n", entry.Index, entry.Term, entry.EntryType, msg)
+				}
+				return nil
+			})
+		},
+	}
+	c.Flags().Uint64("from", 0, "the first index, the first entry which is not truncated by default")
+	c.Flags().Uint64("to", 0, "the index after the last one, the end of the log by default")
+	return c
+}
+
+func newMvccCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "mvcc <key> [--format=raw|hex]",
+		Short: "show the lock, writes and values of the user key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := []byte(args[0])
+			switch format, _ := cmd.Flags().GetString("format"); format {
+			case "raw":
+			case "hex":
+				var err error
+				if key, err = hex.DecodeString(args[0]); err != nil {
+					return errors.Errorf("invalid hex key: %s", args[0])
+				}
+			default:
+				return errors.Errorf("invalid format: %s", format)
+			}
+			return withDebugger(cmd, true, func(d *debug.Debugger) error {
+				info, err := d.Mvcc(key)
+				if err != nil {
+					return err
+				}
+				if lock := info.Lock; lock != nil {
+					cmd.Printf("lock: start_ts %d, primary %s, ttl %d, kind %v\n", lock.Ts, hex.EncodeToString(lock.Primary),
+						lock.Ttl, lock.Kind.ToProto())
+				}
+				for _, write := range info.Writes {
+					cmd.Printf("write: commit_ts %d, start_ts %d, kind %v\n", write.CommitTS, write.StartTS, write.Kind.ToProto())
+				}
+				for _, value := range info.Values {
+					cmd.Printf("value: start_ts %d, value %s\n", value.StartTS, hex.EncodeToString(value.Value))
+				}
+				return nil
+			})
+		},
+	}
+	c.Flags().String("format", "raw", "the format of the key, raw or hex")
+	return c
+}
+
+func newTombstoneCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tombstone <region_id>",
+		Short: "mark the peer of the region as tombstone, it is destroyed when the store starts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			regionID, err := parseRegionID(args[0])
+			if err != nil {
+				return err
+			}
+			return withDebugger(cmd, false, func(d *debug.Debugger) error {
+				if err := d.TombstoneRegion(regionID); err != nil {
+					return err
+				}
+				cmd.Printf("region %d is tombstone now\n", regionID)
+				return nil
+			})
+		},
+	}
+}
+
+func newConsistencyCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "consistency-check [<region_id>]",
+		Short: "check the apply state of the regions against the raft log and the data",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var regionID uint64
+			if len(args) == 1 {
+				var err error
+				if regionID, err = parseRegionID(args[0]); err != nil {
+					return err
+				}
+			}
+			return withDebugger(cmd, true, func(d *debug.Debugger) error {
+				problems, err := d.CheckConsistency(regionID)
+				if err != nil {
+					return err
+				}
+				if len(problems) > 0 {
+					return errors.Errorf("found %d problems:\n%s", len(problems), strings.Join(problems, "\n"))
+				}
+				cmd.Println("no problem found")
+				return nil
+			})
+		},
+	}
+}
@@ -8,12 +8,14 @@ import (
 )
 
 type Config struct {
-	StoreAddr string
-	Raft      bool
-	PDAddr    string
-	LogLevel  string
+	StoreAddr  string
+	StatusAddr string // Address of the status HTTP server, empty to disable it.
+	Raft       bool
+	PDAddr     string
+	LogLevel   string
 
 	DBPath string // Directory to store the data in. Should exist and be writable.
+	Engine string // Storage engine for the kv and raft data, EngineBadger or EngineMemory.
 
 	// raft_base_tick_interval is a base tick interval (ms).
 	RaftBaseTickInterval     time.Duration
@@ -30,15 +32,25 @@ type Config struct {
 	// delay time before deleting a stale peer
 	PdHeartbeatTickInterval      time.Duration
 	PdStoreHeartbeatTickInterval time.Duration
+	// A peer the leader hasn't heard from for this long is reported to the scheduler as down.
+	MaxPeerDownDuration time.Duration
 
 	// When region [a,e) size meets regionMaxSize, it will be split into
 	// several regions [a,b), [b,c), [c,d), [d,e). And the size of [a,b),
 	// [b,c), [c,d) will be regionSplitSize (maybe a little larger).
 	RegionMaxSize   uint64
 	RegionSplitSize uint64
+
+	// Interval to advance the resolved ts of the regions led by the store while stale reads wait on them, and to send
+	// the resolved ts to the change data subscribers.
+	ResolvedTsInterval time.Duration
 }
 
 func (c *Config) Validate() error {
+	if c.Engine != EngineBadger && c.Engine != EngineMemory {
+		return fmt.Errorf("unknown storage engine %s", c.Engine)
+	}
+
 	if c.RaftHeartbeatTicks == 0 {
 		return fmt.Errorf("heartbeat tick must greater than 0")
 	}
@@ -52,9 +64,33 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("election tick must be greater than heartbeat tick.")
 	}
 
+	if c.RaftBaseTickInterval <= 0 {
+		return fmt.Errorf("raft base tick interval must be greater than 0")
+	}
+
+	if c.MaxPeerDownDuration <= 0 {
+		return fmt.Errorf("max peer down duration must be greater than 0")
+	}
+
+	if c.ResolvedTsInterval <= 0 {
+		return fmt.Errorf("resolved ts interval must be greater than 0")
+	}
+
+	if c.RegionSplitSize == 0 || c.RegionSplitSize > c.RegionMaxSize {
+		return fmt.Errorf("region split size %d must be greater than 0 and not greater than region max size %d",
+			c.RegionSplitSize, c.RegionMaxSize)
+	}
+
 	return nil
 }
 
+const (
+	// EngineBadger stores data on disk with badger.
+	EngineBadger = "badger"
+	// EngineMemory keeps all data in memory, intended for testing only.
+	EngineMemory = "memory"
+)
+
 const (
 	KB uint64 = 1024
 	MB uint64 = 1024 * 1024
@@ -64,6 +100,7 @@ func NewDefaultConfig() *Config {
 	return &Config{
 		PDAddr:                   "127.0.0.1:2379",
 		StoreAddr:                "127.0.0.1:20160",
+		StatusAddr:               "127.0.0.1:20180",
 		LogLevel:                 "info",
 		RaftBaseTickInterval:     1 * time.Second,
 		RaftHeartbeatTicks:       2,
@@ -74,9 +111,12 @@ func NewDefaultConfig() *Config {
 		SplitRegionCheckTickInterval: 10 * time.Second,
 		PdHeartbeatTickInterval:      100 * time.Millisecond,
 		PdStoreHeartbeatTickInterval: 10 * time.Second,
+		MaxPeerDownDuration:          5 * time.Minute,
 		RegionMaxSize:                144 * MB,
 		RegionSplitSize:              96 * MB,
+		ResolvedTsInterval:           1 * time.Second,
 		DBPath:                       "/tmp/badger",
+		Engine:                       EngineBadger,
 	}
 }
 
@@ -92,8 +132,11 @@ func NewTestConfig() *Config {
 		SplitRegionCheckTickInterval: 100 * time.Millisecond,
 		PdHeartbeatTickInterval:      100 * time.Millisecond,
 		PdStoreHeartbeatTickInterval: 500 * time.Millisecond,
+		MaxPeerDownDuration:          5 * time.Second,
 		RegionMaxSize:                144 * MB,
 		RegionSplitSize:              96 * MB,
+		ResolvedTsInterval:           100 * time.Millisecond,
 		DBPath:                       "/tmp/badger",
+		Engine:                       EngineBadger,
 	}
 }
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "tinykv_config")
+	require.Nil(t, err)
+	path := filepath.Join(dir, "config.toml")
+	require.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeConfigFile(t, `
+[server]
+raft = true
+
+[storage]
+db-path = "/tmp/tinykv"
+
+[raftstore]
+raft-log-gc-tick-interval = "3s"
+region-split-size = "64MiB"
+`)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	cfg, err := NewDefaultConfig().LoadFile(path)
+	require.Nil(t, err)
+	require.True(t, cfg.Raft)
+	require.Equal(t, "/tmp/tinykv", cfg.DBPath)
+	require.Equal(t, 3*time.Second, cfg.RaftLogGCTickInterval)
+	require.Equal(t, 64*MB, cfg.RegionSplitSize)
+	// Options missing from the file keep their default value.
+	require.Equal(t, NewDefaultConfig().StoreAddr, cfg.StoreAddr)
+	require.Equal(t, NewDefaultConfig().RegionMaxSize, cfg.RegionMaxSize)
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	for _, content := range []string{
+		"[raftstore]\nunknown-option = 1\n",
+		"[raftstore]\nregion-split-size = \"200MiB\"\n",
+		"[storage]\nengine = \"rocksdb\"\n",
+	} {
+		path := writeConfigFile(t, content)
+		_, err := NewDefaultConfig().LoadFile(path)
+		require.NotNil(t, err, content)
+		os.RemoveAll(filepath.Dir(path))
+	}
+}
+
+func TestControllerUpdate(t *testing.T) {
+	controller := NewController(NewDefaultConfig())
+	var pushed *Config
+	controller.Register(func(cfg *Config) {
+		pushed = cfg
+	})
+
+	err := controller.Update(map[string]string{
+		"raftstore.region-split-size":       "64MiB",
+		"raftstore.raft-log-gc-count-limit": "1000",
+	})
+	require.Nil(t, err)
+	require.Equal(t, controller.Get(), pushed)
+	require.Equal(t, 64*MB, pushed.RegionSplitSize)
+	require.Equal(t, uint64(1000), pushed.RaftLogGcCountLimit)
+
+	// Options which can't be changed online and invalid values are rejected as a whole.
+	for _, changes := range []map[string]string{
+		{"raftstore.raft-base-tick-interval": "2s"},
+		{"raftstore.region-split-size": "1TiB"},
+		{"raftstore.raft-log-gc-tick-interval": "abc"},
+	} {
+		require.NotNil(t, controller.Update(changes), changes)
+	}
+	require.Equal(t, 64*MB, controller.Get().RegionSplitSize)
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+)
+
+// FileConfig is the layout of the TOML configuration file of tinykv-server, e.g.
+//
+//	[server]
+//	addr = "127.0.0.1:20160"
+//	raft = true
+//
+//	[storage]
+//	db-path = "/tmp/badger"
+//
+//	[raftstore]
+//	raft-log-gc-tick-interval = "10s"
+//	region-split-size = "96MiB"
+//
+// Every field is mapped onto a field of Config, options missing from the file keep their current value.
+type FileConfig struct {
+	Server    ServerFileConfig    `toml:"server" json:"server"`
+	Storage   StorageFileConfig   `toml:"storage" json:"storage"`
+	Raftstore RaftstoreFileConfig `toml:"raftstore" json:"raftstore"`
+	CDC       CDCFileConfig       `toml:"cdc" json:"cdc"`
+}
+
+type ServerFileConfig struct {
+	Addr       string `toml:"addr" json:"addr"`
+	StatusAddr string `toml:"status-addr" json:"status-addr"`
+	PDAddr     string `toml:"pd-addr" json:"pd-addr"`
+	Raft       bool   `toml:"raft" json:"raft"`
+	LogLevel   string `toml:"log-level" json:"log-level"`
+}
+
+type StorageFileConfig struct {
+	DBPath string `toml:"db-path" json:"db-path"`
+	Engine string `toml:"engine" json:"engine"`
+}
+
+type RaftstoreFileConfig struct {
+	RaftBaseTickInterval         typeutil.Duration `toml:"raft-base-tick-interval" json:"raft-base-tick-interval"`
+	RaftHeartbeatTicks           int               `toml:"raft-heartbeat-ticks" json:"raft-heartbeat-ticks"`
+	RaftElectionTimeoutTicks     int               `toml:"raft-election-timeout-ticks" json:"raft-election-timeout-ticks"`
+	RaftLogGCTickInterval        typeutil.Duration `toml:"raft-log-gc-tick-interval" json:"raft-log-gc-tick-interval"`
+	RaftLogGcCountLimit          uint64            `toml:"raft-log-gc-count-limit" json:"raft-log-gc-count-limit"`
+	SplitRegionCheckTickInterval typeutil.Duration `toml:"split-region-check-tick-interval" json:"split-region-check-tick-interval"`
+	PdHeartbeatTickInterval      typeutil.Duration `toml:"pd-heartbeat-tick-interval" json:"pd-heartbeat-tick-interval"`
+	PdStoreHeartbeatTickInterval typeutil.Duration `toml:"pd-store-heartbeat-tick-interval" json:"pd-store-heartbeat-tick-interval"`
+	MaxPeerDownDuration          typeutil.Duration `toml:"max-peer-down-duration" json:"max-peer-down-duration"`
+	RegionMaxSize                typeutil.ByteSize `toml:"region-max-size" json:"region-max-size"`
+	RegionSplitSize              typeutil.ByteSize `toml:"region-split-size" json:"region-split-size"`
+}
+
+type CDCFileConfig struct {
+	ResolvedTsInterval typeutil.Duration `toml:"resolved-ts-interval" json:"resolved-ts-interval"`
+}
+
+// NewFileConfig returns the file representation of c.
+func NewFileConfig(c *Config) *FileConfig {
+	return &FileConfig{
+		Server: ServerFileConfig{
+			Addr:       c.StoreAddr,
+			StatusAddr: c.StatusAddr,
+			PDAddr:     c.PDAddr,
+			Raft:       c.Raft,
+			LogLevel:   c.LogLevel,
+		},
+		Storage: StorageFileConfig{
+			DBPath: c.DBPath,
+			Engine: c.Engine,
+		},
+		Raftstore: RaftstoreFileConfig{
+			RaftBaseTickInterval:         typeutil.NewDuration(c.RaftBaseTickInterval),
+			RaftHeartbeatTicks:           c.RaftHeartbeatTicks,
+			RaftElectionTimeoutTicks:     c.RaftElectionTimeoutTicks,
+			RaftLogGCTickInterval:        typeutil.NewDuration(c.RaftLogGCTickInterval),
+			RaftLogGcCountLimit:          c.RaftLogGcCountLimit,
+			SplitRegionCheckTickInterval: typeutil.NewDuration(c.SplitRegionCheckTickInterval),
+			PdHeartbeatTickInterval:      typeutil.NewDuration(c.PdHeartbeatTickInterval),
+			PdStoreHeartbeatTickInterval: typeutil.NewDuration(c.PdStoreHeartbeatTickInterval),
+			MaxPeerDownDuration:          typeutil.NewDuration(c.MaxPeerDownDuration),
+			RegionMaxSize:                typeutil.ByteSize(c.RegionMaxSize),
+			RegionSplitSize:              typeutil.ByteSize(c.RegionSplitSize),
+		},
+		CDC: CDCFileConfig{
+			ResolvedTsInterval: typeutil.NewDuration(c.ResolvedTsInterval),
+		},
+	}
+}
+
+// Config converts the file representation back to a Config.
+func (f *FileConfig) Config() *Config {
+	return &Config{
+		StoreAddr:                    f.Server.Addr,
+		StatusAddr:                   f.Server.StatusAddr,
+		PDAddr:                       f.Server.PDAddr,
+		Raft:                         f.Server.Raft,
+		LogLevel:                     f.Server.LogLevel,
+		DBPath:                       f.Storage.DBPath,
+		Engine:                       f.Storage.Engine,
+		RaftBaseTickInterval:         f.Raftstore.RaftBaseTickInterval.Duration,
+		RaftHeartbeatTicks:           f.Raftstore.RaftHeartbeatTicks,
+		RaftElectionTimeoutTicks:     f.Raftstore.RaftElectionTimeoutTicks,
+		RaftLogGCTickInterval:        f.Raftstore.RaftLogGCTickInterval.Duration,
+		RaftLogGcCountLimit:          f.Raftstore.RaftLogGcCountLimit,
+		SplitRegionCheckTickInterval: f.Raftstore.SplitRegionCheckTickInterval.Duration,
+		PdHeartbeatTickInterval:      f.Raftstore.PdHeartbeatTickInterval.Duration,
+		PdStoreHeartbeatTickInterval: f.Raftstore.PdStoreHeartbeatTickInterval.Duration,
+		MaxPeerDownDuration:          f.Raftstore.MaxPeerDownDuration.Duration,
+		RegionMaxSize:                uint64(f.Raftstore.RegionMaxSize),
+		RegionSplitSize:              uint64(f.Raftstore.RegionSplitSize),
+		ResolvedTsInterval:           f.CDC.ResolvedTsInterval.Duration,
+	}
+}
+
+// LoadFile reads the TOML file at path on top of c and returns the resulting config. Unknown options are rejected
+// so that typos don't silently fall back to the defaults. The result is validated.
+func (c *Config) LoadFile(path string) (*Config, error) {
+	f := NewFileConfig(c)
+	meta, err := toml.DecodeFile(path, f)
+	if err != nil {
+		return nil, err
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		keys := make([]string, 0, len(undecoded))
+		for _, key := range undecoded {
+			keys = append(keys, key.String())
+		}
+		return nil, fmt.Errorf("unknown config options in %s: %s", path, strings.Join(keys, ", "))
+	}
+	cfg := f.Config()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
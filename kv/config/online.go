@@ -0,0 +1,134 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+)
+
+// onlineOptions are the options which can be changed while the server is running, keyed by their
+// `section.name` in the config file. Options which must be the same across the cluster (e.g. the raft ticks) or
+// which are only read on start up (e.g. addresses and paths) are not listed.
+var onlineOptions = map[string]func(c *Config, value string) error{
+	"raftstore.raft-log-gc-tick-interval": durationOption(func(c *Config) *time.Duration {
+		return &c.RaftLogGCTickInterval
+	}),
+	"raftstore.raft-log-gc-count-limit": uint64Option(func(c *Config) *uint64 {
+		return &c.RaftLogGcCountLimit
+	}),
+	"raftstore.split-region-check-tick-interval": durationOption(func(c *Config) *time.Duration {
+		return &c.SplitRegionCheckTickInterval
+	}),
+	"raftstore.pd-heartbeat-tick-interval": durationOption(func(c *Config) *time.Duration {
+		return &c.PdHeartbeatTickInterval
+	}),
+	"raftstore.pd-store-heartbeat-tick-interval": durationOption(func(c *Config) *time.Duration {
+		return &c.PdStoreHeartbeatTickInterval
+	}),
+	"raftstore.max-peer-down-duration": durationOption(func(c *Config) *time.Duration {
+		return &c.MaxPeerDownDuration
+	}),
+	"raftstore.region-max-size": byteSizeOption(func(c *Config) *uint64 {
+		return &c.RegionMaxSize
+	}),
+	"raftstore.region-split-size": byteSizeOption(func(c *Config) *uint64 {
+		return &c.RegionSplitSize
+	}),
+}
+
+func durationOption(field func(c *Config) *time.Duration) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func uint64Option(field func(c *Config) *uint64) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		var v uint64
+		if _, err := fmt.Sscan(value, &v); err != nil {
+			return err
+		}
+		*field(c) = v
+		return nil
+	}
+}
+
+func byteSizeOption(field func(c *Config) *uint64) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		var v typeutil.ByteSize
+		if err := v.UnmarshalText([]byte(value)); err != nil {
+			return err
+		}
+		*field(c) = uint64(v)
+		return nil
+	}
+}
+
+// OnlineOptions returns the sorted names of the options which can be changed by Controller.Update.
+func OnlineOptions() []string {
+	names := make([]string, 0, len(onlineOptions))
+	for name := range onlineOptions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Controller owns the running config of a server. Changes made through Update are validated and then pushed to
+// every registered handler, so running components pick them up without a restart.
+type Controller struct {
+	mu       sync.Mutex
+	cfg      *Config
+	handlers []func(cfg *Config)
+}
+
+func NewController(cfg *Config) *Controller {
+	return &Controller{cfg: cfg}
+}
+
+// Get returns the current config. The returned value must not be modified.
+func (c *Controller) Get() *Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cfg
+}
+
+// Register adds a handler which is called with the new config after each successful Update.
+func (c *Controller) Register(handler func(cfg *Config)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers = append(c.handlers, handler)
+}
+
+// Update applies changes, a map from `section.name` to the new value in config file syntax, e.g.
+// {"raftstore.region-split-size": "64MiB"}. Either all changes are applied or none.
+func (c *Controller) Update(changes map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cfg := *c.cfg
+	for name, value := range changes {
+		set, ok := onlineOptions[name]
+		if !ok {
+			return fmt.Errorf("config option %s can't be changed online", name)
+		}
+		if err := set(&cfg, value); err != nil {
+			return fmt.Errorf("invalid value %q for %s: %v", value, name, err)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	c.cfg = &cfg
+	for _, handler := range c.handlers {
+		handler(c.cfg)
+	}
+	return nil
+}
@@ -0,0 +1,408 @@
+// Package debug inspects and repairs the data of a store offline, that is while tinykv-server is not running on it.
+package debug
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/codec"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+)
+
+// ErrRegionNotFound is returned when a store has no state at all for a region.
+var ErrRegionNotFound = errors.New("region not found")
+
+// Debugger reads the raft and kv engines of a store.
+type Debugger struct {
+	engines *engine_util.Engines
+}
+
+// NewDebugger creates a Debugger for the engines of a store.
+func NewDebugger(engines *engine_util.Engines) *Debugger {
+	return &Debugger{engines: engines}
+}
+
+// RegionInfo is the local state of a region. States which are missing in the store are nil.
+type RegionInfo struct {
+	RegionLocalState *rspb.RegionLocalState
+	RaftLocalState   *rspb.RaftLocalState
+	ApplyState       *rspb.RaftApplyState
+}
+
+// RegionInfo returns the local state of the region, or ErrRegionNotFound if the store has none of its states.
+func (d *Debugger) RegionInfo(regionID uint64) (*RegionInfo, error) {
+	info := new(RegionInfo)
+	regionState, err := meta.GetRegionLocalState(d.engines.Kv, regionID)
+	if err == nil {
+		info.RegionLocalState = regionState
+	} else if err != engine_util.ErrKeyNotFound {
+		return nil, err
+	}
+	raftState, err := meta.GetRaftLocalState(d.engines.Raft, regionID)
+	if err == nil {
+		info.RaftLocalState = raftState
+	} else if err != engine_util.ErrKeyNotFound {
+		return nil, err
+	}
+	applyState, err := meta.GetApplyState(d.engines.Kv, regionID)
+	if err == nil {
+		info.ApplyState = applyState
+	} else if err != engine_util.ErrKeyNotFound {
+		return nil, err
+	}
+	if info.RegionLocalState == nil && info.RaftLocalState == nil && info.ApplyState == nil {
+		return nil, errors.WithStack(ErrRegionNotFound)
+	}
+	return info, nil
+}
+
+// RaftLog returns the raft log entries of the region in [from, to), stopping at the first missing entry. If to is 0,
+// the entries up to the last index of the raft state are returned.
+func (d *Debugger) RaftLog(regionID, from, to uint64) ([]*eraftpb.Entry, error) {
+	if to == 0 {
+		raftState, err := meta.GetRaftLocalState(d.engines.Raft, regionID)
+		if err != nil {
+			if err == engine_util.ErrKeyNotFound {
+				return nil, errors.WithStack(ErrRegionNotFound)
+			}
+			return nil, err
+		}
+		to = raftState.LastIndex + 1
+	}
+	var entries []*eraftpb.Entry
+	for idx := from; idx < to; idx++ {
+		entry, err := meta.GetRaftEntry(d.engines.Raft, regionID, idx)
+		if err == engine_util.ErrKeyNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// DecodeEntry decodes the data of a raft log entry, which is a RaftCmdRequest for normal entries and a ConfChange for
+// conf change entries. It returns nil for empty entries, e.g. those proposed by new leaders.
+func DecodeEntry(entry *eraftpb.Entry) (proto.Message, error) {
+	if len(entry.Data) == 0 {
+		return nil, nil
+	}
+	var msg proto.Message
+	switch entry.EntryType {
+	case eraftpb.EntryType_EntryNormal:
+		msg = new(raft_cmdpb.RaftCmdRequest)
+	case eraftpb.EntryType_EntryConfChange:
+		msg = new(eraftpb.ConfChange)
+	default:
+		return nil, errors.Errorf("unknown entry type %v", entry.EntryType)
+	}
+	if err := proto.Unmarshal(entry.Data, msg); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return msg, nil
+}
+
+// MvccWrite is a write of a user key in CfWrite.
+type MvccWrite struct {
+	CommitTS uint64
+	*mvcc.Write
+}
+
+// MvccValue is a value of a user key in CfDefault.
+type MvccValue struct {
+	StartTS uint64
+	Value   []byte
+}
+
+// MvccInfo is all the versions of a user key.
+type MvccInfo struct {
+	Lock *mvcc.Lock
+	// Writes and Values are ordered from the newest to the oldest.
+	Writes []MvccWrite
+	Values []MvccValue
+}
+
+// Mvcc returns the lock, the writes and the values of the user key.
+func (d *Debugger) Mvcc(key []byte) (*MvccInfo, error) {
+	snap := d.engines.Kv.NewSnapshot()
+	defer snap.Discard()
+
+	info := new(MvccInfo)
+	lockValue, err := engine_util.GetCFFromSnapshot(snap, engine_util.CfLock, key)
+	if err != nil && err != engine_util.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if info.Lock, err = mvcc.ParseLock(lockValue); err != nil {
+			return nil, err
+		}
+	}
+
+	err = scanVersions(snap, engine_util.CfWrite, key, func(ts uint64, value []byte) error {
+		write, err := mvcc.ParseWrite(value)
+		if err != nil {
+			return err
+		}
+		info.Writes = append(info.Writes, MvccWrite{CommitTS: ts, Write: write})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = scanVersions(snap, engine_util.CfDefault, key, func(ts uint64, value []byte) error {
+		info.Values = append(info.Values, MvccValue{StartTS: ts, Value: value})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// scanVersions calls f with every version of the user key in the cf, newest first.
+func scanVersions(snap engine_util.Snapshot, cf string, key []byte, f func(ts uint64, value []byte) error) error {
+	it := engine_util.NewCFIterator(cf, snap)
+	defer it.Close()
+	for it.Seek(mvcc.EncodeKey(key, mvcc.TsMax)); it.Valid(); it.Next() {
+		item := it.Item()
+		userKey, ts, err := decodeKey(item.Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(userKey, key) {
+			break
+		}
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err = f(ts, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeKey splits a key encoded by mvcc.EncodeKey into the user key and the timestamp.
+func decodeKey(key []byte) ([]byte, uint64, error) {
+	left, userKey, err := codec.DecodeBytes(key)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+	if len(left) != 8 {
+		return nil, 0, errors.Errorf("invalid timestamp of key %x", key)
+	}
+	return userKey, ^binary.BigEndian.Uint64(left), nil
+}
+
+// Regions returns the local states of the regions in the store, ordered by region id. If states are given, only the
+// regions in one of them are returned.
+func (d *Debugger) Regions(states ...rspb.PeerState) ([]*rspb.RegionLocalState, error) {
+	snap := d.engines.Kv.NewSnapshot()
+	defer snap.Discard()
+
+	var regions []*rspb.RegionLocalState
+	it := snap.NewIterator()
+	defer it.Close()
+	for it.Seek(meta.RegionMetaMinKey); it.Valid(); it.Next() {
+		item := it.Item()
+		if bytes.Compare(item.Key(), meta.RegionMetaMaxKey) >= 0 {
+			break
+		}
+		_, suffix, err := meta.DecodeRegionMetaKey(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		if suffix != meta.RegionStateSuffix {
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		state := new(rspb.RegionLocalState)
+		if err = state.Unmarshal(value); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(states) == 0 || containsState(states, state.State) {
+			regions = append(regions, state)
+		}
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].GetRegion().GetId() < regions[j].GetRegion().GetId()
+	})
+	return regions, nil
+}
+
+func containsState(states []rspb.PeerState, state rspb.PeerState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// TombstoneRegion marks the region as tombstone, so that the store drops its peer and its data the next time it
+// starts. This is meant for peers which are already removed from the region but can't find it out by themselves.
+func (d *Debugger) TombstoneRegion(regionID uint64) error {
+	state, err := meta.GetRegionLocalState(d.engines.Kv, regionID)
+	if err != nil {
+		if err == engine_util.ErrKeyNotFound {
+			return errors.WithStack(ErrRegionNotFound)
+		}
+		return err
+	}
+	if state.State == rspb.PeerState_Tombstone {
+		return errors.Errorf("region %d is already tombstone", regionID)
+	}
+	state.State = rspb.PeerState_Tombstone
+	return engine_util.PutMsg(d.engines.Kv, meta.RegionStateKey(regionID), state)
+}
+
+// CheckConsistency checks that the raft and apply states of the regions agree with each other and with the raft log,
+// and that every key in the kv engine belongs to a region. If regionID is 0, all the regions are checked and the keys
+// of the kv engine are scanned. It returns the problems found.
+func (d *Debugger) CheckConsistency(regionID uint64) ([]string, error) {
+	var regions []*rspb.RegionLocalState
+	if regionID != 0 {
+		state, err := meta.GetRegionLocalState(d.engines.Kv, regionID)
+		if err != nil {
+			if err == engine_util.ErrKeyNotFound {
+				return nil, errors.WithStack(ErrRegionNotFound)
+			}
+			return nil, err
+		}
+		regions = append(regions, state)
+	} else {
+		var err error
+		if regions, err = d.Regions(); err != nil {
+			return nil, err
+		}
+	}
+
+	var problems []string
+	var normalRegions []*metapb.Region
+	for _, state := range regions {
+		if state.State == rspb.PeerState_Tombstone {
+			continue
+		}
+		if state.State == rspb.PeerState_Normal {
+			normalRegions = append(normalRegions, state.Region)
+		}
+		regionProblems, err := d.checkRegion(state.Region.GetId())
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, regionProblems...)
+	}
+	if regionID != 0 {
+		return problems, nil
+	}
+
+	dataProblems, err := d.checkData(normalRegions)
+	if err != nil {
+		return nil, err
+	}
+	return append(problems, dataProblems...), nil
+}
+
+func (d *Debugger) checkRegion(regionID uint64) ([]string, error) {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("region %d: ", regionID)+fmt.Sprintf(format, args...))
+	}
+
+	info, err := d.RegionInfo(regionID)
+	if err != nil {
+		return nil, err
+	}
+	if info.RaftLocalState == nil {
+		report("raft state is missing")
+	}
+	if info.ApplyState == nil {
+		report("apply state is missing")
+	}
+	if info.RaftLocalState == nil || info.ApplyState == nil {
+		return problems, nil
+	}
+
+	raftState, applyState := info.RaftLocalState, info.ApplyState
+	truncatedIndex := applyState.GetTruncatedState().GetIndex()
+	if applyState.AppliedIndex < truncatedIndex {
+		report("applied index %d is less than truncated index %d", applyState.AppliedIndex, truncatedIndex)
+	}
+	if commit := raftState.GetHardState().GetCommit(); applyState.AppliedIndex > commit {
+		report("applied index %d is greater than commit index %d", applyState.AppliedIndex, commit)
+	}
+	if applyState.AppliedIndex > raftState.LastIndex {
+		report("applied index %d is greater than last index %d", applyState.AppliedIndex, raftState.LastIndex)
+	}
+	for idx := truncatedIndex + 1; idx <= raftState.LastIndex; idx++ {
+		entry, err := meta.GetRaftEntry(d.engines.Raft, regionID, idx)
+		if err == engine_util.ErrKeyNotFound {
+			report("raft log entry %d is missing, log is [%d, %d]", idx, truncatedIndex+1, raftState.LastIndex)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if entry.Index != idx {
+			report("raft log entry %d has index %d", idx, entry.Index)
+		}
+	}
+	return problems, nil
+}
+
+// checkData reports the keys of the kv engine which don't belong to any of the regions.
+func (d *Debugger) checkData(regions []*metapb.Region) ([]string, error) {
+	sort.Slice(regions, func(i, j int) bool {
+		return bytes.Compare(regions[i].StartKey, regions[j].StartKey) < 0
+	})
+	inRegions := func(key []byte) bool {
+		i := sort.Search(len(regions), func(i int) bool {
+			return bytes.Compare(regions[i].StartKey, key) > 0
+		})
+		if i == 0 {
+			return false
+		}
+		region := regions[i-1]
+		return len(region.EndKey) == 0 || bytes.Compare(key, region.EndKey) < 0
+	}
+
+	snap := d.engines.Kv.NewSnapshot()
+	defer snap.Discard()
+
+	var problems []string
+	for _, cf := range engine_util.CFs {
+		orphans := 0
+		var first []byte
+		it := engine_util.NewCFIterator(cf, snap)
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Item().Key()
+			if !inRegions(key) {
+				if orphans == 0 {
+					first = it.Item().KeyCopy(nil)
+				}
+				orphans++
+			}
+		}
+		it.Close()
+		if orphans > 0 {
+			problems = append(problems, fmt.Sprintf("cf %s: %d keys don't belong to any region, the first one is %x", cf, orphans, first))
+		}
+	}
+	return problems, nil
+}
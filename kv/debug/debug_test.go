@@ -0,0 +1,188 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDebugger bootstraps region 1 covering all keys with two more log entries applied, 6 and 7.
+func newTestDebugger(t *testing.T) (*Debugger, *engine_util.Engines) {
+	engines := util.NewTestEngines()
+	_, err := raftstore.PrepareBootstrap(engines, 1, 1, 1)
+	require.Nil(t, err)
+
+	raftWB := new(engine_util.WriteBatch)
+	for idx := uint64(meta.RaftInitLogIndex + 1); idx <= meta.RaftInitLogIndex+2; idx++ {
+		cmd := &raft_cmdpb.RaftCmdRequest{
+			Header: &raft_cmdpb.RaftRequestHeader{RegionId: 1},
+			Requests: []*raft_cmdpb.Request{{
+				CmdType: raft_cmdpb.CmdType_Put,
+				Put:     &raft_cmdpb.PutRequest{Cf: engine_util.CfDefault, Key: []byte("k"), Value: []byte("v")},
+			}},
+		}
+		data, err := cmd.Marshal()
+		require.Nil(t, err)
+		entry := &eraftpb.Entry{Term: meta.RaftInitLogTerm, Index: idx, Data: data}
+		require.Nil(t, raftWB.SetMsg(meta.RaftLogKey(1, idx), entry))
+	}
+	raftWB.SetMsg(meta.RaftStateKey(1), &rspb.RaftLocalState{
+		HardState: &eraftpb.HardState{Term: meta.RaftInitLogTerm, Commit: meta.RaftInitLogIndex + 2},
+		LastIndex: meta.RaftInitLogIndex + 2,
+	})
+	require.Nil(t, engines.WriteRaft(raftWB))
+
+	kvWB := new(engine_util.WriteBatch)
+	kvWB.SetMsg(meta.ApplyStateKey(1), &rspb.RaftApplyState{
+		AppliedIndex:   meta.RaftInitLogIndex + 2,
+		TruncatedState: &rspb.RaftTruncatedState{Index: meta.RaftInitLogIndex, Term: meta.RaftInitLogTerm},
+	})
+	require.Nil(t, engines.WriteKV(kvWB))
+	return NewDebugger(engines), engines
+}
+
+func TestRegionInfo(t *testing.T) {
+	d, engines := newTestDebugger(t)
+	defer engines.Destroy()
+
+	info, err := d.RegionInfo(1)
+	require.Nil(t, err)
+	assert.Equal(t, rspb.PeerState_Normal, info.RegionLocalState.State)
+	assert.Equal(t, uint64(meta.RaftInitLogIndex+2), info.RaftLocalState.LastIndex)
+	assert.Equal(t, uint64(meta.RaftInitLogIndex+2), info.ApplyState.AppliedIndex)
+
+	_, err = d.RegionInfo(2)
+	assert.Equal(t, ErrRegionNotFound, errors.Cause(err))
+}
+
+func TestRaftLog(t *testing.T) {
+	d, engines := newTestDebugger(t)
+	defer engines.Destroy()
+
+	entries, err := d.RaftLog(1, meta.RaftInitLogIndex+1, 0)
+	require.Nil(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(meta.RaftInitLogIndex+2), entries[1].Index)
+
+	msg, err := DecodeEntry(entries[0])
+	require.Nil(t, err)
+	cmd := msg.(*raft_cmdpb.RaftCmdRequest)
+	assert.Equal(t, uint64(1), cmd.Header.RegionId)
+	assert.Equal(t, []byte("k"), cmd.Requests[0].Put.Key)
+
+	entries, err = d.RaftLog(1, meta.RaftInitLogIndex+1, meta.RaftInitLogIndex+2)
+	require.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	msg, err = DecodeEntry(&eraftpb.Entry{Index: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, msg)
+}
+
+func TestMvcc(t *testing.T) {
+	d, engines := newTestDebugger(t)
+	defer engines.Destroy()
+
+	key := []byte("k")
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CfLock, key, (&mvcc.Lock{Primary: key, Ts: 30, Ttl: 10, Kind: mvcc.WriteKindDelete}).ToBytes())
+	wb.SetCF(engine_util.CfWrite, mvcc.EncodeKey(key, 11), (&mvcc.Write{StartTS: 10, Kind: mvcc.WriteKindPut}).ToBytes())
+	wb.SetCF(engine_util.CfWrite, mvcc.EncodeKey(key, 21), (&mvcc.Write{StartTS: 20, Kind: mvcc.WriteKindPut}).ToBytes())
+	wb.SetCF(engine_util.CfDefault, mvcc.EncodeKey(key, 10), []byte("v10"))
+	wb.SetCF(engine_util.CfDefault, mvcc.EncodeKey(key, 20), []byte("v20"))
+	// Versions of other keys must not show up.
+	wb.SetCF(engine_util.CfDefault, mvcc.EncodeKey([]byte("k1"), 10), []byte("other"))
+	require.Nil(t, engines.WriteKV(wb))
+
+	info, err := d.Mvcc(key)
+	require.Nil(t, err)
+	require.NotNil(t, info.Lock)
+	assert.Equal(t, uint64(30), info.Lock.Ts)
+	assert.Equal(t, mvcc.WriteKindDelete, info.Lock.Kind)
+	require.Len(t, info.Writes, 2)
+	assert.Equal(t, uint64(21), info.Writes[0].CommitTS)
+	assert.Equal(t, uint64(20), info.Writes[0].StartTS)
+	assert.Equal(t, uint64(11), info.Writes[1].CommitTS)
+	assert.Equal(t, []MvccValue{{StartTS: 20, Value: []byte("v20")}, {StartTS: 10, Value: []byte("v10")}}, info.Values)
+
+	info, err = d.Mvcc([]byte("no-such-key"))
+	require.Nil(t, err)
+	assert.Nil(t, info.Lock)
+	assert.Len(t, info.Writes, 0)
+	assert.Len(t, info.Values, 0)
+}
+
+func TestRegionsAndTombstone(t *testing.T) {
+	d, engines := newTestDebugger(t)
+	defer engines.Destroy()
+
+	regions, err := d.Regions()
+	require.Nil(t, err)
+	require.Len(t, regions, 1)
+	assert.Equal(t, uint64(1), regions[0].Region.Id)
+
+	require.Nil(t, d.TombstoneRegion(1))
+	assert.NotNil(t, d.TombstoneRegion(1))
+	assert.Equal(t, ErrRegionNotFound, errors.Cause(d.TombstoneRegion(2)))
+
+	regions, err = d.Regions(rspb.PeerState_Normal, rspb.PeerState_Applying)
+	require.Nil(t, err)
+	assert.Len(t, regions, 0)
+	regions, err = d.Regions(rspb.PeerState_Tombstone)
+	require.Nil(t, err)
+	assert.Len(t, regions, 1)
+}
+
+func TestCheckConsistency(t *testing.T) {
+	d, engines := newTestDebugger(t)
+	defer engines.Destroy()
+
+	require.Nil(t, engine_util.PutValue(engines.Kv, engine_util.KeyWithCF(engine_util.CfDefault, []byte("k")), []byte("v")))
+	problems, err := d.CheckConsistency(0)
+	require.Nil(t, err)
+	assert.Len(t, problems, 0)
+
+	// A hole in the raft log.
+	wb := new(engine_util.WriteBatch)
+	wb.Delete(meta.RaftLogKey(1, meta.RaftInitLogIndex+1))
+	require.Nil(t, engines.WriteRaft(wb))
+	problems, err = d.CheckConsistency(1)
+	require.Nil(t, err)
+	assert.Len(t, problems, 1)
+
+	// The data no longer belongs to any region.
+	require.Nil(t, d.TombstoneRegion(1))
+	problems, err = d.CheckConsistency(0)
+	require.Nil(t, err)
+	assert.Len(t, problems, 1)
+}
+
+func TestReadOnlyEngines(t *testing.T) {
+	_, engines := newTestDebugger(t)
+	defer engines.Destroy()
+	require.Nil(t, engines.Close())
+
+	kv, err := engine_util.OpenBadgerEngineReadOnly(engines.KvPath)
+	require.Nil(t, err)
+	raft, err := engine_util.OpenBadgerEngineReadOnly(engines.RaftPath)
+	require.Nil(t, err)
+	engines.Kv, engines.Raft = kv, raft
+
+	info, err := NewDebugger(engines).RegionInfo(1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), info.RegionLocalState.Region.Id)
+	assert.NotNil(t, engine_util.PutValue(kv, engine_util.KeyWithCF(engine_util.CfDefault, []byte("k")), []byte("v")))
+
+	_, err = engine_util.OpenBadgerEngineReadOnly(engines.KvPath + "-no-such-dir")
+	assert.NotNil(t, err)
+}
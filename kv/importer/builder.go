@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"bytes"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+
+	"github.com/Connor1996/badger"
+	"github.com/Connor1996/badger/table"
+	"github.com/Connor1996/badger/y"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap/errors"
+)
+
+// SSTBuilder builds a file to import. The keys are the keys of the kv engine, e.g. the MVCC keys of the write and
+// default column families, and must be added in order of the keys prefixed by their column families.
+type SSTBuilder struct {
+	file     *os.File
+	builder  *table.Builder
+	lastKey  []byte
+	startKey []byte
+	endKey   []byte
+}
+
+// NewSSTBuilder creates a builder which writes to a temporary file.
+func NewSSTBuilder() (*SSTBuilder, error) {
+	file, err := ioutil.TempFile("", "import_sst")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &SSTBuilder{
+		file:    file,
+		builder: table.NewExternalTableBuilder(file, nil, badger.DefaultOptions.TableBuilderOptions),
+	}, nil
+}
+
+// Add adds the key/value pair of the column family to the file.
+func (b *SSTBuilder) Add(cf string, key, value []byte) error {
+	cfKey := engine_util.KeyWithCF(cf, key)
+	if b.lastKey != nil && bytes.Compare(cfKey, b.lastKey) <= 0 {
+		return errors.Errorf("key %q of cf %s is not in order", key, cf)
+	}
+	if err := b.builder.Add(cfKey, y.ValueStruct{Value: value}); err != nil {
+		return err
+	}
+	b.lastKey = cfKey
+	if b.startKey == nil || bytes.Compare(key, b.startKey) < 0 {
+		b.startKey = y.SafeCopy(nil, key)
+	}
+	if b.endKey == nil || bytes.Compare(key, b.endKey) > 0 {
+		b.endKey = y.SafeCopy(nil, key)
+	}
+	return nil
+}
+
+// Finish returns the data of the file and its meta with the range, the length and the checksum filled. The caller
+// fills the uuid and the region of the meta.
+func (b *SSTBuilder) Finish() ([]byte, *import_sstpb.SSTMeta, error) {
+	defer b.Close()
+	if b.lastKey == nil {
+		return nil, nil, errors.New("the file is empty")
+	}
+	if err := b.builder.Finish(); err != nil {
+		return nil, nil, err
+	}
+	data, err := ioutil.ReadFile(b.file.Name())
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	meta := &import_sstpb.SSTMeta{
+		StartKey: b.startKey,
+		EndKey:   b.endKey,
+		Length:   uint64(len(data)),
+		Crc32:    crc32.ChecksumIEEE(data),
+	}
+	return data, meta, nil
+}
+
+// Close removes the temporary file.
+func (b *SSTBuilder) Close() {
+	b.builder.Close()
+	b.file.Close()
+	os.Remove(b.file.Name())
+}
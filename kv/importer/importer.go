@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Connor1996/badger"
+	"github.com/Connor1996/badger/options"
+	"github.com/Connor1996/badger/table"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap/errors"
+)
+
+const (
+	sstSuffix = ".sst"
+	tmpSuffix = ".tmp"
+)
+
+// SSTImporter keeps the files uploaded to a store until they are ingested into the kv engine by the IngestSST admin
+// commands of their regions. It also holds the import mode of the store.
+type SSTImporter struct {
+	dir        string
+	importMode int32
+}
+
+// NewSSTImporter creates an importer which keeps the files in dir. The files must be on the same file system as the
+// kv engine since they are hard linked into it. The unfinished uploads left by a previous run are removed.
+func NewSSTImporter(dir string) (*SSTImporter, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, info := range infos {
+		if strings.HasSuffix(info.Name(), tmpSuffix) {
+			if err := os.RemoveAll(filepath.Join(dir, info.Name())); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+	return &SSTImporter{dir: dir}, nil
+}
+
+// NewSSTImporterForEngines creates an importer next to the kv engine of the engines.
+func NewSSTImporterForEngines(engines *engine_util.Engines) (*SSTImporter, error) {
+	return NewSSTImporter(filepath.Join(filepath.Dir(engines.KvPath), "import"))
+}
+
+// SwitchMode switches the store to the import mode or back to the normal mode.
+func (i *SSTImporter) SwitchMode(mode import_sstpb.SwitchMode) {
+	var v int32
+	if mode == import_sstpb.SwitchMode_Import {
+		v = 1
+	}
+	if atomic.SwapInt32(&i.importMode, v) != v {
+		log.Infof("store switches to %s mode", mode)
+	}
+}
+
+// InImportMode returns whether the store is in the import mode, in which the split checks are paused and the raft log
+// compaction keeps more logs since the regions are about to receive lots of data. The compaction of the kv engine is
+// not relaxed, the options of Badger are fixed once it is opened.
+func (i *SSTImporter) InImportMode() bool {
+	return atomic.LoadInt32(&i.importMode) == 1
+}
+
+// Path returns the path of the file of the meta. The region and its epoch are part of the name, so a file can only
+// be ingested by the IngestSST command it is uploaded for.
+func (i *SSTImporter) Path(meta *import_sstpb.SSTMeta) string {
+	epoch := meta.GetRegionEpoch()
+	name := fmt.Sprintf("%x_%d_%d_%d%s", meta.GetUuid(), meta.GetRegionId(), epoch.GetConfVer(), epoch.GetVersion(), sstSuffix)
+	return filepath.Join(i.dir, name)
+}
+
+// Exists returns whether the file of the meta is uploaded.
+func (i *SSTImporter) Exists(meta *import_sstpb.SSTMeta) bool {
+	_, err := os.Stat(i.Path(meta))
+	return err == nil
+}
+
+// Delete removes the file of the meta, it is not an error if the file does not exist.
+func (i *SSTImporter) Delete(meta *import_sstpb.SSTMeta) error {
+	if err := os.Remove(i.Path(meta)); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Ingest ingests the file of the meta into the engine atomically.
+func (i *SSTImporter) Ingest(meta *import_sstpb.SSTMeta, engine engine_util.Engine) error {
+	file, err := os.Open(i.Path(meta))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+	_, err = engine.IngestExternalFiles([]*os.File{file})
+	return err
+}
+
+// Create starts the upload of the file of the meta. The file can't be ingested until the upload finishes.
+func (i *SSTImporter) Create(meta *import_sstpb.SSTMeta) (*UploadFile, error) {
+	if len(meta.GetUuid()) == 0 || meta.GetRegionEpoch() == nil {
+		return nil, errors.New("the uuid and the region epoch of the file must be given")
+	}
+	if bytes.Compare(meta.GetStartKey(), meta.GetEndKey()) > 0 {
+		return nil, errors.Errorf("invalid range [%q, %q] of the file", meta.GetStartKey(), meta.GetEndKey())
+	}
+	path := i.Path(meta)
+	if _, err := os.Stat(path); err == nil {
+		return nil, errors.Errorf("file %s is already uploaded", filepath.Base(path))
+	}
+	file, err := ioutil.TempFile(i.dir, filepath.Base(path)+".*"+tmpSuffix)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &UploadFile{meta: meta, path: path, file: file, crc32: crc32.NewIEEE()}, nil
+}
+
+// UploadFile is a file being uploaded.
+type UploadFile struct {
+	meta   *import_sstpb.SSTMeta
+	path   string
+	file   *os.File
+	crc32  hash.Hash32
+	length uint64
+}
+
+// Write appends the data to the file.
+func (f *UploadFile) Write(data []byte) error {
+	if _, err := f.file.Write(data); err != nil {
+		return errors.WithStack(err)
+	}
+	f.crc32.Write(data)
+	f.length += uint64(len(data))
+	return nil
+}
+
+// Finish checks the length, the checksum and the keys of the file against its meta, then makes it available to be
+// ingested. The file is removed if it does not match its meta.
+func (f *UploadFile) Finish() error {
+	err := f.finish()
+	if err != nil {
+		f.Abort()
+	}
+	return err
+}
+
+func (f *UploadFile) finish() error {
+	if f.length != f.meta.GetLength() {
+		return errors.Errorf("the length of the file is %d, but %d is expected", f.length, f.meta.GetLength())
+	}
+	if f.crc32.Sum32() != f.meta.GetCrc32() {
+		return errors.Errorf("the crc32 of the file is %d, but %d is expected", f.crc32.Sum32(), f.meta.GetCrc32())
+	}
+	if err := f.file.Sync(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := f.file.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := checkKeys(f.file.Name(), f.meta); err != nil {
+		return err
+	}
+	if err := os.Rename(f.file.Name(), f.path); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Abort removes the file.
+func (f *UploadFile) Abort() {
+	f.file.Close()
+	os.Remove(f.file.Name())
+}
+
+// checkKeys checks that the keys of the SST file at path belong to the column families and the range of the meta.
+func checkKeys(path string, meta *import_sstpb.SSTMeta) error {
+	dir, err := ioutil.TempDir(filepath.Dir(path), filepath.Base(path)+".*"+tmpSuffix)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.RemoveAll(dir)
+	// badger only opens tables whose file name is a table id.
+	tablePath := table.NewFilename(1, dir)
+	if err := os.Link(path, tablePath); err != nil {
+		return errors.WithStack(err)
+	}
+	fd, err := os.OpenFile(tablePath, os.O_RDWR, 0666)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	tbl, err := table.OpenTable(fd, options.FileIO, badger.DefaultOptions.TableBuilderOptions.Compression, nil)
+	if err != nil {
+		fd.Close()
+		return errors.Annotate(err, "invalid sst file")
+	}
+	defer tbl.Close()
+
+	it := tbl.NewIterator(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		cf, key, err := splitCFKey(it.RawKey())
+		if err != nil {
+			return err
+		}
+		if bytes.Compare(key, meta.GetStartKey()) < 0 || bytes.Compare(key, meta.GetEndKey()) > 0 {
+			return errors.Errorf("key %q of cf %s is out of the range [%q, %q] of the file",
+				key, cf, meta.GetStartKey(), meta.GetEndKey())
+		}
+	}
+	return nil
+}
+
+// splitCFKey splits a key of the kv engine into its column family and the key in it.
+func splitCFKey(cfKey []byte) (string, []byte, error) {
+	for _, cf := range engine_util.CFs {
+		if bytes.HasPrefix(cfKey, []byte(cf+"_")) {
+			return cf, cfKey[len(cf)+1:], nil
+		}
+	}
+	return "", nil, errors.Errorf("key %q has no column family", cfKey)
+}
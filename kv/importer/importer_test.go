@@ -0,0 +1,102 @@
+package importer
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestSST(t *testing.T, keys ...string) ([]byte, *import_sstpb.SSTMeta) {
+	builder, err := NewSSTBuilder()
+	require.Nil(t, err)
+	for _, key := range keys {
+		require.Nil(t, builder.Add(engine_util.CfDefault, []byte(key), []byte("v"+key)))
+	}
+	data, meta, err := builder.Finish()
+	require.Nil(t, err)
+	meta.Uuid = []byte("uuid")
+	meta.RegionId = 1
+	meta.RegionEpoch = &metapb.RegionEpoch{ConfVer: 1, Version: 1}
+	return data, meta
+}
+
+func upload(importer *SSTImporter, data []byte, meta *import_sstpb.SSTMeta) error {
+	file, err := importer.Create(meta)
+	if err != nil {
+		return err
+	}
+	if err := file.Write(data); err != nil {
+		file.Abort()
+		return err
+	}
+	return file.Finish()
+}
+
+func TestUploadAndIngest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "importer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	importer, err := NewSSTImporter(dir)
+	require.Nil(t, err)
+
+	data, meta := buildTestSST(t, "a", "b", "c")
+	assert.Equal(t, []byte("a"), meta.StartKey)
+	assert.Equal(t, []byte("c"), meta.EndKey)
+
+	// The data must match the meta.
+	badMeta := *meta
+	badMeta.Crc32++
+	assert.NotNil(t, upload(importer, data, &badMeta))
+	badMeta = *meta
+	badMeta.EndKey = []byte("b")
+	assert.NotNil(t, upload(importer, data, &badMeta))
+	assert.False(t, importer.Exists(meta))
+
+	require.Nil(t, upload(importer, data, meta))
+	assert.True(t, importer.Exists(meta))
+	assert.NotNil(t, upload(importer, data, meta))
+	// The file of another epoch of the region is another file.
+	otherEpoch := *meta
+	otherEpoch.RegionEpoch = &metapb.RegionEpoch{ConfVer: 1, Version: 2}
+	assert.False(t, importer.Exists(&otherEpoch))
+
+	engine := engine_util.NewMemEngine()
+	require.Nil(t, importer.Ingest(meta, engine))
+	snap := engine.NewSnapshot()
+	value, err := snap.Get(engine_util.KeyWithCF(engine_util.CfDefault, []byte("b")))
+	snap.Discard()
+	require.Nil(t, err)
+	assert.Equal(t, []byte("vb"), value)
+
+	require.Nil(t, importer.Delete(meta))
+	assert.False(t, importer.Exists(meta))
+	require.Nil(t, importer.Delete(meta))
+	// The failed uploads leave nothing behind.
+	infos, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Empty(t, infos)
+}
+
+func TestRemoveUnfinishedUploads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "importer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	importer, err := NewSSTImporter(dir)
+	require.Nil(t, err)
+	data, meta := buildTestSST(t, "a")
+	file, err := importer.Create(meta)
+	require.Nil(t, err)
+	require.Nil(t, file.Write(data))
+
+	_, err = NewSSTImporter(dir)
+	require.Nil(t, err)
+	infos, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Empty(t, infos)
+}
@@ -1,8 +1,11 @@
 package inner_server
 
 import (
+	"github.com/pingcap-incubator/tinykv/kv/importer"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
 // InnerServer represents the internal-facing server part of TinyKV, it handles sending and receiving from other
@@ -14,6 +17,55 @@ type InnerServer interface {
 	Reader(ctx *kvrpcpb.Context) (DBReader, error)
 }
 
+// StaleReader is implemented by the InnerServers which can serve a read at a past ts from the local data of any
+// replica of a region.
+type StaleReader interface {
+	// StaleReader returns a reader of the local data of the replica if every transaction which commits at or before
+	// ts is applied to it, otherwise the error is a region error with DataIsNotReady.
+	StaleReader(ctx *kvrpcpb.Context, ts uint64) (DBReader, error)
+}
+
+// ReplicaReader is implemented by the InnerServers which can serve the latest data from the local data of any replica
+// of a region.
+type ReplicaReader interface {
+	// ReplicaReader returns a reader of the local data of the replica once it applies the read index from the leader,
+	// so the reader sees every write finished before the call.
+	ReplicaReader(ctx *kvrpcpb.Context) (DBReader, error)
+}
+
+// RegionManager is implemented by the InnerServers which can list and split the regions of their store.
+type RegionManager interface {
+	// LeaderRegions returns the regions whose leaders are on the store.
+	LeaderRegions() ([]LeaderRegion, error)
+	// SplitRegion splits the region at the split keys through Raft and returns the regions after the split in order of
+	// their ranges. A rejected split is a region error.
+	SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error)
+}
+
+// SSTIngester is implemented by the InnerServers which can ingest the files uploaded to their store through Raft.
+type SSTIngester interface {
+	// SSTImporter returns the importer which keeps the files uploaded to the store.
+	SSTImporter() (*importer.SSTImporter, error)
+	// IngestSST proposes to ingest the uploaded file into the region and waits until it is applied. A rejected
+	// proposal, e.g. the region has changed since the file is uploaded, is a region error.
+	IngestSST(ctx *kvrpcpb.Context, sst *import_sstpb.SSTMeta) error
+}
+
+// LeaderRegion is a region whose leader is on the store of an InnerServer.
+type LeaderRegion struct {
+	Region *metapb.Region
+	Leader *metapb.Peer
+}
+
+// Context returns the context to request the region.
+func (r LeaderRegion) Context() *kvrpcpb.Context {
+	return &kvrpcpb.Context{
+		RegionId:    r.Region.GetId(),
+		RegionEpoch: r.Region.GetRegionEpoch(),
+		Peer:        r.Leader,
+	}
+}
+
 type DBReader interface {
 	GetCF(cf string, key []byte) ([]byte, error)
 	IterCF(cf string) engine_util.DBIterator
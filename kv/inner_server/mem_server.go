@@ -61,6 +61,30 @@ func (is *MemInnerServer) Write(ctx *kvrpcpb.Context, batch []Modify) error {
 			case engine_util.CfWrite:
 				is.CfWrite.Delete(item)
 			}
+		case DeleteRange:
+			var tree *llrb.LLRB
+			switch data.Cf {
+			case engine_util.CfDefault:
+				tree = is.CfDefault
+			case engine_util.CfLock:
+				tree = is.CfLock
+			case engine_util.CfWrite:
+				tree = is.CfWrite
+			default:
+				continue
+			}
+			// The tree can't be modified while it is iterated.
+			var items []llrb.Item
+			tree.AscendGreaterOrEqual(memItem{key: data.StartKey}, func(item llrb.Item) bool {
+				if engine_util.ExceedEndKey(item.(memItem).key, data.EndKey) {
+					return false
+				}
+				items = append(items, item)
+				return true
+			})
+			for _, item := range items {
+				tree.Delete(item)
+			}
 		}
 	}
 
@@ -6,6 +6,8 @@ type ModifyType int64
 const (
 	ModifyTypePut    ModifyType = 1
 	ModifyTypeDelete ModifyType = 2
+	// ModifyTypeDeleteRange deletes all the keys of a CF in a range.
+	ModifyTypeDeleteRange ModifyType = 3
 )
 
 type Put struct {
@@ -19,6 +21,13 @@ type Delete struct {
 	Cf  string
 }
 
+// DeleteRange deletes the keys of Cf in [StartKey, EndKey), an empty EndKey means no upper bound.
+type DeleteRange struct {
+	StartKey []byte
+	EndKey   []byte
+	Cf       string
+}
+
 type Modify struct {
 	Type ModifyType
 	Data interface{}
@@ -30,6 +39,8 @@ func (m *Modify) Key() []byte {
 		return m.Data.(Put).Key
 	case ModifyTypeDelete:
 		return m.Data.(Delete).Key
+	case ModifyTypeDeleteRange:
+		return m.Data.(DeleteRange).StartKey
 	}
 	return nil
 }
@@ -40,6 +51,8 @@ func (m *Modify) Cf() string {
 		return m.Data.(Put).Cf
 	case ModifyTypeDelete:
 		return m.Data.(Delete).Cf
+	case ModifyTypeDeleteRange:
+		return m.Data.(DeleteRange).Cf
 	}
 	return ""
 }
@@ -0,0 +1,56 @@
+package raft_server
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/importer"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+)
+
+// ingestSSTTimeout is how long IngestSST waits for the ingestion to be applied.
+const ingestSSTTimeout = 30 * time.Second
+
+var _ inner_server.SSTIngester = new(RaftInnerServer)
+
+func (ris *RaftInnerServer) SSTImporter() (*importer.SSTImporter, error) {
+	return ris.batchSystem.SSTImporter(), nil
+}
+
+func (ris *RaftInnerServer) IngestSST(ctx *kvrpcpb.Context, sst *import_sstpb.SSTMeta) error {
+	return IngestSST(ris.raftRouter, ctx, sst)
+}
+
+// IngestSST proposes to ingest the uploaded file through the peer of the store of the router and waits until the
+// leader applies it.
+func IngestSST(router *raftstore.RaftstoreRouter, ctx *kvrpcpb.Context, sst *import_sstpb.SSTMeta) error {
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    ctx.GetRegionId(),
+			Peer:        ctx.GetPeer(),
+			RegionEpoch: ctx.GetRegionEpoch(),
+			Term:        ctx.GetTerm(),
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:   raft_cmdpb.AdminCmdType_IngestSST,
+			IngestSst: &raft_cmdpb.IngestSSTRequest{Sst: sst},
+		},
+	}
+	cb := message.NewCallback()
+	if err := router.SendRaftCommand(request, cb); err != nil {
+		return err
+	}
+	resp := cb.WaitRespWithTimeout(ingestSSTTimeout)
+	if resp == nil {
+		return errors.Errorf("ingest sst %x to region %d timeout", sst.GetUuid(), ctx.GetRegionId())
+	}
+	if resp.GetHeader().GetError() != nil {
+		return &RegionError{RequestErr: resp.Header.Error}
+	}
+	return nil
+}
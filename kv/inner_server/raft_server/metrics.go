@@ -0,0 +1,41 @@
+package raft_server
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	snapshotCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tinykv",
+			Subsystem: "server",
+			Name:      "snapshot_total",
+			Help:      "Total number of sent and received snapshots.",
+		}, []string{"type", "result"})
+
+	snapshotDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tinykv",
+			Subsystem: "server",
+			Name:      "snapshot_duration_seconds",
+			Help:      "Bucketed histogram of the duration of sending and receiving snapshots.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 20),
+		}, []string{"type"})
+)
+
+func init() {
+	prometheus.MustRegister(snapshotCounter)
+	prometheus.MustRegister(snapshotDuration)
+}
+
+// observeSnapshot records the result and duration of sending or receiving a snapshot.
+func observeSnapshot(tp string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	snapshotCounter.WithLabelValues(tp, result).Inc()
+	snapshotDuration.WithLabelValues(tp).Observe(time.Since(start).Seconds())
+}
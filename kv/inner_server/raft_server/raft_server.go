@@ -96,6 +96,15 @@ func (ris *RaftInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Mod
 					Cf:  delete.Cf,
 					Key: delete.Key,
 				}})
+		case inner_server.ModifyTypeDeleteRange:
+			deleteRange := m.Data.(inner_server.DeleteRange)
+			reqs = append(reqs, &raft_cmdpb.Request{
+				CmdType: raft_cmdpb.CmdType_DeleteRange,
+				DeleteRange: &raft_cmdpb.DeleteRangeRequest{
+					Cf:       deleteRange.Cf,
+					StartKey: deleteRange.StartKey,
+					EndKey:   deleteRange.EndKey,
+				}})
 		}
 	}
 
@@ -118,6 +127,18 @@ func (ris *RaftInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Mod
 }
 
 func (ris *RaftInnerServer) Reader(ctx *kvrpcpb.Context) (inner_server.DBReader, error) {
+	return ris.snapshot(ctx, &raft_cmdpb.SnapRequest{})
+}
+
+func (ris *RaftInnerServer) StaleReader(ctx *kvrpcpb.Context, ts uint64) (inner_server.DBReader, error) {
+	return ris.snapshot(ctx, &raft_cmdpb.SnapRequest{StaleReadTs: ts})
+}
+
+func (ris *RaftInnerServer) ReplicaReader(ctx *kvrpcpb.Context) (inner_server.DBReader, error) {
+	return ris.snapshot(ctx, &raft_cmdpb.SnapRequest{ReplicaRead: true})
+}
+
+func (ris *RaftInnerServer) snapshot(ctx *kvrpcpb.Context, snapReq *raft_cmdpb.SnapRequest) (inner_server.DBReader, error) {
 	header := &raft_cmdpb.RaftRequestHeader{
 		RegionId:    ctx.RegionId,
 		Peer:        ctx.Peer,
@@ -128,7 +149,7 @@ func (ris *RaftInnerServer) Reader(ctx *kvrpcpb.Context) (inner_server.DBReader,
 		Header: header,
 		Requests: []*raft_cmdpb.Request{{
 			CmdType: raft_cmdpb.CmdType_Snap,
-			Snap:    &raft_cmdpb.SnapRequest{},
+			Snap:    snapReq,
 		}},
 	}
 	cb := message.NewCallback()
@@ -138,18 +159,18 @@ func (ris *RaftInnerServer) Reader(ctx *kvrpcpb.Context) (inner_server.DBReader,
 
 	resp := cb.WaitResp()
 	if err := ris.checkResponse(resp, 1); err != nil {
-		if cb.Txn != nil {
-			cb.Txn.Discard()
+		if cb.Snap != nil {
+			cb.Snap.Discard()
 		}
 		return nil, err
 	}
-	if cb.Txn == nil {
+	if cb.Snap == nil {
 		panic("can not found region snap")
 	}
 	if len(resp.Responses) != 1 {
 		panic("wrong response count for snap cmd")
 	}
-	return NewRegionReader(cb.Txn, *resp.Responses[0].GetSnap().Region), nil
+	return NewRegionReader(cb.Snap, *resp.Responses[0].GetSnap().Region), nil
 }
 
 func (ris *RaftInnerServer) Raft(stream tinykvpb.TinyKv_RaftServer) error {
@@ -210,6 +231,26 @@ func (ris *RaftInnerServer) Start() error {
 	return nil
 }
 
+// UpdateConfig pushes an online config change to the running raftstore.
+func (ris *RaftInnerServer) UpdateConfig(cfg *config.Config) {
+	ris.batchSystem.UpdateConfig(cfg)
+}
+
+// SetCmdObserver sets the observer of the commands applied by the raftstore, it must be called after Start.
+func (ris *RaftInnerServer) SetCmdObserver(observer raftstore.CmdObserver) {
+	ris.batchSystem.SetCmdObserver(observer)
+}
+
+// RegionIDs returns the IDs of the regions which have a peer on this store.
+func (ris *RaftInnerServer) RegionIDs() []uint64 {
+	return ris.raftRouter.RegionIDs()
+}
+
+// RegionInfo returns the state of the peer of the region on this store, or nil if there is no such peer.
+func (ris *RaftInnerServer) RegionInfo(regionID uint64) (*raftstore.RegionInfo, error) {
+	return ris.raftRouter.RegionInfo(regionID)
+}
+
 func (ris *RaftInnerServer) Stop() error {
 	ris.snapWorker.Stop()
 	ris.node.Stop()
@@ -0,0 +1,59 @@
+package raft_server
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/errors"
+)
+
+// splitRegionTimeout is how long SplitRegion waits for the split to be applied.
+const splitRegionTimeout = 10 * time.Second
+
+var _ inner_server.RegionManager = new(RaftInnerServer)
+
+func (ris *RaftInnerServer) LeaderRegions() ([]inner_server.LeaderRegion, error) {
+	return LeaderRegions(ris.raftRouter)
+}
+
+func (ris *RaftInnerServer) SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error) {
+	return SplitRegion(ris.raftRouter, ctx, splitKeys)
+}
+
+// LeaderRegions returns the regions led by the peers of the store of the router.
+func LeaderRegions(router *raftstore.RaftstoreRouter) ([]inner_server.LeaderRegion, error) {
+	infos, err := router.LeaderRegions()
+	if err != nil {
+		return nil, err
+	}
+	regions := make([]inner_server.LeaderRegion, 0, len(infos))
+	for _, info := range infos {
+		for _, peer := range info.Region.GetPeers() {
+			if peer.GetId() == info.PeerID {
+				regions = append(regions, inner_server.LeaderRegion{Region: info.Region, Leader: peer})
+				break
+			}
+		}
+	}
+	return regions, nil
+}
+
+// SplitRegion splits the region of ctx through the peer of the store of the router.
+func SplitRegion(router *raftstore.RaftstoreRouter, ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error) {
+	cb := message.NewCallback()
+	if err := router.SplitRegion(ctx.GetRegionId(), ctx.GetRegionEpoch(), splitKeys, cb); err != nil {
+		return nil, err
+	}
+	resp := cb.WaitRespWithTimeout(splitRegionTimeout)
+	if resp == nil {
+		return nil, errors.Errorf("split region %d timeout", ctx.GetRegionId())
+	}
+	if resp.GetHeader().GetError() != nil {
+		return nil, &RegionError{RequestErr: resp.Header.Error}
+	}
+	return resp.GetAdminResponse().GetSplits().GetRegions(), nil
+}
@@ -1,18 +1,17 @@
 package raft_server
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
 type RegionReader struct {
-	txn    *badger.Txn
+	txn    engine_util.Snapshot
 	region *metapb.Region
 }
 
-func NewRegionReader(txn *badger.Txn, region metapb.Region) *RegionReader {
+func NewRegionReader(txn engine_util.Snapshot, region metapb.Region) *RegionReader {
 	return &RegionReader{
 		txn:    txn,
 		region: &region,
@@ -23,11 +22,14 @@ func (r *RegionReader) GetCF(cf string, key []byte) ([]byte, error) {
 	if err := util.CheckKeyInRegion(key, r.region); err != nil {
 		return nil, err
 	}
-	return engine_util.GetCFFromTxn(r.txn, cf, key)
+	return engine_util.GetCFFromSnapshot(r.txn, cf, key)
 }
 
+// IterCF returns an iterator of cf positioned at the start of the region.
 func (r *RegionReader) IterCF(cf string) engine_util.DBIterator {
-	return NewRegionIterator(engine_util.NewCFIterator(cf, r.txn), r.region)
+	iter := engine_util.NewCFIterator(cf, r.txn)
+	iter.Seek(r.region.StartKey)
+	return NewRegionIterator(iter, r.region)
 }
 
 func (r *RegionReader) Close() {
@@ -37,11 +39,11 @@ func (r *RegionReader) Close() {
 // RegionIterator wraps a db iterator and only allow it to iterate in the region. It behaves as if underlying
 // db only contains one region.
 type RegionIterator struct {
-	iter   *engine_util.BadgerIterator
+	iter   *engine_util.CFIterator
 	region *metapb.Region
 }
 
-func NewRegionIterator(iter *engine_util.BadgerIterator, region *metapb.Region) *RegionIterator {
+func NewRegionIterator(iter *engine_util.CFIterator, region *metapb.Region) *RegionIterator {
 	return &RegionIterator{
 		iter:   iter,
 		region: region,
@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
@@ -53,7 +54,10 @@ func (r *snapRunner) Handle(t worker.Task) {
 }
 
 func (r *snapRunner) send(t sendSnapTask) {
-	t.callback(r.sendSnap(t.addr, t.msg))
+	start := time.Now()
+	err := r.sendSnap(t.addr, t.msg)
+	observeSnapshot("send", start, err)
+	t.callback(err)
 }
 
 const snapChunkLen = 1024 * 1024
@@ -105,6 +109,9 @@ func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error
 		if err != nil {
 			return errors.Errorf("failed to read snapshot chunk: %v", err)
 		}
+		if err := failpoint.Eval("snap-runner/send-chunk"); err != nil {
+			return err
+		}
 		err = stream.Send(&raft_serverpb.SnapshotChunk{Data: buf})
 		if err != nil {
 			return err
@@ -120,7 +127,9 @@ func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error
 }
 
 func (r *snapRunner) recv(t recvSnapTask) {
+	start := time.Now()
 	msg, err := r.recvSnap(t.stream)
+	observeSnapshot("recv", start, err)
 	if err == nil {
 		r.router.SendRaftMessage(msg)
 	}
@@ -172,6 +181,10 @@ func (r *snapRunner) recvSnap(stream tinykvpb.TinyKv_SnapshotServer) (*raft_serv
 		}
 	}
 
+	// All the chunks are received, but the snapshot file isn't saved yet.
+	if err := failpoint.Eval("snap-runner/recv-before-save"); err != nil {
+		return nil, err
+	}
 	err = snapshot.Save()
 	if err != nil {
 		return nil, err
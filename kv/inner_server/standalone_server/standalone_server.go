@@ -1,7 +1,6 @@
 package standalone_server
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
@@ -12,7 +11,7 @@ import (
 // StandAloneInnerServer is an InnerServer for a single-node TinyKV instance. It does not
 // communicate with other nodes and all data is stored locally.
 type StandAloneInnerServer struct {
-	db *badger.DB
+	db engine_util.Engine
 }
 
 func NewStandAloneInnerServer(conf *config.Config) *StandAloneInnerServer {
@@ -31,49 +30,51 @@ func (is *StandAloneInnerServer) Stop() error {
 }
 
 func (is *StandAloneInnerServer) Reader(ctx *kvrpcpb.Context) (inner_server.DBReader, error) {
-	txn := is.db.NewTransaction(false)
-	reader := NewBadgerReader(txn)
-	return reader, nil
+	return NewEngineReader(is.db.NewSnapshot()), nil
 }
 
 func (is *StandAloneInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error {
-	return is.db.Update(func(txn *badger.Txn) error {
-		for _, op := range batch {
-			var err error
-			switch op.Type {
-			case inner_server.ModifyTypePut:
-				put := op.Data.(inner_server.Put)
-				err = txn.Set(engine_util.KeyWithCF(put.Cf, put.Key), put.Value)
-			case inner_server.ModifyTypeDelete:
-				delete := op.Data.(inner_server.Delete)
-				err = txn.Delete(engine_util.KeyWithCF(delete.Cf, delete.Key))
-			default:
-				err = errors.New("Unsupported modify type")
-			}
-			if err != nil {
-				return err
-			}
+	wb := new(engine_util.WriteBatch)
+	for _, op := range batch {
+		switch op.Type {
+		case inner_server.ModifyTypePut:
+			put := op.Data.(inner_server.Put)
+			wb.SetCF(put.Cf, put.Key, put.Value)
+		case inner_server.ModifyTypeDelete:
+			delete := op.Data.(inner_server.Delete)
+			wb.DeleteCF(delete.Cf, delete.Key)
+		case inner_server.ModifyTypeDeleteRange:
+			deleteRange := op.Data.(inner_server.DeleteRange)
+			snap := is.db.NewSnapshot()
+			engine_util.DeleteRangeCF(snap, wb, deleteRange.Cf, deleteRange.StartKey, deleteRange.EndKey)
+			snap.Discard()
+		default:
+			return errors.New("Unsupported modify type")
 		}
-		return nil
-	})
+	}
+	return wb.WriteToDB(is.db)
 }
 
-type BadgerReader struct {
-	txn *badger.Txn
+// EngineReader is a DBReader which reads from a snapshot of an engine.
+type EngineReader struct {
+	snap engine_util.Snapshot
 }
 
-func NewBadgerReader(txn *badger.Txn) *BadgerReader {
-	return &BadgerReader{txn}
+func NewEngineReader(snap engine_util.Snapshot) *EngineReader {
+	return &EngineReader{snap}
 }
 
-func (b *BadgerReader) GetCF(cf string, key []byte) ([]byte, error) {
-	return engine_util.GetCFFromTxn(b.txn, cf, key)
+func (r *EngineReader) GetCF(cf string, key []byte) ([]byte, error) {
+	return engine_util.GetCFFromSnapshot(r.snap, cf, key)
 }
 
-func (b *BadgerReader) IterCF(cf string) engine_util.DBIterator {
-	return engine_util.NewCFIterator(cf, b.txn)
+// IterCF returns an iterator of cf positioned at its first key.
+func (r *EngineReader) IterCF(cf string) engine_util.DBIterator {
+	iter := engine_util.NewCFIterator(cf, r.snap)
+	iter.Rewind()
+	return iter
 }
 
-func (b *BadgerReader) Close() {
-	b.txn.Discard()
+func (r *EngineReader) Close() {
+	r.snap.Discard()
 }
@@ -3,38 +3,55 @@ package main
 import (
 	"flag"
 	"net"
-	_ "net/http/pprof"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/kv/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server/standalone_server"
 	"github.com/pingcap-incubator/tinykv/kv/server"
+	"github.com/pingcap-incubator/tinykv/kv/status"
 	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
 
 var (
-	pdAddr    = flag.String("pd", "", "pd address")
-	storeAddr = flag.String("addr", "", "store address")
+	configPath = flag.String("config", "", "config file path")
+	pdAddr     = flag.String("pd", "", "pd address")
+	storeAddr  = flag.String("addr", "", "store address")
+	statusAddr = flag.String("status-addr", "", "status server address")
 )
 
 func main() {
 	flag.Parse()
 	conf := config.NewDefaultConfig()
+	if *configPath != "" {
+		var err error
+		if conf, err = conf.LoadFile(*configPath); err != nil {
+			log.Fatalf("load config file %s failed: %v", *configPath, err)
+		}
+	}
 	if *pdAddr != "" {
 		conf.PDAddr = *pdAddr
 	}
 	if *storeAddr != "" {
 		conf.StoreAddr = *storeAddr
 	}
+	if *statusAddr != "" {
+		conf.StatusAddr = *statusAddr
+	}
+	if err := conf.Validate(); err != nil {
+		log.Fatal(err)
+	}
 	log.SetLevelByString(conf.LogLevel)
 	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds | log.Lshortfile)
 	log.Infof("conf %v", conf)
@@ -48,7 +65,28 @@ func main() {
 	if err := innerServer.Start(); err != nil {
 		log.Fatal(err)
 	}
-	server := server.NewServer(innerServer)
+	controller := config.NewController(conf)
+	var inspector status.RegionInspector
+	if raftServer, ok := innerServer.(*raft_server.RaftInnerServer); ok {
+		controller.Register(raftServer.UpdateConfig)
+		inspector = raftServer
+	}
+	if conf.StatusAddr != "" {
+		if err := status.NewServer(conf.StatusAddr, controller, inspector).Start(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	kvServer := server.NewServer(innerServer)
+	if raftServer, ok := innerServer.(*raft_server.RaftInnerServer); ok {
+		// The resolved ts of the change data subscriptions is advanced by the timestamps of the scheduler.
+		tsoClient, err := pd.NewClient(strings.Split(conf.PDAddr, ","), pd.SecurityOption{})
+		if err != nil {
+			log.Fatal(err)
+		}
+		endpoint := cdc.NewEndpoint(tsoClient, conf.ResolvedTsInterval)
+		raftServer.SetCmdObserver(endpoint)
+		kvServer.SetCDCEndpoint(endpoint)
+	}
 
 	var alivePolicy = keepalive.EnforcementPolicy{
 		MinTime:             2 * time.Second, // If a client pings more than once every 2 seconds, terminate the connection
@@ -60,8 +98,11 @@ func main() {
 		grpc.InitialWindowSize(1<<30),
 		grpc.InitialConnWindowSize(1<<30),
 		grpc.MaxRecvMsgSize(10*1024*1024),
+		grpc.UnaryInterceptor(server.UnaryMetricsInterceptor),
+		grpc.StreamInterceptor(server.StreamMetricsInterceptor),
 	)
-	tinykvpb.RegisterTinyKvServer(grpcServer, server)
+	tinykvpb.RegisterTinyKvServer(grpcServer, kvServer)
+	import_sstpb.RegisterImportSSTServer(grpcServer, server.NewImportSSTServer(innerServer))
 	listenAddr := conf.StoreAddr[strings.IndexByte(conf.StoreAddr, ':'):]
 	l, err := net.Listen("tcp", listenAddr)
 	if err != nil {
@@ -37,6 +37,7 @@ import (
 type Client interface {
 	GetClusterID(ctx context.Context) uint64
 	AllocID(ctx context.Context) (uint64, error)
+	GetTS(ctx context.Context) (int64, int64, error)
 	Bootstrap(ctx context.Context, store *metapb.Store) (*pdpb.BootstrapResponse, error)
 	IsBootstrapped(ctx context.Context) (bool, error)
 	PutStore(ctx context.Context, store *metapb.Store) error
@@ -398,6 +399,30 @@ func (c *client) AllocID(ctx context.Context) (uint64, error) {
 	return resp.GetId(), nil
 }
 
+// GetTS allocates a timestamp, it returns the physical and the logical part.
+func (c *client) GetTS(ctx context.Context) (int64, int64, error) {
+	var resp *pdpb.TsoResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client pdpb.PDClient) error {
+		stream, err1 := client.Tso(ctx)
+		if err1 != nil {
+			return err1
+		}
+		defer stream.CloseSend()
+		if err1 = stream.Send(&pdpb.TsoRequest{Header: c.requestHeader(), Count: 1}); err1 != nil {
+			return err1
+		}
+		resp, err1 = stream.Recv()
+		return err1
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return 0, 0, errors.New(herr.String())
+	}
+	return resp.GetTimestamp().GetPhysical(), resp.GetTimestamp().GetLogical(), nil
+}
+
 func (c *client) Bootstrap(ctx context.Context, store *metapb.Store) (resp *pdpb.BootstrapResponse, err error) {
 	err = c.doRequest(ctx, func(ctx context.Context, client pdpb.PDClient) error {
 		var err1 error
@@ -3,16 +3,19 @@ package raftstore
 import (
 	"bytes"
 	"fmt"
+	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/log"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
@@ -36,6 +39,7 @@ type proposal struct {
 	index        uint64
 	term         uint64
 	cb           *message.Callback
+	proposedAt   time.Time
 }
 
 type MsgApplyRefresh struct {
@@ -68,6 +72,14 @@ type execResultSplitRegion struct {
 	derived *metapb.Region
 }
 
+type execResultUpdateResolvedTs struct {
+	resolvedTs uint64
+}
+
+type execResultIngestSST struct {
+	sst *import_sstpb.SSTMeta
+}
+
 /// Calls the callback of `cmd` when the Region is removed.
 func notifyRegionRemoved(regionID, peerID uint64, cmd pendingCmd) {
 	log.Debugf("region %d is removed, peerID %d, index %d, term %d", regionID, peerID, cmd.index, cmd.term)
@@ -191,13 +203,13 @@ func (a *applier) handleProposal(regionProposal *MsgApplyProposal) {
 	y.Assert(a.id == regionProposal.Id)
 	if a.pendingRemove {
 		for _, p := range regionProposal.Props {
-			cmd := pendingCmd{index: p.index, term: p.term, cb: p.cb}
+			cmd := pendingCmd{index: p.index, term: p.term, cb: p.cb, proposedAt: p.proposedAt}
 			notifyStaleCommand(regionID, peerID, a.term, cmd)
 		}
 		return
 	}
 	for _, p := range regionProposal.Props {
-		cmd := pendingCmd{index: p.index, term: p.term, cb: p.cb}
+		cmd := pendingCmd{index: p.index, term: p.term, cb: p.cb, proposedAt: p.proposedAt}
 		if p.isConfChange {
 			if confCmd := a.pendingCmds.takeConfChange(); confCmd != nil {
 				// if it loses leadership before conf change is replicated, there may be
@@ -214,9 +226,10 @@ func (a *applier) handleProposal(regionProposal *MsgApplyProposal) {
 }
 
 type pendingCmd struct {
-	index uint64
-	term  uint64
-	cb    *message.Callback
+	index      uint64
+	term       uint64
+	cb         *message.Callback
+	proposedAt time.Time
 }
 
 type pendingCmdQueue struct {
@@ -284,10 +297,10 @@ func (c *applyCallback) invokeAll() {
 	}
 }
 
-func (c *applyCallback) push(cb *message.Callback, resp *raft_cmdpb.RaftCmdResponse, txn *badger.Txn) {
+func (c *applyCallback) push(cb *message.Callback, resp *raft_cmdpb.RaftCmdResponse, txn engine_util.Snapshot) {
 	if cb != nil {
 		cb.Resp = resp
-		cb.Txn = txn
+		cb.Snap = txn
 	}
 	c.cbs = append(c.cbs, cb)
 }
@@ -302,15 +315,19 @@ type applyContext struct {
 	wb               *engine_util.WriteBatch
 	lastAppliedIndex uint64
 	committedCount   int
+	observer         *observerHolder
+	importer         *importer.SSTImporter
 }
 
 func newApplyContext(tag string, engines *engine_util.Engines,
-	notifier chan<- message.Msg, cfg *config.Config) *applyContext {
+	notifier chan<- message.Msg, cfg *config.Config, observer *observerHolder, importer *importer.SSTImporter) *applyContext {
 	return &applyContext{
 		tag:      tag,
 		engines:  engines,
 		notifier: notifier,
 		wb:       new(engine_util.WriteBatch),
+		observer: observer,
+		importer: importer,
 	}
 }
 
@@ -349,8 +366,11 @@ func (ac *applyContext) commitOpt(d *applier, persistent bool) {
 	}
 }
 
-/// Writes all the changes into badger.
+/// Writes all the changes into the kv engine.
 func (ac *applyContext) writeToDB() {
+	if err := failpoint.Eval("raftstore/before-write-apply-state"); err != nil {
+		panic(err)
+	}
 	if err := ac.wb.WriteToDB(ac.engines.Kv); err != nil {
 		panic(err)
 	}
@@ -386,12 +406,26 @@ func (ac *applyContext) flush() {
 	ac.committedCount = 0
 }
 
+func (ac *applyContext) cmdObserver() CmdObserver {
+	if ac.observer == nil {
+		return nil
+	}
+	return ac.observer.get()
+}
+
+func (ac *applyContext) observeRegionChanged(region *metapb.Region) {
+	if observer := ac.cmdObserver(); observer != nil {
+		observer.OnRegionChanged(region)
+	}
+}
+
 /// Handles all the committed_entries, namely, applies the committed entries.
 func (a *applier) handleRaftCommittedEntries(aCtx *applyContext, committedEntries []eraftpb.Entry) {
 	if len(committedEntries) == 0 {
 		return
 	}
 	aCtx.prepareFor(a)
+	applyBatchSize.Observe(float64(len(committedEntries)))
 	aCtx.committedCount += len(committedEntries)
 	// If we send multiple ConfChange commands, only first one will be proposed correctly,
 	// others will be saved as a normal entry with no data, so we must re-propose these
@@ -491,6 +525,7 @@ func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.C
 			return nil
 		}
 		if cmd.index == index && cmd.term == term {
+			proposeToApplyDuration.Observe(time.Since(cmd.proposedAt).Seconds())
 			return cmd.cb
 		}
 		notifyStaleCommand(regionID, peerID, term, *cmd)
@@ -502,6 +537,7 @@ func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.C
 			break
 		}
 		if head.index == index && head.term == term {
+			proposeToApplyDuration.Observe(time.Since(head.proposedAt).Seconds())
 			return head.cb
 		}
 		// Because of the lack of original RaftCmdRequest, we skip calling
@@ -536,7 +572,7 @@ func (a *applier) processRaftCmd(aCtx *applyContext, index, term uint64, cmd *ra
 /// we should try to apply the entry again or panic. Considering that this
 /// usually due to disk operation fail, which is rare, so just panic is ok.
 func (a *applier) applyRaftCmd(aCtx *applyContext, index, term uint64,
-	req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, *badger.Txn, applyResult) {
+	req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot, applyResult) {
 	// if pending remove, apply should be aborted already.
 	y.Assert(!a.pendingRemove)
 
@@ -566,8 +602,10 @@ func (a *applier) applyRaftCmd(aCtx *applyContext, index, term uint64,
 		switch x := applyResult.data.(type) {
 		case *execResultChangePeer:
 			a.region = x.region
+			aCtx.observeRegionChanged(a.region)
 		case *execResultSplitRegion:
 			a.region = x.derived
+			aCtx.observeRegionChanged(a.region)
 		default:
 		}
 	}
@@ -584,7 +622,7 @@ func (a *applier) newCtx(engines *engine_util.Engines, index, term uint64) *appl
 
 // Only errors that will also occur on all other stores should be returned.
 func (a *applier) execRaftCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdRequest) (
-	resp *raft_cmdpb.RaftCmdResponse, txn *badger.Txn, result applyResult, err error) {
+	resp *raft_cmdpb.RaftCmdResponse, txn engine_util.Snapshot, result applyResult, err error) {
 	// Include region for epoch not match after merge may cause key not in range.
 	err = util.CheckRegionEpoch(req, a.region, false)
 	if err != nil {
@@ -597,10 +635,10 @@ func (a *applier) execRaftCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdRequest
 }
 
 func (a *applier) execAdminCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdRequest) (
-	resp *raft_cmdpb.RaftCmdResponse, txn *badger.Txn, result applyResult, err error) {
+	resp *raft_cmdpb.RaftCmdResponse, txn engine_util.Snapshot, result applyResult, err error) {
 	adminReq := req.AdminRequest
 	cmdType := adminReq.CmdType
-	if cmdType != raft_cmdpb.AdminCmdType_CompactLog {
+	if cmdType != raft_cmdpb.AdminCmdType_CompactLog && cmdType != raft_cmdpb.AdminCmdType_UpdateResolvedTs {
 		log.Infof("%s execute admin command. term %d, index %d, command %s",
 			a.tag, aCtx.execCtx.term, aCtx.execCtx.index, adminReq)
 	}
@@ -612,6 +650,10 @@ func (a *applier) execAdminCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReques
 		adminResp, result, err = a.execBatchSplit(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_CompactLog:
 		adminResp, result, err = a.execCompactLog(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_UpdateResolvedTs:
+		adminResp, result = a.execUpdateResolvedTs(adminReq)
+	case raft_cmdpb.AdminCmdType_IngestSST:
+		adminResp, result, err = a.execIngestSST(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_TransferLeader:
 		err = errors.New("transfer leader won't execute")
 	case raft_cmdpb.AdminCmdType_InvalidAdmin:
@@ -627,7 +669,7 @@ func (a *applier) execAdminCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReques
 }
 
 func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdRequest) (
-	resp *raft_cmdpb.RaftCmdResponse, txn *badger.Txn, result applyResult, err error) {
+	resp *raft_cmdpb.RaftCmdResponse, txn engine_util.Snapshot, result applyResult, err error) {
 	requests := req.GetRequests()
 	resps := make([]*raft_cmdpb.Response, 0, len(requests))
 	hasWrite, hasRead := false, false
@@ -643,6 +685,11 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 			r, err = a.handleDelete(aCtx, req.GetDelete())
 			resps = append(resps, r)
 			hasWrite = true
+		case raft_cmdpb.CmdType_DeleteRange:
+			var r *raft_cmdpb.Response
+			r, err = a.handleDeleteRange(aCtx, req.GetDeleteRange())
+			resps = append(resps, r)
+			hasWrite = true
 		case raft_cmdpb.CmdType_Get:
 			var r *raft_cmdpb.Response
 			r, err = a.handleGet(aCtx, req.GetGet())
@@ -653,7 +700,7 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 				CmdType: raft_cmdpb.CmdType_Snap,
 				Snap:    &raft_cmdpb.SnapResponse{Region: a.region},
 			})
-			txn = aCtx.engines.Kv.NewTransaction(false)
+			txn = aCtx.engines.Kv.NewSnapshot()
 			hasRead = true
 		default:
 			log.Fatalf("invalid cmd type=%v", req.CmdType)
@@ -662,6 +709,11 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 	if hasWrite && hasRead {
 		panic("mixed write and read in one request")
 	}
+	if hasWrite && err == nil {
+		if observer := aCtx.cmdObserver(); observer != nil {
+			observer.OnApplyCmd(a.region, requests)
+		}
+	}
 	resp = newCmdRespForReq(req)
 	resp.Responses = resps
 	return
@@ -699,6 +751,33 @@ func (a *applier) handleDelete(aCtx *applyContext, req *raft_cmdpb.DeleteRequest
 	}, nil
 }
 
+func (a *applier) handleDeleteRange(aCtx *applyContext, req *raft_cmdpb.DeleteRangeRequest) (*raft_cmdpb.Response, error) {
+	startKey, endKey := req.GetStartKey(), req.GetEndKey()
+	if err := util.CheckKeyInRegion(startKey, a.region); err != nil {
+		return nil, err
+	}
+	// The end key of an encoded range may be past the end of the region while all the keys of the region in the range
+	// are before it, so the range is cut at the end of the region instead of being rejected.
+	if regionEnd := a.region.GetEndKey(); len(regionEnd) != 0 && engine_util.ExceedEndKey(endKey, regionEnd) {
+		endKey = regionEnd
+	}
+	if len(endKey) != 0 && bytes.Compare(startKey, endKey) >= 0 {
+		return nil, errors.Errorf("invalid delete range [%v, %v)", startKey, endKey)
+	}
+
+	cf := req.GetCf()
+	if len(cf) == 0 {
+		cf = engine_util.CfDefault
+	}
+	// The write batch is written after every entry, so the snapshot has all the keys written before this entry.
+	snap := aCtx.engines.Kv.NewSnapshot()
+	engine_util.DeleteRangeCF(snap, aCtx.wb, cf, startKey, endKey)
+	snap.Discard()
+	return &raft_cmdpb.Response{
+		CmdType: raft_cmdpb.CmdType_DeleteRange,
+	}, nil
+}
+
 func (a *applier) handleGet(aCtx *applyContext, req *raft_cmdpb.GetRequest) (*raft_cmdpb.Response, error) {
 	key := req.GetKey()
 	if err := util.CheckKeyInRegion(key, a.region); err != nil {
@@ -711,7 +790,7 @@ func (a *applier) handleGet(aCtx *applyContext, req *raft_cmdpb.GetRequest) (*ra
 	} else {
 		val, err = engine_util.GetCF(aCtx.engines.Kv, engine_util.CfDefault, key)
 	}
-	if err == badger.ErrKeyNotFound {
+	if err == engine_util.ErrKeyNotFound {
 		err = nil
 		val = nil
 	}
@@ -896,4 +975,51 @@ func (a *applier) execCompactLog(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	return
 }
 
+// execUpdateResolvedTs passes the resolved ts to the peer, which takes effect after the entries before it are
+// written.
+func (a *applier) execUpdateResolvedTs(req *raft_cmdpb.AdminRequest) (*raft_cmdpb.AdminResponse, applyResult) {
+	resp := &raft_cmdpb.AdminResponse{UpdateResolvedTs: &raft_cmdpb.UpdateResolvedTsResponse{}}
+	return resp, applyResult{tp: applyResultTypeExecResult, data: &execResultUpdateResolvedTs{
+		resolvedTs: req.GetUpdateResolvedTs().GetResolvedTs(),
+	}}
+}
+
+// execIngestSST ingests the uploaded file into the kv engine. The write batch of the previous entries is already
+// written, so the data of the file overrides it. The file is deleted by the peer after the apply state is persisted.
+func (a *applier) execIngestSST(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	*raft_cmdpb.AdminResponse, applyResult, error) {
+	sst := req.GetIngestSst().GetSst()
+	if err := checkSSTForIngestion(sst, a.region); err != nil {
+		// The file can never be ingested since its region has changed.
+		if err := aCtx.importer.Delete(sst); err != nil {
+			log.Warnf("%s delete sst %x failed: %v", a.tag, sst.GetUuid(), err)
+		}
+		return nil, applyResult{}, err
+	}
+	// All the replicas must ingest the file or they diverge, the leader checks that the file exists before proposing.
+	if err := aCtx.importer.Ingest(sst, aCtx.engines.Kv); err != nil {
+		panic(fmt.Sprintf("%s ingest sst %x: %v", a.tag, sst.GetUuid(), err))
+	}
+	resp := &raft_cmdpb.AdminResponse{IngestSst: &raft_cmdpb.IngestSSTResponse{}}
+	return resp, applyResult{tp: applyResultTypeExecResult, data: &execResultIngestSST{sst: sst}}, nil
+}
+
+// checkSSTForIngestion checks that the file is uploaded for the current epoch of the region and its keys are in the
+// region.
+func checkSSTForIngestion(sst *import_sstpb.SSTMeta, region *metapb.Region) error {
+	epoch, regionEpoch := sst.GetRegionEpoch(), region.GetRegionEpoch()
+	if sst.GetRegionId() != region.GetId() || epoch.GetConfVer() != regionEpoch.GetConfVer() ||
+		epoch.GetVersion() != regionEpoch.GetVersion() {
+		return &util.ErrEpochNotMatch{
+			Message: fmt.Sprintf("sst of region %d epoch %v, current region %d epoch %v",
+				sst.GetRegionId(), epoch, region.GetId(), regionEpoch),
+			Regions: []*metapb.Region{region},
+		}
+	}
+	if err := util.CheckKeyInRegion(sst.GetStartKey(), region); err != nil {
+		return err
+	}
+	return util.CheckKeyInRegion(sst.GetEndKey(), region)
+}
+
 // TODO: Delete End
@@ -3,11 +3,12 @@ package raftstore
 import (
 	"bytes"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
@@ -84,7 +85,7 @@ func (m *storeMeta) getOverlapRegions(region *metapb.Region) []*metapb.Region {
 }
 
 type GlobalContext struct {
-	cfg                  *config.Config
+	cfg                  atomic.Value // *config.Config, replaced on online config change
 	engine               *engine_util.Engines
 	store                *metapb.Store
 	storeMeta            *storeMeta
@@ -97,6 +98,19 @@ type GlobalContext struct {
 	splitCheckTaskSender chan<- worker.Task
 	pdClient             pd.Client
 	tickDriverSender     chan uint64
+	observer             *observerHolder
+	importer             *importer.SSTImporter
+}
+
+func (ctx *GlobalContext) config() *config.Config {
+	return ctx.cfg.Load().(*config.Config)
+}
+
+func (ctx *GlobalContext) cmdObserver() CmdObserver {
+	if ctx.observer == nil {
+		return nil
+	}
+	return ctx.observer.get()
 }
 
 type Transport interface {
@@ -122,8 +136,10 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peer, error) {
 	raftWB := new(engine_util.WriteBatch)
 	var applyingRegions []*metapb.Region
 	var mergingCount int
-	err := kvEngine.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
+	err := func() error {
+		snap := kvEngine.NewSnapshot()
+		defer snap.Discard()
+		it := snap.NewIterator()
 		defer it.Close()
 		for it.Seek(startKey); it.Valid(); it.Next() {
 			item := it.Item()
@@ -162,7 +178,7 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peer, error) {
 				continue
 			}
 
-			peer, err := createPeer(storeID, ctx.cfg, ctx.regionTaskSender, ctx.engine, region)
+			peer, err := createPeer(storeID, ctx.config(), ctx.regionTaskSender, ctx.engine, region)
 			if err != nil {
 				return err
 			}
@@ -173,7 +189,7 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peer, error) {
 			regionPeers = append(regionPeers, peer)
 		}
 		return nil
-	})
+	}()
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +199,7 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peer, error) {
 	// schedule applying snapshot after raft write batch were written.
 	for _, region := range applyingRegions {
 		log.Infof("region %d is applying snapshot", region.Id)
-		peer, err := createPeer(storeID, ctx.cfg, ctx.regionTaskSender, ctx.engine, region)
+		peer, err := createPeer(storeID, ctx.config(), ctx.regionTaskSender, ctx.engine, region)
 		if err != nil {
 			return nil, err
 		}
@@ -229,6 +245,7 @@ type RaftBatchSystem struct {
 	tickDriver *tickDriver
 	closeCh    chan struct{}
 	wg         *sync.WaitGroup
+	observer   observerHolder
 }
 
 func (bs *RaftBatchSystem) start(
@@ -247,6 +264,10 @@ func (bs *RaftBatchSystem) start(
 	if err != nil {
 		return err
 	}
+	sstImporter, err := importer.NewSSTImporterForEngines(engines)
+	if err != nil {
+		return err
+	}
 	wg := new(sync.WaitGroup)
 	bs.workers = &workers{
 		splitCheckWorker: worker.NewWorker("split-check", wg),
@@ -256,7 +277,6 @@ func (bs *RaftBatchSystem) start(
 		wg:               wg,
 	}
 	bs.ctx = &GlobalContext{
-		cfg:                  cfg,
 		engine:               engines,
 		store:                meta,
 		storeMeta:            newStoreMeta(),
@@ -269,7 +289,10 @@ func (bs *RaftBatchSystem) start(
 		raftLogGCTaskSender:  bs.workers.raftLogGCWorker.Sender(),
 		pdClient:             pdClient,
 		tickDriverSender:     bs.tickDriver.newRegionCh,
+		observer:             &bs.observer,
+		importer:             sstImporter,
 	}
+	bs.ctx.cfg.Store(cfg)
 	regionPeers, err := bs.loadPeers()
 	if err != nil {
 		return err
@@ -299,7 +322,7 @@ func (bs *RaftBatchSystem) startWorkers(peers []*peer) {
 		_ = router.send(regionID, message.Msg{RegionID: regionID, Type: message.MsgTypeStart})
 	}
 	engines := ctx.engine
-	cfg := ctx.cfg
+	cfg := ctx.config()
 	workers.splitCheckWorker.Start(runner.NewSplitCheckHandler(engines.Kv, NewRaftstoreRouter(router), cfg))
 	workers.regionWorker.Start(runner.NewRegionTaskHandler(engines, ctx.snapMgr))
 	workers.raftLogGCWorker.Start(runner.NewRaftLogGCTaskHandler())
@@ -324,6 +347,27 @@ func (bs *RaftBatchSystem) shutDown() {
 	workers.wg.Wait()
 }
 
+// UpdateConfig pushes an online config change to the running raftstore. Only the options listed by
+// config.OnlineOptions are expected to differ from the config the raftstore was started with.
+func (bs *RaftBatchSystem) UpdateConfig(cfg *config.Config) {
+	if bs.workers == nil {
+		return
+	}
+	bs.ctx.cfg.Store(cfg)
+	bs.router.peers.Range(func(key, value interface{}) bool {
+		regionID := key.(uint64)
+		_ = bs.router.send(regionID, message.NewPeerMsg(message.MsgTypeConfigChange, regionID, cfg))
+		return true
+	})
+	bs.router.sendStore(message.NewMsg(message.MsgTypeStoreConfigChange, cfg))
+	bs.workers.splitCheckWorker.Sender() <- worker.Task{Tp: worker.TaskTypeConfigChange, Data: cfg}
+}
+
+// SSTImporter returns the importer of the files uploaded to the store, it must be called after the raftstore starts.
+func (bs *RaftBatchSystem) SSTImporter() *importer.SSTImporter {
+	return bs.ctx.importer
+}
+
 func CreateRaftBatchSystem(cfg *config.Config) (*RaftstoreRouter, *RaftBatchSystem) {
 	storeSender, storeState := newStoreState(cfg)
 	router := newRouter(storeSender)
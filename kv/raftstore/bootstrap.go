@@ -3,7 +3,6 @@ package raftstore
 import (
 	"bytes"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
@@ -17,24 +16,20 @@ const (
 	InitEpochConfVer uint64 = 1
 )
 
-func isRangeEmpty(engine *badger.DB, startKey, endKey []byte) (bool, error) {
+func isRangeEmpty(engine engine_util.Engine, startKey, endKey []byte) (bool, error) {
 	var hasData bool
-	err := engine.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		it.Seek(startKey)
-		if it.Valid() {
-			item := it.Item()
-			if bytes.Compare(item.Key(), endKey) < 0 {
-				hasData = true
-			}
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
+	it := snap.NewIterator()
+	defer it.Close()
+	it.Seek(startKey)
+	if it.Valid() {
+		item := it.Item()
+		if bytes.Compare(item.Key(), endKey) < 0 {
+			hasData = true
 		}
-		return nil
-	})
-	if err != nil {
-		return false, errors.WithStack(err)
 	}
-	return !hasData, err
+	return !hasData, nil
 }
 
 func BootstrapStore(engines *engine_util.Engines, clusterID, storeID uint64) error {
@@ -128,11 +123,11 @@ func writeInitialRaftState(raftWB *engine_util.WriteBatch, regionID uint64) {
 }
 
 func ClearPrepareBootstrap(engines *engine_util.Engines, regionID uint64) error {
-	err := engines.Raft.Update(func(txn *badger.Txn) error {
-		return txn.Delete(meta.RaftStateKey(regionID))
-	})
+	raftWB := new(engine_util.WriteBatch)
+	raftWB.Delete(meta.RaftStateKey(regionID))
+	err := engines.WriteRaft(raftWB)
 	if err != nil {
-		return errors.WithStack(err)
+		return err
 	}
 	wb := new(engine_util.WriteBatch)
 	wb.Delete(meta.PrepareBootstrapKey)
@@ -147,8 +142,7 @@ func ClearPrepareBootstrap(engines *engine_util.Engines, regionID uint64) error
 }
 
 func ClearPrepareBootstrapState(engines *engine_util.Engines) error {
-	err := engines.Kv.Update(func(txn *badger.Txn) error {
-		return txn.Delete(meta.PrepareBootstrapKey)
-	})
-	return errors.WithStack(err)
+	wb := new(engine_util.WriteBatch)
+	wb.Delete(meta.PrepareBootstrapKey)
+	return engines.WriteKV(wb)
 }
@@ -3,13 +3,13 @@ package message
 import (
 	"time"
 
-	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 )
 
 type Callback struct {
 	Resp *raft_cmdpb.RaftCmdResponse
-	Txn  *badger.Txn // used for GetSnap
+	Snap engine_util.Snapshot // used for GetSnap
 	done chan struct{}
 }
 
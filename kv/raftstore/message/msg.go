@@ -18,10 +18,13 @@ const (
 	MsgTypeGcSnap                MsgType = 7
 	MsgTypeSplitRegion           MsgType = 8
 	MsgTypeRegionApproximateSize MsgType = 9
+	MsgTypeConfigChange          MsgType = 10
+	MsgTypeRegionInfo            MsgType = 11
 
-	MsgTypeStoreRaftMessage MsgType = 101
-	MsgTypeStoreTick        MsgType = 106
-	MsgTypeStoreStart       MsgType = 107
+	MsgTypeStoreRaftMessage  MsgType = 101
+	MsgTypeStoreTick         MsgType = 106
+	MsgTypeStoreStart        MsgType = 107
+	MsgTypeStoreConfigChange MsgType = 108
 
 	MsgTypeApplyCommitted MsgType = 301
 	MsgTypeApplyRefresh   MsgType = 302
@@ -1,7 +1,6 @@
 package meta
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
@@ -10,7 +9,7 @@ import (
 	"github.com/pingcap/errors"
 )
 
-func GetRegionLocalState(db *badger.DB, regionId uint64) (*rspb.RegionLocalState, error) {
+func GetRegionLocalState(db engine_util.Engine, regionId uint64) (*rspb.RegionLocalState, error) {
 	regionLocalState := new(rspb.RegionLocalState)
 	if err := engine_util.GetMsg(db, RegionStateKey(regionId), regionLocalState); err != nil {
 		return regionLocalState, err
@@ -18,7 +17,7 @@ func GetRegionLocalState(db *badger.DB, regionId uint64) (*rspb.RegionLocalState
 	return regionLocalState, nil
 }
 
-func GetRaftLocalState(db *badger.DB, regionId uint64) (*rspb.RaftLocalState, error) {
+func GetRaftLocalState(db engine_util.Engine, regionId uint64) (*rspb.RaftLocalState, error) {
 	raftLocalState := new(rspb.RaftLocalState)
 	if err := engine_util.GetMsg(db, RaftStateKey(regionId), raftLocalState); err != nil {
 		return raftLocalState, err
@@ -26,7 +25,7 @@ func GetRaftLocalState(db *badger.DB, regionId uint64) (*rspb.RaftLocalState, er
 	return raftLocalState, nil
 }
 
-func GetSnapRaftState(db *badger.DB, regionId uint64) (*rspb.RaftLocalState, error) {
+func GetSnapRaftState(db engine_util.Engine, regionId uint64) (*rspb.RaftLocalState, error) {
 	snapRaftState := new(rspb.RaftLocalState)
 	if err := engine_util.GetMsg(db, SnapshotRaftStateKey(regionId), snapRaftState); err != nil {
 		return nil, err
@@ -34,7 +33,7 @@ func GetSnapRaftState(db *badger.DB, regionId uint64) (*rspb.RaftLocalState, err
 	return snapRaftState, nil
 }
 
-func GetApplyState(db *badger.DB, regionId uint64) (*rspb.RaftApplyState, error) {
+func GetApplyState(db engine_util.Engine, regionId uint64) (*rspb.RaftApplyState, error) {
 	applyState := new(rspb.RaftApplyState)
 	if err := engine_util.GetMsg(db, ApplyStateKey(regionId), applyState); err != nil {
 		return nil, err
@@ -42,7 +41,7 @@ func GetApplyState(db *badger.DB, regionId uint64) (*rspb.RaftApplyState, error)
 	return applyState, nil
 }
 
-func GetRaftEntry(db *badger.DB, regionId, idx uint64) (*eraftpb.Entry, error) {
+func GetRaftEntry(db engine_util.Engine, regionId, idx uint64) (*eraftpb.Entry, error) {
 	entry := new(eraftpb.Entry)
 	if err := engine_util.GetMsg(db, RaftLogKey(regionId, idx), entry); err != nil {
 		return nil, err
@@ -57,12 +56,12 @@ const (
 	RaftInitLogIndex = 5
 )
 
-func InitRaftLocalState(raftEngine *badger.DB, region *metapb.Region) (*rspb.RaftLocalState, error) {
+func InitRaftLocalState(raftEngine engine_util.Engine, region *metapb.Region) (*rspb.RaftLocalState, error) {
 	raftState, err := GetRaftLocalState(raftEngine, region.Id)
-	if err != nil && err != badger.ErrKeyNotFound {
+	if err != nil && err != engine_util.ErrKeyNotFound {
 		return nil, err
 	}
-	if err == badger.ErrKeyNotFound {
+	if err == engine_util.ErrKeyNotFound {
 		raftState = new(rspb.RaftLocalState)
 		raftState.HardState = new(eraftpb.HardState)
 		if len(region.Peers) > 0 {
@@ -79,12 +78,12 @@ func InitRaftLocalState(raftEngine *badger.DB, region *metapb.Region) (*rspb.Raf
 	return raftState, nil
 }
 
-func InitApplyState(kvEngine *badger.DB, region *metapb.Region) (*rspb.RaftApplyState, error) {
+func InitApplyState(kvEngine engine_util.Engine, region *metapb.Region) (*rspb.RaftApplyState, error) {
 	applyState, err := GetApplyState(kvEngine, region.Id)
-	if err != nil && err != badger.ErrKeyNotFound {
+	if err != nil && err != engine_util.ErrKeyNotFound {
 		return nil, err
 	}
-	if err == badger.ErrKeyNotFound {
+	if err == engine_util.ErrKeyNotFound {
 		applyState = new(rspb.RaftApplyState)
 		applyState.TruncatedState = new(rspb.RaftTruncatedState)
 		if len(region.Peers) > 0 {
@@ -100,7 +99,7 @@ func InitApplyState(kvEngine *badger.DB, region *metapb.Region) (*rspb.RaftApply
 	return applyState, nil
 }
 
-func InitLastTerm(raftEngine *badger.DB, region *metapb.Region,
+func InitLastTerm(raftEngine engine_util.Engine, region *metapb.Region,
 	raftState *rspb.RaftLocalState, applyState *rspb.RaftApplyState) (uint64, error) {
 	lastIdx := raftState.LastIndex
 	if lastIdx == 0 {
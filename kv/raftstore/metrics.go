@@ -0,0 +1,38 @@
+package raftstore
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	proposeToApplyDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "tinykv",
+			Subsystem: "raftstore",
+			Name:      "propose_to_apply_duration_seconds",
+			Help:      "Bucketed histogram of the duration from proposing a command to applying it.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 20),
+		})
+
+	raftReadyDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "tinykv",
+			Subsystem: "raftstore",
+			Name:      "raft_ready_duration_seconds",
+			Help:      "Bucketed histogram of the duration of handling a raft ready.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 20),
+		})
+
+	applyBatchSize = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "tinykv",
+			Subsystem: "raftstore",
+			Name:      "apply_batch_size",
+			Help:      "Bucketed histogram of the number of committed entries applied in one batch.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		})
+)
+
+func init() {
+	prometheus.MustRegister(proposeToApplyDuration)
+	prometheus.MustRegister(raftReadyDuration)
+	prometheus.MustRegister(applyBatchSize)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/golang/protobuf/proto"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
@@ -79,7 +78,7 @@ func (n *Node) Start(ctx context.Context, engines *engine_util.Engines, trans Tr
 func (n *Node) checkStore(engines *engine_util.Engines) (uint64, error) {
 	val, err := engine_util.GetValue(engines.Kv, meta.StoreIdentKey)
 	if err != nil {
-		if err == badger.ErrKeyNotFound {
+		if err == engine_util.ErrKeyNotFound {
 			return 0, nil
 		}
 		return 0, err
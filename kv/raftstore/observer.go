@@ -0,0 +1,45 @@
+package raftstore
+
+import (
+	"sync/atomic"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+)
+
+// CmdObserver observes the commands applied by the peers of a store. It is called by the apply worker in the order
+// the commands are applied, so it must not block.
+type CmdObserver interface {
+	// OnApplyCmd is called after the write requests of a command are applied to the region. The requests must not be
+	// modified.
+	OnApplyCmd(region *metapb.Region, requests []*raft_cmdpb.Request)
+	// OnRegionChanged is called after the range or the peers of the region are changed by an admin command.
+	OnRegionChanged(region *metapb.Region)
+	// OnRoleChanged is called by the raft worker after the peer of the store becomes or stops being the leader of
+	// the region.
+	OnRoleChanged(region *metapb.Region, isLeader bool)
+}
+
+// observerHolder holds the CmdObserver of a store, it can be replaced while the store is running.
+type observerHolder struct {
+	v atomic.Value // observerBox
+}
+
+// observerBox wraps the observer since an atomic.Value can't store a nil interface.
+type observerBox struct {
+	observer CmdObserver
+}
+
+func (h *observerHolder) set(observer CmdObserver) {
+	h.v.Store(observerBox{observer: observer})
+}
+
+func (h *observerHolder) get() CmdObserver {
+	box, _ := h.v.Load().(observerBox)
+	return box.observer
+}
+
+// SetCmdObserver sets the observer of the commands applied by the store, nil removes it.
+func (bs *RaftBatchSystem) SetCmdObserver(observer CmdObserver) {
+	bs.observer.set(observer)
+}
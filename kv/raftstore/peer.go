@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
@@ -14,6 +13,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/log"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap-incubator/tinykv/raft"
@@ -121,6 +121,19 @@ type peer struct {
 
 	PendingRemove bool
 
+	// Every transaction which commits in the region at a ts not greater than ResolvedTs is applied, so the local data
+	// can serve a stale read at it. It is advanced by the UpdateResolvedTs commands.
+	ResolvedTs uint64
+	// The max ts of the stale reads refused by the peer and the last time one is refused. The leader advances the
+	// resolved ts only for a while after a stale read waits on it.
+	staleReadTs   uint64
+	staleReadTime time.Time
+
+	// The replica reads on the follower, which wait for their read indexes from the leader and then for the read
+	// indexes to be applied.
+	replicaReads      []*replicaRead
+	lastReplicaReadID uint64
+
 	// If a snapshot is being applied asynchronously, messages should not be sent.
 	pendingMessages []eraftpb.Message
 }
@@ -252,6 +265,10 @@ func (p *peer) Destroy(engine *engine_util.Engines, keepData bool) error {
 		NotifyReqRegionRemoved(region.Id, proposal.cb)
 	}
 	p.applyProposals = nil
+	for _, read := range p.replicaReads {
+		NotifyReqRegionRemoved(region.Id, read.cb)
+	}
+	p.replicaReads = nil
 
 	log.Infof("%v destroy itself, takes %v", p.Tag, time.Now().Sub(start))
 	return nil
@@ -340,6 +357,25 @@ func (p *peer) CheckPeers() {
 	}
 }
 
+/// Collects the peers which the leader hasn't heard from for longer than `maxDuration`.
+func (p *peer) CollectDownPeers(maxDuration time.Duration) []*pdpb.PeerStats {
+	downPeers := make([]*pdpb.PeerStats, 0)
+	for _, peer := range p.Region().GetPeers() {
+		if peer.GetId() == p.Meta.GetId() {
+			continue
+		}
+		if hb, ok := p.PeerHeartbeats[peer.GetId()]; ok {
+			if elapsed := time.Since(hb); elapsed >= maxDuration {
+				downPeers = append(downPeers, &pdpb.PeerStats{
+					Peer:        peer,
+					DownSeconds: uint64(elapsed.Seconds()),
+				})
+			}
+		}
+	}
+	return downPeers
+}
+
 /// Collects all pending peers and update `peers_start_pending_time`.
 func (p *peer) CollectPendingPeers() []*metapb.Peer {
 	pendingPeers := make([]*metapb.Peer, 0, len(p.Region().GetPeers()))
@@ -415,7 +451,8 @@ func (p *peer) TakeApplyProposals() *MsgApplyProposal {
 	}
 }
 
-func (p *peer) HandleRaftReady(msgs []message.Msg, pdScheduler chan<- worker.Task, trans Transport) (*ApplySnapResult, []message.Msg) {
+func (p *peer) HandleRaftReady(msgs []message.Msg, pdScheduler chan<- worker.Task, trans Transport,
+	observer CmdObserver, maxPeerDownDuration time.Duration) (*ApplySnapResult, []message.Msg) {
 	if p.PendingRemove {
 		return nil, msgs
 	}
@@ -460,7 +497,10 @@ func (p *peer) HandleRaftReady(msgs []message.Msg, pdScheduler chan<- worker.Tas
 	}
 	ss := ready.SoftState
 	if ss != nil && ss.RaftState == raft.StateLeader {
-		p.HeartbeatPd(pdScheduler)
+		p.HeartbeatPd(pdScheduler, maxPeerDownDuration)
+	}
+	if ss != nil && observer != nil {
+		observer.OnRoleChanged(p.Region(), ss.RaftState == raft.StateLeader)
 	}
 
 	applySnapResult, err := p.Store().SaveReadyState(&ready)
@@ -524,12 +564,13 @@ func (p *peer) Stop() {
 	p.Store().CancelApplyingSnap()
 }
 
-func (p *peer) HeartbeatPd(pdScheduler chan<- worker.Task) {
+func (p *peer) HeartbeatPd(pdScheduler chan<- worker.Task, maxPeerDownDuration time.Duration) {
 	pdScheduler <- worker.Task{
 		Tp: worker.TaskTypePDHeartbeat,
 		Data: &runner.PdRegionHeartbeatTask{
 			Region:          p.Region(),
 			Peer:            p.Meta,
+			DownPeers:       p.CollectDownPeers(maxPeerDownDuration),
 			PendingPeers:    p.CollectPendingPeers(),
 			ApproximateSize: p.ApproximateSize,
 		},
@@ -574,7 +615,7 @@ func (p *peer) sendRaftMessage(msg eraftpb.Message, trans Transport) error {
 // Propose a request.
 //
 // Return true means the request has been proposed successfully.
-func (p *peer) Propose(kv *badger.DB, cfg *config.Config, cb *message.Callback, req *raft_cmdpb.RaftCmdRequest, errResp *raft_cmdpb.RaftCmdResponse) bool {
+func (p *peer) Propose(kv engine_util.Engine, cfg *config.Config, cb *message.Callback, req *raft_cmdpb.RaftCmdRequest, errResp *raft_cmdpb.RaftCmdResponse) bool {
 	if p.PendingRemove {
 		return false
 	}
@@ -614,6 +655,7 @@ func (p *peer) PostPropose(index, term uint64, isConfChange bool, cb *message.Ca
 		index:        index,
 		term:         term,
 		cb:           cb,
+		proposedAt:   time.Now(),
 	}
 	p.applyProposals = append(p.applyProposals, proposal)
 }
@@ -791,7 +833,7 @@ func (p *peer) inspect(req *raft_cmdpb.RaftCmdRequest) (RequestPolicy, error) {
 		switch r.CmdType {
 		case raft_cmdpb.CmdType_Get, raft_cmdpb.CmdType_Snap:
 			hasRead = true
-		case raft_cmdpb.CmdType_Delete, raft_cmdpb.CmdType_Put:
+		case raft_cmdpb.CmdType_Delete, raft_cmdpb.CmdType_Put, raft_cmdpb.CmdType_DeleteRange:
 			hasWrite = true
 		case raft_cmdpb.CmdType_Invalid:
 			return RequestPolicy_Invalid, fmt.Errorf("invalid cmd type %v, message maybe corrupted", r.CmdType)
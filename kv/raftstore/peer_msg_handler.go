@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/Connor1996/badger/y"
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
@@ -12,6 +14,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/worker"
 	"github.com/pingcap-incubator/tinykv/log"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
@@ -26,8 +29,17 @@ const (
 	PeerTickRaftLogGC        PeerTick = 1
 	PeerTickSplitRegionCheck PeerTick = 2
 	PeerTickPdHeartbeat      PeerTick = 3
+	PeerTickResolvedTs       PeerTick = 4
 )
 
+// importModeRaftLogGcFactor multiplies the raft log GC count limit in the import mode, so the raft logs are compacted
+// less often.
+const importModeRaftLogGcFactor = 4
+
+// staleReadWaitTicks is the number of resolved ts ticks for which the leader keeps advancing the resolved ts after a
+// stale read is refused.
+const staleReadWaitTicks = 10
+
 type peerMsgHandler struct {
 	*peer
 	applyCh chan []message.Msg
@@ -68,6 +80,13 @@ func (d *peerMsgHandler) HandleMsgs(msg message.Msg) {
 		d.onGCSnap(gcSnap.Snaps)
 	case message.MsgTypeStart:
 		d.startTicker()
+	case message.MsgTypeConfigChange:
+		// A peer which is not started yet gets the new intervals when its ticker is created on start.
+		if d.ticker != nil {
+			d.ticker.updatePeerIntervals(msg.Data.(*config.Config))
+		}
+	case message.MsgTypeRegionInfo:
+		msg.Data.(chan<- *RegionInfo) <- d.regionInfo()
 	}
 }
 
@@ -88,16 +107,20 @@ func (d *peerMsgHandler) onTick() {
 	if d.ticker.isOnTick(PeerTickSplitRegionCheck) {
 		d.onSplitRegionCheckTick()
 	}
+	if d.ticker.isOnTick(PeerTickResolvedTs) {
+		d.onResolvedTsTick()
+	}
 	d.ctx.tickDriverSender <- d.regionID()
 }
 
 func (d *peerMsgHandler) startTicker() {
-	d.ticker = newTicker(d.regionID(), d.ctx.cfg)
+	d.ticker = newTicker(d.regionID(), d.ctx.config())
 	d.ctx.tickDriverSender <- d.regionID()
 	d.ticker.schedule(PeerTickRaft)
 	d.ticker.schedule(PeerTickRaftLogGC)
 	d.ticker.schedule(PeerTickSplitRegionCheck)
 	d.ticker.schedule(PeerTickPdHeartbeat)
+	d.ticker.schedule(PeerTickResolvedTs)
 }
 
 func (d *peerMsgHandler) onGCSnap(snaps []snap.SnapKeyWithSending) {
@@ -140,6 +163,10 @@ func (d *peerMsgHandler) HandleRaftReady() {
 	if d.stopped {
 		return
 	}
+	start := time.Now()
+	defer func() {
+		raftReadyDuration.Observe(time.Since(start).Seconds())
+	}()
 
 	// Your Code Here (2B).
 	// TODO: Delete Start
@@ -148,7 +175,8 @@ func (d *peerMsgHandler) HandleRaftReady() {
 		msg := message.Msg{Type: message.MsgTypeApplyProposal, Data: p, RegionID: p.RegionId}
 		msgs = append(msgs, msg)
 	}
-	applySnapResult, msgs := d.peer.HandleRaftReady(msgs, d.ctx.pdTaskSender, d.ctx.trans)
+	applySnapResult, msgs := d.peer.HandleRaftReady(msgs, d.ctx.pdTaskSender, d.ctx.trans, d.ctx.cmdObserver(),
+		d.ctx.config().MaxPeerDownDuration)
 	if applySnapResult != nil {
 		prevRegion := applySnapResult.PrevRegion
 		region := applySnapResult.Region
@@ -173,6 +201,7 @@ func (d *peerMsgHandler) onRaftBaseTick() {
 	if d.peer.PendingRemove {
 		return
 	}
+	d.expireReplicaReads()
 	// When having pending snapshot, if election timeout is met, it can't pass
 	// the pending conf change check because first index has been updated to
 	// a value that is larger than last index.
@@ -200,12 +229,19 @@ func (d *peerMsgHandler) onApplyResult(res *MsgApplyRes) {
 			d.onReadyCompactLog(x.firstIndex, x.truncatedIndex)
 		case *execResultSplitRegion:
 			d.onReadySplitRegion(x.derived, x.regions)
+		case *execResultUpdateResolvedTs:
+			if x.resolvedTs > d.peer.ResolvedTs {
+				d.peer.ResolvedTs = x.resolvedTs
+			}
+		case *execResultIngestSST:
+			d.onReadyIngestSST(x.sst)
 		}
 	}
 	res.execResults = nil
 	if d.stopped {
 		return
 	}
+	d.serveReplicaReads()
 
 	diff := d.peer.SizeDiffHint + res.sizeDiffHint
 	if diff > 0 {
@@ -216,6 +252,15 @@ func (d *peerMsgHandler) onApplyResult(res *MsgApplyRes) {
 	// TODO: Delete End
 }
 
+// onReadyIngestSST removes the ingested file, the apply state after the ingestion is persisted, so the file won't be
+// ingested again. The size of the file is counted for the next split check.
+func (d *peerMsgHandler) onReadyIngestSST(sst *import_sstpb.SSTMeta) {
+	if err := d.ctx.importer.Delete(sst); err != nil {
+		log.Warnf("%s delete ingested sst %x failed: %v", d.tag(), sst.GetUuid(), err)
+	}
+	d.peer.SizeDiffHint += sst.GetLength()
+}
+
 func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 	log.Debugf("%s handle raft message %s from %d to %d",
 		d.tag(), msg.GetMessage().GetMsgType(), msg.GetFromPeer().GetId(), msg.GetToPeer().GetId())
@@ -233,6 +278,10 @@ func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 	if d.checkMessage(msg) {
 		return nil
 	}
+	if isReadIndexMsg(msg) {
+		d.onReadIndexMsg(msg)
+		return nil
+	}
 	key, err := d.checkSnapshot(msg)
 	if err != nil {
 		return err
@@ -255,7 +304,7 @@ func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 		return err
 	}
 	if d.peer.AnyNewPeerCatchUp(msg.FromPeer.Id) {
-		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender, d.ctx.config().MaxPeerDownDuration)
 	}
 	return nil
 }
@@ -480,7 +529,7 @@ func (d *peerMsgHandler) onReadyChangePeer(cp *execResultChangePeer) {
 	if d.peer.IsLeader() {
 		// Notify pd immediately.
 		log.Infof("%s notify pd with change peer region %s", d.tag(), d.region())
-		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender, d.ctx.config().MaxPeerDownDuration)
 	}
 	myPeerID := d.peerID()
 
@@ -512,13 +561,17 @@ func (d *peerMsgHandler) onReadyCompactLog(firstIndex uint64, truncatedIndex uin
 func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*metapb.Region) {
 	// Your Code Here (3B).
 	// TODO: Delete Start
+	// The split is persisted by the applier, but the peers of the new regions are not created yet.
+	if err := failpoint.Eval("raftstore/before-create-split-peers"); err != nil {
+		panic(fmt.Sprintf("%s %v", d.tag(), err))
+	}
 	meta := d.ctx.storeMeta
 	regionID := derived.Id
 	meta.setRegion(derived, d.peer)
 	d.peer.SizeDiffHint = 0
 	isLeader := d.peer.IsLeader()
 	if isLeader {
-		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender, d.ctx.config().MaxPeerDownDuration)
 		// Notify pd immediately to let it update the region meta.
 		log.Infof("%s notify pd with split count %d", d.tag(), len(regions))
 	}
@@ -555,7 +608,7 @@ func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*m
 			d.ctx.router.close(newRegionID)
 		}
 
-		newPeer, err := createPeer(d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.engine, newRegion)
+		newPeer, err := createPeer(d.ctx.store.Id, d.ctx.config(), d.ctx.regionTaskSender, d.ctx.engine, newRegion)
 		if err != nil {
 			// peer information is already written into db, can't recover.
 			// there is probably a bug.
@@ -574,7 +627,7 @@ func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*m
 		if isLeader {
 			// The new peer is likely to become leader, send a heartbeat immediately to reduce
 			// client query miss.
-			newPeer.HeartbeatPd(d.ctx.pdTaskSender)
+			newPeer.HeartbeatPd(d.ctx.pdTaskSender, d.ctx.config().MaxPeerDownDuration)
 		}
 
 		meta.regions[newRegionID] = newRegion
@@ -599,10 +652,10 @@ func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) e
 		return err
 	}
 
-	// Check whether the store has the right peer to handle the request.
+	// Check whether the store has the right peer to handle the request, any peer serves a stale read or a replica read.
 	regionID := d.regionID()
 	leaderID := d.peer.LeaderId()
-	if !d.peer.IsLeader() {
+	if !d.peer.IsLeader() && staleReadTs(req) == 0 && !isReplicaRead(req) {
 		leader := d.peer.getPeerFromCache(leaderID)
 		return &util.ErrNotLeader{RegionId: regionID, Leader: leader}
 	}
@@ -642,6 +695,14 @@ func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *
 		NotifyReqRegionRemoved(d.regionID(), cb)
 		return
 	}
+	if ts := staleReadTs(msg); ts != 0 {
+		d.onStaleRead(msg, ts, cb)
+		return
+	}
+	if isReplicaRead(msg) && !d.peer.IsLeader() {
+		d.onReplicaRead(msg, cb)
+		return
+	}
 
 	// Note:
 	// The peer that is being checked is a leader. It might step down to be a follower later. It
@@ -649,10 +710,37 @@ func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *
 	// command log entry can't be committed.
 	resp := &raft_cmdpb.RaftCmdResponse{}
 	BindRespTerm(resp, d.peer.Term())
-	d.peer.Propose(d.ctx.engine.Kv, d.ctx.cfg, cb, msg, resp)
+	d.peer.Propose(d.ctx.engine.Kv, d.ctx.config(), cb, msg, resp)
 	// TODO: Delete End
 }
 
+// staleReadTs returns the ts of a stale read request, 0 if req is not a stale read.
+func staleReadTs(req *raft_cmdpb.RaftCmdRequest) uint64 {
+	if len(req.Requests) != 1 || req.Requests[0].CmdType != raft_cmdpb.CmdType_Snap {
+		return 0
+	}
+	return req.Requests[0].GetSnap().GetStaleReadTs()
+}
+
+// onStaleRead serves a snapshot of the local data if every transaction which commits at or before ts is applied.
+func (d *peerMsgHandler) onStaleRead(req *raft_cmdpb.RaftCmdRequest, ts uint64, cb *message.Callback) {
+	if ts > d.peer.ResolvedTs || d.peer.IsApplyingSnapshot() {
+		if ts > d.peer.staleReadTs {
+			d.peer.staleReadTs = ts
+		}
+		d.peer.staleReadTime = time.Now()
+		cb.Done(ErrResp(&util.ErrDataIsNotReady{RegionId: d.regionID(), PeerId: d.peerID(), SafeTs: d.peer.ResolvedTs}))
+		return
+	}
+	resp := newCmdRespForReq(req)
+	resp.Responses = []*raft_cmdpb.Response{{
+		CmdType: raft_cmdpb.CmdType_Snap,
+		Snap:    &raft_cmdpb.SnapResponse{Region: d.region()},
+	}}
+	cb.Snap = d.ctx.engine.Kv.NewSnapshot()
+	cb.Done(resp)
+}
+
 func (d *peerMsgHandler) findSiblingRegion() (result *metapb.Region) {
 	meta := d.ctx.storeMeta
 	item := &regionItem{region: d.region()}
@@ -671,8 +759,13 @@ func (d *peerMsgHandler) onRaftGCLogTick() {
 
 	appliedIdx := d.peer.Store().AppliedIndex()
 	firstIdx, _ := d.peer.Store().FirstIndex()
+	countLimit := d.ctx.config().RaftLogGcCountLimit
+	if d.ctx.importer.InImportMode() {
+		// Keep more logs so the followers which fall behind catch up without snapshots of the imported data.
+		countLimit *= importModeRaftLogGcFactor
+	}
 	var compactIdx uint64
-	if appliedIdx > firstIdx && appliedIdx-firstIdx >= d.ctx.cfg.RaftLogGcCountLimit {
+	if appliedIdx > firstIdx && appliedIdx-firstIdx >= countLimit {
 		compactIdx = appliedIdx
 	} else {
 		return
@@ -709,7 +802,11 @@ func (d *peerMsgHandler) onSplitRegionCheckTick() {
 	if !d.peer.IsLeader() {
 		return
 	}
-	if d.peer.ApproximateSize != nil && d.peer.SizeDiffHint < d.ctx.cfg.RegionSplitSize/8 {
+	// The regions are split by the importer before the data is imported, and checked after the import.
+	if d.ctx.importer.InImportMode() {
+		return
+	}
+	if d.peer.ApproximateSize != nil && d.peer.SizeDiffHint < d.ctx.config().RegionSplitSize/8 {
 		return
 	}
 	d.ctx.splitCheckTaskSender <- worker.Task{
@@ -788,7 +885,30 @@ func (d *peerMsgHandler) onPDHeartbeatTick() {
 	if !d.peer.IsLeader() {
 		return
 	}
-	d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+	d.peer.HeartbeatPd(d.ctx.pdTaskSender, d.ctx.config().MaxPeerDownDuration)
+}
+
+// onResolvedTsTick advances the resolved ts of the region while a stale read waits on the leader for it. The idle
+// regions do not propose the UpdateResolvedTs commands, a stale read refused by a follower should be retried on the
+// leader.
+func (d *peerMsgHandler) onResolvedTsTick() {
+	d.ticker.schedule(PeerTickResolvedTs)
+	if !d.peer.IsLeader() || d.peer.PendingRemove {
+		return
+	}
+	if d.peer.staleReadTs <= d.peer.ResolvedTs ||
+		time.Since(d.peer.staleReadTime) > staleReadWaitTicks*d.ctx.config().ResolvedTsInterval {
+		return
+	}
+	d.ctx.pdTaskSender <- worker.Task{
+		Tp: worker.TaskTypePDResolvedTs,
+		Data: &runner.PdResolvedTsTask{
+			Region: d.region(),
+			Peer:       d.peer.Meta,
+			Engine:     d.ctx.engine.Kv,
+			ResolvedTs: d.peer.ResolvedTs,
+		},
+	}
 }
 
 func newAdminRequest(regionID uint64, peer *metapb.Peer) *raft_cmdpb.RaftCmdRequest {
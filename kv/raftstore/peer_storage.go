@@ -6,9 +6,9 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
@@ -90,7 +90,7 @@ func recoverFromApplyingState(engines *engine_util.Engines, raftWB *engine_util.
 	}
 
 	raftState, err := meta.GetRaftLocalState(engines.Raft, regionID)
-	if err != nil && err != badger.ErrKeyNotFound {
+	if err != nil && err != engine_util.ErrKeyNotFound {
 		return errors.WithStack(err)
 	}
 
@@ -372,14 +372,14 @@ func (ps *PeerStorage) clearExtraData(newRegion *metapb.Region) {
 	}
 }
 
-func fetchEntriesTo(engine *badger.DB, regionID, low, high uint64, buf []eraftpb.Entry) ([]eraftpb.Entry, uint64, error) {
+func fetchEntriesTo(engine engine_util.Engine, regionID, low, high uint64, buf []eraftpb.Entry) ([]eraftpb.Entry, uint64, error) {
 	var totalSize uint64
 	nextIndex := low
-	txn := engine.NewTransaction(false)
+	txn := engine.NewSnapshot()
 	defer txn.Discard()
 	startKey := meta.RaftLogKey(regionID, low)
 	endKey := meta.RaftLogKey(regionID, high)
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	iter := txn.NewIterator()
 	defer iter.Close()
 	for iter.Seek(startKey); iter.Valid(); iter.Next() {
 		item := iter.Item()
@@ -419,22 +419,20 @@ func ClearMeta(engines *engine_util.Engines, kvWB, raftWB *engine_util.WriteBatc
 	firstIndex := lastIndex + 1
 	beginLogKey := meta.RaftLogKey(regionID, 0)
 	endLogKey := meta.RaftLogKey(regionID, firstIndex)
-	err := engines.Raft.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		it.Seek(beginLogKey)
-		if it.Valid() && bytes.Compare(it.Item().Key(), endLogKey) < 0 {
-			logIdx, err1 := meta.RaftLogIndex(it.Item().Key())
-			if err1 != nil {
-				return err1
-			}
-			firstIndex = logIdx
+	snap := engines.Raft.NewSnapshot()
+	it := snap.NewIterator()
+	it.Seek(beginLogKey)
+	if it.Valid() && bytes.Compare(it.Item().Key(), endLogKey) < 0 {
+		logIdx, err := meta.RaftLogIndex(it.Item().Key())
+		if err != nil {
+			it.Close()
+			snap.Discard()
+			return err
 		}
-		return nil
-	})
-	if err != nil {
-		return err
+		firstIndex = logIdx
 	}
+	it.Close()
+	snap.Discard()
 	for i := firstIndex; i <= lastIndex; i++ {
 		raftWB.Delete(meta.RaftLogKey(regionID, i))
 	}
@@ -476,6 +474,9 @@ func (ps *PeerStorage) ApplySnapshot(ctx *InvokeContext, snap *eraftpb.Snapshot,
 			return err
 		}
 	}
+	if err := failpoint.Eval("raftstore/apply-snapshot-after-clear-meta"); err != nil {
+		return err
+	}
 
 	WritePeerState(kvWB, snapData.Region, rspb.PeerState_Applying)
 
@@ -543,6 +544,10 @@ func (ps *PeerStorage) SaveReadyState(ready *raft.Ready) (*ApplySnapResult, erro
 
 	kvWB.MustWriteToDB(ps.Engines.Kv)
 	raftWB.MustWriteToDB(ps.Engines.Raft)
+	// The appended entries are persisted, but the apply state isn't advanced by the applier yet.
+	if err := failpoint.Eval("raftstore/after-write-raft-state"); err != nil {
+		return nil, err
+	}
 
 	ps.raftState = ctx.RaftState
 	ps.lastTerm = ctx.lastTerm
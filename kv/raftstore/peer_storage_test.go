@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"testing"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
@@ -93,49 +92,31 @@ func appendEnts(t *testing.T, peerStore *PeerStorage, ents []eraftpb.Entry) {
 	peerStore.raftState = ctx.RaftState
 }
 
+func countKeys(engine engine_util.Engine, start, end []byte) int {
+	count := 0
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
+	it := snap.NewIterator()
+	defer it.Close()
+	for it.Seek(start); it.Valid(); it.Next() {
+		if bytes.Compare(it.Item().Key(), end) >= 0 {
+			break
+		}
+		count++
+	}
+	return count
+}
+
 func getMetaKeyCount(t *testing.T, peerStore *PeerStorage) int {
 	regionID := peerStore.region.Id
 	count := 0
 	metaStart := meta.RegionMetaPrefixKey(regionID)
 	metaEnd := meta.RegionMetaPrefixKey(regionID + 1)
-	err := peerStore.Engines.Kv.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		for it.Seek(metaStart); it.Valid(); it.Next() {
-			if bytes.Compare(it.Item().Key(), metaEnd) >= 0 {
-				break
-			}
-			count++
-		}
-		return nil
-	})
-	require.Nil(t, err)
+	count += countKeys(peerStore.Engines.Kv, metaStart, metaEnd)
 	raftStart := meta.RegionRaftPrefixKey(regionID)
 	raftEnd := meta.RegionRaftPrefixKey(regionID + 1)
-	err = peerStore.Engines.Kv.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		for it.Seek(metaStart); it.Valid(); it.Next() {
-			if bytes.Compare(it.Item().Key(), metaEnd) >= 0 {
-				break
-			}
-			count++
-		}
-		return nil
-	})
-	require.Nil(t, err)
-	err = peerStore.Engines.Raft.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		for it.Seek(raftStart); it.Valid(); it.Next() {
-			if bytes.Compare(it.Item().Key(), raftEnd) >= 0 {
-				break
-			}
-			count++
-		}
-		return nil
-	})
-	require.Nil(t, err)
+	count += countKeys(peerStore.Engines.Kv, metaStart, metaEnd)
+	count += countKeys(peerStore.Engines.Raft, raftStart, raftEnd)
 	return count
 }
 
@@ -87,7 +87,7 @@ func newApplyWorker(ctx *GlobalContext, ch chan []message.Msg, pr *router) *appl
 		applyCh: ch,
 		ctx:     ctx,
 		// TODO: Delete this
-		applyCtx: newApplyContext("", ctx.engine, pr.peerSender, ctx.cfg),
+		applyCtx: newApplyContext("", ctx.engine, pr.peerSender, ctx.config(), ctx.observer, ctx.importer),
 	}
 }
 
@@ -126,10 +126,10 @@ func TestHandleRaftCommittedEntries(t *testing.T) {
 	raftRouter, _ := CreateRaftBatchSystem(cfg)
 	router := raftRouter.router
 	ctx := &GlobalContext{
-		cfg:    cfg,
 		engine: engines,
 		router: router,
 	}
+	ctx.cfg.Store(cfg)
 	applyCh := make(chan []message.Msg, 1)
 	aw := newApplyWorker(ctx, applyCh, router)
 	wg := new(sync.WaitGroup)
@@ -212,7 +212,7 @@ func TestHandleRaftCommittedEntries(t *testing.T) {
 	resp = cb.WaitResp()
 	require.True(t, resp.GetHeader().GetError() == nil)
 	require.Equal(t, len(resp.GetResponses()), 1)
-	val, err := engine_util.GetCFFromTxn(cb.Txn, engine_util.CfLock, []byte("k1"))
+	val, err := engine_util.GetCFFromSnapshot(cb.Snap, engine_util.CfLock, []byte("k1"))
 	require.Nil(t, err)
 	require.True(t, bytes.Equal(val, []byte("v11")))
 	applyRes = fetchApplyRes(router.peerSender)
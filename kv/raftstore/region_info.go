@@ -0,0 +1,108 @@
+package raftstore
+
+import (
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/errors"
+)
+
+// RegionInfo is a copy of the state of a peer, used to inspect a running store.
+type RegionInfo struct {
+	Region         *metapb.Region       `json:"region"`
+	PeerID         uint64               `json:"peer_id"`
+	Role           string               `json:"role"`
+	LeaderID       uint64               `json:"leader_id"`
+	RaftLocalState *rspb.RaftLocalState `json:"raft_local_state"`
+	RaftApplyState *rspb.RaftApplyState `json:"raft_apply_state"`
+	// SnapshotState is the state of the snapshot being generated or applied by the peer storage.
+	SnapshotState string `json:"snapshot_state"`
+	// PendingSnapshots are the snapshots of the region known to the snapshot manager, mapped to what is being done
+	// with them, e.g. sending or receiving.
+	PendingSnapshots map[string][]string `json:"pending_snapshots"`
+	// Error is set by the listings of the regions instead of the state if the peer can't be asked for it, e.g. the
+	// peer is too busy to answer in time.
+	Error string `json:"error,omitempty"`
+}
+
+func (d *peerMsgHandler) regionInfo() *RegionInfo {
+	store := d.peer.Store()
+	info := &RegionInfo{
+		Region:           proto.Clone(d.region()).(*metapb.Region),
+		PeerID:           d.peerID(),
+		Role:             d.RaftGroup.Raft.State.String(),
+		LeaderID:         d.RaftGroup.Raft.Lead,
+		RaftLocalState:   proto.Clone(&store.raftState).(*rspb.RaftLocalState),
+		RaftApplyState:   store.applyState(),
+		SnapshotState:    store.snapState.StateType.String(),
+		PendingSnapshots: make(map[string][]string),
+	}
+	for key, entries := range d.ctx.snapMgr.RegionEntries(d.regionID()) {
+		for _, entry := range entries {
+			info.PendingSnapshots[key.String()] = append(info.PendingSnapshots[key.String()], entry.String())
+		}
+	}
+	return info
+}
+
+// regionInfoTimeout is how long RegionInfo waits for a busy peer.
+const regionInfoTimeout = 3 * time.Second
+
+// ErrRegionInfoTimeout is returned by RegionInfo if the peer doesn't answer in regionInfoTimeout.
+var ErrRegionInfoTimeout = errors.New("get region info timeout")
+
+// RegionIDs returns the sorted IDs of the regions which have a peer on this store.
+func (r *RaftstoreRouter) RegionIDs() []uint64 {
+	var regionIDs []uint64
+	r.router.peers.Range(func(key, value interface{}) bool {
+		regionIDs = append(regionIDs, key.(uint64))
+		return true
+	})
+	sort.Slice(regionIDs, func(i, j int) bool { return regionIDs[i] < regionIDs[j] })
+	return regionIDs
+}
+
+// RegionInfo asks the peer of the region for its state. It returns nil without an error if there is no such peer
+// on this store.
+func (r *RaftstoreRouter) RegionInfo(regionID uint64) (*RegionInfo, error) {
+	ch := make(chan *RegionInfo, 1)
+	msg := message.NewPeerMsg(message.MsgTypeRegionInfo, regionID, (chan<- *RegionInfo)(ch))
+	if err := r.router.send(regionID, msg); err != nil {
+		if err == errPeerNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	select {
+	case info := <-ch:
+		return info, nil
+	case <-time.After(regionInfoTimeout):
+		return nil, errors.Annotatef(ErrRegionInfoTimeout, "region %d", regionID)
+	}
+}
+
+// LeaderRegions returns the state of the peers on this store which are the leaders of their regions. The peers which
+// don't answer in time are skipped, as if they were not leaders.
+func (r *RaftstoreRouter) LeaderRegions() ([]*RegionInfo, error) {
+	var infos []*RegionInfo
+	for _, regionID := range r.RegionIDs() {
+		info, err := r.RegionInfo(regionID)
+		if errors.Cause(err) == ErrRegionInfoTimeout {
+			log.Warnf("skip the busy peer of region %d: %v", regionID, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info != nil && info.Role == raft.StateLeader.String() {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
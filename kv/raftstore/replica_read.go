@@ -0,0 +1,170 @@
+package raftstore
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+// replicaRead is a read served by a follower. The follower asks the leader for the read index, which the leader
+// commits through Raft, and serves the read from its local data once it applies the read index.
+type replicaRead struct {
+	id  uint64
+	req *raft_cmdpb.RaftCmdRequest
+	cb  *message.Callback
+	// readIndex is 0 until the leader replies.
+	readIndex  uint64
+	proposedAt time.Time
+}
+
+// isReplicaRead returns true if req is a replica read.
+func isReplicaRead(req *raft_cmdpb.RaftCmdRequest) bool {
+	if len(req.Requests) != 1 || req.Requests[0].CmdType != raft_cmdpb.CmdType_Snap {
+		return false
+	}
+	return req.Requests[0].GetSnap().GetReplicaRead()
+}
+
+// isReadIndexMsg returns true if msg carries a read index request or response instead of a raft message.
+func isReadIndexMsg(msg *rspb.RaftMessage) bool {
+	return msg.GetReadIndexRequest() != nil || msg.GetReadIndexResponse() != nil
+}
+
+// onReplicaRead asks the leader for the read index of a replica read on the follower.
+func (d *peerMsgHandler) onReplicaRead(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	leader := d.peer.getPeerFromCache(d.peer.LeaderId())
+	if leader == nil {
+		cb.Done(ErrResp(&util.ErrNotLeader{RegionId: d.regionID()}))
+		return
+	}
+	d.peer.lastReplicaReadID++
+	read := &replicaRead{
+		id:         d.peer.lastReplicaReadID,
+		req:        req,
+		cb:         cb,
+		proposedAt: time.Now(),
+	}
+	d.peer.replicaReads = append(d.peer.replicaReads, read)
+	d.sendReadIndexMsg(d.newReadIndexMsg(leader, &rspb.RaftMessage{
+		ReadIndexRequest: &rspb.ReadIndexRequest{Id: read.id},
+	}))
+}
+
+// onReadIndexMsg handles a read index request on the leader, or a read index response on the follower.
+func (d *peerMsgHandler) onReadIndexMsg(msg *rspb.RaftMessage) {
+	if req := msg.GetReadIndexRequest(); req != nil {
+		d.onReadIndexRequest(msg.GetFromPeer(), req.GetId())
+		return
+	}
+	resp := msg.GetReadIndexResponse()
+	for i, read := range d.peer.replicaReads {
+		if read.id != resp.GetId() {
+			continue
+		}
+		if resp.GetIndex() == 0 {
+			// The leader has changed, the client retries on the new leader or another follower.
+			d.peer.replicaReads = append(d.peer.replicaReads[:i], d.peer.replicaReads[i+1:]...)
+			read.cb.Done(ErrResp(&util.ErrNotLeader{RegionId: d.regionID()}))
+			return
+		}
+		read.readIndex = resp.GetIndex()
+		d.serveReplicaReads()
+		return
+	}
+}
+
+// onReadIndexRequest proposes a read through Raft for the follower, and replies the index of the read once it is
+// applied. The read commits only if the peer is still the leader, so every write finished before the request has a
+// smaller index.
+func (d *peerMsgHandler) onReadIndexRequest(from *metapb.Peer, id uint64) {
+	resp := d.newReadIndexMsg(from, &rspb.RaftMessage{
+		ReadIndexResponse: &rspb.ReadIndexResponse{Id: id},
+	})
+	if !d.peer.IsLeader() || d.peer.PendingRemove {
+		d.sendReadIndexMsg(resp)
+		return
+	}
+	req := newAdminRequest(d.regionID(), d.peer.Meta)
+	req.Header.RegionEpoch = d.region().GetRegionEpoch()
+	req.Requests = []*raft_cmdpb.Request{{CmdType: raft_cmdpb.CmdType_Snap, Snap: &raft_cmdpb.SnapRequest{}}}
+	index, err := d.peer.ProposeNormal(d.ctx.config(), req)
+	if err != nil {
+		d.sendReadIndexMsg(resp)
+		return
+	}
+	cb := message.NewCallback()
+	d.peer.PostPropose(index, d.peer.Term(), false, cb)
+	go func() {
+		if cb.WaitResp().GetHeader().GetError() == nil {
+			resp.ReadIndexResponse.Index = index
+		}
+		if cb.Snap != nil {
+			cb.Snap.Discard()
+		}
+		d.sendReadIndexMsg(resp)
+	}()
+}
+
+// serveReplicaReads serves the replica reads whose read indexes are applied from the local data.
+func (d *peerMsgHandler) serveReplicaReads() {
+	if len(d.peer.replicaReads) == 0 || d.peer.IsApplyingSnapshot() {
+		return
+	}
+	appliedIndex := d.peer.Store().AppliedIndex()
+	reads := d.peer.replicaReads[:0]
+	for _, read := range d.peer.replicaReads {
+		if read.readIndex == 0 || read.readIndex > appliedIndex {
+			reads = append(reads, read)
+			continue
+		}
+		// The region may have changed while the read waits.
+		if err := util.CheckRegionEpoch(read.req, d.region(), true); err != nil {
+			read.cb.Done(ErrResp(err))
+			continue
+		}
+		resp := newCmdRespForReq(read.req)
+		resp.Responses = []*raft_cmdpb.Response{{
+			CmdType: raft_cmdpb.CmdType_Snap,
+			Snap:    &raft_cmdpb.SnapResponse{Region: d.region()},
+		}}
+		read.cb.Snap = d.ctx.engine.Kv.NewSnapshot()
+		read.cb.Done(resp)
+	}
+	d.peer.replicaReads = reads
+}
+
+// expireReplicaReads fails the replica reads which wait longer than an election timeout, the read index messages may
+// be lost or the follower may be cut off from the leader. The client retries them.
+func (d *peerMsgHandler) expireReplicaReads() {
+	cfg := d.ctx.config()
+	timeout := time.Duration(cfg.RaftElectionTimeoutTicks) * cfg.RaftBaseTickInterval
+	reads := d.peer.replicaReads[:0]
+	for _, read := range d.peer.replicaReads {
+		if time.Since(read.proposedAt) > timeout {
+			read.cb.Done(ErrRespStaleCommand(d.peer.Term()))
+			continue
+		}
+		reads = append(reads, read)
+	}
+	d.peer.replicaReads = reads
+}
+
+func (d *peerMsgHandler) newReadIndexMsg(to *metapb.Peer, msg *rspb.RaftMessage) *rspb.RaftMessage {
+	msg.RegionId = d.regionID()
+	msg.FromPeer = d.peer.Meta
+	msg.ToPeer = to
+	msg.RegionEpoch = d.region().GetRegionEpoch()
+	return msg
+}
+
+// sendReadIndexMsg sends msg through the transport, which is safe to call out of the peer.
+func (d *peerMsgHandler) sendReadIndexMsg(msg *rspb.RaftMessage) {
+	if err := d.ctx.trans.Send(msg); err != nil {
+		log.Warnf("%s send read index message to %s failed: %v", d.tag(), msg.GetToPeer(), err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 
@@ -105,3 +106,14 @@ func (r *RaftstoreRouter) SendRaftCommand(req *raft_cmdpb.RaftCmdRequest, cb *me
 	regionID := req.Header.RegionId
 	return r.router.send(regionID, message.NewPeerMsg(message.MsgTypeRaftCmd, regionID, cmd))
 }
+
+// SplitRegion asks the peer of the region to split the region at the split keys. The callback is done with the
+// response of the split after it is applied, or with an error if the peer rejects it.
+func (r *RaftstoreRouter) SplitRegion(regionID uint64, epoch *metapb.RegionEpoch, splitKeys [][]byte, cb *message.Callback) error {
+	split := &message.MsgSplitRegion{
+		RegionEpoch: epoch,
+		SplitKeys:   splitKeys,
+		Callback:    cb,
+	}
+	return r.router.send(regionID, message.NewPeerMsg(message.MsgTypeSplitRegion, regionID, split))
+}
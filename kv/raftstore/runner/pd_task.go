@@ -2,15 +2,18 @@ package runner
 
 import (
 	"context"
+	"math"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/worker"
 	"github.com/pingcap-incubator/tinykv/log"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
 	"github.com/shirou/gopsutil/disk"
 )
 
@@ -24,16 +27,26 @@ type PdAskBatchSplitTask struct {
 type PdRegionHeartbeatTask struct {
 	Region          *metapb.Region
 	Peer            *metapb.Peer
+	DownPeers       []*pdpb.PeerStats
 	PendingPeers    []*metapb.Peer
 	ApproximateSize *uint64
 }
 
 type PdStoreHeartbeatTask struct {
 	Stats  *pdpb.StoreStats
-	Engine *badger.DB
+	Engine engine_util.Engine
 	Path   string
 }
 
+// PdResolvedTsTask advances the resolved ts of a region, Engine is the kv engine of the leader and ResolvedTs is the
+// current resolved ts of the leader.
+type PdResolvedTsTask struct {
+	Region     *metapb.Region
+	Peer       *metapb.Peer
+	Engine     engine_util.Engine
+	ResolvedTs uint64
+}
+
 type pdTaskHandler struct {
 	storeID  uint64
 	pdClient pd.Client
@@ -56,6 +69,8 @@ func (r *pdTaskHandler) Handle(t worker.Task) {
 		r.onHeartbeat(t.Data.(*PdRegionHeartbeatTask))
 	case worker.TaskTypePDStoreHeartbeat:
 		r.onStoreHeartbeat(t.Data.(*PdStoreHeartbeatTask))
+	case worker.TaskTypePDResolvedTs:
+		r.onResolvedTs(t.Data.(*PdResolvedTsTask))
 	default:
 		log.Error("unsupported worker.Task type:", t.Tp)
 	}
@@ -116,6 +131,7 @@ func (r *pdTaskHandler) onHeartbeat(t *PdRegionHeartbeatTask) {
 	req := &pdpb.RegionHeartbeatRequest{
 		Region:          t.Region,
 		Leader:          t.Peer,
+		DownPeers:       t.DownPeers,
 		PendingPeers:    t.PendingPeers,
 		ApproximateSize: uint64(size),
 	}
@@ -130,8 +146,7 @@ func (r *pdTaskHandler) onStoreHeartbeat(t *PdStoreHeartbeatTask) {
 	}
 
 	capacity := diskStat.Total
-	lsmSize, vlogSize := t.Engine.Size()
-	usedSize := t.Stats.UsedSize + uint64(lsmSize) + uint64(vlogSize) // t.Stats.UsedSize contains size of snapshot files.
+	usedSize := t.Stats.UsedSize + t.Engine.Size() // t.Stats.UsedSize contains size of snapshot files.
 	available := uint64(0)
 	if capacity > usedSize {
 		available = capacity - usedSize
@@ -144,6 +159,58 @@ func (r *pdTaskHandler) onStoreHeartbeat(t *PdStoreHeartbeatTask) {
 	r.pdClient.StoreHeartbeat(context.TODO(), t.Stats)
 }
 
+// onResolvedTs proposes the min of a ts allocated now and the start ts of the locks in the region as the resolved ts.
+// The ts is allocated before the locks are read, so a transaction whose lock is not read yet commits at a greater ts.
+// Nothing is proposed if a lock holds the resolved ts back at the current one.
+func (r *pdTaskHandler) onResolvedTs(t *PdResolvedTsTask) {
+	physical, logical, err := r.pdClient.GetTS(context.TODO())
+	if err != nil {
+		log.Warnf("region %d: get ts failed: %v", t.Region.GetId(), err)
+		return
+	}
+	resolvedTs := uint64(physical)<<tsoutil.PhysicalShiftBits + uint64(logical)
+	if lockTs, err := minLockTs(t.Engine, t.Region); err != nil {
+		log.Warnf("region %d: read locks failed: %v", t.Region.GetId(), err)
+		return
+	} else if lockTs < resolvedTs {
+		resolvedTs = lockTs
+	}
+	if resolvedTs <= t.ResolvedTs {
+		return
+	}
+	r.sendAdminRequest(t.Region.GetId(), t.Region.GetRegionEpoch(), t.Peer, &raft_cmdpb.AdminRequest{
+		CmdType:          raft_cmdpb.AdminCmdType_UpdateResolvedTs,
+		UpdateResolvedTs: &raft_cmdpb.UpdateResolvedTsRequest{ResolvedTs: resolvedTs},
+	}, message.NewCallback())
+}
+
+// minLockTs returns the min start ts of the locks in the region, math.MaxUint64 if there is none.
+func minLockTs(engine engine_util.Engine, region *metapb.Region) (uint64, error) {
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
+	it := engine_util.NewCFIterator(engine_util.CfLock, snap)
+	defer it.Close()
+	minTs := uint64(math.MaxUint64)
+	for it.Seek(region.GetStartKey()); it.Valid(); it.Next() {
+		item := it.Item()
+		if engine_util.ExceedEndKey(item.Key(), region.GetEndKey()) {
+			break
+		}
+		value, err := item.Value()
+		if err != nil {
+			return 0, err
+		}
+		lock, err := mvcc.ParseLock(value)
+		if err != nil {
+			return 0, err
+		}
+		if lock.Ts < minTs {
+			minTs = lock.Ts
+		}
+	}
+	return minTs, nil
+}
+
 func (r *pdTaskHandler) sendAdminRequest(regionID uint64, epoch *metapb.RegionEpoch, peer *metapb.Peer, req *raft_cmdpb.AdminRequest, callback *message.Callback) {
 	cmd := &raft_cmdpb.RaftCmdRequest{
 		Header: &raft_cmdpb.RaftRequestHeader{
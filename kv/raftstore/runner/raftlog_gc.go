@@ -1,7 +1,6 @@
 package runner
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/worker"
@@ -9,7 +8,7 @@ import (
 )
 
 type RaftLogGCTask struct {
-	RaftEngine *badger.DB
+	RaftEngine engine_util.Engine
 	RegionID   uint64
 	StartIdx   uint64
 	EndIdx     uint64
@@ -26,26 +25,24 @@ func NewRaftLogGCTaskHandler() *raftLogGCTaskHandler {
 }
 
 // gcRaftLog does the GC job and returns the count of logs collected.
-func (r *raftLogGCTaskHandler) gcRaftLog(raftDb *badger.DB, regionId, startIdx, endIdx uint64) (uint64, error) {
+func (r *raftLogGCTaskHandler) gcRaftLog(raftDb engine_util.Engine, regionId, startIdx, endIdx uint64) (uint64, error) {
 	// Find the raft log idx range needed to be gc.
 	firstIdx := startIdx
 	if firstIdx == 0 {
 		firstIdx = endIdx
-		err := raftDb.View(func(txn *badger.Txn) error {
-			startKey := meta.RaftLogKey(regionId, 0)
-			ite := txn.NewIterator(badger.DefaultIteratorOptions)
-			defer ite.Close()
-			if ite.Seek(startKey); ite.Valid() {
-				var err error
-				if firstIdx, err = meta.RaftLogIndex(ite.Item().Key()); err != nil {
-					return err
-				}
+		snap := raftDb.NewSnapshot()
+		startKey := meta.RaftLogKey(regionId, 0)
+		ite := snap.NewIterator()
+		if ite.Seek(startKey); ite.Valid() {
+			var err error
+			if firstIdx, err = meta.RaftLogIndex(ite.Item().Key()); err != nil {
+				ite.Close()
+				snap.Discard()
+				return 0, err
 			}
-			return nil
-		})
-		if err != nil {
-			return 0, err
 		}
+		ite.Close()
+		snap.Discard()
 	}
 
 	if firstIdx >= endIdx {
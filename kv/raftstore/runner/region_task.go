@@ -6,9 +6,9 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/juju/errors"
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
@@ -108,6 +108,9 @@ func (snapCtx *snapContext) applySnap(regionId uint64, status *snap.JobStatus) e
 	if err := snapCtx.cleanUpOriginData(regionState, status); err != nil {
 		return err
 	}
+	if err := failpoint.Eval("region-worker/apply-snapshot-after-clean-up"); err != nil {
+		return err
+	}
 
 	applyState, err := meta.GetApplyState(snapCtx.engines.Kv, regionId)
 	if err != nil {
@@ -127,6 +130,10 @@ func (snapCtx *snapContext) applySnap(regionId uint64, status *snap.JobStatus) e
 	if err := snapshot.Apply(*applyOptions); err != nil {
 		return err
 	}
+	// The data is ingested, but the peer is still in the applying state.
+	if err := failpoint.Eval("region-worker/apply-snapshot-before-normal-state"); err != nil {
+		return err
+	}
 
 	regionState.State = rspb.PeerState_Normal
 	wb := new(engine_util.WriteBatch)
@@ -167,9 +174,9 @@ func (snapCtx *snapContext) cleanUpRange(regionId uint64, startKey, endKey []byt
 	}
 }
 
-func getAppliedIdxTermForSnapshot(raft *badger.DB, kv *badger.Txn, regionId uint64) (uint64, uint64, error) {
+func getAppliedIdxTermForSnapshot(raft engine_util.Engine, kv engine_util.Snapshot, regionId uint64) (uint64, uint64, error) {
 	applyState := new(rspb.RaftApplyState)
-	val, err := engine_util.GetValueTxn(kv, meta.ApplyStateKey(regionId))
+	val, err := engine_util.GetValueFromSnapshot(kv, meta.ApplyStateKey(regionId))
 	if err != nil {
 		return 0, 0, err
 	}
@@ -192,8 +199,11 @@ func getAppliedIdxTermForSnapshot(raft *badger.DB, kv *badger.Txn, regionId uint
 
 func doSnapshot(engines *engine_util.Engines, mgr *snap.SnapManager, regionId uint64) (*eraftpb.Snapshot, error) {
 	log.Debugf("begin to generate a snapshot. [regionId: %d]", regionId)
+	if err := failpoint.Eval("region-worker/gen-snapshot"); err != nil {
+		return nil, err
+	}
 
-	txn := engines.Kv.NewTransaction(false)
+	txn := engines.Kv.NewSnapshot()
 
 	index, term, err := getAppliedIdxTermForSnapshot(engines.Raft, txn, regionId)
 	if err != nil {
@@ -205,7 +215,7 @@ func doSnapshot(engines *engine_util.Engines, mgr *snap.SnapManager, regionId ui
 	defer mgr.Deregister(key, snap.SnapEntryGenerating)
 
 	regionState := new(rspb.RegionLocalState)
-	val, err := engine_util.GetValueTxn(txn, meta.RegionStateKey(regionId))
+	val, err := engine_util.GetValueFromSnapshot(txn, meta.RegionStateKey(regionId))
 	if err != nil {
 		return nil, err
 	}
@@ -39,22 +39,18 @@ func copySnapshot(to, from snap.Snapshot) error {
 	return nil
 }
 
-func newEnginesWithKVDb(t *testing.T, kv *badger.DB) *engine_util.Engines {
+func newEnginesWithKVDb(t *testing.T, kv engine_util.Engine) *engine_util.Engines {
 	engines := new(engine_util.Engines)
 	engines.Kv = kv
 	var err error
 	engines.RaftPath, err = ioutil.TempDir("", "tinykv_raft")
 	require.Nil(t, err)
-	raftOpts := badger.DefaultOptions
-	raftOpts.Dir = engines.RaftPath
-	raftOpts.ValueDir = engines.RaftPath
-	raftOpts.ValueThreshold = 256
-	engines.Raft, err = badger.Open(raftOpts)
+	engines.Raft, err = engine_util.OpenBadgerEngine(engines.RaftPath, 256)
 	require.Nil(t, err)
 	return engines
 }
 
-func getTestDBForRegions(t *testing.T, path string, regions []uint64) *badger.DB {
+func getTestDBForRegions(t *testing.T, path string, regions []uint64) engine_util.Engine {
 	db := openDB(t, path)
 	fillDBData(t, db)
 	for _, regionID := range regions {
@@ -91,16 +87,13 @@ func genTestRegion(regionID, storeID, peerID uint64) *metapb.Region {
 	}
 }
 
-func openDB(t *testing.T, dir string) *badger.DB {
-	opts := badger.DefaultOptions
-	opts.Dir = dir
-	opts.ValueDir = dir
-	db, err := badger.Open(opts)
+func openDB(t *testing.T, dir string) engine_util.Engine {
+	db, err := engine_util.OpenBadgerEngine(dir, badger.DefaultOptions.ValueThreshold)
 	require.Nil(t, err)
 	return db
 }
 
-func fillDBData(t *testing.T, db *badger.DB) {
+func fillDBData(t *testing.T, db engine_util.Engine) {
 	// write some data for multiple cfs.
 	wb := new(engine_util.WriteBatch)
 	value := make([]byte, 32)
@@ -117,11 +110,10 @@ func TestApplies(t *testing.T) {
 	db := getTestDBForRegions(t, kvPath, []uint64{1, 2, 3, 4, 5, 6})
 	keys := []byte{1, 2, 3, 4, 5, 6}
 	for _, k := range keys {
-		require.Nil(t, db.Update(func(txn *badger.Txn) error {
-			require.Nil(t, txn.Set([]byte{k}, []byte{k}))
-			require.Nil(t, txn.Set([]byte{k + 1}, []byte{k + 1}))
-			return nil
-		}))
+		wb := new(engine_util.WriteBatch)
+		wb.Set([]byte{k}, []byte{k})
+		wb.Set([]byte{k + 1}, []byte{k + 1})
+		require.Nil(t, wb.WriteToDB(db))
 	}
 
 	engines := newEnginesWithKVDb(t, db)
@@ -291,26 +283,20 @@ func TestGcRaftLog(t *testing.T) {
 	}
 }
 
-func raftLogMustNotExist(t *testing.T, db *badger.DB, regionId, startIdx, endIdx uint64) {
+func raftLogMustNotExist(t *testing.T, db engine_util.Engine, regionId, startIdx, endIdx uint64) {
 	for i := startIdx; i < endIdx; i++ {
 		k := meta.RaftLogKey(regionId, i)
-		db.View(func(txn *badger.Txn) error {
-			_, err := txn.Get(k)
-			assert.Equal(t, err, badger.ErrKeyNotFound)
-			return nil
-		})
+		_, err := engine_util.GetValue(db, k)
+		assert.Equal(t, err, engine_util.ErrKeyNotFound)
 	}
 }
 
-func raftLogMustExist(t *testing.T, db *badger.DB, regionId, startIdx, endIdx uint64) {
+func raftLogMustExist(t *testing.T, db engine_util.Engine, regionId, startIdx, endIdx uint64) {
 	for i := startIdx; i < endIdx; i++ {
 		k := meta.RaftLogKey(regionId, i)
-		db.View(func(txn *badger.Txn) error {
-			item, err := txn.Get(k)
-			assert.Nil(t, err)
-			assert.NotNil(t, item)
-			return nil
-		})
+		val, err := engine_util.GetValue(db, k)
+		assert.Nil(t, err)
+		assert.NotNil(t, val)
 	}
 }
 
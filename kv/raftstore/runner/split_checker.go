@@ -3,7 +3,6 @@ package runner
 import (
 	"encoding/hex"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
@@ -19,12 +18,12 @@ type SplitCheckTask struct {
 }
 
 type splitCheckHandler struct {
-	engine  *badger.DB
+	engine  engine_util.Engine
 	router  message.RaftRouter
 	checker *sizeSplitChecker
 }
 
-func NewSplitCheckHandler(engine *badger.DB, router message.RaftRouter, conf *config.Config) *splitCheckHandler {
+func NewSplitCheckHandler(engine engine_util.Engine, router message.RaftRouter, conf *config.Config) *splitCheckHandler {
 	runner := &splitCheckHandler{
 		engine:  engine,
 		router:  router,
@@ -35,6 +34,11 @@ func NewSplitCheckHandler(engine *badger.DB, router message.RaftRouter, conf *co
 
 /// run checks a region with split checkers to produce split keys and generates split admin command.
 func (r *splitCheckHandler) Handle(t worker.Task) {
+	if t.Tp == worker.TaskTypeConfigChange {
+		conf := t.Data.(*config.Config)
+		r.checker = newSizeSplitChecker(conf.RegionMaxSize, conf.RegionSplitSize)
+		return
+	}
 	spCheckTask := t.Data.(*SplitCheckTask)
 	region := spCheckTask.Region
 	regionId := region.Id
@@ -67,7 +71,7 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 
 /// SplitCheck gets the split keys by scanning the range.
 func (r *splitCheckHandler) splitCheck(regionID uint64, startKey, endKey []byte) []byte {
-	txn := r.engine.NewTransaction(false)
+	txn := r.engine.NewSnapshot()
 	defer txn.Discard()
 
 	r.checker.reset()
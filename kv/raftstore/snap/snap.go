@@ -43,6 +43,20 @@ const (
 	SnapState_ApplyAborted
 )
 
+func (t SnapStateType) String() string {
+	switch t {
+	case SnapState_Relax:
+		return "relax"
+	case SnapState_Generating:
+		return "generating"
+	case SnapState_Applying:
+		return "applying"
+	case SnapState_ApplyAborted:
+		return "apply_aborted"
+	}
+	return "unknown"
+}
+
 type SnapState struct {
 	StateType SnapStateType
 	Status    *JobStatus
@@ -108,12 +122,12 @@ type SnapStatistics struct {
 }
 
 type ApplyOptions struct {
-	DB     *badger.DB
+	DB     engine_util.Engine
 	Region *metapb.Region
 	Abort  *uint32
 }
 
-func NewApplyOptions(db *badger.DB, region *metapb.Region, abort *uint32) *ApplyOptions {
+func NewApplyOptions(db engine_util.Engine, region *metapb.Region, abort *uint32) *ApplyOptions {
 	return &ApplyOptions{
 		DB:     db,
 		Region: region,
@@ -131,7 +145,7 @@ func NewApplyOptions(db *badger.DB, region *metapb.Region, abort *uint32) *Apply
 type Snapshot interface {
 	io.Reader
 	io.Writer
-	Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error
+	Build(dbSnap engine_util.Snapshot, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error
 	Path() string
 	Exists() bool
 	Delete()
@@ -535,7 +549,7 @@ func (s *Snap) saveMetaFile() error {
 	return nil
 }
 
-func (s *Snap) Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error {
+func (s *Snap) Build(dbSnap engine_util.Snapshot, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error {
 	if s.Exists() {
 		err := s.validate()
 		if err == nil {
@@ -1,7 +1,6 @@
 package snap
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
@@ -10,13 +9,13 @@ import (
 // snapBuilder builds snapshot files.
 type snapBuilder struct {
 	region  *metapb.Region
-	txn     *badger.Txn
+	txn     engine_util.Snapshot
 	cfFiles []*CFFile
 	kvCount int
 	size    int
 }
 
-func newSnapBuilder(cfFiles []*CFFile, dbSnap *badger.Txn, region *metapb.Region) *snapBuilder {
+func newSnapBuilder(cfFiles []*CFFile, dbSnap engine_util.Snapshot, region *metapb.Region) *snapBuilder {
 	return &snapBuilder{
 		region:  region,
 		cfFiles: cfFiles,
@@ -279,6 +279,19 @@ func (sm *SnapManager) Deregister(key SnapKey, entry SnapEntry) {
 	log.Warnf("stale deregister key:%s, entry:%s", key, entry)
 }
 
+// RegionEntries returns the registered snapshots of the region and what is being done with them.
+func (sm *SnapManager) RegionEntries(regionID uint64) map[SnapKey][]SnapEntry {
+	sm.registryLock.RLock()
+	defer sm.registryLock.RUnlock()
+	result := make(map[SnapKey][]SnapEntry)
+	for key, entries := range sm.registry {
+		if key.RegionID == regionID {
+			result[key] = append([]SnapEntry(nil), entries...)
+		}
+	}
+	return result
+}
+
 func (sm *SnapManager) Stats() SnapStats {
 	sm.registryLock.RLock()
 	defer sm.registryLock.RUnlock()
@@ -37,16 +37,13 @@ func (d *dummyDeleter) DeleteSnapshot(key SnapKey, snapshot Snapshot, checkEntry
 	return true
 }
 
-func openDB(t *testing.T, dir string) *badger.DB {
-	opts := badger.DefaultOptions
-	opts.Dir = dir
-	opts.ValueDir = dir
-	db, err := badger.Open(opts)
+func openDB(t *testing.T, dir string) engine_util.Engine {
+	db, err := engine_util.OpenBadgerEngine(dir, badger.DefaultOptions.ValueThreshold)
 	require.Nil(t, err)
 	return db
 }
 
-func fillDBData(t *testing.T, db *badger.DB) {
+func fillDBData(t *testing.T, db engine_util.Engine) {
 	// write some data for multiple cfs.
 	wb := new(engine_util.WriteBatch)
 	value := make([]byte, 32)
@@ -57,23 +54,20 @@ func fillDBData(t *testing.T, db *badger.DB) {
 	require.Nil(t, err)
 }
 
-func getKVCount(t *testing.T, db *badger.DB) int {
+func getKVCount(t *testing.T, db engine_util.Engine) int {
 	count := 0
-	err := db.View(func(txn *badger.Txn) error {
-		for _, cf := range engine_util.CFs {
-			it := engine_util.NewCFIterator(cf, txn)
-			defer it.Close()
-			for it.Seek(regionTestBegin); it.Valid(); it.Next() {
-				if bytes.Compare(it.Item().Key(), regionTestEnd) >= 0 {
-					break
-				}
-				count++
+	snap := db.NewSnapshot()
+	defer snap.Discard()
+	for _, cf := range engine_util.CFs {
+		it := engine_util.NewCFIterator(cf, snap)
+		for it.Seek(regionTestBegin); it.Valid(); it.Next() {
+			if bytes.Compare(it.Item().Key(), regionTestEnd) >= 0 {
+				break
 			}
+			count++
 		}
-		return nil
-	})
-
-	assert.Nil(t, err)
+		it.Close()
+	}
 	return count
 }
 
@@ -92,7 +86,7 @@ func genTestRegion(regionID, storeID, peerID uint64) *metapb.Region {
 	}
 }
 
-func assertEqDB(t *testing.T, expected, actual *badger.DB) {
+func assertEqDB(t *testing.T, expected, actual engine_util.Engine) {
 	for _, cf := range engine_util.CFs {
 		expectedVal := getDBValue(t, expected, cf, snapTestKey)
 		actualVal := getDBValue(t, actual, cf, snapTestKey)
@@ -100,7 +94,7 @@ func assertEqDB(t *testing.T, expected, actual *badger.DB) {
 	}
 }
 
-func getDBValue(t *testing.T, db *badger.DB, cf string, key []byte) (val []byte) {
+func getDBValue(t *testing.T, db engine_util.Engine, cf string, key []byte) (val []byte) {
 	val, err := engine_util.GetCF(db, cf, key)
 	require.Nil(t, err, string(key))
 	return val
@@ -166,7 +160,7 @@ func doTestSnapFile(t *testing.T, dbHasData bool) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	assert.Nil(t, s1.Build(db.NewTransaction(false), region, snapData, stat, deleter))
+	assert.Nil(t, s1.Build(db.NewSnapshot(), region, snapData, stat, deleter))
 
 	// Ensure that this snapshot file does exist after being built.
 	assert.True(t, s1.Exists())
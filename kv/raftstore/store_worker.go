@@ -3,7 +3,6 @@ package raftstore
 import (
 	"sync"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
@@ -86,6 +85,8 @@ func (d *storeWorker) handleMsg(msg message.Msg) {
 		d.onTick(msg.Data.(StoreTick))
 	case message.MsgTypeStoreStart:
 		d.start(msg.Data.(*metapb.Store))
+	case message.MsgTypeStoreConfigChange:
+		d.ticker.updateStoreIntervals(msg.Data.(*config.Config))
 	}
 }
 
@@ -110,7 +111,7 @@ func (d *storeWorker) checkMsg(msg *rspb.RaftMessage) (bool, error) {
 	localState := new(rspb.RegionLocalState)
 	err := engine_util.GetMsg(d.ctx.engine.Kv, stateKey, localState)
 	if err != nil {
-		if err == badger.ErrKeyNotFound {
+		if err == engine_util.ErrKeyNotFound {
 			return false, nil
 		}
 		return false, err
@@ -165,8 +166,8 @@ func (d *storeWorker) onRaftMessage(msg *rspb.RaftMessage) error {
 		log.Errorf("missing region epoch in raft message, ignore it. region_id:%d", regionID)
 		return nil
 	}
-	if msg.IsTombstone {
-		// Target tombstone peer doesn't exist, so ignore it.
+	if msg.IsTombstone || isReadIndexMsg(msg) {
+		// Target tombstone peer doesn't exist, so ignore it. The read index messages are only for the existing peers.
 		return nil
 	}
 	ok, err := d.checkMsg(msg)
@@ -215,7 +216,7 @@ func (d *storeWorker) maybeCreatePeer(regionID uint64, msg *rspb.RaftMessage) (b
 	}
 
 	peer, err := replicatePeer(
-		d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.engine, regionID, msg.ToPeer)
+		d.ctx.store.Id, d.ctx.config(), d.ctx.regionTaskSender, d.ctx.engine, regionID, msg.ToPeer)
 	if err != nil {
 		return false, err
 	}
@@ -19,18 +19,33 @@ type tickSchedule struct {
 }
 
 func newTicker(regionID uint64, cfg *config.Config) *ticker {
-	baseInterval := cfg.RaftBaseTickInterval
 	t := &ticker{
 		regionID:  regionID,
 		schedules: make([]tickSchedule, 6),
 	}
 	t.schedules[int(PeerTickRaft)].interval = 1
-	t.schedules[int(PeerTickRaftLogGC)].interval = int64(cfg.RaftLogGCTickInterval / baseInterval)
-	t.schedules[int(PeerTickSplitRegionCheck)].interval = int64(cfg.SplitRegionCheckTickInterval / baseInterval)
-	t.schedules[int(PeerTickPdHeartbeat)].interval = int64(cfg.PdHeartbeatTickInterval / baseInterval)
+	t.updatePeerIntervals(cfg)
 	return t
 }
 
+// updatePeerIntervals sets the intervals of the peer ticks from cfg, they take effect from the next schedule.
+func (t *ticker) updatePeerIntervals(cfg *config.Config) {
+	baseInterval := cfg.RaftBaseTickInterval
+	t.schedules[int(PeerTickRaftLogGC)].interval = tickInterval(cfg.RaftLogGCTickInterval, baseInterval)
+	t.schedules[int(PeerTickSplitRegionCheck)].interval = tickInterval(cfg.SplitRegionCheckTickInterval, baseInterval)
+	t.schedules[int(PeerTickPdHeartbeat)].interval = tickInterval(cfg.PdHeartbeatTickInterval, baseInterval)
+	t.schedules[int(PeerTickResolvedTs)].interval = tickInterval(cfg.ResolvedTsInterval, baseInterval)
+}
+
+// tickInterval converts interval to a number of base ticks. An interval shorter than the base tick runs on every
+// base tick, rather than becoming 0 which would stop the tick.
+func tickInterval(interval, baseInterval time.Duration) int64 {
+	if ticks := int64(interval / baseInterval); ticks > 1 {
+		return ticks
+	}
+	return 1
+}
+
 const SnapMgrGcTickInterval = 1 * time.Minute
 
 func newStoreTicker(cfg *config.Config) *ticker {
@@ -38,11 +53,16 @@ func newStoreTicker(cfg *config.Config) *ticker {
 	t := &ticker{
 		schedules: make([]tickSchedule, 4),
 	}
-	t.schedules[int(StoreTickPdStoreHeartbeat)].interval = int64(cfg.PdStoreHeartbeatTickInterval / baseInterval)
-	t.schedules[int(StoreTickSnapGC)].interval = int64(SnapMgrGcTickInterval / baseInterval)
+	t.schedules[int(StoreTickSnapGC)].interval = tickInterval(SnapMgrGcTickInterval, baseInterval)
+	t.updateStoreIntervals(cfg)
 	return t
 }
 
+// updateStoreIntervals sets the intervals of the store ticks from cfg, they take effect from the next schedule.
+func (t *ticker) updateStoreIntervals(cfg *config.Config) {
+	t.schedules[int(StoreTickPdStoreHeartbeat)].interval = tickInterval(cfg.PdStoreHeartbeatTickInterval, cfg.RaftBaseTickInterval)
+}
+
 // tickClock should be called when peerMsgHandler received tick message.
 func (t *ticker) tickClock() {
 	t.tick++
@@ -0,0 +1,25 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+)
+
+func TestTickerShortInterval(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.PdHeartbeatTickInterval = cfg.RaftBaseTickInterval / 2
+	ticker := newTicker(1, cfg)
+	ticker.schedule(PeerTickPdHeartbeat)
+	ticker.tickClock()
+	require.True(t, ticker.isOnTick(PeerTickPdHeartbeat))
+
+	cfg.PdStoreHeartbeatTickInterval = time.Millisecond
+	storeTicker := newStoreTicker(cfg)
+	storeTicker.scheduleStore(StoreTickPdStoreHeartbeat)
+	storeTicker.tickClock()
+	require.True(t, storeTicker.isOnStoreTick(StoreTickPdStoreHeartbeat))
+}
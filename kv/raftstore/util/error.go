@@ -58,6 +58,16 @@ func (e *ErrStoreNotMatch) Error() string {
 	return fmt.Sprintf("store not match, request store id is %v, but actual store id is %v", e.RequestStoreId, e.ActualStoreId)
 }
 
+type ErrDataIsNotReady struct {
+	RegionId uint64
+	PeerId   uint64
+	SafeTs   uint64
+}
+
+func (e *ErrDataIsNotReady) Error() string {
+	return fmt.Sprintf("data of region %v is not ready on peer %v, safe ts is %v", e.RegionId, e.PeerId, e.SafeTs)
+}
+
 func RaftstoreErrToPbError(e error) *errorpb.Error {
 	ret := new(errorpb.Error)
 	switch err := errors.Cause(e).(type) {
@@ -74,6 +84,8 @@ func RaftstoreErrToPbError(e error) *errorpb.Error {
 		ret.StaleCommand = &errorpb.StaleCommand{}
 	case *ErrStoreNotMatch:
 		ret.StoreNotMatch = &errorpb.StoreNotMatch{RequestStoreId: err.RequestStoreId, ActualStoreId: err.ActualStoreId}
+	case *ErrDataIsNotReady:
+		ret.DataIsNotReady = &errorpb.DataIsNotReady{RegionId: err.RegionId, PeerId: err.PeerId, SafeTs: err.SafeTs}
 	default:
 		ret.Message = e.Error()
 	}
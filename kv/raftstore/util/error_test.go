@@ -44,4 +44,10 @@ func TestRaftstoreErrToPbError(t *testing.T) {
 	require.NotNil(t, pbErr.StoreNotMatch)
 	assert.Equal(t, pbErr.StoreNotMatch.RequestStoreId, requestStoreId)
 	assert.Equal(t, pbErr.StoreNotMatch.ActualStoreId, actualStoreId)
+
+	dataIsNotReady := &ErrDataIsNotReady{RegionId: regionId, PeerId: 2, SafeTs: 3}
+	pbErr = RaftstoreErrToPbError(dataIsNotReady)
+	require.NotNil(t, pbErr.DataIsNotReady)
+	assert.Equal(t, pbErr.DataIsNotReady.RegionId, regionId)
+	assert.Equal(t, pbErr.DataIsNotReady.SafeTs, uint64(3))
 }
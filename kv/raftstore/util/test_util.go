@@ -3,7 +3,6 @@ package util
 import (
 	"io/ioutil"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 )
 
@@ -14,11 +13,7 @@ func NewTestEngines() *engine_util.Engines {
 	if err != nil {
 		panic("create kv dir failed")
 	}
-	kvOpts := badger.DefaultOptions
-	kvOpts.Dir = engines.KvPath
-	kvOpts.ValueDir = engines.KvPath
-	kvOpts.ValueThreshold = 256
-	engines.Kv, err = badger.Open(kvOpts)
+	engines.Kv, err = engine_util.OpenBadgerEngine(engines.KvPath, 256)
 	if err != nil {
 		panic("open kv db failed")
 	}
@@ -26,11 +21,7 @@ func NewTestEngines() *engine_util.Engines {
 	if err != nil {
 		panic("create raft dir failed")
 	}
-	raftOpts := badger.DefaultOptions
-	raftOpts.Dir = engines.RaftPath
-	raftOpts.ValueDir = engines.RaftPath
-	raftOpts.ValueThreshold = 256
-	engines.Raft, err = badger.Open(raftOpts)
+	engines.Raft, err = engine_util.OpenBadgerEngine(engines.RaftPath, 256)
 	if err != nil {
 		panic("open raft db failed")
 	}
@@ -85,7 +85,11 @@ func CheckRegionEpoch(req *raft_cmdpb.RaftCmdRequest, region *metapb.Region, inc
 		case raft_cmdpb.AdminCmdType_CompactLog, raft_cmdpb.AdminCmdType_InvalidAdmin:
 		case raft_cmdpb.AdminCmdType_ChangePeer:
 			checkConfVer = true
-		case raft_cmdpb.AdminCmdType_BatchSplit, raft_cmdpb.AdminCmdType_TransferLeader:
+		case raft_cmdpb.AdminCmdType_UpdateResolvedTs:
+			// The resolved ts is computed from the locks in the range of the region.
+			checkVer = true
+		case raft_cmdpb.AdminCmdType_BatchSplit, raft_cmdpb.AdminCmdType_TransferLeader,
+			raft_cmdpb.AdminCmdType_IngestSST:
 			checkVer = true
 			checkConfVer = true
 		}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/pingcap-incubator/tinykv/kv/importer"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap/errors"
+)
+
+// ImportSSTServer serves the ImportSST service of a store, which loads sorted key/value files into the regions
+// without the transactional write path.
+type ImportSSTServer struct {
+	innerServer inner_server.InnerServer
+}
+
+var _ import_sstpb.ImportSSTServer = new(ImportSSTServer)
+
+func NewImportSSTServer(innerServer inner_server.InnerServer) *ImportSSTServer {
+	return &ImportSSTServer{innerServer: innerServer}
+}
+
+func (s *ImportSSTServer) ingester() (inner_server.SSTIngester, error) {
+	ingester, ok := s.innerServer.(inner_server.SSTIngester)
+	if !ok {
+		return nil, errors.New("import is not supported by the store")
+	}
+	return ingester, nil
+}
+
+func (s *ImportSSTServer) importer() (*importer.SSTImporter, error) {
+	ingester, err := s.ingester()
+	if err != nil {
+		return nil, err
+	}
+	return ingester.SSTImporter()
+}
+
+func (s *ImportSSTServer) SwitchMode(_ context.Context, req *import_sstpb.SwitchModeRequest) (*import_sstpb.SwitchModeResponse, error) {
+	importer, err := s.importer()
+	if err != nil {
+		return nil, err
+	}
+	importer.SwitchMode(req.GetMode())
+	return new(import_sstpb.SwitchModeResponse), nil
+}
+
+func (s *ImportSSTServer) Upload(stream import_sstpb.ImportSST_UploadServer) error {
+	importer, err := s.importer()
+	if err != nil {
+		return err
+	}
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := req.GetMeta()
+	if meta == nil {
+		return errors.New("the first message of the upload must carry the meta of the file")
+	}
+	file, err := importer.Create(meta)
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err == nil && req.GetMeta() != nil {
+			err = errors.New("the meta of the file is sent twice")
+		}
+		if err == nil {
+			err = file.Write(req.GetData())
+		}
+		if err != nil {
+			file.Abort()
+			return err
+		}
+	}
+	if err := file.Finish(); err != nil {
+		return err
+	}
+	return stream.SendAndClose(new(import_sstpb.UploadResponse))
+}
+
+func (s *ImportSSTServer) Ingest(_ context.Context, req *import_sstpb.IngestRequest) (*import_sstpb.IngestResponse, error) {
+	ingester, err := s.ingester()
+	if err != nil {
+		return nil, err
+	}
+	importer, err := ingester.SSTImporter()
+	if err != nil {
+		return nil, err
+	}
+	// Every replica ingests its own copy of the file, so it must be uploaded to this store at least.
+	if !importer.Exists(req.GetSst()) {
+		return nil, errors.Errorf("sst %x of region %d is not uploaded", req.GetSst().GetUuid(), req.GetSst().GetRegionId())
+	}
+	response := new(import_sstpb.IngestResponse)
+	if err := ingester.IngestSST(req.GetContext(), req.GetSst()); err != nil {
+		regionErr, ok := err.(*raft_server.RegionError)
+		if !ok {
+			return nil, err
+		}
+		response.Error = regionErr.RequestErr
+	}
+	return response, nil
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcMsgCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tinykv",
+			Subsystem: "grpc",
+			Name:      "msg_total",
+			Help:      "Total number of handled gRPC requests.",
+		}, []string{"type", "code"})
+
+	grpcMsgDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tinykv",
+			Subsystem: "grpc",
+			Name:      "msg_duration_seconds",
+			Help:      "Bucketed histogram of gRPC handler duration.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 20),
+		}, []string{"type"})
+)
+
+func init() {
+	prometheus.MustRegister(grpcMsgCounter)
+	prometheus.MustRegister(grpcMsgDuration)
+}
+
+// UnaryMetricsInterceptor records the count and duration of unary gRPC requests.
+func UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeGrpcMsg(info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamMetricsInterceptor records the count and duration of streaming gRPC requests.
+func StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeGrpcMsg(info.FullMethod, start, err)
+	return err
+}
+
+func observeGrpcMsg(fullMethod string, start time.Time, err error) {
+	tp := path.Base(fullMethod)
+	grpcMsgCounter.WithLabelValues(tp, status.Code(err).String()).Inc()
+	grpcMsgDuration.WithLabelValues(tp).Observe(time.Since(start).Seconds())
+}
@@ -1,17 +1,23 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"reflect"
 
-	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/commands"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/latches"
+	"github.com/pingcap-incubator/tinykv/kv/util/codec"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
 )
 
 var _ tinykvpb.TinyKvServer = new(Server)
@@ -20,6 +26,8 @@ var _ tinykvpb.TinyKvServer = new(Server)
 type Server struct {
 	innerServer inner_server.InnerServer
 	Latches     *latches.Latches
+	// The change data capture endpoint of the store, nil if it is not enabled.
+	cdc *cdc.Endpoint
 }
 
 func NewServer(innerServer inner_server.InnerServer) *Server {
@@ -29,11 +37,31 @@ func NewServer(innerServer inner_server.InnerServer) *Server {
 	}
 }
 
+// SetCDCEndpoint enables the change data capture API, the endpoint must observe the commands applied by the store
+// of the server.
+func (server *Server) SetCDCEndpoint(endpoint *cdc.Endpoint) {
+	server.cdc = endpoint
+}
+
 // Run runs a transactional command.
 func (server *Server) Run(cmd commands.Command) (interface{}, error) {
 	return commands.RunCommand(cmd, server.innerServer, server.Latches)
 }
 
+// runTxn runs a transactional command. A region error is set in the RegionError field of the empty response resp,
+// which is returned instead, so the client retries the request in the right region.
+func (server *Server) runTxn(cmd commands.Command, resp interface{}) (interface{}, error) {
+	result, err := server.Run(cmd)
+	if err != nil {
+		if regionErr, ok := err.(*raft_server.RegionError); ok {
+			reflect.ValueOf(resp).Elem().FieldByName("RegionError").Set(reflect.ValueOf(regionErr.RequestErr))
+			return resp, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
 // The below functions are Server's gRPC API (implements TinyKvServer).
 
 // TODO: delete the bodies of the below functions.
@@ -42,50 +70,155 @@ func (server *Server) Run(cmd commands.Command) (interface{}, error) {
 func (server *Server) KvGet(_ context.Context, req *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error) {
 	// Your code here 4A
 	cmd := commands.NewGet(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.GetResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.GetResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.GetResponse), nil
 }
 
 func (server *Server) KvScan(_ context.Context, req *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error) {
 	// Your code here 4B
 	cmd := commands.NewScan(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.ScanResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.ScanResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.ScanResponse), nil
 }
 
 func (server *Server) KvPrewrite(_ context.Context, req *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error) {
 	// Your code here 4A
 	cmd := commands.NewPrewrite(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.PrewriteResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.PrewriteResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.PrewriteResponse), nil
 }
 
 func (server *Server) KvCommit(_ context.Context, req *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error) {
 	// Your code here 4A
 	cmd := commands.NewCommit(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.CommitResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.CommitResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.CommitResponse), nil
 }
 
 func (server *Server) KvCheckTxnStatus(_ context.Context, req *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error) {
 	// Your code here 4B
 	cmd := commands.NewCheckTxnStatus(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.CheckTxnStatusResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.CheckTxnStatusResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.CheckTxnStatusResponse), nil
 }
 
 func (server *Server) KvBatchRollback(_ context.Context, req *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error) {
 	// Your code here 4B
 	cmd := commands.NewRollback(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.BatchRollbackResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.BatchRollbackResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.BatchRollbackResponse), nil
 }
 
 func (server *Server) KvResolveLock(_ context.Context, req *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error) {
 	// Your code here 4B
 	cmd := commands.NewResolveLock(req)
-	resp, err := server.Run(&cmd)
-	return resp.(*kvrpcpb.ResolveLockResponse), err
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.ResolveLockResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.ResolveLockResponse), nil
+}
+
+func (server *Server) KvBatchGet(_ context.Context, req *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error) {
+	cmd := commands.NewBatchGet(req)
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.BatchGetResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.BatchGetResponse), nil
+}
+
+func (server *Server) KvScanLock(_ context.Context, req *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error) {
+	cmd := commands.NewScanLock(req)
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.ScanLockResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.ScanLockResponse), nil
+}
+
+func (server *Server) KvCleanup(_ context.Context, req *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error) {
+	cmd := commands.NewCleanup(req)
+	resp, err := server.runTxn(&cmd, new(kvrpcpb.CleanupResponse))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kvrpcpb.CleanupResponse), nil
+}
+
+// KvDeleteRange is handled inline rather than as a Command, since it takes no latches and writes a range instead of
+// keys.
+func (server *Server) KvDeleteRange(_ context.Context, req *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error) {
+	response := new(kvrpcpb.DeleteRangeResponse)
+	if len(req.EndKey) != 0 && bytes.Compare(req.StartKey, req.EndKey) >= 0 {
+		response.Error = fmt.Sprintf("invalid range [%v, %v)", req.StartKey, req.EndKey)
+		return response, nil
+	}
+	// The keys of the default and write CFs are encoded with their timestamps, all the versions of the keys in the
+	// range are between the encoded bounds.
+	encodedStart, encodedEnd := codec.EncodeBytes(req.StartKey), []byte(nil)
+	if len(req.EndKey) != 0 {
+		encodedEnd = codec.EncodeBytes(req.EndKey)
+	}
+	var batch []inner_server.Modify
+	for _, cf := range engine_util.CFs {
+		deleteRange := inner_server.DeleteRange{StartKey: encodedStart, EndKey: encodedEnd, Cf: cf}
+		if cf == engine_util.CfLock {
+			deleteRange.StartKey, deleteRange.EndKey = req.StartKey, req.EndKey
+		}
+		batch = append(batch, inner_server.Modify{Type: inner_server.ModifyTypeDeleteRange, Data: deleteRange})
+	}
+	err := server.innerServer.Write(req.Context, batch)
+	rawRegionError(err, response)
+	return response, nil
+}
+
+// Change data capture API.
+func (server *Server) EventFeed(req *kvrpcpb.ChangeDataRequest, stream tinykvpb.TinyKv_EventFeedServer) error {
+	if server.cdc == nil {
+		return errors.New("change data capture is not enabled")
+	}
+	return server.cdc.EventFeed(req, server.innerServer, stream)
+}
+
+// Backup and restore API.
+func (server *Server) Backup(req *kvrpcpb.BackupRequest, stream tinykvpb.TinyKv_BackupServer) error {
+	return backup.Backup(req, server.innerServer, stream)
+}
+
+func (server *Server) RestoreFile(_ context.Context, req *kvrpcpb.RestoreFileRequest) (*kvrpcpb.RestoreFileResponse, error) {
+	return backup.RestoreFile(req, server.innerServer)
+}
+
+func (server *Server) SplitRegion(_ context.Context, req *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error) {
+	manager, ok := server.innerServer.(inner_server.RegionManager)
+	if !ok {
+		return nil, errors.New("split region is not supported by the store")
+	}
+	response := new(kvrpcpb.SplitRegionResponse)
+	regions, err := manager.SplitRegion(req.Context, req.SplitKeys)
+	if !rawRegionError(err, response) {
+		response.Regions = regions
+	}
+	return response, nil
 }
 
 // Raw API. These commands are handled inline rather than by using Run and am implementation of the Commands interface.
@@ -98,7 +231,7 @@ func (server *Server) RawGet(_ context.Context, req *kvrpcpb.RawGetRequest) (*kv
 	if !rawRegionError(err, response) {
 		val, err := reader.GetCF(req.Cf, req.Key)
 		if err != nil {
-			if err == badger.ErrKeyNotFound {
+			if err == engine_util.ErrKeyNotFound {
 				response.NotFound = true
 			} else {
 				rawRegionError(err, response)
@@ -189,7 +322,7 @@ func rawRegionError(err error, resp interface{}) bool {
 	if err == nil {
 		return false
 	}
-	respValue := reflect.ValueOf(resp)
+	respValue := reflect.ValueOf(resp).Elem()
 	if regionErr, ok := err.(*raft_server.RegionError); ok {
 		respValue.FieldByName("RegionError").Set(reflect.ValueOf(regionErr.RequestErr))
 	} else {
@@ -77,3 +77,20 @@ func TestScan(t *testing.T) {
 		assert.Equal(t, []byte{42, byte(i + 2)}, kv.Value)
 	}
 }
+
+func TestReplicaRead(t *testing.T) {
+	mem := inner_server.NewMemInnerServer()
+	server := NewServer(mem)
+
+	// An inner server without replicas serves a replica read as a normal read.
+	var req kvrpcpb.GetRequest
+	req.Context = &kvrpcpb.Context{ReplicaRead: true}
+	req.Key = []byte{99}
+	req.Version = 100
+
+	resp, err := server.KvGet(context.Background(), &req)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.Value)
+}
@@ -5,10 +5,10 @@ import (
 	"path/filepath"
 	"testing"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server/standalone_server"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/stretchr/testify/assert"
 )
@@ -178,7 +178,7 @@ func TestRawDeleteLab1(t *testing.T) {
 	assert.Nil(t, err)
 
 	_, err = get(is, cf, []byte{99})
-	assert.Equal(t, err, badger.ErrKeyNotFound)
+	assert.Equal(t, err, engine_util.ErrKeyNotFound)
 }
 
 func TestRawScanLab1(t *testing.T) {
@@ -0,0 +1,155 @@
+package status
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// RegionInspector gives access to the state of the regions hosted by a server.
+type RegionInspector interface {
+	RegionIDs() []uint64
+	// RegionInfo returns the state of the region's peer, or nil if the region has no peer on this server.
+	RegionInfo(regionID uint64) (*raftstore.RegionInfo, error)
+}
+
+// Server is the status HTTP server of tinykv-server. It serves:
+//
+//	GET  /config       the running config, in the layout of the config file.
+//	POST /config       change online options, the body is a JSON object such as {"raftstore.region-split-size": "64MiB"}.
+//	GET  /metrics      Prometheus metrics.
+//	GET  /debug/pprof  Go runtime profiles.
+//	GET  /regions      the state of all regions hosted by the server, a region whose peer fails to answer has an error.
+//	GET  /region/{id}  the state of a single region.
+//	/failpoints/       list, enable and disable the failpoints, see failpoint.Handler.
+type Server struct {
+	addr       string
+	controller *config.Controller
+	inspector  RegionInspector
+	httpServer *http.Server
+}
+
+// NewServer creates a status server. inspector may be nil if the server doesn't host regions, the region endpoints
+// are unavailable then.
+func NewServer(addr string, controller *config.Controller, inspector RegionInspector) *Server {
+	s := &Server{
+		addr:       addr,
+		controller: controller,
+		inspector:  inspector,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config", s.handleConfig)
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/regions", s.handleRegions)
+	mux.HandleFunc("/region/", s.handleRegion)
+	mux.Handle("/failpoints/", http.StripPrefix("/failpoints", failpoint.Handler()))
+	s.httpServer = &http.Server{Handler: mux}
+	return s
+}
+
+func (s *Server) Start() error {
+	l, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := s.httpServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Errorf("status server stopped: %v", err)
+		}
+	}()
+	log.Infof("status server listening on %s", s.addr)
+	return nil
+}
+
+func (s *Server) Stop() error {
+	return s.httpServer.Close()
+}
+
+func (s *Server) handleConfig(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, config.NewFileConfig(s.controller.Get()))
+	case http.MethodPost:
+		changes := make(map[string]string)
+		if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := s.controller.Update(changes); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Infof("config changed online: %v", changes)
+		writeJSON(w, http.StatusOK, config.NewFileConfig(s.controller.Get()))
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleRegions(w http.ResponseWriter, r *http.Request) {
+	if s.inspector == nil {
+		http.Error(w, "regions are only available in raft mode", http.StatusNotFound)
+		return
+	}
+	infos := make([]*raftstore.RegionInfo, 0)
+	for _, regionID := range s.inspector.RegionIDs() {
+		info, err := s.inspector.RegionInfo(regionID)
+		if err != nil {
+			// One busy peer doesn't fail the whole listing.
+			info = &raftstore.RegionInfo{Region: &metapb.Region{Id: regionID}, Error: err.Error()}
+		}
+		// The region may have been removed since listing the IDs.
+		if info != nil {
+			infos = append(infos, info)
+		}
+	}
+	writeJSON(w, http.StatusOK, infos)
+}
+
+func (s *Server) handleRegion(w http.ResponseWriter, r *http.Request) {
+	if s.inspector == nil {
+		http.Error(w, "regions are only available in raft mode", http.StatusNotFound)
+		return
+	}
+	regionID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/region/"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid region id", http.StatusBadRequest)
+		return
+	}
+	info, err := s.inspector.RegionInfo(regionID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if info == nil {
+		http.Error(w, "region not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, info)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
@@ -0,0 +1,136 @@
+package status
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+type mockInspector struct {
+	regions map[uint64]*raftstore.RegionInfo
+	errs    map[uint64]error
+}
+
+func (m *mockInspector) RegionIDs() []uint64 {
+	var ids []uint64
+	for id := range m.regions {
+		ids = append(ids, id)
+	}
+	for id := range m.errs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (m *mockInspector) RegionInfo(regionID uint64) (*raftstore.RegionInfo, error) {
+	return m.regions[regionID], m.errs[regionID]
+}
+
+func newTestServer(inspector RegionInspector) (*httptest.Server, *config.Controller) {
+	controller := config.NewController(config.NewDefaultConfig())
+	s := NewServer("", controller, inspector)
+	return httptest.NewServer(s.httpServer.Handler), controller
+}
+
+func TestConfig(t *testing.T) {
+	ts, controller := newTestServer(nil)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/config", "application/json",
+		strings.NewReader(`{"raftstore.region-split-size": "64MiB"}`))
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 64*config.MB, controller.Get().RegionSplitSize)
+
+	resp, err = http.Post(ts.URL+"/config", "application/json", strings.NewReader(`{"storage.db-path": "/tmp"}`))
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(ts.URL + "/config")
+	require.Nil(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), `"region-split-size": "64MiB"`)
+}
+
+func TestRegions(t *testing.T) {
+	standalone, _ := newTestServer(nil)
+	resp, err := http.Get(standalone.URL + "/regions")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	standalone.Close()
+
+	ts, _ := newTestServer(&mockInspector{regions: map[uint64]*raftstore.RegionInfo{
+		1: {Region: &metapb.Region{Id: 1}, PeerID: 2, Role: "StateLeader", LeaderID: 2},
+	}, errs: map[uint64]error{
+		2: raftstore.ErrRegionInfoTimeout,
+	}})
+	defer ts.Close()
+
+	resp, err = http.Get(ts.URL + "/regions")
+	require.Nil(t, err)
+	var infos []*raftstore.RegionInfo
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&infos))
+	require.Len(t, infos, 2)
+	require.Equal(t, uint64(2), infos[0].LeaderID)
+	// The busy peer is listed with the error.
+	require.Equal(t, uint64(2), infos[1].Region.GetId())
+	require.Equal(t, raftstore.ErrRegionInfoTimeout.Error(), infos[1].Error)
+
+	resp, err = http.Get(ts.URL + "/region/1")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, err = http.Get(ts.URL + "/region/3")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, err = http.Get(ts.URL + "/region/abc")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestMetrics(t *testing.T) {
+	ts, _ := newTestServer(nil)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/metrics")
+	require.Nil(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Contains(t, string(body), "tinykv_raftstore_propose_to_apply_duration_seconds")
+}
+
+func TestFailpoints(t *testing.T) {
+	ts, _ := newTestServer(nil)
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodPut, ts.URL+"/failpoints/status/test", strings.NewReader("return(injected)"))
+	require.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.EqualError(t, failpoint.Eval("status/test"), "failpoint status/test: injected")
+
+	resp, err = http.Get(ts.URL + "/failpoints/")
+	require.Nil(t, err)
+	terms := make(map[string]string)
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&terms))
+	require.Equal(t, map[string]string{"status/test": "return(injected)"}, terms)
+
+	req, err = http.NewRequest(http.MethodDelete, ts.URL+"/failpoints/status/test", nil)
+	require.Nil(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Nil(t, failpoint.Eval("status/test"))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -11,10 +12,11 @@ import (
 	"path/filepath"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
 	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/log"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
@@ -24,10 +26,15 @@ import (
 type Simulator interface {
 	RunStore(raftConf *config.Config, engine *engine_util.Engines, ctx context.Context) error
 	StopStore(storeID uint64)
+	SetCmdObserver(storeID uint64, observer raftstore.CmdObserver)
 	AddFilter(filter Filter)
 	ClearFilters()
 	GetStoreIds() []uint64
-	CallCommandOnStore(storeID uint64, request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, *badger.Txn)
+	CallCommandOnStore(storeID uint64, request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot)
+	// GetRouter returns the router of a running store, or nil if the store is stopped.
+	GetRouter(storeID uint64) *raftstore.RaftstoreRouter
+	// GetSSTImporter returns the importer of the files uploaded to a running store, or nil if the store is stopped.
+	GetSSTImporter(storeID uint64) *importer.SSTImporter
 }
 
 type Cluster struct {
@@ -179,7 +186,7 @@ func (c *Cluster) AllocPeer(storeID uint64) *metapb.Peer {
 	return NewPeer(storeID, id)
 }
 
-func (c *Cluster) Request(key []byte, reqs []*raft_cmdpb.Request, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, *badger.Txn) {
+func (c *Cluster) Request(key []byte, reqs []*raft_cmdpb.Request, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot) {
 	startTime := time.Now()
 	for i := 0; i < 10 || time.Now().Sub(startTime) < timeout; i++ {
 		region := c.GetRegion(key)
@@ -200,12 +207,12 @@ func (c *Cluster) Request(key []byte, reqs []*raft_cmdpb.Request, timeout time.D
 	panic("request timeout")
 }
 
-func (c *Cluster) CallCommand(request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, *badger.Txn) {
+func (c *Cluster) CallCommand(request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot) {
 	storeID := request.Header.Peer.StoreId
 	return c.simulator.CallCommandOnStore(storeID, request, timeout)
 }
 
-func (c *Cluster) CallCommandOnLeader(request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, *badger.Txn) {
+func (c *Cluster) CallCommandOnLeader(request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot) {
 	startTime := time.Now()
 	regionID := request.Header.RegionId
 	leader := c.LeaderOfRegion(regionID)
@@ -436,3 +443,89 @@ func (c *Cluster) MustNonePeer(regionID uint64, peer *metapb.Peer) {
 		SleepMS(10)
 	}
 }
+
+// MustSplitRegion splits the region of key at key.
+func (c *Cluster) MustSplitRegion(key []byte) {
+	for i := 0; i < 100; i++ {
+		region := c.GetRegion(key)
+		if bytes.Equal(region.GetStartKey(), key) {
+			return
+		}
+		split := &raft_cmdpb.SplitRequest{SplitKey: key}
+		var err error
+		if split.NewRegionId, err = c.pdClient.AllocID(context.TODO()); err != nil {
+			panic(err)
+		}
+		for range region.GetPeers() {
+			id, err := c.pdClient.AllocID(context.TODO())
+			if err != nil {
+				panic(err)
+			}
+			split.NewPeerIds = append(split.NewPeerIds, id)
+		}
+		req := NewAdminRequest(region.GetId(), region.GetRegionEpoch(), &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_BatchSplit,
+			Splits:  &raft_cmdpb.BatchSplitRequest{Requests: []*raft_cmdpb.SplitRequest{split}},
+		})
+		c.CallCommandOnLeader(req, time.Second)
+		SleepMS(100)
+	}
+	panic(fmt.Sprintf("failed to split region at %s", hex.EncodeToString(key)))
+}
+
+// ErrRequestUnknown means a request may or may not be applied, e.g. it times out after being proposed.
+var ErrRequestUnknown = errors.New("result of the request is unknown")
+
+// TryRequest sends the requests to the leader of the region of key once they may be proposed, unlike Request it
+// doesn't retry after the result is unknown, since the requests may be applied twice then. It only retries the errors
+// which show the requests are not applied, and gives up after timeout. It returns ErrRequestUnknown if the requests
+// may be applied, or another error if they are not.
+func (c *Cluster) TryRequest(key []byte, reqs []*raft_cmdpb.Request, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot, error) {
+	deadline := time.Now().Add(timeout)
+	var leader *metapb.Peer
+	for time.Now().Before(deadline) {
+		region, regionLeader, err := c.pdClient.GetRegion(context.TODO(), key)
+		if err != nil || region == nil {
+			SleepMS(20)
+			continue
+		}
+		if leader == nil || FindPeer(region, leader.GetStoreId()) == nil {
+			leader = regionLeader
+		}
+		if leader == nil {
+			leader = region.GetPeers()[rand.Intn(len(region.GetPeers()))]
+		}
+		router := c.simulator.GetRouter(leader.GetStoreId())
+		if router == nil {
+			// The store is stopped, the requests are not sent.
+			leader = nil
+			SleepMS(20)
+			continue
+		}
+		req := NewRequest(region.GetId(), region.GetRegionEpoch(), reqs)
+		req.Header.Peer = leader
+		cb := message.NewCallback()
+		if err := router.SendRaftCommand(&req, cb); err != nil {
+			// The store has no peer of the region, the requests are not sent.
+			leader = nil
+			SleepMS(20)
+			continue
+		}
+		resp := cb.WaitRespWithTimeout(time.Until(deadline))
+		if resp == nil {
+			return nil, nil, ErrRequestUnknown
+		}
+		if err := resp.Header.Error; err != nil {
+			// These errors are returned before the requests are proposed, or when they are skipped on applying.
+			// Others like RegionNotFound may be returned after the requests are proposed by a removed peer.
+			if err.GetNotLeader() == nil && err.GetEpochNotMatch() == nil && err.GetStaleCommand() == nil && err.GetKeyNotInRegion() == nil {
+				return nil, nil, ErrRequestUnknown
+			}
+			leader = err.GetNotLeader().GetLeader()
+			SleepMS(20)
+			continue
+		}
+		return resp, cb.Snap, nil
+	}
+	return nil, nil, errors.New("request timeout")
+}
@@ -2,6 +2,8 @@ package test_raftstore
 
 import (
 	"math/rand"
+	"sync"
+	"time"
 
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 )
@@ -43,3 +45,143 @@ func (f *DropFilter) Before(msg *rspb.RaftMessage) bool {
 }
 
 func (f *DropFilter) After() {}
+
+// LockedRand is a random source which is safe for concurrent use, so a filter
+// or nemesis seeded with it makes the same choices for the same seed.
+type LockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func NewLockedRand(seed int64) *LockedRand {
+	return &LockedRand{r: rand.New(rand.NewSource(seed))}
+}
+
+func (r *LockedRand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Float64()
+}
+
+func (r *LockedRand) Int63n(n int64) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Int63n(n)
+}
+
+func (r *LockedRand) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Intn(n)
+}
+
+// duration returns a random duration in (0, max].
+func (r *LockedRand) duration(max time.Duration) time.Duration {
+	return time.Duration(r.Int63n(int64(max))) + 1
+}
+
+// DeliveryFilter decides when a message passing all filters is delivered. It
+// returns the delays of the deliveries: several delays duplicate the message,
+// and a delay lets the messages sent later overtake it.
+type DeliveryFilter interface {
+	Filter
+	Deliveries(msg *rspb.RaftMessage) []time.Duration
+}
+
+// RandomDropFilter drops a message with the probability Rate.
+type RandomDropFilter struct {
+	Rand *LockedRand
+	Rate float64
+}
+
+func (f *RandomDropFilter) Before(msg *rspb.RaftMessage) bool {
+	return f.Rand.Float64() >= f.Rate
+}
+
+func (f *RandomDropFilter) After() {}
+
+// DelayFilter delays a message for up to MaxDelay with the probability Rate.
+type DelayFilter struct {
+	Rand     *LockedRand
+	Rate     float64
+	MaxDelay time.Duration
+}
+
+func (f *DelayFilter) Before(msg *rspb.RaftMessage) bool { return true }
+
+func (f *DelayFilter) After() {}
+
+func (f *DelayFilter) Deliveries(msg *rspb.RaftMessage) []time.Duration {
+	if f.Rand.Float64() < f.Rate {
+		return []time.Duration{f.Rand.duration(f.MaxDelay)}
+	}
+	return []time.Duration{0}
+}
+
+// ReorderFilter holds a message back with the probability Rate, so the
+// messages sent in the following Window are delivered before it.
+type ReorderFilter struct {
+	Rand   *LockedRand
+	Rate   float64
+	Window time.Duration
+}
+
+func (f *ReorderFilter) Before(msg *rspb.RaftMessage) bool { return true }
+
+func (f *ReorderFilter) After() {}
+
+func (f *ReorderFilter) Deliveries(msg *rspb.RaftMessage) []time.Duration {
+	if f.Rand.Float64() < f.Rate {
+		return []time.Duration{f.Window}
+	}
+	return []time.Duration{0}
+}
+
+// DuplicateFilter delivers a message twice with the probability Rate, the
+// copy is delivered up to MaxDelay later.
+type DuplicateFilter struct {
+	Rand     *LockedRand
+	Rate     float64
+	MaxDelay time.Duration
+}
+
+func (f *DuplicateFilter) Before(msg *rspb.RaftMessage) bool { return true }
+
+func (f *DuplicateFilter) After() {}
+
+func (f *DuplicateFilter) Deliveries(msg *rspb.RaftMessage) []time.Duration {
+	if f.Rand.Float64() < f.Rate {
+		return []time.Duration{0, f.Rand.duration(f.MaxDelay)}
+	}
+	return []time.Duration{0}
+}
+
+// SwitchablePartitionFilter partitions the stores into groups which can be
+// changed while the filter is installed, the stores in different groups
+// cannot reach each other. A store not in any group reaches all stores.
+type SwitchablePartitionFilter struct {
+	mu     sync.RWMutex
+	groups map[uint64]int
+}
+
+// Partition replaces the groups, no group heals the partition.
+func (f *SwitchablePartitionFilter) Partition(groups ...[]uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.groups = make(map[uint64]int)
+	for i, group := range groups {
+		for _, storeID := range group {
+			f.groups[storeID] = i
+		}
+	}
+}
+
+func (f *SwitchablePartitionFilter) Before(msg *rspb.RaftMessage) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	from, ok1 := f.groups[msg.FromPeer.StoreId]
+	to, ok2 := f.groups[msg.ToPeer.StoreId]
+	return !ok1 || !ok2 || from == to
+}
+
+func (f *SwitchablePartitionFilter) After() {}
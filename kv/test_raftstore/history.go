@@ -0,0 +1,110 @@
+package test_raftstore
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// KvOpKind is the kind of an operation of the raw KV model.
+type KvOpKind int
+
+const (
+	KvGet KvOpKind = iota
+	KvPut
+	KvDelete
+)
+
+func (k KvOpKind) String() string {
+	switch k {
+	case KvGet:
+		return "get"
+	case KvPut:
+		return "put"
+	case KvDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Operation is a client operation of the raw KV model. Call and Return are the
+// times the operation is invoked and completed at, an operation with an
+// unknown result never returns, so it may take effect at any time after it is
+// invoked or never.
+type Operation struct {
+	ClientID int
+	Kind     KvOpKind
+	Key      string
+	// Value is the value to put, or the value got, an empty value means the
+	// key does not exist.
+	Value   string
+	Call    int64
+	Return  int64
+	Unknown bool
+}
+
+func (op *Operation) String() string {
+	ret := fmt.Sprintf("%d", op.Return)
+	if op.Unknown {
+		ret = "?"
+	}
+	return fmt.Sprintf("client %d %s(%q)=%q [%d, %s]", op.ClientID, op.Kind, op.Key, op.Value, op.Call, ret)
+}
+
+// History records the invoke and complete events of the client operations,
+// it is safe for concurrent use.
+type History struct {
+	mu    sync.Mutex
+	start time.Time
+	ops   []*Operation
+}
+
+func NewHistory() *History {
+	return &History{start: time.Now()}
+}
+
+func (h *History) now() int64 {
+	return int64(time.Since(h.start))
+}
+
+// Invoke records that the client invokes the operation, the value is only
+// set for a put.
+func (h *History) Invoke(clientID int, kind KvOpKind, key, value string) *Operation {
+	return &Operation{ClientID: clientID, Kind: kind, Key: key, Value: value, Call: h.now()}
+}
+
+// Complete records that the operation completes, value is the value got by a
+// get.
+func (h *History) Complete(op *Operation, value string) {
+	op.Return = h.now()
+	if op.Kind == KvGet {
+		op.Value = value
+	}
+	h.add(op)
+}
+
+// Unknown records that the result of the operation is unknown, e.g. the
+// request times out after it may be proposed. A get without result is not
+// recorded since it has no effect.
+func (h *History) Unknown(op *Operation) {
+	if op.Kind == KvGet {
+		return
+	}
+	op.Return = math.MaxInt64
+	op.Unknown = true
+	h.add(op)
+}
+
+func (h *History) add(op *Operation) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.ops = append(h.ops, op)
+}
+
+// Operations returns the recorded operations.
+func (h *History) Operations() []*Operation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*Operation(nil), h.ops...)
+}
@@ -0,0 +1,222 @@
+package test_raftstore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CheckKvLinearizable checks whether the history of the raw KV operations is
+// linearizable. Linearizability is compositional, so the operations of each
+// key are checked alone. It returns an error describing the operations of the
+// first key which are not linearizable.
+//
+// The check is the algorithm of Wing & Gong with the memoization of Lowe, the
+// one used by Porcupine: it linearizes the operations in an order of their
+// calls, and backtracks once an operation returns before it is linearized.
+// The (linearized set, state) pairs seen before are skipped.
+func CheckKvLinearizable(ops []*Operation) error {
+	byKey := make(map[string][]*Operation)
+	for _, op := range ops {
+		byKey[op.Key] = append(byKey[op.Key], op)
+	}
+	keys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !checkSingleKey(byKey[key]) {
+			return fmt.Errorf("operations of key %q are not linearizable:\n%s", key, describeOps(byKey[key]))
+		}
+	}
+	return nil
+}
+
+func describeOps(ops []*Operation) string {
+	ops = append([]*Operation(nil), ops...)
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Call < ops[j].Call })
+	lines := make([]string, 0, len(ops))
+	for _, op := range ops {
+		lines = append(lines, op.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// kvStep applies the operation to the value of the key, it returns false if
+// the operation cannot happen on the value.
+func kvStep(state string, op *Operation) (bool, string) {
+	switch op.Kind {
+	case KvGet:
+		return op.Value == state, state
+	case KvPut:
+		return true, op.Value
+	case KvDelete:
+		return true, ""
+	}
+	return false, state
+}
+
+// entry is a call or return event of an operation in a doubly linked list.
+type entry struct {
+	id    int
+	op    *Operation
+	isRet bool
+	// match is the return entry of a call entry.
+	match      *entry
+	prev, next *entry
+}
+
+// lift removes the call and return entries of an operation from the list.
+func (e *entry) lift() {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	m := e.match
+	m.prev.next = m.next
+	if m.next != nil {
+		m.next.prev = m.prev
+	}
+}
+
+// unlift puts the entries removed by lift back.
+func (e *entry) unlift() {
+	m := e.match
+	m.prev.next = m
+	if m.next != nil {
+		m.next.prev = m
+	}
+	e.prev.next = e
+	e.next.prev = e
+}
+
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) bitset {
+	b[i/64] |= 1 << uint(i%64)
+	return b
+}
+
+func (b bitset) clear(i int) bitset {
+	b[i/64] &^= 1 << uint(i%64)
+	return b
+}
+
+func (b bitset) clone() bitset {
+	return append(bitset(nil), b...)
+}
+
+func (b bitset) equals(other bitset) bool {
+	for i := range b {
+		if b[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b bitset) hash() uint64 {
+	var h uint64 = 14695981039346656037
+	for _, v := range b {
+		h = (h ^ v) * 1099511628211
+	}
+	return h
+}
+
+type cacheEntry struct {
+	linearized bitset
+	state      string
+}
+
+type callFrame struct {
+	entry *entry
+	state string
+}
+
+func checkSingleKey(ops []*Operation) bool {
+	head := makeEntries(ops)
+	linearized := newBitset(len(ops))
+	cache := make(map[uint64][]cacheEntry)
+	var calls []callFrame
+	state := ""
+
+	e := head.next
+	for head.next != nil {
+		if !e.isRet {
+			ok, newState := kvStep(state, e.op)
+			if ok {
+				newLinearized := linearized.clone().set(e.id)
+				if !cacheContains(cache, newLinearized, newState) {
+					h := newLinearized.hash()
+					cache[h] = append(cache[h], cacheEntry{linearized: newLinearized, state: newState})
+					calls = append(calls, callFrame{entry: e, state: state})
+					state = newState
+					linearized.set(e.id)
+					e.lift()
+					e = head.next
+					continue
+				}
+			}
+			e = e.next
+			continue
+		}
+		// The operation returns before it is linearized, backtrack.
+		if len(calls) == 0 {
+			return false
+		}
+		top := calls[len(calls)-1]
+		calls = calls[:len(calls)-1]
+		state = top.state
+		linearized.clear(top.entry.id)
+		top.entry.unlift()
+		e = top.entry.next
+	}
+	return true
+}
+
+func cacheContains(cache map[uint64][]cacheEntry, linearized bitset, state string) bool {
+	for _, c := range cache[linearized.hash()] {
+		if c.state == state && c.linearized.equals(linearized) {
+			return true
+		}
+	}
+	return false
+}
+
+// makeEntries returns the head of the list of the call and return entries
+// ordered by time, the calls go first at the same time, since the operations
+// returning and being invoked at the same time are concurrent.
+func makeEntries(ops []*Operation) *entry {
+	entries := make([]*entry, 0, 2*len(ops))
+	for i, op := range ops {
+		call := &entry{id: i, op: op}
+		ret := &entry{id: i, op: op, isRet: true}
+		call.match = ret
+		entries = append(entries, call, ret)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		ti, tj := entries[i].time(), entries[j].time()
+		if ti != tj {
+			return ti < tj
+		}
+		return !entries[i].isRet && entries[j].isRet
+	})
+	head := &entry{id: -1}
+	prev := head
+	for _, e := range entries {
+		prev.next = e
+		e.prev = prev
+		prev = e
+	}
+	return head
+}
+
+func (e *entry) time() int64 {
+	if e.isRet {
+		return e.op.Return
+	}
+	return e.op.Call
+}
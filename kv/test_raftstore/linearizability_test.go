@@ -0,0 +1,162 @@
+package test_raftstore
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+)
+
+var seed = flag.Int64("seed", 0, "seed of the randomized fault injection tests, a random seed is used if it is 0")
+
+func TestCheckKvLinearizable(t *testing.T) {
+	op := func(kind KvOpKind, key, value string, call, ret int64) *Operation {
+		return &Operation{Kind: kind, Key: key, Value: value, Call: call, Return: ret}
+	}
+	unknown := func(kind KvOpKind, key, value string, call int64) *Operation {
+		return &Operation{Kind: kind, Key: key, Value: value, Call: call, Return: math.MaxInt64, Unknown: true}
+	}
+	testCases := []struct {
+		ops          []*Operation
+		linearizable bool
+	}{
+		// The get overlapping the put may see either value.
+		{[]*Operation{op(KvPut, "a", "1", 0, 10), op(KvGet, "a", "", 5, 8)}, true},
+		{[]*Operation{op(KvPut, "a", "1", 0, 10), op(KvGet, "a", "1", 5, 8)}, true},
+		// The get after the put must see it.
+		{[]*Operation{op(KvPut, "a", "1", 0, 10), op(KvGet, "a", "", 11, 12)}, false},
+		{[]*Operation{op(KvPut, "a", "1", 0, 10), op(KvDelete, "a", "", 11, 12), op(KvGet, "a", "", 13, 14)}, true},
+		// Two gets can't see the concurrent puts in different orders.
+		{[]*Operation{
+			op(KvPut, "a", "1", 0, 10), op(KvPut, "a", "2", 0, 10),
+			op(KvGet, "a", "1", 1, 2), op(KvGet, "a", "2", 3, 4), op(KvGet, "a", "1", 5, 6),
+		}, false},
+		// The keys are independent.
+		{[]*Operation{op(KvPut, "a", "1", 0, 10), op(KvGet, "b", "", 11, 12)}, true},
+		{[]*Operation{op(KvPut, "a", "1", 0, 1), op(KvGet, "b", "1", 2, 3)}, false},
+		// The put with unknown result may take effect at any time after it is invoked, or never.
+		{[]*Operation{unknown(KvPut, "a", "1", 0), op(KvGet, "a", "", 5, 6), op(KvGet, "a", "1", 7, 8)}, true},
+		{[]*Operation{unknown(KvPut, "a", "1", 0), op(KvGet, "a", "", 5, 6)}, true},
+		{[]*Operation{op(KvGet, "a", "1", 0, 1), unknown(KvPut, "a", "1", 2)}, false},
+		{[]*Operation{unknown(KvPut, "a", "1", 0), op(KvGet, "a", "1", 5, 6), op(KvGet, "a", "", 7, 8)}, false},
+	}
+	for i, c := range testCases {
+		err := CheckKvLinearizable(c.ops)
+		assert.Equal(t, c.linearizable, err == nil, "case %d: %v", i, err)
+	}
+}
+
+// kvClient runs the random raw KV operations on the keys and records them in the history.
+func kvClient(cluster *Cluster, history *History, r *LockedRand, id int, keys []string, done *int32) {
+	for i := 0; atomic.LoadInt32(done) == 0; i++ {
+		key := keys[r.Intn(len(keys))]
+		var op *Operation
+		var req *raft_cmdpb.Request
+		switch n := r.Intn(10); {
+		case n < 5:
+			op = history.Invoke(id, KvGet, key, "")
+			req = NewGetCfCmd(engine_util.CfDefault, []byte(key))
+		case n < 9:
+			value := fmt.Sprintf("%d-%d", id, i)
+			op = history.Invoke(id, KvPut, key, value)
+			req = NewPutCfCmd(engine_util.CfDefault, []byte(key), []byte(value))
+		default:
+			op = history.Invoke(id, KvDelete, key, "")
+			req = NewDeleteCfCmd(engine_util.CfDefault, []byte(key))
+		}
+		resp, _, err := cluster.TryRequest([]byte(key), []*raft_cmdpb.Request{req}, 2*time.Second)
+		switch {
+		case err == ErrRequestUnknown:
+			history.Unknown(op)
+		case err != nil:
+			// The request is not applied, so it is not in the history.
+		case op.Kind == KvGet:
+			history.Complete(op, string(resp.Responses[0].GetGet().GetValue()))
+		default:
+			history.Complete(op, "")
+		}
+		// Keep the history small enough to be checked.
+		time.Sleep(r.duration(10 * time.Millisecond))
+	}
+}
+
+// TestLinearizableWithFaults runs random raw KV operations on a cluster while the nemesis kills and restarts
+// stores, partitions the cluster and changes the peers of the regions, and the network drops, delays, reorders
+// and duplicates the messages. The history of the operations must be linearizable. The faults and operations
+// are chosen by the seed printed, run with `-seed` to reproduce them, though the interleaving of the goroutines
+// still differs between runs.
+func TestLinearizableWithFaults(t *testing.T) {
+	s := *seed
+	if s == 0 {
+		s = time.Now().UnixNano()
+	}
+	t.Logf("seed: %d", s)
+	r := NewLockedRand(s)
+
+	const (
+		nservers = 5
+		nclients = 5
+		duration = 5 * time.Second
+	)
+	keys := []string{"k0", "k1", "k2", "k3", "k4"}
+	cfg := newTestConfig()
+	cluster := NewTestCluster(nservers, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+	electionTimeout := cfg.RaftBaseTickInterval * time.Duration(cfg.RaftElectionTimeoutTicks)
+
+	cluster.AddFilter(&RandomDropFilter{Rand: NewLockedRand(r.Int63n(math.MaxInt64)), Rate: 0.05})
+	cluster.AddFilter(&DelayFilter{Rand: NewLockedRand(r.Int63n(math.MaxInt64)), Rate: 0.1, MaxDelay: 50 * time.Millisecond})
+	cluster.AddFilter(&ReorderFilter{Rand: NewLockedRand(r.Int63n(math.MaxInt64)), Rate: 0.05, Window: 10 * time.Millisecond})
+	cluster.AddFilter(&DuplicateFilter{Rand: NewLockedRand(r.Int63n(math.MaxInt64)), Rate: 0.05, MaxDelay: 50 * time.Millisecond})
+	nemesis := NewNemesis(cluster)
+	schedule := NewNemesisSchedule(NewLockedRand(r.Int63n(math.MaxInt64)), nservers, keys, duration, electionTimeout)
+
+	history := NewHistory()
+	done := int32(0)
+	var wg sync.WaitGroup
+	for i := 0; i < nclients; i++ {
+		wg.Add(1)
+		go func(id int, r *LockedRand) {
+			defer wg.Done()
+			kvClient(cluster, history, r, id, keys, &done)
+		}(i, NewLockedRand(r.Int63n(math.MaxInt64)))
+	}
+	stop := make(chan struct{})
+	nemesisDone := make(chan struct{})
+	go func() {
+		nemesis.Run(schedule, stop)
+		close(nemesisDone)
+	}()
+
+	time.Sleep(duration)
+	close(stop)
+	<-nemesisDone
+	atomic.StoreInt32(&done, 1)
+	wg.Wait()
+
+	// The cluster recovers once the faults are gone, every key can be read then.
+	cluster.ClearFilters()
+	nemesis.Heal()
+	for _, key := range keys {
+		op := history.Invoke(nclients, KvGet, key, "")
+		history.Complete(op, string(cluster.Get([]byte(key))))
+	}
+
+	ops := history.Operations()
+	unknown := 0
+	for _, op := range ops {
+		if op.Unknown {
+			unknown++
+		}
+	}
+	t.Logf("%d operations, %d unknown", len(ops), unknown)
+	assert.NoError(t, CheckKvLinearizable(ops))
+}
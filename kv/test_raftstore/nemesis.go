@@ -0,0 +1,161 @@
+package test_raftstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/log"
+)
+
+// NemesisKind is the kind of a fault injected by the nemesis.
+type NemesisKind int
+
+const (
+	NemesisKill NemesisKind = iota
+	NemesisRestart
+	NemesisPartition
+	NemesisHeal
+	// NemesisConfChange adds the store to the region of a key, or removes it
+	// if the region has a peer on it.
+	NemesisConfChange
+)
+
+// NemesisEvent is a fault injected at a time after the nemesis starts.
+type NemesisEvent struct {
+	At      time.Duration
+	Kind    NemesisKind
+	StoreID uint64
+	Groups  [][]uint64
+	Key     []byte
+}
+
+func (e NemesisEvent) String() string {
+	switch e.Kind {
+	case NemesisKill:
+		return fmt.Sprintf("%v kill store %d", e.At, e.StoreID)
+	case NemesisRestart:
+		return fmt.Sprintf("%v restart store %d", e.At, e.StoreID)
+	case NemesisPartition:
+		return fmt.Sprintf("%v partition %v", e.At, e.Groups)
+	case NemesisHeal:
+		return fmt.Sprintf("%v heal partition", e.At)
+	case NemesisConfChange:
+		return fmt.Sprintf("%v change peer of store %d in region of %q", e.At, e.StoreID, e.Key)
+	}
+	return fmt.Sprintf("%v unknown", e.At)
+}
+
+// NewNemesisSchedule generates the faults injected into a cluster of count
+// stores for the duration, an event about every interval. Less than half of
+// the stores are killed at the same time. The schedule only depends on the
+// random source, so it is reproduced by the same seed.
+func NewNemesisSchedule(r *LockedRand, count int, keys []string, duration, interval time.Duration) []NemesisEvent {
+	var events []NemesisEvent
+	down := make(map[uint64]bool)
+	partitioned := false
+	for at := r.duration(interval); at < duration; at += interval/2 + r.duration(interval) {
+		e := NemesisEvent{At: at, StoreID: uint64(r.Intn(count) + 1)}
+		switch r.Intn(3) {
+		case 0:
+			if down[e.StoreID] {
+				e.Kind = NemesisRestart
+				delete(down, e.StoreID)
+			} else if len(down)+1 <= (count-1)/2 {
+				e.Kind = NemesisKill
+				down[e.StoreID] = true
+			} else {
+				continue
+			}
+		case 1:
+			if partitioned {
+				e.Kind = NemesisHeal
+			} else {
+				e.Kind = NemesisPartition
+				e.Groups = make([][]uint64, 2)
+				for storeID := 1; storeID <= count; storeID++ {
+					i := r.Intn(2)
+					e.Groups[i] = append(e.Groups[i], uint64(storeID))
+				}
+			}
+			partitioned = !partitioned
+		case 2:
+			e.Kind = NemesisConfChange
+			e.Key = []byte(keys[r.Intn(len(keys))])
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// Nemesis injects the faults of a schedule into the cluster.
+type Nemesis struct {
+	cluster   *Cluster
+	partition *SwitchablePartitionFilter
+	down      map[uint64]bool
+}
+
+// NewNemesis creates a Nemesis, it installs a partition filter which is
+// switched by the schedule.
+func NewNemesis(cluster *Cluster) *Nemesis {
+	n := &Nemesis{
+		cluster:   cluster,
+		partition: &SwitchablePartitionFilter{},
+		down:      make(map[uint64]bool),
+	}
+	cluster.AddFilter(n.partition)
+	return n
+}
+
+// Run injects the faults at their times until stop is closed.
+func (n *Nemesis) Run(schedule []NemesisEvent, stop <-chan struct{}) {
+	start := time.Now()
+	for _, e := range schedule {
+		select {
+		case <-stop:
+			return
+		case <-time.After(time.Until(start.Add(e.At))):
+		}
+		log.Infof("nemesis: %v", e)
+		n.inject(e)
+	}
+	<-stop
+}
+
+func (n *Nemesis) inject(e NemesisEvent) {
+	switch e.Kind {
+	case NemesisKill:
+		n.cluster.StopServer(e.StoreID)
+		n.down[e.StoreID] = true
+	case NemesisRestart:
+		n.cluster.StartServer(e.StoreID)
+		delete(n.down, e.StoreID)
+	case NemesisPartition:
+		n.partition.Partition(e.Groups...)
+	case NemesisHeal:
+		n.partition.Partition()
+	case NemesisConfChange:
+		region, _, _ := n.cluster.pdClient.GetRegion(context.TODO(), e.Key)
+		if region == nil {
+			// No leader has reported the region yet.
+			return
+		}
+		if p := FindPeer(region, e.StoreID); p != nil {
+			// Keep 3 peers at least, so the region survives a killed store.
+			if len(region.GetPeers()) > 3 {
+				n.cluster.pdClient.RemovePeer(region.GetId(), p)
+			}
+		} else {
+			n.cluster.pdClient.AddPeer(region.GetId(), n.cluster.AllocPeer(e.StoreID))
+		}
+	}
+}
+
+// Heal restarts the killed stores and heals the partition.
+func (n *Nemesis) Heal() {
+	for storeID := range n.down {
+		n.cluster.StartServer(storeID)
+	}
+	n.down = make(map[uint64]bool)
+	n.partition.Partition()
+}
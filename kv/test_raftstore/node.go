@@ -8,8 +8,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/Connor1996/badger"
+	"github.com/golang/protobuf/proto"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
@@ -118,7 +119,17 @@ func (t *MockTransport) Send(msg *raft_serverpb.RaftMessage) error {
 	if !found {
 		return errors.New(fmt.Sprintf("store %d is closed", toStore))
 	}
-	router.SendRaftMessage(msg)
+	for i, delay := range t.deliveries(msg) {
+		m := msg
+		if i > 0 {
+			m = proto.Clone(msg).(*raft_serverpb.RaftMessage)
+		}
+		if delay == 0 {
+			router.SendRaftMessage(m)
+			continue
+		}
+		time.AfterFunc(delay, func() { t.deliver(toStore, m) })
+	}
 
 	for _, filter := range t.filters {
 		filter.After()
@@ -127,20 +138,56 @@ func (t *MockTransport) Send(msg *raft_serverpb.RaftMessage) error {
 	return nil
 }
 
+// deliveries returns the delays of the deliveries of the message decided by
+// the delivery filters, each filter applies to every delivery decided by the
+// previous ones.
+func (t *MockTransport) deliveries(msg *raft_serverpb.RaftMessage) []time.Duration {
+	delays := []time.Duration{0}
+	for _, filter := range t.filters {
+		f, ok := filter.(DeliveryFilter)
+		if !ok {
+			continue
+		}
+		var next []time.Duration
+		for _, d := range delays {
+			for _, e := range f.Deliveries(msg) {
+				next = append(next, d+e)
+			}
+		}
+		delays = next
+	}
+	return delays
+}
+
+// deliver sends a delayed message, it is lost if the store is stopped in the
+// meantime.
+func (t *MockTransport) deliver(storeID uint64, msg *raft_serverpb.RaftMessage) {
+	t.RLock()
+	defer t.RUnlock()
+	if router, found := t.routers[storeID]; found {
+		router.SendRaftMessage(msg)
+	}
+}
+
 type NodeSimulator struct {
 	sync.RWMutex
 
-	trans    *MockTransport
-	pdClient pd.Client
-	nodes    map[uint64]*raftstore.Node
+	trans        *MockTransport
+	pdClient     pd.Client
+	nodes        map[uint64]*raftstore.Node
+	batchSystems map[uint64]*raftstore.RaftBatchSystem
+	// The observers are kept across the restarts of the stores.
+	observers map[uint64]raftstore.CmdObserver
 }
 
 func NewNodeSimulator(pdClient pd.Client) *NodeSimulator {
 	trans := NewMockTransport()
 	return &NodeSimulator{
-		trans:    trans,
-		pdClient: pdClient,
-		nodes:    make(map[uint64]*raftstore.Node),
+		trans:        trans,
+		pdClient:     pdClient,
+		nodes:        make(map[uint64]*raftstore.Node),
+		batchSystems: make(map[uint64]*raftstore.RaftBatchSystem),
+		observers:    make(map[uint64]raftstore.CmdObserver),
 	}
 }
 
@@ -159,6 +206,8 @@ func (c *NodeSimulator) RunStore(cfg *config.Config, engine *engine_util.Engines
 
 	storeID := node.GetStoreID()
 	c.nodes[storeID] = node
+	c.batchSystems[storeID] = batchSystem
+	batchSystem.SetCmdObserver(c.observers[storeID])
 	c.trans.AddStore(storeID, raftRouter, snapManager)
 
 	return nil
@@ -174,9 +223,19 @@ func (c *NodeSimulator) StopStore(storeID uint64) {
 	}
 	node.Stop()
 	delete(c.nodes, storeID)
+	delete(c.batchSystems, storeID)
 	c.trans.RemoveStore(storeID)
 }
 
+func (c *NodeSimulator) SetCmdObserver(storeID uint64, observer raftstore.CmdObserver) {
+	c.Lock()
+	defer c.Unlock()
+	c.observers[storeID] = observer
+	if batchSystem := c.batchSystems[storeID]; batchSystem != nil {
+		batchSystem.SetCmdObserver(observer)
+	}
+}
+
 func (c *NodeSimulator) AddFilter(filter Filter) {
 	c.Lock()
 	defer c.Unlock()
@@ -199,13 +258,31 @@ func (c *NodeSimulator) GetStoreIds() []uint64 {
 	return storeIDs
 }
 
-func (c *NodeSimulator) CallCommandOnStore(storeID uint64, request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, *badger.Txn) {
+func (c *NodeSimulator) GetRouter(storeID uint64) *raftstore.RaftstoreRouter {
+	c.RLock()
+	defer c.RUnlock()
+	router, _ := c.trans.routers[storeID].(*raftstore.RaftstoreRouter)
+	return router
+}
+
+func (c *NodeSimulator) GetSSTImporter(storeID uint64) *importer.SSTImporter {
+	c.RLock()
+	defer c.RUnlock()
+	if batchSystem := c.batchSystems[storeID]; batchSystem != nil {
+		return batchSystem.SSTImporter()
+	}
+	return nil
+}
+
+func (c *NodeSimulator) CallCommandOnStore(storeID uint64, request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot) {
 	c.RLock()
 	router := c.trans.routers[storeID]
+	c.RUnlock()
 	if router == nil {
-		log.Fatalf("Can not find node %d", storeID)
+		// The store is stopped, it is unreachable just like a crashed store.
+		log.Warnf("Can not find node %d", storeID)
+		return nil, nil
 	}
-	c.RUnlock()
 
 	cb := message.NewCallback()
 	err := router.SendRaftCommand(request, cb)
@@ -214,5 +291,5 @@ func (c *NodeSimulator) CallCommandOnStore(storeID uint64, request *raft_cmdpb.R
 	}
 
 	resp := cb.WaitRespWithTimeout(timeout)
-	return resp, cb.Txn
+	return resp, cb.Snap
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/btree"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
@@ -13,6 +14,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
 	"github.com/pingcap/errors"
 )
 
@@ -89,6 +91,9 @@ type MockPDClient struct {
 	pendingPeers map[uint64]*metapb.Peer // peerID -> peer
 
 	bootstrapped bool
+
+	// the last timestamp allocated by GetTS
+	physical, logical int64
 }
 
 func NewMockPDClient(clusterID uint64, baseID uint64) *MockPDClient {
@@ -122,6 +127,22 @@ func (m *MockPDClient) AllocID(ctx context.Context) (uint64, error) {
 	return ret, nil
 }
 
+// GetTS allocates a timestamp which is greater than all allocated ones, the physical part is the current time in
+// milliseconds.
+func (m *MockPDClient) GetTS(ctx context.Context) (int64, int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	if now := time.Now().UnixNano() / int64(time.Millisecond); now > m.physical {
+		m.physical, m.logical = now, 0
+	} else {
+		m.logical++
+		if m.logical > tsoutil.LogicalBits {
+			m.physical, m.logical = m.physical+1, 0
+		}
+	}
+	return m.physical, m.logical, nil
+}
+
 func (m *MockPDClient) Bootstrap(ctx context.Context, store *metapb.Store) (*pdpb.BootstrapResponse, error) {
 	m.Lock()
 	defer m.Unlock()
@@ -164,6 +185,11 @@ func (m *MockPDClient) PutStore(ctx context.Context, store *metapb.Store) error
 	m.Lock()
 	defer m.Unlock()
 
+	if s, ok := m.stores[store.GetId()]; ok {
+		// Only update the meta, the running store keeps its heartbeat response handler.
+		s.store = *store
+		return nil
+	}
 	s := NewStore(store)
 	m.stores[store.GetId()] = s
 	return nil
@@ -218,6 +244,38 @@ func (m *MockPDClient) getRegionByIDLocked(regionID uint64) (*metapb.Region, *me
 	return region, leader, nil
 }
 
+// ScanRegions gets at most limit regions in order which intersect with [key, endKey), an empty endKey means the end of
+// the key space.
+func (m *MockPDClient) ScanRegions(ctx context.Context, key, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error) {
+	if err := m.checkBootstrap(); err != nil {
+		return nil, nil, err
+	}
+	m.RLock()
+	defer m.RUnlock()
+
+	var regions []*metapb.Region
+	var leaders []*metapb.Peer
+	visit := func(i btree.Item) bool {
+		region := i.(*regionItem).region
+		if len(endKey) > 0 && bytes.Compare(region.GetStartKey(), endKey) >= 0 {
+			return false
+		}
+		leader := m.leaders[region.GetId()]
+		if leader == nil {
+			leader = new(metapb.Peer)
+		}
+		regions = append(regions, &region)
+		leaders = append(leaders, leader)
+		return limit <= 0 || len(regions) < limit
+	}
+	if first := m.findRegion(key); first != nil {
+		m.regionsRange.AscendGreaterOrEqual(first, visit)
+	} else {
+		m.regionsRange.AscendGreaterOrEqual(&regionItem{region: metapb.Region{StartKey: key}}, visit)
+	}
+	return regions, leaders, nil
+}
+
 func (m *MockPDClient) AskBatchSplit(ctx context.Context, region *metapb.Region, count int) (*pdpb.AskBatchSplitResponse, error) {
 	resp := new(pdpb.AskBatchSplitResponse)
 	resp.Header = &pdpb.ResponseHeader{ClusterId: m.clusterID}
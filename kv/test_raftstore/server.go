@@ -0,0 +1,210 @@
+package test_raftstore
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/cdc"
+	"github.com/pingcap-incubator/tinykv/kv/importer"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/inner_server/raft_server"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/server"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PDClient returns the mock scheduler client of the cluster.
+func (c *Cluster) PDClient() *MockPDClient {
+	return c.pdClient
+}
+
+// ServeStores starts a TinyKv gRPC server for every store of the cluster on a random local port and registers the
+// address of the store to the mock scheduler, so clients can be tested against the cluster. The change data capture
+// API and the ImportSST service of the servers are enabled. The returned function
+// stops the servers after the pending requests finish, it should be called before the cluster shuts down.
+func (c *Cluster) ServeStores() (stop func(), err error) {
+	var servers []*grpc.Server
+	stop = func() {
+		for _, s := range servers {
+			s.GracefulStop()
+		}
+	}
+	for storeID := range c.engines {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			stop()
+			return nil, errors.WithStack(err)
+		}
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(c.unavailableIfStopped(storeID)))
+		innerServer := &storeInnerServer{cluster: c, storeID: storeID}
+		kvServer := server.NewServer(innerServer)
+		endpoint := cdc.NewEndpoint(c.pdClient, c.cfg.ResolvedTsInterval)
+		c.simulator.SetCmdObserver(storeID, endpoint)
+		kvServer.SetCDCEndpoint(endpoint)
+		tinykvpb.RegisterTinyKvServer(grpcServer, kvServer)
+		import_sstpb.RegisterImportSSTServer(grpcServer, server.NewImportSSTServer(innerServer))
+		go grpcServer.Serve(lis)
+		servers = append(servers, grpcServer)
+
+		store := &metapb.Store{Id: storeID, Address: lis.Addr().String()}
+		if err := c.pdClient.PutStore(context.TODO(), store); err != nil {
+			stop()
+			return nil, err
+		}
+	}
+	return stop, nil
+}
+
+// unavailableIfStopped fails the requests to a stopped store as if the store is unreachable.
+func (c *Cluster) unavailableIfStopped(storeID uint64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, id := range c.simulator.GetStoreIds() {
+			if id == storeID {
+				return handler(ctx, req)
+			}
+		}
+		return nil, status.Errorf(codes.Unavailable, "store %d is stopped", storeID)
+	}
+}
+
+// storeInnerServer is an InnerServer which sends the requests to the raftstore of a store in the cluster.
+type storeInnerServer struct {
+	cluster *Cluster
+	storeID uint64
+}
+
+var (
+	_ inner_server.InnerServer   = new(storeInnerServer)
+	_ inner_server.StaleReader   = new(storeInnerServer)
+	_ inner_server.ReplicaReader = new(storeInnerServer)
+	_ inner_server.RegionManager = new(storeInnerServer)
+	_ inner_server.SSTIngester   = new(storeInnerServer)
+)
+
+func (s *storeInnerServer) Start() error {
+	return nil
+}
+
+func (s *storeInnerServer) Stop() error {
+	return nil
+}
+
+func (s *storeInnerServer) call(ctx *kvrpcpb.Context, reqs []*raft_cmdpb.Request) (*raft_cmdpb.RaftCmdResponse, engine_util.Snapshot, error) {
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    ctx.RegionId,
+			Peer:        ctx.Peer,
+			RegionEpoch: ctx.RegionEpoch,
+			Term:        ctx.Term,
+		},
+		Requests: reqs,
+	}
+	resp, snap := s.cluster.simulator.CallCommandOnStore(s.storeID, request, time.Second)
+	if resp == nil {
+		return nil, nil, errors.Errorf("request to store %d timeout", s.storeID)
+	}
+	if resp.Header.Error != nil {
+		if snap != nil {
+			snap.Discard()
+		}
+		return nil, nil, &raft_server.RegionError{RequestErr: resp.Header.Error}
+	}
+	return resp, snap, nil
+}
+
+func (s *storeInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error {
+	var reqs []*raft_cmdpb.Request
+	for _, m := range batch {
+		switch m.Type {
+		case inner_server.ModifyTypePut:
+			put := m.Data.(inner_server.Put)
+			reqs = append(reqs, NewPutCfCmd(put.Cf, put.Key, put.Value))
+		case inner_server.ModifyTypeDelete:
+			delete := m.Data.(inner_server.Delete)
+			reqs = append(reqs, NewDeleteCfCmd(delete.Cf, delete.Key))
+		case inner_server.ModifyTypeDeleteRange:
+			deleteRange := m.Data.(inner_server.DeleteRange)
+			reqs = append(reqs, NewDeleteRangeCfCmd(deleteRange.Cf, deleteRange.StartKey, deleteRange.EndKey))
+		}
+	}
+	_, _, err := s.call(ctx, reqs)
+	return err
+}
+
+func (s *storeInnerServer) Reader(ctx *kvrpcpb.Context) (inner_server.DBReader, error) {
+	resp, snap, err := s.call(ctx, []*raft_cmdpb.Request{NewSnapCmd()})
+	if err != nil {
+		return nil, err
+	}
+	return raft_server.NewRegionReader(snap, *resp.Responses[0].GetSnap().Region), nil
+}
+
+func (s *storeInnerServer) StaleReader(ctx *kvrpcpb.Context, ts uint64) (inner_server.DBReader, error) {
+	snapCmd := NewSnapCmd()
+	snapCmd.Snap.StaleReadTs = ts
+	resp, snap, err := s.call(ctx, []*raft_cmdpb.Request{snapCmd})
+	if err != nil {
+		return nil, err
+	}
+	return raft_server.NewRegionReader(snap, *resp.Responses[0].GetSnap().Region), nil
+}
+
+func (s *storeInnerServer) ReplicaReader(ctx *kvrpcpb.Context) (inner_server.DBReader, error) {
+	snapCmd := NewSnapCmd()
+	snapCmd.Snap.ReplicaRead = true
+	resp, snap, err := s.call(ctx, []*raft_cmdpb.Request{snapCmd})
+	if err != nil {
+		return nil, err
+	}
+	return raft_server.NewRegionReader(snap, *resp.Responses[0].GetSnap().Region), nil
+}
+
+func (s *storeInnerServer) router() (*raftstore.RaftstoreRouter, error) {
+	router := s.cluster.simulator.GetRouter(s.storeID)
+	if router == nil {
+		return nil, errors.Errorf("store %d is stopped", s.storeID)
+	}
+	return router, nil
+}
+
+func (s *storeInnerServer) LeaderRegions() ([]inner_server.LeaderRegion, error) {
+	router, err := s.router()
+	if err != nil {
+		return nil, err
+	}
+	return raft_server.LeaderRegions(router)
+}
+
+func (s *storeInnerServer) SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error) {
+	router, err := s.router()
+	if err != nil {
+		return nil, err
+	}
+	return raft_server.SplitRegion(router, ctx, splitKeys)
+}
+
+func (s *storeInnerServer) SSTImporter() (*importer.SSTImporter, error) {
+	importer := s.cluster.simulator.GetSSTImporter(s.storeID)
+	if importer == nil {
+		return nil, errors.Errorf("store %d is stopped", s.storeID)
+	}
+	return importer, nil
+}
+
+func (s *storeInnerServer) IngestSST(ctx *kvrpcpb.Context, sst *import_sstpb.SSTMeta) error {
+	router, err := s.router()
+	if err != nil {
+		return err
+	}
+	return raft_server.IngestSST(router, ctx, sst)
+}
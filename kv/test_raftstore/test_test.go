@@ -2,6 +2,7 @@ package test_raftstore
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -10,7 +11,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
@@ -19,6 +19,21 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var engine = flag.String("engine", config.EngineBadger, "storage engine of the test cluster, badger or memory")
+
+// newTestConfig returns a test config which uses the storage engine chosen by the -engine flag, so the whole suite
+// can run against any engine, e.g. `go test ./kv/test_raftstore -engine=memory`.
+func newTestConfig() *config.Config {
+	cfg := config.NewTestConfig()
+	cfg.Engine = *engine
+	if cfg.Engine == config.EngineMemory {
+		// The memory engine appends entries much faster than badger, so the raft log is compacted at every raft tick
+		// to stay within the limit checked by the tests.
+		cfg.RaftLogGCTickInterval = cfg.RaftBaseTickInterval
+	}
+	return cfg
+}
+
 // a client runs the function f and then signals it is done
 func run_client(t *testing.T, me int, ca chan bool, fn func(me int, t *testing.T)) {
 	ok := false
@@ -178,7 +193,7 @@ func GenericTest(t *testing.T, part string, nclients int, unreliable bool, crash
 	title = title + " (" + part + ")" // 3A or 3B
 
 	nservers := 5
-	cfg := config.NewTestConfig()
+	cfg := newTestConfig()
 	if maxraftlog != -1 {
 		cfg.RaftLogGcCountLimit = uint64(maxraftlog)
 	}
@@ -306,7 +321,7 @@ func GenericTest(t *testing.T, part string, nclients int, unreliable bool, crash
 				}
 				for _, engine := range cluster.engines {
 					state, err := meta.GetApplyState(engine.Kv, region.GetId())
-					if err == badger.ErrKeyNotFound {
+					if err == engine_util.ErrKeyNotFound {
 						continue
 					}
 					if err != nil {
@@ -354,7 +369,7 @@ func GenericTest(t *testing.T, part string, nclients int, unreliable bool, crash
 // doesn't go through until the partition heals.  The leader in the original
 // network ends up in the minority partition.
 func TestOnePartition2B(t *testing.T) {
-	cfg := config.NewTestConfig()
+	cfg := newTestConfig()
 	cluster := NewTestCluster(5, cfg)
 	cluster.Start()
 	defer cluster.Shutdown()
@@ -440,7 +455,7 @@ func TestPersistPartitionUnreliable2B(t *testing.T) {
 }
 
 func TestOneSnapshot2B(t *testing.T) {
-	cfg := config.NewTestConfig()
+	cfg := newTestConfig()
 	cfg.RaftLogGcCountLimit = 10
 	cluster := NewTestCluster(3, cfg)
 	cluster.Start()
@@ -528,7 +543,7 @@ func TestSnapshotUnreliableRecoverConcurrentPartition2B(t *testing.T) {
 }
 
 func TestBasicConfChange3B(t *testing.T) {
-	cfg := config.NewTestConfig()
+	cfg := newTestConfig()
 	cluster := NewTestCluster(5, cfg)
 	cluster.Start()
 	defer cluster.Shutdown()
@@ -619,7 +634,7 @@ func TestConfChangeSnapshotUnreliableRecoverConcurrentPartition3B(t *testing.T)
 }
 
 func TestOneSplit(t *testing.T) {
-	cfg := config.NewTestConfig()
+	cfg := newTestConfig()
 	cfg.RegionMaxSize = 800
 	cfg.RegionSplitSize = 500
 	cluster := NewTestCluster(5, cfg)
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/log"
@@ -75,6 +74,19 @@ func NewDeleteCfCmd(cf string, key []byte) *raft_cmdpb.Request {
 	return cmd
 }
 
+func NewDeleteRangeCfCmd(cf string, startKey, endKey []byte) *raft_cmdpb.Request {
+	deleteRange := &raft_cmdpb.DeleteRangeRequest{
+		Cf:       cf,
+		StartKey: startKey,
+		EndKey:   endKey,
+	}
+	cmd := &raft_cmdpb.Request{
+		CmdType:     raft_cmdpb.CmdType_DeleteRange,
+		DeleteRange: deleteRange,
+	}
+	return cmd
+}
+
 func NewSnapCmd() *raft_cmdpb.Request {
 	cmd := &raft_cmdpb.Request{
 		CmdType: raft_cmdpb.CmdType_Snap,
@@ -116,7 +128,7 @@ func MustGetCfNone(engine *engine_util.Engines, cf string, key []byte) {
 	var err error
 	for i := 0; i < 300; i++ {
 		val, err = engine_util.GetCF(engine.Kv, cf, key)
-		if err == badger.ErrKeyNotFound {
+		if err == engine_util.ErrKeyNotFound {
 			return
 		}
 		SleepMS(20)
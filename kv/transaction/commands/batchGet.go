@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+type BatchGet struct {
+	ReadOnly
+	CommandBase
+	request *kvrpcpb.BatchGetRequest
+}
+
+func NewBatchGet(request *kvrpcpb.BatchGetRequest) BatchGet {
+	return BatchGet{
+		CommandBase: CommandBase{
+			context: request.Context,
+		},
+		request: request,
+	}
+}
+
+func (bg *BatchGet) readTS() uint64 {
+	return bg.request.Version
+}
+
+func (bg *BatchGet) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	txn.StartTS = &bg.request.Version
+	response := new(kvrpcpb.BatchGetResponse)
+
+	for _, key := range bg.request.Keys {
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return regionErrorRo(err, response)
+		}
+		pair := &kvrpcpb.KvPair{Key: key}
+		if lock.IsLockedFor(key, *txn.StartTS, pair) {
+			// A locked key is returned with its lock so the client can resolve it, the other keys are still read.
+			response.Pairs = append(response.Pairs, pair)
+			continue
+		}
+
+		value, err := txn.FindWrittenValue(key, *txn.StartTS)
+		if err != nil {
+			return regionErrorRo(err, response)
+		}
+		if value != nil {
+			pair.Value = value
+			response.Pairs = append(response.Pairs, pair)
+		}
+	}
+	return response, nil, nil
+}
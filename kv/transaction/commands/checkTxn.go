@@ -33,7 +33,7 @@ func (c *CheckTxnStatus) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 		if physical(lock.Ts)+lock.Ttl < physical(c.request.CurrentTs) {
 			// Lock has expired, roll it back.
 			write := mvcc.Write{StartTS: *txn.StartTS, Kind: mvcc.WriteKindRollback}
-			if lock.Kind == mvcc.WriteKindPut {
+			if lock.Kind == mvcc.WriteKindPut && lock.ShortValue == nil {
 				txn.DeleteValue(key)
 			}
 			txn.PutWrite(key, &write, *txn.StartTS)
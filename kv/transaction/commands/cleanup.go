@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+type Cleanup struct {
+	CommandBase
+	request *kvrpcpb.CleanupRequest
+}
+
+func NewCleanup(request *kvrpcpb.CleanupRequest) Cleanup {
+	return Cleanup{
+		CommandBase: CommandBase{
+			context: request.Context,
+		},
+		request: request,
+	}
+}
+
+func (c *Cleanup) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	txn.StartTS = &c.request.StartVersion
+	key := c.request.Key
+	response := new(kvrpcpb.CleanupResponse)
+
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return regionError(err, response)
+	}
+	if lock != nil && lock.Ts == *txn.StartTS {
+		if c.request.CurrentTs != 0 && physical(lock.Ts)+lock.Ttl >= physical(c.request.CurrentTs) {
+			// The lock is still alive, leave it alone.
+			response.Error = &kvrpcpb.KeyError{Locked: lock.Info(key)}
+			return response, nil
+		}
+	} else {
+		existingWrite, commitTs, err := txn.FindWrite(key, *txn.StartTS)
+		if err != nil {
+			return regionError(err, response)
+		}
+		if existingWrite != nil && existingWrite.Kind != mvcc.WriteKindRollback {
+			// The key has already been committed, it can't be rolled back.
+			response.CommitVersion = commitTs
+			response.Error = &kvrpcpb.KeyError{
+				Abort: fmt.Sprintf("key has already been committed: %v at %d", key, commitTs),
+			}
+			return response, nil
+		}
+	}
+
+	resp, err := rollbackKey(key, txn, response)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	return response, nil
+}
+
+func (c *Cleanup) WillWrite() [][]byte {
+	return [][]byte{c.request.Key}
+}
@@ -33,7 +33,7 @@ func RunCommand(cmd Command, innerServer inner_server.InnerServer, latches *latc
 	keysToWrite := cmd.WillWrite()
 	if keysToWrite == nil {
 		// The command is readonly or requires access to the DB to determine the keys it will write.
-		reader, err := innerServer.Reader(ctxt)
+		reader, err := readOnlyReader(cmd, innerServer)
 		if err != nil {
 			return nil, err
 		}
@@ -76,6 +76,31 @@ func RunCommand(cmd Command, innerServer inner_server.InnerServer, latches *latc
 	return resp, nil
 }
 
+// staleReadable is implemented by the readonly commands which can be served by a stale read at their read ts.
+type staleReadable interface {
+	readTS() uint64
+}
+
+// readOnlyReader returns the reader for the readonly part of cmd. A command which asks for a stale read reads the local
+// data of the replica at its read ts, and a command which asks for a replica read reads the local data of the replica
+// after it applies the read index from the leader, if the inner server supports them.
+func readOnlyReader(cmd Command, innerServer inner_server.InnerServer) (inner_server.DBReader, error) {
+	ctxt := cmd.Context()
+	if ctxt.GetReplicaRead() {
+		if replicaReader, ok := innerServer.(inner_server.ReplicaReader); ok {
+			return replicaReader.ReplicaReader(ctxt)
+		}
+	}
+	if ctxt.GetStaleRead() {
+		staleCmd, ok1 := cmd.(staleReadable)
+		staleReader, ok2 := innerServer.(inner_server.StaleReader)
+		if ok1 && ok2 {
+			return staleReader.StaleReader(ctxt, staleCmd.readTS())
+		}
+	}
+	return innerServer.Reader(ctxt)
+}
+
 // CommandBase provides some default function implementations for the Command interface.
 type CommandBase struct {
 	context *kvrpcpb.Context
@@ -106,7 +131,7 @@ func (ro ReadOnly) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 // nil and the error.
 func regionError(err error, resp interface{}) (interface{}, error) {
 	if regionErr, ok := err.(*raft_server.RegionError); ok {
-		respValue := reflect.ValueOf(resp)
+		respValue := reflect.Indirect(reflect.ValueOf(resp))
 		respValue.FieldByName("RegionError").Set(reflect.ValueOf(regionErr.RequestErr))
 		return resp, nil
 	}
@@ -71,7 +71,7 @@ func commitKey(key []byte, commitTs uint64, txn *mvcc.MvccTxn, response interfac
 	}
 
 	// Commit a Write object to the DB
-	write := mvcc.Write{StartTS: *txn.StartTS, Kind: lock.Kind}
+	write := mvcc.Write{StartTS: *txn.StartTS, Kind: lock.Kind, ShortValue: lock.ShortValue}
 	txn.PutWrite(key, &write, commitTs)
 	// Unlock the key
 	txn.DeleteLock(key)
@@ -20,6 +20,10 @@ func NewGet(request *kvrpcpb.GetRequest) Get {
 	}
 }
 
+func (g *Get) readTS() uint64 {
+	return g.request.Version
+}
+
 func (g *Get) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 	key := g.request.Key
 	txn.StartTS = &g.request.Version
@@ -75,19 +75,55 @@ func (p *Prewrite) prewriteMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*
 		}
 	}
 
-	// Write a lock and value.
+	if keyError, err := p.checkAssertion(txn, mut); keyError != nil || err != nil {
+		return keyError, err
+	}
+
+	// Write a lock and value, only a put has a value. A short value is inlined in the lock, which passes it on to the
+	// write at commit, instead of being written to the default CF.
 	lock := mvcc.Lock{
 		Primary: p.request.PrimaryLock,
 		Ts:      *txn.StartTS,
 		Kind:    mvcc.WriteKindFromProto(mut.Op),
 		Ttl:     p.request.LockTtl,
 	}
+	if mut.Op == kvrpcpb.Op_Put && mvcc.IsShortValue(mut.Value) {
+		lock.ShortValue = append([]byte{}, mut.Value...)
+	}
 	txn.PutLock(key, &lock)
-	txn.PutValue(key, mut.Value)
+	if mut.Op == kvrpcpb.Op_Put && lock.ShortValue == nil {
+		txn.PutValue(key, mut.Value)
+	}
 
 	return nil, nil
 }
 
+// checkAssertion checks the assertion of mut against the latest committed write of its key, it returns a key error if
+// the assertion does not hold.
+func (p *Prewrite) checkAssertion(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*kvrpcpb.KeyError, error) {
+	if mut.Assertion == kvrpcpb.Assertion_None {
+		return nil, nil
+	}
+	write, commitTs, err := txn.LatestValueWrite(mut.Key, mvcc.TsMax)
+	if err != nil {
+		return nil, err
+	}
+	exists := write != nil && write.Kind == mvcc.WriteKindPut
+	if exists == (mut.Assertion == kvrpcpb.Assertion_Exist) {
+		return nil, nil
+	}
+	failed := &kvrpcpb.AssertionFailed{
+		StartTs:   *txn.StartTS,
+		Key:       mut.Key,
+		Assertion: mut.Assertion,
+	}
+	if write != nil {
+		failed.ExistingStartTs = write.StartTS
+		failed.ExistingCommitTs = commitTs
+	}
+	return &kvrpcpb.KeyError{AssertionFailed: failed}, nil
+}
+
 func (p *Prewrite) WillWrite() [][]byte {
 	result := [][]byte{}
 	for _, m := range p.request.Mutations {
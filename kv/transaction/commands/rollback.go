@@ -69,7 +69,7 @@ func rollbackKey(key []byte, txn *mvcc.MvccTxn, response interface{}) (interface
 		}
 	}
 
-	if lock.Kind == mvcc.WriteKindPut {
+	if lock.Kind == mvcc.WriteKindPut && lock.ShortValue == nil {
 		txn.DeleteValue(key)
 	}
 
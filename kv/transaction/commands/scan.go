@@ -21,11 +21,16 @@ func NewScan(request *kvrpcpb.ScanRequest) Scan {
 	return result
 }
 
+func (s *Scan) readTS() uint64 {
+	return s.request.Version
+}
+
 func (s *Scan) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 	txn.StartTS = &s.request.Version
 	response := new(kvrpcpb.ScanResponse)
 
 	scanner := mvcc.NewScanner(s.request.StartKey, txn)
+	defer scanner.Close()
 	limit := s.request.Limit
 	for {
 		if limit == 0 {
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+type ScanLock struct {
+	ReadOnly
+	CommandBase
+	request *kvrpcpb.ScanLockRequest
+}
+
+func NewScanLock(request *kvrpcpb.ScanLockRequest) ScanLock {
+	return ScanLock{
+		CommandBase: CommandBase{
+			context: request.Context,
+		},
+		request: request,
+	}
+}
+
+func (sl *ScanLock) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	response := new(kvrpcpb.ScanLockResponse)
+	limit := int(sl.request.Limit)
+
+	iter := txn.Reader.IterCF(engine_util.CfLock)
+	defer iter.Close()
+	for iter.Seek(sl.request.StartKey); iter.Valid(); iter.Next() {
+		if limit != 0 && len(response.Locks) >= limit {
+			break
+		}
+		item := iter.Item()
+		if engine_util.ExceedEndKey(item.Key(), sl.request.EndKey) {
+			break
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return regionErrorRo(err, response)
+		}
+		lock, err := mvcc.ParseLock(val)
+		if err != nil {
+			return regionErrorRo(err, response)
+		}
+		if lock.Ts <= sl.request.MaxVersion {
+			response.Locks = append(response.Locks, lock.Info(item.KeyCopy(nil)))
+		}
+	}
+	return response, nil, nil
+}
@@ -263,10 +263,9 @@ func TestSinglePrewrite4A(t *testing.T) {
 
 	assert.Empty(t, resp.Errors)
 	assert.Nil(t, resp.RegionError)
-	builder.assertLens(1, 1, 0)
+	builder.assertLens(0, 1, 0)
 	builder.assert([]kv{
-		{cf: engine_util.CfDefault, key: []byte{3}, value: []byte{42}},
-		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 3, 232}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 118, 1, 42, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 3, 232}},
 	})
 }
 
@@ -281,10 +280,9 @@ func TestPrewriteLocked4A(t *testing.T) {
 	assert.Nil(t, resps[0].(*kvrpcpb.PrewriteResponse).RegionError)
 	assert.Equal(t, len(resps[1].(*kvrpcpb.PrewriteResponse).Errors), 1)
 	assert.Nil(t, resps[1].(*kvrpcpb.PrewriteResponse).RegionError)
-	builder.assertLens(1, 1, 0)
+	builder.assertLens(0, 1, 0)
 	builder.assert([]kv{
-		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
-		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 118, 1, 42, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
 	})
 }
 
@@ -321,12 +319,11 @@ func TestPrewriteWrittenNoConflict4A(t *testing.T) {
 	assert.Empty(t, resp.Errors)
 	assert.Nil(t, resp.RegionError)
 	assert.Nil(t, resp.RegionError)
-	builder.assertLens(2, 1, 1)
+	builder.assertLens(1, 1, 1)
 
 	builder.assert([]kv{
 		{cf: engine_util.CfDefault, key: []byte{3}, value: []byte{5}, ts: 80},
-		{cf: engine_util.CfDefault, key: []byte{3}, value: []byte{42}},
-		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 118, 1, 42, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
 	})
 }
 
@@ -341,13 +338,11 @@ func TestMultiplePrewrites4A(t *testing.T) {
 	assert.Nil(t, resps[0].(*kvrpcpb.PrewriteResponse).RegionError)
 	assert.Empty(t, resps[1].(*kvrpcpb.PrewriteResponse).Errors)
 	assert.Nil(t, resps[1].(*kvrpcpb.PrewriteResponse).RegionError)
-	builder.assertLens(2, 2, 0)
+	builder.assertLens(0, 2, 0)
 
 	builder.assert([]kv{
-		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
-		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
-		{cf: engine_util.CfDefault, key: []byte{4}, ts: 101, value: []byte{53}},
-		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 118, 1, 42, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 118, 1, 53, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 0}},
 	})
 }
 
@@ -359,11 +354,10 @@ func TestPrewriteOverwrite4A(t *testing.T) {
 
 	assert.Empty(t, resp.Errors)
 	assert.Nil(t, resp.RegionError)
-	builder.assertLens(1, 1, 0)
+	builder.assertLens(0, 1, 0)
 
 	builder.assert([]kv{
-		{cf: engine_util.CfDefault, key: []byte{3}, value: []byte{45}},
-		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 118, 1, 45, 0, 3, 129, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
 	})
 }
 
@@ -382,10 +376,10 @@ func TestPrewriteMultiple4A(t *testing.T) {
 
 	assert.Empty(t, resp.Errors)
 	assert.Nil(t, resp.RegionError)
-	builder.assertLens(4, 4, 0)
+	builder.assertLens(0, 4, 0)
 
 	builder.assert([]kv{
-		{cf: engine_util.CfDefault, key: []byte{4}, value: []byte{1, 3, 5}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 118, 3, 1, 3, 5, 0, 5, 129, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
 	})
 }
 
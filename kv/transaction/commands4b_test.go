@@ -427,6 +427,114 @@ func TestScanDeleted4B(t *testing.T) {
 	assert.Equal(t, []byte{64}, resp3.Pairs[1].Value)
 }
 
+// TestScanRolledBack4B scan over a value whose latest write is a rollback.
+func TestScanRolledBack4B(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 99, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfWrite, []byte{1}, 102, []byte{3, 0, 0, 0, 0, 0, 0, 0, 102}},
+		{engine_util.CfDefault, []byte{2}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{2}, 99, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+
+	req := builder.scanRequest([]byte{1}, 10000)
+	req.Version = 110
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{50}, resp.Pairs[0].Value)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+}
+
+// TestScanMultipleVersions4B tests that a scan returns only the latest visible version of a key.
+func TestScanMultipleVersions4B(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{49}},
+		{engine_util.CfWrite, []byte{1}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfDefault, []byte{1}, 90, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 95, []byte{1, 0, 0, 0, 0, 0, 0, 0, 90}},
+		{engine_util.CfDefault, []byte{2}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{2}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+
+	req := builder.scanRequest([]byte{1}, 10000)
+	req.Version = 110
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{50}, resp.Pairs[0].Value)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+	assert.Equal(t, []byte{51}, resp.Pairs[1].Value)
+}
+
+// TestScanLockedUnwritten4B tests that a scan is blocked by the lock of a key which has never been written.
+func TestScanLockedUnwritten4B(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{engine_util.CfDefault, []byte{3}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{3}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+
+	req := builder.scanRequest([]byte{1}, 2)
+	req.Version = 110
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Error.Locked.Key)
+
+	// The locked key is reported once, and the scan goes on after it.
+	req = builder.scanRequest([]byte{1}, 10)
+	req.Version = 110
+	resp = builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 3, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Error.Locked.Key)
+	assert.Equal(t, []byte{3}, resp.Pairs[2].Key)
+	assert.Equal(t, []byte{51}, resp.Pairs[2].Value)
+
+	// The lock of a later transaction does not block the scan.
+	req = builder.scanRequest([]byte{1}, 10000)
+	req.Version = 90
+	resp = builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{3}, resp.Pairs[1].Key)
+}
+
+// TestScanLockedWritten4B tests that the lock of a key which has been written is reported once, and the scan goes on
+// after the key.
+func TestScanLockedWritten4B(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfDefault, []byte{2}, 80, []byte{52}},
+		{engine_util.CfWrite, []byte{2}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{engine_util.CfDefault, []byte{3}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{3}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+
+	req := builder.scanRequest([]byte{1}, 10)
+	req.Version = 110
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 3, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Error.Locked.Key)
+	assert.Equal(t, []byte{3}, resp.Pairs[2].Key)
+	assert.Equal(t, []byte{51}, resp.Pairs[2].Value)
+}
+
 func builderForScan(t *testing.T) *testBuilder {
 	values := []kv{
 		// Committed before 100.
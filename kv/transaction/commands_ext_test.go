@@ -0,0 +1,288 @@
+package transaction
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatchGet tests a batch get of committed, locked and missing keys.
+func TestBatchGet(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfDefault, []byte{2}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{2}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		// Deleted at 95.
+		{engine_util.CfWrite, []byte{2}, 95, []byte{2, 0, 0, 0, 0, 0, 0, 0, 90}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	resp := builder.runOneRequest(&kvrpcpb.BatchGetRequest{Keys: [][]byte{{1}, {2}, {3}, {4}}, Version: 110}).(*kvrpcpb.BatchGetResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{50}, resp.Pairs[0].Value)
+	assert.Nil(t, resp.Pairs[0].Error)
+	assert.Equal(t, []byte{3}, resp.Pairs[1].Error.Locked.Key)
+
+	// Every key is read at the version.
+	resp = builder.runOneRequest(&kvrpcpb.BatchGetRequest{Keys: [][]byte{{1}, {2}, {3}}, Version: 90}).(*kvrpcpb.BatchGetResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Pairs))
+	assert.Equal(t, []byte{50}, resp.Pairs[0].Value)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+	assert.Equal(t, []byte{51}, resp.Pairs[1].Value)
+}
+
+// TestScanLock tests listing the locks of a range up to a version.
+func TestScanLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 2, 0, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 5}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{4, 1, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	resp := builder.runOneRequest(&kvrpcpb.ScanLockRequest{MaxVersion: 90}).(*kvrpcpb.ScanLockResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 3, len(resp.Locks))
+	assert.Equal(t, &kvrpcpb.LockInfo{PrimaryLock: []byte{1}, LockVersion: 80, Key: []byte{3}, LockTtl: 5}, resp.Locks[1])
+	assert.Equal(t, []byte{4}, resp.Locks[2].Key)
+
+	resp = builder.runOneRequest(&kvrpcpb.ScanLockRequest{MaxVersion: 100, StartKey: []byte{2}, EndKey: []byte{4}}).(*kvrpcpb.ScanLockResponse)
+	assert.Equal(t, 2, len(resp.Locks))
+	assert.Equal(t, []byte{2}, resp.Locks[0].Key)
+	assert.Equal(t, []byte{3}, resp.Locks[1].Key)
+
+	resp = builder.runOneRequest(&kvrpcpb.ScanLockRequest{MaxVersion: 100, StartKey: []byte{2}, Limit: 1}).(*kvrpcpb.ScanLockResponse)
+	assert.Equal(t, 1, len(resp.Locks))
+	assert.Equal(t, []byte{2}, resp.Locks[0].Key)
+}
+
+// TestCleanupExpired tests cleaning up a lock whose ttl has expired.
+func TestCleanupExpired(t *testing.T) {
+	builder := newBuilder(t)
+	req := builder.cleanupRequest([]byte{3})
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: req.StartVersion, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 5, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 8}},
+	})
+	resp := builder.runOneRequest(req).(*kvrpcpb.CleanupResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	builder.assertLens(0, 0, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: req.StartVersion, value: []byte{3, 0, 0, 5, 0, 0, 0, 0, builder.ts()}},
+	})
+}
+
+// TestCleanupAlive tests that a live lock is returned instead of being cleaned up, unless there is no current ts.
+func TestCleanupAlive(t *testing.T) {
+	builder := newBuilder(t)
+	req := builder.cleanupRequest([]byte{3})
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: req.StartVersion, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 5, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 1, 0, 0, 0, 8}},
+	})
+	resp := builder.runOneRequest(req).(*kvrpcpb.CleanupResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, []byte{3}, resp.Error.Locked.Key)
+	builder.assertLens(1, 1, 0)
+
+	req.CurrentTs = 0
+	resp = builder.runOneRequest(req).(*kvrpcpb.CleanupResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	builder.assertLens(0, 0, 1)
+}
+
+// TestCleanupCommitted tests that a committed key is not rolled back.
+func TestCleanupCommitted(t *testing.T) {
+	builder := newBuilder(t)
+	req := builder.cleanupRequest([]byte{3})
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: req.StartVersion, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: req.StartVersion + 1, value: []byte{1, 0, 0, 5, 0, 0, 0, 0, builder.ts()}},
+	})
+	resp := builder.runOneRequest(req).(*kvrpcpb.CleanupResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.NotEmpty(t, resp.Error.Abort)
+	assert.Equal(t, req.StartVersion+1, resp.CommitVersion)
+	builder.assertLens(1, 0, 1)
+}
+
+// TestCleanupNoLock tests that cleaning up a key which was never locked leaves a rollback.
+func TestCleanupNoLock(t *testing.T) {
+	builder := newBuilder(t)
+	req := builder.cleanupRequest([]byte{3})
+	resp := builder.runOneRequest(req).(*kvrpcpb.CleanupResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	builder.assertLens(0, 0, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: req.StartVersion, value: []byte{3, 0, 0, 5, 0, 0, 0, 0, builder.ts()}},
+	})
+}
+
+// TestDeleteRange tests that a delete range removes every version and lock of the keys in the range.
+func TestDeleteRange(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{1}, 80, []byte{50}},
+		{engine_util.CfWrite, []byte{1}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfDefault, []byte{2}, 80, []byte{51}},
+		{engine_util.CfWrite, []byte{2}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfDefault, []byte{2, 5}, 90, []byte{52}},
+		{engine_util.CfWrite, []byte{2, 5}, 95, []byte{1, 0, 0, 0, 0, 0, 0, 0, 90}},
+		{engine_util.CfWrite, []byte{2, 5}, 99, []byte{3, 0, 0, 0, 0, 0, 0, 0, 99}},
+		{cf: engine_util.CfLock, key: []byte{2, 6}, value: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{engine_util.CfDefault, []byte{3}, 80, []byte{53}},
+		{engine_util.CfWrite, []byte{3}, 85, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+
+	resp := builder.runOneRequest(&kvrpcpb.DeleteRangeRequest{StartKey: []byte{2}, EndKey: []byte{3}}).(*kvrpcpb.DeleteRangeResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Empty(t, resp.Error)
+	builder.assertLens(2, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 85},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 85},
+	})
+
+	resp = builder.runOneRequest(&kvrpcpb.DeleteRangeRequest{StartKey: []byte{2}, EndKey: []byte{2}}).(*kvrpcpb.DeleteRangeResponse)
+	assert.NotEmpty(t, resp.Error)
+
+	// An empty end key deletes to the end.
+	resp = builder.runOneRequest(&kvrpcpb.DeleteRangeRequest{StartKey: []byte{1}}).(*kvrpcpb.DeleteRangeResponse)
+	assert.Empty(t, resp.Error)
+	builder.assertLens(0, 0, 0)
+}
+
+// TestPrewriteLockOp tests that a locked key keeps its value and its lock and write are skipped by reads.
+func TestPrewriteLockOp(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{3}, 80, []byte{15}},
+		{engine_util.CfWrite, []byte{3}, 84, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+	prewrite := builder.prewriteRequest(mutation(3, nil, kvrpcpb.Op_Lock))
+	resp := builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 4, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	get := builder.runOneRequest(&kvrpcpb.GetRequest{Key: []byte{3}, Version: 110}).(*kvrpcpb.GetResponse)
+	assert.Nil(t, get.Error)
+	assert.Equal(t, []byte{15}, get.Value)
+
+	commit := &kvrpcpb.CommitRequest{StartVersion: prewrite.StartVersion, CommitVersion: 105, Keys: [][]byte{{3}}}
+	assert.Nil(t, builder.runOneRequest(commit).(*kvrpcpb.CommitResponse).Error)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 105, value: []byte{4, 0, 0, 0, 0, 0, 0, 0, builder.ts()}},
+	})
+
+	get = builder.runOneRequest(&kvrpcpb.GetRequest{Key: []byte{3}, Version: 110}).(*kvrpcpb.GetResponse)
+	assert.Equal(t, []byte{15}, get.Value)
+	scan := builder.runOneRequest(&kvrpcpb.ScanRequest{StartKey: []byte{0}, Limit: 10, Version: 110}).(*kvrpcpb.ScanResponse)
+	assert.Equal(t, 1, len(scan.Pairs))
+	assert.Equal(t, []byte{15}, scan.Pairs[0].Value)
+
+	// The lock record conflicts with the transactions which start before it.
+	put := &kvrpcpb.PrewriteRequest{PrimaryLock: []byte{3}, StartVersion: 104, Mutations: []*kvrpcpb.Mutation{mutation(3, []byte{16}, kvrpcpb.Op_Put)}}
+	resp = builder.runOneRequest(put).(*kvrpcpb.PrewriteResponse)
+	assert.Equal(t, 1, len(resp.Errors))
+	assert.NotNil(t, resp.Errors[0].Conflict)
+}
+
+// TestPrewriteAssertion tests that prewrite checks the assertions of the mutations against the latest committed writes.
+func TestPrewriteAssertion(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{engine_util.CfDefault, []byte{3}, 80, []byte{15}},
+		{engine_util.CfWrite, []byte{3}, 84, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		// Locked and rolled back after the put.
+		{engine_util.CfWrite, []byte{3}, 90, []byte{4, 0, 0, 0, 0, 0, 0, 0, 86}},
+		{engine_util.CfWrite, []byte{3}, 92, []byte{3, 0, 0, 0, 0, 0, 0, 0, 92}},
+		{engine_util.CfDefault, []byte{4}, 80, []byte{16}},
+		{engine_util.CfWrite, []byte{4}, 84, []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{engine_util.CfWrite, []byte{4}, 90, []byte{2, 0, 0, 0, 0, 0, 0, 0, 86}},
+	})
+
+	exist := func(key byte, assertion kvrpcpb.Assertion) *kvrpcpb.Mutation {
+		mut := mutation(key, []byte{42}, kvrpcpb.Op_Put)
+		mut.Assertion = assertion
+		return mut
+	}
+	prewrite := builder.prewriteRequest(
+		exist(3, kvrpcpb.Assertion_NotExist),
+		exist(4, kvrpcpb.Assertion_NotExist),
+		exist(5, kvrpcpb.Assertion_Exist),
+	)
+	resp := builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, 2, len(resp.Errors))
+	assert.Equal(t, &kvrpcpb.AssertionFailed{
+		StartTs:          prewrite.StartVersion,
+		Key:              []byte{3},
+		Assertion:        kvrpcpb.Assertion_NotExist,
+		ExistingStartTs:  80,
+		ExistingCommitTs: 84,
+	}, resp.Errors[0].AssertionFailed)
+	assert.Equal(t, []byte{5}, resp.Errors[1].AssertionFailed.Key)
+	assert.Equal(t, uint64(0), resp.Errors[1].AssertionFailed.ExistingCommitTs)
+
+	prewrite = builder.prewriteRequest(exist(3, kvrpcpb.Assertion_Exist), exist(5, kvrpcpb.Assertion_NotExist))
+	resp = builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestShortValue tests that a short value is inlined in the lock and the write instead of the default CF.
+func TestShortValue(t *testing.T) {
+	builder := newBuilder(t)
+	long := bytes.Repeat([]byte{9}, mvcc.ShortValueMaxLen+1)
+	prewrite := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(4, long, kvrpcpb.Op_Put))
+	resp := builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+	// Only the long value is in the default CF.
+	builder.assertLens(1, 2, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{4}, value: long},
+	})
+
+	commit := &kvrpcpb.CommitRequest{StartVersion: prewrite.StartVersion, CommitVersion: 110, Keys: [][]byte{{3}, {4}}}
+	assert.Nil(t, builder.runOneRequest(commit).(*kvrpcpb.CommitResponse).Error)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100, 'v', 1, 42}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	get := builder.runOneRequest(&kvrpcpb.GetRequest{Key: []byte{3}, Version: 200}).(*kvrpcpb.GetResponse)
+	assert.Equal(t, []byte{42}, get.Value)
+	scan := builder.runOneRequest(&kvrpcpb.ScanRequest{StartKey: []byte{0}, Limit: 10, Version: 200}).(*kvrpcpb.ScanResponse)
+	assert.Equal(t, 2, len(scan.Pairs))
+	assert.Equal(t, []byte{42}, scan.Pairs[0].Value)
+	assert.Equal(t, long, scan.Pairs[1].Value)
+
+	// Rolling back a short value has no value to delete.
+	prewrite = &kvrpcpb.PrewriteRequest{PrimaryLock: []byte{3}, StartVersion: 120, Mutations: []*kvrpcpb.Mutation{mutation(3, []byte{43}, kvrpcpb.Op_Put)}}
+	assert.Empty(t, builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse).Errors)
+	rollback := &kvrpcpb.BatchRollbackRequest{StartVersion: 120, Keys: [][]byte{{3}}}
+	assert.Nil(t, builder.runOneRequest(rollback).(*kvrpcpb.BatchRollbackResponse).Error)
+	builder.assertLens(1, 0, 3)
+}
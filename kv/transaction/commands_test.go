@@ -195,6 +195,15 @@ func (builder *testBuilder) checkTxnStatusRequest(key []byte) *kvrpcpb.CheckTxnS
 	return &req
 }
 
+func (builder *testBuilder) cleanupRequest(key []byte) *kvrpcpb.CleanupRequest {
+	var req kvrpcpb.CleanupRequest
+	builder.nextTs()
+	req.StartVersion = binary.BigEndian.Uint64([]byte{0, 0, 5, 0, 0, 0, 0, builder.ts()})
+	req.CurrentTs = binary.BigEndian.Uint64([]byte{0, 0, 6, 0, 0, 0, 0, builder.ts()})
+	req.Key = key
+	return &req
+}
+
 func resolveRequest(startTs uint64, commitTs uint64) *kvrpcpb.ResolveLockRequest {
 	var req kvrpcpb.ResolveLockRequest
 	req.StartVersion = startTs
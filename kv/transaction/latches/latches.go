@@ -2,6 +2,7 @@ package latches
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 )
@@ -82,6 +83,10 @@ func (l *Latches) ReleaseLatches(keysToUnlatch [][]byte) {
 // WaitForLatches will wait for it to become unlocked then try again. Therefore WaitForLatches may block for an unbounded
 // length of time.
 func (l *Latches) WaitForLatches(keysToLatch [][]byte) {
+	start := time.Now()
+	defer func() {
+		latchesWaitDuration.Observe(time.Since(start).Seconds())
+	}()
 	for {
 		wg := l.AcquireLatches(keysToLatch)
 		if wg == nil {
@@ -0,0 +1,16 @@
+package latches
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var latchesWaitDuration = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Namespace: "tinykv",
+		Subsystem: "transaction",
+		Name:      "latches_wait_duration_seconds",
+		Help:      "Bucketed histogram of the time spent waiting for latches.",
+		Buckets:   prometheus.ExponentialBuckets(0.00001, 2, 24),
+	})
+
+func init() {
+	prometheus.MustRegister(latchesWaitDuration)
+}
@@ -17,8 +17,14 @@ type Lock struct {
 	Ts      uint64
 	Ttl     uint64
 	Kind    WriteKind
+	// ShortValue is the inlined value of a put, see Write.
+	ShortValue []byte
 }
 
+// lockHasFields is set in the kind byte of an encoded lock which has fields. The fields are between the primary and the
+// kind, followed by their length in two bytes.
+const lockHasFields byte = 0x80
+
 type KlPair struct {
 	Key  []byte
 	Lock *Lock
@@ -35,10 +41,19 @@ func (lock *Lock) Info(key []byte) *kvrpcpb.LockInfo {
 }
 
 func (lock *Lock) ToBytes() []byte {
-	buf := append(lock.Primary, byte(lock.Kind))
+	buf := append([]byte{}, lock.Primary...)
+	kind := byte(lock.Kind)
+	if lock.ShortValue != nil {
+		fieldsStart := len(buf)
+		buf = appendFields(buf, lock.ShortValue)
+		buf = append(buf, 0, 0)
+		binary.BigEndian.PutUint16(buf[len(buf)-2:], uint16(len(buf)-2-fieldsStart))
+		kind |= lockHasFields
+	}
+	buf = append(buf, kind)
 	buf = append(buf, make([]byte, 16)...)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+1:], lock.Ts)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+9:], lock.Ttl)
+	binary.BigEndian.PutUint64(buf[len(buf)-16:], lock.Ts)
+	binary.BigEndian.PutUint64(buf[len(buf)-8:], lock.Ttl)
 	return buf
 }
 
@@ -49,17 +64,34 @@ func ParseLock(input []byte) (*Lock, error) {
 	}
 
 	primaryLen := len(input) - 17
-	primary := input[:primaryLen]
-	kind := WriteKind(input[primaryLen])
+	kind := input[primaryLen]
 	ts := binary.BigEndian.Uint64(input[primaryLen+1:])
 	ttl := binary.BigEndian.Uint64(input[primaryLen+9:])
+	var shortValue []byte
+	if kind&lockHasFields != 0 {
+		kind &^= lockHasFields
+		if primaryLen < 2 {
+			return nil, fmt.Errorf("mvcc: error parsing lock, not enough input for fields")
+		}
+		fieldsLen := int(binary.BigEndian.Uint16(input[primaryLen-2:]))
+		if primaryLen-2 < fieldsLen {
+			return nil, fmt.Errorf("mvcc: error parsing lock, fields length %d exceeds the input", fieldsLen)
+		}
+		fieldsStart := primaryLen - 2 - fieldsLen
+		var err error
+		if shortValue, err = parseFields(input[fieldsStart : primaryLen-2]); err != nil {
+			return nil, err
+		}
+		primaryLen = fieldsStart
+	}
 
-	return &Lock{Primary: primary, Ts: ts, Ttl: ttl, Kind: kind}, nil
+	return &Lock{Primary: input[:primaryLen], Ts: ts, Ttl: ttl, Kind: WriteKind(kind), ShortValue: shortValue}, nil
 }
 
-// IsLockedFor checks if lock locks key at txnStartTs.
+// IsLockedFor checks if lock locks key at txnStartTs. A lock which does not change the value of the key never blocks
+// a read.
 func (lock *Lock) IsLockedFor(key []byte, txnStartTs uint64, resp interface{}) bool {
-	if lock == nil {
+	if lock == nil || lock.Kind == WriteKindLock {
 		return false
 	}
 	if txnStartTs == TsMax && bytes.Compare(key, lock.Primary) != 0 {
@@ -77,9 +109,11 @@ func (lock *Lock) IsLockedFor(key []byte, txnStartTs uint64, resp interface{}) b
 // AllLocksForTxn returns all locks for the current transaction.
 func AllLocksForTxn(txn *RoTxn) ([]KlPair, error) {
 	var result []KlPair
-	for iter := txn.Reader.IterCF(engine_util.CfLock); iter.Valid(); iter.Next() {
+	iter := txn.Reader.IterCF(engine_util.CfLock)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
 		item := iter.Item()
-		val, err := item.Value()
+		val, err := item.ValueCopy(nil)
 		if err != nil {
 			return nil, err
 		}
@@ -88,7 +122,8 @@ func AllLocksForTxn(txn *RoTxn) ([]KlPair, error) {
 			return nil, err
 		}
 		if lock.Ts == *txn.StartTS {
-			result = append(result, KlPair{item.Key(), lock})
+			// The key of the item is only valid until the iterator moves.
+			result = append(result, KlPair{item.KeyCopy(nil), lock})
 		}
 	}
 	return result, nil
@@ -1,6 +1,8 @@
 package mvcc
 
 import (
+	"bytes"
+
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 )
@@ -10,15 +12,20 @@ import (
 // Invariant: either the scanner is finished and cannot be used, or it is ready to return a value immediately.
 type Scanner struct {
 	writeIter engine_util.DBIterator
-	txn       *RoTxn
+	// lockIter finds the locks of the keys which have not been written yet.
+	lockIter engine_util.DBIterator
+	txn      *RoTxn
 }
 
 // NewScanner creates a new scanner ready to read from the snapshot in txn.
 func NewScanner(startKey []byte, txn *RoTxn) *Scanner {
 	writeIter := txn.Reader.IterCF(engine_util.CfWrite)
 	writeIter.Seek(EncodeKey(startKey, TsMax))
+	lockIter := txn.Reader.IterCF(engine_util.CfLock)
+	lockIter.Seek(startKey)
 	return &Scanner{
 		writeIter: writeIter,
+		lockIter:  lockIter,
 		txn:       txn,
 	}
 }
@@ -27,14 +34,24 @@ func NewScanner(startKey []byte, txn *RoTxn) *Scanner {
 func (scan *Scanner) Next() ([]byte, []byte, interface{}) {
 	// Search for the next relevant key/value.
 	for {
-		if !scan.writeIter.Valid() {
+		var userKey []byte
+		if scan.writeIter.Valid() {
+			userKey = DecodeUserKey(scan.writeIter.Item().Key())
+		}
+		keyError, err := scan.checkLocksBefore(userKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if keyError != nil {
+			return nil, nil, keyError
+		}
+		if userKey == nil {
 			// The underlying iterator is exhausted - we've reached the end of the DB.
 			return nil, nil, nil
 		}
 
 		item := scan.writeIter.Item()
-		userKey := DecodeUserKey(item.Key())
-		commitTs := decodeTimestamp(item.Key())
+		commitTs := DecodeTimestamp(item.Key())
 
 		if commitTs >= *scan.txn.StartTS {
 			// The key was not committed before our transaction started, find an earlier key.
@@ -46,8 +63,13 @@ func (scan *Scanner) Next() ([]byte, []byte, interface{}) {
 		if err != nil {
 			return nil, nil, err
 		}
-		if lock != nil && lock.Ts < *scan.txn.StartTS {
-			// The key is currently locked.
+		if lock != nil && lock.Kind != WriteKindLock && lock.Ts < *scan.txn.StartTS {
+			// The key is currently locked. Skip the key, and its lock so it is not reported again as the lock of a
+			// key before the next key.
+			scan.writeIter.Seek(EncodeKey(userKey, 0))
+			if scan.lockIter.Valid() && bytes.Equal(scan.lockIter.Item().Key(), userKey) {
+				scan.lockIter.Next()
+			}
 			keyError := new(kvrpcpb.KeyError)
 			keyError.Locked = lock.Info(userKey)
 			return nil, nil, keyError
@@ -61,19 +83,57 @@ func (scan *Scanner) Next() ([]byte, []byte, interface{}) {
 		if err != nil {
 			return nil, nil, err
 		}
+		if write.Kind == WriteKindRollback || write.Kind == WriteKindLock {
+			// A rolled back or locking transaction changed nothing, look at the earlier writes of the key.
+			scan.writeIter.Next()
+			continue
+		}
 		if write.Kind != WriteKindPut {
 			// Key is removed, go to next key.
 			scan.writeIter.Seek(EncodeKey(userKey, 0))
 			continue
 		}
 
-		value, err := scan.txn.GetValue(userKey, write.StartTS)
+		value, err := scan.txn.WriteValue(userKey, write)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		scan.writeIter.Next()
+		// The older writes of the key are not visible, go to the next key.
+		scan.writeIter.Seek(EncodeKey(userKey, 0))
 
 		return userKey, value, nil
 	}
 }
+
+// checkLocksBefore returns the error of the first lock before key which blocks the scan, a nil key means the end of
+// the DB. The keys before key have no write, so the locks of them are only found by the lock iterator.
+func (scan *Scanner) checkLocksBefore(key []byte) (*kvrpcpb.KeyError, error) {
+	for ; scan.lockIter.Valid(); scan.lockIter.Next() {
+		item := scan.lockIter.Item()
+		if key != nil && bytes.Compare(item.Key(), key) >= 0 {
+			return nil, nil
+		}
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		lock, err := ParseLock(value)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Kind != WriteKindLock && lock.Ts < *scan.txn.StartTS {
+			keyError := &kvrpcpb.KeyError{Locked: lock.Info(item.KeyCopy(nil))}
+			// The locked key is reported once, the scan goes on from the next lock.
+			scan.lockIter.Next()
+			return keyError, nil
+		}
+	}
+	return nil, nil
+}
+
+// Close releases the iterators of the scanner, it cannot be used afterwards.
+func (scan *Scanner) Close() {
+	scan.writeIter.Close()
+	scan.lockIter.Close()
+}
@@ -33,12 +33,13 @@ func NewTxn(reader inner_server.DBReader) MvccTxn {
 // Postcondition: the returned ts is <= the ts arg.
 func (txn *RoTxn) SeekWrite(key []byte, ts uint64) (*Write, uint64, error) {
 	iter := txn.Reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
 	iter.Seek(EncodeKey(key, ts))
 	if !iter.Valid() {
 		return nil, 0, nil
 	}
 	item := iter.Item()
-	commitTs := decodeTimestamp(item.Key())
+	commitTs := DecodeTimestamp(item.Key())
 	if bytes.Compare(DecodeUserKey(item.Key()), key) != 0 {
 		return nil, 0, nil
 	}
@@ -75,10 +76,11 @@ func (txn *RoTxn) FindWrite(key []byte, startTs uint64) (*Write, uint64, error)
 	}
 }
 
-// FindWrittenValue searches for a put write at key and with a timestamp at ts or later, if it finds one, it uses the
-// write's timestamp to look up the value.
+// FindWrittenValue searches for a put write at key and with a timestamp at ts or later, if it finds one, it returns the
+// value inlined in the write or uses the write's timestamp to look up the value.
 func (txn *RoTxn) FindWrittenValue(key []byte, ts uint64) ([]byte, error) {
 	iter := txn.Reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
 	bts := [8]byte{}
 	binary.BigEndian.PutUint64(bts[:], ts)
 	for iter.Seek(EncodeKey(key, ts)); iter.Valid(); iter.Next() {
@@ -97,10 +99,11 @@ func (txn *RoTxn) FindWrittenValue(key []byte, ts uint64) ([]byte, error) {
 		}
 		switch write.Kind {
 		case WriteKindPut:
-			return txn.Reader.GetCF(engine_util.CfDefault, EncodeKey(key, write.StartTS))
+			return txn.WriteValue(key, write)
 		case WriteKindDelete:
 			return nil, nil
-		case WriteKindRollback:
+		case WriteKindRollback, WriteKindLock:
+			// Neither changes the value, look at the earlier writes of the key.
 		}
 	}
 
@@ -108,9 +111,27 @@ func (txn *RoTxn) FindWrittenValue(key []byte, ts uint64) ([]byte, error) {
 	return nil, nil
 }
 
+// LatestValueWrite finds the most recent put or delete write of key committed at or before ts, skipping the rollbacks
+// and the locks. It returns the write and its commit timestamp, or nil if the key has never been written.
+func (txn *RoTxn) LatestValueWrite(key []byte, ts uint64) (*Write, uint64, error) {
+	for {
+		write, commitTs, err := txn.SeekWrite(key, ts)
+		if err != nil || write == nil {
+			return nil, 0, err
+		}
+		if write.Kind == WriteKindPut || write.Kind == WriteKindDelete {
+			return write, commitTs, nil
+		}
+		if commitTs == 0 {
+			return nil, 0, nil
+		}
+		ts = commitTs - 1
+	}
+}
+
 // GetWrite gets the write at precisely the given key and ts, without searching.
 func (txn *RoTxn) GetWrite(key []byte, ts uint64) (*Write, error) {
-	value, err := txn.Reader.GetCF(engine_util.CfWrite, EncodeKey(key, ts))
+	value, err := txn.getCF(engine_util.CfWrite, EncodeKey(key, ts))
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +153,7 @@ func (txn *MvccTxn) PutWrite(key []byte, write *Write, ts uint64) {
 // GetLock returns a lock if key is locked. It will return (nil, nil) if there is no lock on key, and (nil, err)
 // if an error occurs during lookup.
 func (txn *RoTxn) GetLock(key []byte) (*Lock, error) {
-	bytes, err := txn.Reader.GetCF(engine_util.CfLock, key)
+	bytes, err := txn.getCF(engine_util.CfLock, key)
 	if err != nil {
 		return nil, err
 	}
@@ -171,9 +192,26 @@ func (txn *MvccTxn) DeleteLock(key []byte) {
 	})
 }
 
+// WriteValue returns the value of a put write of key, which is either inlined in the write or in the default CF.
+func (txn *RoTxn) WriteValue(key []byte, write *Write) ([]byte, error) {
+	if write.ShortValue != nil {
+		return write.ShortValue, nil
+	}
+	return txn.GetValue(key, write.StartTS)
+}
+
 // GetValue gets the value at precisely the given key and ts, without searching.
 func (txn *RoTxn) GetValue(key []byte, ts uint64) ([]byte, error) {
-	return txn.Reader.GetCF(engine_util.CfDefault, EncodeKey(key, ts))
+	return txn.getCF(engine_util.CfDefault, EncodeKey(key, ts))
+}
+
+// getCF reads the value of key in cf, it is nil if the key does not exist.
+func (txn *RoTxn) getCF(cf string, key []byte) ([]byte, error) {
+	value, err := txn.Reader.GetCF(cf, key)
+	if err == engine_util.ErrKeyNotFound {
+		return nil, nil
+	}
+	return value, err
 }
 
 // PutValue adds a key/value write to this transaction.
@@ -218,8 +256,8 @@ func DecodeUserKey(key []byte) []byte {
 	return userKey
 }
 
-// decodeTimestamp takes a key + timestamp and returns the timestamp part.
-func decodeTimestamp(key []byte) uint64 {
+// DecodeTimestamp takes a key + timestamp and returns the timestamp part.
+func DecodeTimestamp(key []byte) uint64 {
 	left, _, err := codec.DecodeBytes(key)
 	if err != nil {
 		panic(err)
@@ -26,3 +26,46 @@ func TestDecodeKey(t *testing.T) {
 	assert.Equal(t, []byte{42}, DecodeUserKey(EncodeKey([]byte{42}, 2342342355436234)))
 	assert.Equal(t, []byte{42, 0, 5}, DecodeUserKey(EncodeKey([]byte{42, 0, 5}, 234234)))
 }
+
+func TestWriteEncoding(t *testing.T) {
+	// A write without fields keeps the old encoding.
+	write := Write{StartTS: 42, Kind: WriteKindPut}
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 42}, write.ToBytes())
+	parsed, err := ParseWrite(write.ToBytes())
+	assert.Nil(t, err)
+	assert.Equal(t, &write, parsed)
+
+	for _, value := range [][]byte{{}, {5}, bytes.Repeat([]byte{7}, ShortValueMaxLen)} {
+		write := Write{StartTS: 42, Kind: WriteKindPut, ShortValue: value}
+		parsed, err := ParseWrite(write.ToBytes())
+		assert.Nil(t, err)
+		assert.Equal(t, &write, parsed)
+	}
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 42, 'v', 2, 5, 6}, (&Write{StartTS: 42, Kind: WriteKindPut, ShortValue: []byte{5, 6}}).ToBytes())
+
+	_, err = ParseWrite([]byte{1, 0, 0, 0, 0, 0, 0, 0, 42, 'v', 3, 5})
+	assert.NotNil(t, err)
+	_, err = ParseWrite([]byte{1, 0, 0, 0, 0, 0, 0, 0, 42, 'x'})
+	assert.NotNil(t, err)
+}
+
+func TestLockEncoding(t *testing.T) {
+	// A lock without fields keeps the old encoding.
+	lock := Lock{Primary: []byte{3}, Ts: 42, Ttl: 10, Kind: WriteKindDelete}
+	assert.Equal(t, []byte{3, 2, 0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 10}, lock.ToBytes())
+	parsed, err := ParseLock(lock.ToBytes())
+	assert.Nil(t, err)
+	assert.Equal(t, &lock, parsed)
+
+	for _, primary := range [][]byte{{}, {3}, bytes.Repeat([]byte{'v'}, 20)} {
+		for _, value := range [][]byte{{}, {5}, bytes.Repeat([]byte{7}, ShortValueMaxLen)} {
+			lock := Lock{Primary: primary, Ts: 42, Ttl: 10, Kind: WriteKindPut, ShortValue: value}
+			parsed, err := ParseLock(lock.ToBytes())
+			assert.Nil(t, err)
+			assert.Equal(t, &lock, parsed)
+		}
+	}
+
+	_, err = ParseLock([]byte{3, 0x81, 0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 10})
+	assert.NotNil(t, err)
+}
@@ -13,25 +13,73 @@ import (
 type Write struct {
 	StartTS uint64
 	Kind    WriteKind
+	// ShortValue is the value of a put which is short enough to be stored in the write instead of the default CF, it
+	// is nil if the value is in the default CF.
+	ShortValue []byte
+}
+
+// ShortValueMaxLen is the max length of a value which is inlined in its lock and write.
+const ShortValueMaxLen = 255
+
+// The tags of the optional fields which follow the fixed part of an encoded write or lock. A field is a tag followed by
+// its data, a short value is a one byte length followed by the value.
+const (
+	fieldTagShortValue byte = 'v'
+)
+
+// IsShortValue returns whether value is inlined in its lock and write.
+func IsShortValue(value []byte) bool {
+	return len(value) <= ShortValueMaxLen
 }
 
 func (wr *Write) ToBytes() []byte {
 	buf := append([]byte{byte(wr.Kind)}, 0, 0, 0, 0, 0, 0, 0, 0)
 	binary.BigEndian.PutUint64(buf[1:], wr.StartTS)
-	return buf
+	return appendFields(buf, wr.ShortValue)
 }
 
+// ParseWrite parses a write which is a kind and a start ts, optionally followed by fields.
 func ParseWrite(value []byte) (*Write, error) {
 	if value == nil {
 		return nil, nil
 	}
-	if len(value) != 9 {
-		return nil, fmt.Errorf("mvcc/write/ParseWrite: value is incorrect length, expected 9, found %d", len(value))
+	if len(value) < 9 {
+		return nil, fmt.Errorf("mvcc/write/ParseWrite: value is incorrect length, expected at least 9, found %d", len(value))
 	}
 	kind := value[0]
 	startTs := binary.BigEndian.Uint64(value[1:])
+	shortValue, err := parseFields(value[9:])
+	if err != nil {
+		return nil, err
+	}
 
-	return &Write{startTs, WriteKind(kind)}, nil
+	return &Write{startTs, WriteKind(kind), shortValue}, nil
+}
+
+// appendFields appends the fields of the non-nil arguments to buf.
+func appendFields(buf []byte, shortValue []byte) []byte {
+	if shortValue != nil {
+		buf = append(buf, fieldTagShortValue, byte(len(shortValue)))
+		buf = append(buf, shortValue...)
+	}
+	return buf
+}
+
+// parseFields parses the fields appended by appendFields, an absent field is nil.
+func parseFields(input []byte) (shortValue []byte, err error) {
+	for len(input) > 0 {
+		switch input[0] {
+		case fieldTagShortValue:
+			if len(input) < 2 || len(input) < 2+int(input[1]) {
+				return nil, fmt.Errorf("mvcc: error parsing short value, not enough input, found %d bytes", len(input))
+			}
+			shortValue = append([]byte{}, input[2:2+int(input[1])]...)
+			input = input[2+int(input[1]):]
+		default:
+			return nil, fmt.Errorf("mvcc: error parsing fields, unknown tag %d", input[0])
+		}
+	}
+	return shortValue, nil
 }
 
 type WriteKind int
@@ -40,6 +88,9 @@ const (
 	WriteKindPut      WriteKind = 1
 	WriteKindDelete   WriteKind = 2
 	WriteKindRollback WriteKind = 3
+	// WriteKindLock is the write of a key which was locked but not changed by a transaction. It conflicts with the
+	// other transactions like any write, but reads skip it for an earlier write.
+	WriteKindLock WriteKind = 4
 )
 
 func (wk WriteKind) ToProto() kvrpcpb.Op {
@@ -50,6 +101,8 @@ func (wk WriteKind) ToProto() kvrpcpb.Op {
 		return kvrpcpb.Op_Del
 	case WriteKindRollback:
 		return kvrpcpb.Op_Rollback
+	case WriteKindLock:
+		return kvrpcpb.Op_Lock
 	}
 
 	return -1
@@ -63,6 +116,8 @@ func WriteKindFromProto(op kvrpcpb.Op) WriteKind {
 		return WriteKindDelete
 	case kvrpcpb.Op_Rollback:
 		return WriteKindRollback
+	case kvrpcpb.Op_Lock:
+		return WriteKindLock
 	}
 
 	return -1
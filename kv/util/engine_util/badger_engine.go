@@ -0,0 +1,136 @@
+package engine_util
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap/errors"
+)
+
+// BadgerEngine is an Engine which stores its data on disk using badger.
+type BadgerEngine struct {
+	db *badger.DB
+}
+
+var _ Engine = new(BadgerEngine)
+
+func NewBadgerEngine(db *badger.DB) *BadgerEngine {
+	return &BadgerEngine{db: db}
+}
+
+// OpenBadgerEngine opens (creating it if necessary) a badger database in dir.
+func OpenBadgerEngine(dir string, valueThreshold int) (*BadgerEngine, error) {
+	opts := badger.DefaultOptions
+	opts.Dir = filepath.Clean(dir)
+	opts.ValueDir = opts.Dir
+	opts.ValueThreshold = valueThreshold
+	if err := os.MkdirAll(opts.Dir, os.ModePerm); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewBadgerEngine(db), nil
+}
+
+// OpenBadgerEngineReadOnly opens an existing badger database in dir without writing to it, so it can be read while
+// holding only a shared lock of the directory.
+func OpenBadgerEngineReadOnly(dir string) (*BadgerEngine, error) {
+	opts := badger.DefaultOptions
+	opts.Dir = filepath.Clean(dir)
+	opts.ValueDir = opts.Dir
+	opts.ReadOnly = true
+	if _, err := os.Stat(opts.Dir); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewBadgerEngine(db), nil
+}
+
+// DB returns the underlying badger database.
+func (e *BadgerEngine) DB() *badger.DB {
+	return e.db
+}
+
+func (e *BadgerEngine) NewSnapshot() Snapshot {
+	return &badgerSnapshot{txn: e.db.NewTransaction(false)}
+}
+
+func (e *BadgerEngine) Write(wb *WriteBatch) error {
+	err := e.db.Update(func(txn *badger.Txn) error {
+		for _, entry := range wb.entries {
+			var err1 error
+			if len(entry.value) == 0 {
+				err1 = txn.Delete(entry.key)
+			} else {
+				err1 = txn.Set(entry.key, entry.value)
+			}
+			if err1 != nil {
+				return err1
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (e *BadgerEngine) IngestExternalFiles(files []*os.File) (int, error) {
+	return e.db.IngestExternalFiles(files)
+}
+
+func (e *BadgerEngine) Size() uint64 {
+	lsmSize, vlogSize := e.db.Size()
+	return uint64(lsmSize) + uint64(vlogSize)
+}
+
+func (e *BadgerEngine) Close() error {
+	return e.db.Close()
+}
+
+type badgerSnapshot struct {
+	txn *badger.Txn
+}
+
+func (s *badgerSnapshot) Get(key []byte) ([]byte, error) {
+	item, err := s.txn.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+func (s *badgerSnapshot) NewIterator() Iterator {
+	return &badgerIterator{iter: s.txn.NewIterator(badger.DefaultIteratorOptions)}
+}
+
+func (s *badgerSnapshot) Discard() {
+	s.txn.Discard()
+}
+
+type badgerIterator struct {
+	iter *badger.Iterator
+}
+
+func (it *badgerIterator) Item() DBItem {
+	return it.iter.Item()
+}
+
+func (it *badgerIterator) Valid() bool { return it.iter.Valid() }
+
+func (it *badgerIterator) ValidForPrefix(prefix []byte) bool { return it.iter.ValidForPrefix(prefix) }
+
+func (it *badgerIterator) Next() { it.iter.Next() }
+
+func (it *badgerIterator) Seek(key []byte) { it.iter.Seek(key) }
+
+func (it *badgerIterator) Rewind() { it.iter.Rewind() }
+
+func (it *badgerIterator) Close() { it.iter.Close() }
@@ -1,19 +1,10 @@
 package engine_util
 
-import (
-	"github.com/Connor1996/badger"
-)
-
 type CFItem struct {
-	item      *badger.Item
+	item      DBItem
 	prefixLen int
 }
 
-// String returns a string representation of Item
-func (i *CFItem) String() string {
-	return i.item.String()
-}
-
 func (i *CFItem) Key() []byte {
 	return i.item.Key()[i.prefixLen:]
 }
@@ -22,14 +13,6 @@ func (i *CFItem) KeyCopy(dst []byte) []byte {
 	return i.item.KeyCopy(dst)[i.prefixLen:]
 }
 
-func (i *CFItem) Version() uint64 {
-	return i.item.Version()
-}
-
-func (i *CFItem) IsEmpty() bool {
-	return i.item.IsEmpty()
-}
-
 func (i *CFItem) Value() ([]byte, error) {
 	return i.item.Value()
 }
@@ -42,57 +25,46 @@ func (i *CFItem) ValueCopy(dst []byte) ([]byte, error) {
 	return i.item.ValueCopy(dst)
 }
 
-func (i *CFItem) IsDeleted() bool {
-	return i.item.IsDeleted()
-}
-
-func (i *CFItem) EstimatedSize() int64 {
-	return i.item.EstimatedSize()
-}
-
-func (i *CFItem) UserMeta() []byte {
-	return i.item.UserMeta()
-}
-
-type BadgerIterator struct {
-	iter   *badger.Iterator
+// CFIterator iterates over a single column family of a snapshot, keys are returned without the CF prefix.
+type CFIterator struct {
+	iter   Iterator
 	prefix string
 }
 
-func NewCFIterator(cf string, txn *badger.Txn) *BadgerIterator {
-	return &BadgerIterator{
-		iter:   txn.NewIterator(badger.DefaultIteratorOptions),
+func NewCFIterator(cf string, snap Snapshot) *CFIterator {
+	return &CFIterator{
+		iter:   snap.NewIterator(),
 		prefix: cf + "_",
 	}
 }
 
-func (it *BadgerIterator) Item() DBItem {
+func (it *CFIterator) Item() DBItem {
 	return &CFItem{
 		item:      it.iter.Item(),
 		prefixLen: len(it.prefix),
 	}
 }
 
-func (it *BadgerIterator) Valid() bool { return it.iter.ValidForPrefix([]byte(it.prefix)) }
+func (it *CFIterator) Valid() bool { return it.iter.ValidForPrefix([]byte(it.prefix)) }
 
-func (it *BadgerIterator) ValidForPrefix(prefix []byte) bool {
-	return it.iter.ValidForPrefix(append(prefix, []byte(it.prefix)...))
+func (it *CFIterator) ValidForPrefix(prefix []byte) bool {
+	return it.iter.ValidForPrefix(append([]byte(it.prefix), prefix...))
 }
 
-func (it *BadgerIterator) Close() {
+func (it *CFIterator) Close() {
 	it.iter.Close()
 }
 
-func (it *BadgerIterator) Next() {
+func (it *CFIterator) Next() {
 	it.iter.Next()
 }
 
-func (it *BadgerIterator) Seek(key []byte) {
+func (it *CFIterator) Seek(key []byte) {
 	it.iter.Seek(append([]byte(it.prefix), key...))
 }
 
-func (it *BadgerIterator) Rewind() {
-	it.iter.Rewind()
+func (it *CFIterator) Rewind() {
+	it.iter.Seek([]byte(it.prefix))
 }
 
 type DBIterator interface {
@@ -11,7 +11,10 @@ configured separately. Writes can be made atomic across column families, which c
 
 engine_util includes the following packages:
 
+* engine: the Engine, Snapshot and Iterator interfaces implemented by storage engines.
+* badger_engine: an Engine which stores data on disk using badger.
+* mem_engine: an Engine which keeps data in memory, for testing.
 * engines: a data structure for keeping engines required by unistore.
 * write_batch: code to batch writes into a single, atomic 'transaction'.
-* cf_iterator: code to iterate over a whole column family in badger.
+* cf_iterator: code to iterate over a whole column family of a snapshot.
 */
@@ -0,0 +1,46 @@
+package engine_util
+
+import (
+	"os"
+
+	"github.com/Connor1996/badger"
+)
+
+// ErrKeyNotFound is returned by Snapshot.Get (and the helpers built on it) when a key does not exist. All engine
+// implementations must return this exact value so callers can compare against it.
+var ErrKeyNotFound = badger.ErrKeyNotFound
+
+// Engine is a local, ordered key/value store. Column families are emulated by prefixing keys with the CF name, see
+// KeyWithCF.
+type Engine interface {
+	// NewSnapshot returns a consistent, read-only view of the engine. The caller must Discard it when done.
+	NewSnapshot() Snapshot
+	// Write atomically applies all modifications in the write batch.
+	Write(wb *WriteBatch) error
+	// IngestExternalFiles loads snapshot SST files (as built by the snap package) into the engine and returns
+	// the number of files ingested.
+	IngestExternalFiles(files []*os.File) (int, error)
+	// Size returns the approximate number of bytes used by the engine.
+	Size() uint64
+	// Close releases all resources held by the engine.
+	Close() error
+}
+
+// Snapshot is a point-in-time, read-only view of an Engine.
+type Snapshot interface {
+	// Get returns the value for key, or ErrKeyNotFound.
+	Get(key []byte) ([]byte, error)
+	// NewIterator returns an iterator over all (raw, CF prefixed) keys in the snapshot.
+	NewIterator() Iterator
+	// Discard releases the snapshot.
+	Discard()
+}
+
+// Iterator iterates over raw keys of a Snapshot in ascending order.
+type Iterator interface {
+	DBIterator
+	// ValidForPrefix returns false when iteration is done or the current key doesn't have the given prefix.
+	ValidForPrefix(prefix []byte) bool
+	// Rewind seeks to the first key.
+	Rewind()
+}
@@ -3,6 +3,7 @@ package engine_util
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/Connor1996/badger"
@@ -11,11 +12,19 @@ import (
 
 func TestEngineUtil(t *testing.T) {
 	dir, err := ioutil.TempDir("", "engine_util")
-	opts := badger.DefaultOptions
-	opts.Dir = dir
-	opts.ValueDir = dir
-	db, err := badger.Open(opts)
 	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	db, err := OpenBadgerEngine(dir, badger.DefaultOptions.ValueThreshold)
+	require.Nil(t, err)
+	defer db.Close()
+	testEngine(t, db)
+}
+
+func TestMemEngine(t *testing.T) {
+	testEngine(t, NewMemEngine())
+}
+
+func testEngine(t *testing.T, db Engine) {
 
 	batch := new(WriteBatch)
 	batch.SetCF(CfDefault, []byte("a"), []byte("a1"))
@@ -31,14 +40,14 @@ func TestEngineUtil(t *testing.T) {
 	batch.Delete([]byte("a"))
 	batch.SetCF(CfDefault, []byte("e"), []byte("e1"))
 	batch.DeleteCF(CfDefault, []byte("e"))
-	err = batch.WriteToDB(db)
+	err := batch.WriteToDB(db)
 	require.Nil(t, err)
 
 	_, err = GetCF(db, CfDefault, []byte("e"))
-	require.Equal(t, err, badger.ErrKeyNotFound)
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	defaultIter := NewCFIterator(CfDefault, txn)
+	require.Equal(t, err, ErrKeyNotFound)
+	snap := db.NewSnapshot()
+	defer snap.Discard()
+	defaultIter := NewCFIterator(CfDefault, snap)
 	defaultIter.Seek([]byte("a"))
 	item := defaultIter.Item()
 	require.True(t, bytes.Equal(item.Key(), []byte("a")))
@@ -63,7 +72,7 @@ func TestEngineUtil(t *testing.T) {
 	require.False(t, defaultIter.Valid())
 	defaultIter.Close()
 
-	writeIter := NewCFIterator(CfWrite, txn)
+	writeIter := NewCFIterator(CfWrite, snap)
 	writeIter.Seek([]byte("b"))
 	item = writeIter.Item()
 	require.True(t, bytes.Equal(item.Key(), []byte("b")))
@@ -78,7 +87,7 @@ func TestEngineUtil(t *testing.T) {
 	require.False(t, writeIter.Valid())
 	writeIter.Close()
 
-	lockIter := NewCFIterator(CfLock, txn)
+	lockIter := NewCFIterator(CfLock, snap)
 	lockIter.Seek([]byte("d"))
 	require.False(t, lockIter.Valid())
 	lockIter.Close()
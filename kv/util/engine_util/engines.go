@@ -10,19 +10,19 @@ import (
 )
 
 // Engines keeps references to and data for the engines used by unistore.
-// All engines are badger key/value databases.
+// Which Engine implementation is used is decided by config.Config.Engine.
 // the Path fields are the filesystem path to where the data is stored.
 type Engines struct {
 	// Data, including data which is committed (i.e., committed across other nodes) and un-committed (i.e., only present
 	// locally).
-	Kv     *badger.DB
+	Kv     Engine
 	KvPath string
 	// Metadata used by Raft.
-	Raft     *badger.DB
+	Raft     Engine
 	RaftPath string
 }
 
-func NewEngines(kvEngine, raftEngine *badger.DB, kvPath, raftPath string) *Engines {
+func NewEngines(kvEngine, raftEngine Engine, kvPath, raftPath string) *Engines {
 	return &Engines{
 		Kv:       kvEngine,
 		KvPath:   kvPath,
@@ -62,21 +62,20 @@ func (en *Engines) Destroy() error {
 	return nil
 }
 
-// CreateDB creates a new Badger DB on disk at subPath.
-func CreateDB(subPath string, conf *config.Config) *badger.DB {
-	opts := badger.DefaultOptions
+// CreateDB creates a new engine for subPath, using the engine kind configured in conf. For badger, the data is
+// stored on disk at subPath under conf.DBPath.
+func CreateDB(subPath string, conf *config.Config) Engine {
+	if conf.Engine == config.EngineMemory {
+		return NewMemEngine()
+	}
+	valueThreshold := badger.DefaultOptions.ValueThreshold
 	if subPath == "raft" {
 		// Do not need to write blob for raft engine because it will be deleted soon.
-		opts.ValueThreshold = 0
-	}
-	opts.Dir = filepath.Join(conf.DBPath, subPath)
-	opts.ValueDir = opts.Dir
-	if err := os.MkdirAll(opts.Dir, os.ModePerm); err != nil {
-		log.Fatal(err)
+		valueThreshold = 0
 	}
-	db, err := badger.Open(opts)
+	engine, err := OpenBadgerEngine(filepath.Join(conf.DBPath, subPath), valueThreshold)
 	if err != nil {
 		log.Fatal(err)
 	}
-	return db
+	return engine
 }
@@ -0,0 +1,240 @@
+package engine_util
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/Connor1996/badger"
+	"github.com/Connor1996/badger/options"
+	"github.com/Connor1996/badger/table"
+	"github.com/Connor1996/badger/y"
+	"github.com/google/btree"
+	"github.com/pingcap/errors"
+)
+
+// MemEngine is an Engine which keeps all data in an in-memory ordered map. Nothing is written to disk, so data
+// survives restarts of the raftstore (the engine object is kept by the caller) but not of the process. It is intended
+// for testing only.
+type MemEngine struct {
+	mu   sync.Mutex
+	tree *btree.BTree
+	size uint64
+}
+
+var _ Engine = new(MemEngine)
+
+func NewMemEngine() *MemEngine {
+	return &MemEngine{
+		tree: btree.New(32),
+	}
+}
+
+func (e *MemEngine) NewSnapshot() Snapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// Clone is copy-on-write, so the snapshot is cheap and not affected by later writes.
+	return &memSnapshot{tree: e.tree.Clone()}
+}
+
+func (e *MemEngine) Write(wb *WriteBatch) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, entry := range wb.entries {
+		if len(entry.value) == 0 {
+			e.delete(&memItem{key: entry.key})
+		} else {
+			e.put(&memItem{key: y.SafeCopy(nil, entry.key), value: y.SafeCopy(nil, entry.value)})
+		}
+	}
+	return nil
+}
+
+// IngestExternalFiles reads all key/value pairs out of the SST files and inserts them into the engine.
+func (e *MemEngine) IngestExternalFiles(files []*os.File) (int, error) {
+	dir, err := ioutil.TempDir("", "mem_engine_ingest")
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer os.RemoveAll(dir)
+
+	items := make([]*memItem, 0)
+	for i, file := range files {
+		// badger only opens tables whose file name is a table id.
+		path := table.NewFilename(uint64(i+1), dir)
+		if err := linkOrCopy(file.Name(), path); err != nil {
+			return i, err
+		}
+		fd, err := os.OpenFile(path, os.O_RDWR, 0666)
+		if err != nil {
+			return i, errors.WithStack(err)
+		}
+		tbl, err := table.OpenTable(fd, options.LoadToRAM, badger.DefaultOptions.TableBuilderOptions.Compression, nil)
+		if err != nil {
+			return i, err
+		}
+		it := tbl.NewIterator(false)
+		for it.Rewind(); it.Valid(); it.Next() {
+			items = append(items, &memItem{
+				key:   y.SafeCopy(nil, it.RawKey()),
+				value: y.SafeCopy(nil, it.Value().Value),
+			})
+		}
+		if err := tbl.Close(); err != nil {
+			return i, err
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, item := range items {
+		e.put(item)
+	}
+	return len(files), nil
+}
+
+// linkOrCopy hard links src to dst, or copies it if they are on different file systems.
+func linkOrCopy(src, dst string) error {
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(out.Close())
+}
+
+func (e *MemEngine) put(item *memItem) {
+	if old := e.tree.ReplaceOrInsert(item); old != nil {
+		e.size -= old.(*memItem).size()
+	}
+	e.size += item.size()
+}
+
+func (e *MemEngine) delete(item *memItem) {
+	if old := e.tree.Delete(item); old != nil {
+		e.size -= old.(*memItem).size()
+	}
+}
+
+func (e *MemEngine) Size() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.size
+}
+
+func (e *MemEngine) Close() error {
+	return nil
+}
+
+type memSnapshot struct {
+	tree *btree.BTree
+}
+
+func (s *memSnapshot) Get(key []byte) ([]byte, error) {
+	item := s.tree.Get(&memItem{key: key})
+	if item == nil {
+		return nil, ErrKeyNotFound
+	}
+	return y.SafeCopy(nil, item.(*memItem).value), nil
+}
+
+func (s *memSnapshot) NewIterator() Iterator {
+	return &memIterator{tree: s.tree}
+}
+
+func (s *memSnapshot) Discard() {}
+
+type memIterator struct {
+	tree *btree.BTree
+	item *memItem
+}
+
+func (it *memIterator) Item() DBItem {
+	return it.item
+}
+
+func (it *memIterator) Valid() bool {
+	return it.item != nil
+}
+
+func (it *memIterator) ValidForPrefix(prefix []byte) bool {
+	return it.item != nil && bytes.HasPrefix(it.item.key, prefix)
+}
+
+func (it *memIterator) Next() {
+	if it.item == nil {
+		return
+	}
+	current := it.item
+	it.item = nil
+	it.tree.AscendGreaterOrEqual(current, func(i btree.Item) bool {
+		if bytes.Equal(i.(*memItem).key, current.key) {
+			return true
+		}
+		it.item = i.(*memItem)
+		return false
+	})
+}
+
+func (it *memIterator) Seek(key []byte) {
+	it.item = nil
+	it.tree.AscendGreaterOrEqual(&memItem{key: key}, func(i btree.Item) bool {
+		it.item = i.(*memItem)
+		return false
+	})
+}
+
+func (it *memIterator) Rewind() {
+	it.item = nil
+	if min := it.tree.Min(); min != nil {
+		it.item = min.(*memItem)
+	}
+}
+
+func (it *memIterator) Close() {}
+
+type memItem struct {
+	key   []byte
+	value []byte
+}
+
+func (i *memItem) Less(than btree.Item) bool {
+	return bytes.Compare(i.key, than.(*memItem).key) < 0
+}
+
+func (i *memItem) size() uint64 {
+	return uint64(len(i.key) + len(i.value))
+}
+
+func (i *memItem) Key() []byte {
+	return i.key
+}
+
+func (i *memItem) KeyCopy(dst []byte) []byte {
+	return y.SafeCopy(dst, i.key)
+}
+
+func (i *memItem) Value() ([]byte, error) {
+	return i.value, nil
+}
+
+func (i *memItem) ValueSize() int {
+	return len(i.value)
+}
+
+func (i *memItem) ValueCopy(dst []byte) ([]byte, error) {
+	return y.SafeCopy(dst, i.value), nil
+}
@@ -3,7 +3,6 @@ package engine_util
 import (
 	"bytes"
 
-	"github.com/Connor1996/badger"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -11,40 +10,30 @@ func KeyWithCF(cf string, key []byte) []byte {
 	return append([]byte(cf+"_"), key...)
 }
 
-func GetCF(db *badger.DB, cf string, key []byte) (val []byte, err error) {
-	err = db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(KeyWithCF(cf, key))
-		if err != nil {
-			return err
-		}
-		val, err = item.ValueCopy(val)
-		return err
-	})
-	return
+func GetCF(engine Engine, cf string, key []byte) (val []byte, err error) {
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
+	return snap.Get(KeyWithCF(cf, key))
 }
 
-func GetCFFromTxn(txn *badger.Txn, cf string, key []byte) (val []byte, err error) {
-	item, err := txn.Get(KeyWithCF(cf, key))
-	if err != nil {
-		return nil, err
-	}
-	val, err = item.ValueCopy(val)
-	return
+func GetCFFromSnapshot(snap Snapshot, cf string, key []byte) (val []byte, err error) {
+	return snap.Get(KeyWithCF(cf, key))
 }
 
-func DeleteRange(db *badger.DB, startKey, endKey []byte) error {
+func DeleteRange(engine Engine, startKey, endKey []byte) error {
 	batch := new(WriteBatch)
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
 	for _, cf := range CFs {
-		deleteRangeCF(txn, batch, cf, startKey, endKey)
+		DeleteRangeCF(snap, batch, cf, startKey, endKey)
 	}
 
-	return batch.WriteToDB(db)
+	return batch.WriteToDB(engine)
 }
 
-func deleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endKey []byte) {
-	it := NewCFIterator(cf, txn)
+// DeleteRangeCF adds the deletes of the keys of cf in [startKey, endKey) of the snapshot to the batch.
+func DeleteRangeCF(snap Snapshot, batch *WriteBatch, cf string, startKey, endKey []byte) {
+	it := NewCFIterator(cf, snap)
 	for it.Seek(startKey); it.Valid(); it.Next() {
 		item := it.Item()
 		key := item.KeyCopy(nil)
@@ -56,7 +45,7 @@ func deleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endK
 	defer it.Close()
 }
 
-func GetMsg(engine *badger.DB, key []byte, msg proto.Message) error {
+func GetMsg(engine Engine, key []byte, msg proto.Message) error {
 	val, err := GetValue(engine, key)
 	if err != nil {
 		return err
@@ -64,29 +53,17 @@ func GetMsg(engine *badger.DB, key []byte, msg proto.Message) error {
 	return proto.Unmarshal(val, msg)
 }
 
-func GetValueTxn(txn *badger.Txn, key []byte) ([]byte, error) {
-	i, err := txn.Get(key)
-	if err != nil {
-		return nil, err
-	}
-	return i.Value()
+func GetValueFromSnapshot(snap Snapshot, key []byte) ([]byte, error) {
+	return snap.Get(key)
 }
 
-func GetValue(engine *badger.DB, key []byte) ([]byte, error) {
-	var result []byte
-	err := engine.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(key)
-		if err != nil {
-			return err
-		}
-		val, err := item.Value()
-		result = val
-		return err
-	})
-	return result, err
+func GetValue(engine Engine, key []byte) ([]byte, error) {
+	snap := engine.NewSnapshot()
+	defer snap.Discard()
+	return snap.Get(key)
 }
 
-func PutMsg(engine *badger.DB, key []byte, msg proto.Message) error {
+func PutMsg(engine Engine, key []byte, msg proto.Message) error {
 	val, err := proto.Marshal(msg)
 	if err != nil {
 		return err
@@ -94,10 +71,10 @@ func PutMsg(engine *badger.DB, key []byte, msg proto.Message) error {
 	return PutValue(engine, key, val)
 }
 
-func PutValue(engine *badger.DB, key, val []byte) error {
-	return engine.Update(func(txn *badger.Txn) error {
-		return txn.Set(key, val)
-	})
+func PutValue(engine Engine, key, val []byte) error {
+	wb := new(WriteBatch)
+	wb.Set(key, val)
+	return engine.Write(wb)
 }
 
 func ExceedEndKey(current, endKey []byte) bool {
@@ -1,13 +1,19 @@
 package engine_util
 
 import (
-	"github.com/Connor1996/badger"
 	"github.com/golang/protobuf/proto"
 	"github.com/pingcap/errors"
 )
 
+// writeEntry is a single modification in a WriteBatch, an empty value means deletion.
+type writeEntry struct {
+	key   []byte
+	value []byte
+}
+
+// WriteBatch collects modifications which are applied atomically by Engine.Write.
 type WriteBatch struct {
-	entries       []*badger.Entry
+	entries       []*writeEntry
 	size          int
 	safePoint     int
 	safePointSize int
@@ -28,32 +34,32 @@ func (wb *WriteBatch) Len() int {
 
 // TODO: make it `SetMeta`
 func (wb *WriteBatch) Set(key, val []byte) {
-	wb.entries = append(wb.entries, &badger.Entry{
-		Key:   key,
-		Value: val,
+	wb.entries = append(wb.entries, &writeEntry{
+		key:   key,
+		value: val,
 	})
 	wb.size += len(key) + len(val)
 }
 
 func (wb *WriteBatch) SetCF(cf string, key, val []byte) {
-	wb.entries = append(wb.entries, &badger.Entry{
-		Key:   append([]byte(cf+"_"), key...),
-		Value: val,
+	wb.entries = append(wb.entries, &writeEntry{
+		key:   KeyWithCF(cf, key),
+		value: val,
 	})
 	wb.size += len(key) + len(val)
 }
 
 // TODO: make it `DeleteMeta`
 func (wb *WriteBatch) Delete(key []byte) {
-	wb.entries = append(wb.entries, &badger.Entry{
-		Key: key,
+	wb.entries = append(wb.entries, &writeEntry{
+		key: key,
 	})
 	wb.size += len(key)
 }
 
 func (wb *WriteBatch) DeleteCF(cf string, key []byte) {
-	wb.entries = append(wb.entries, &badger.Entry{
-		Key: append([]byte(cf+"_"), key...),
+	wb.entries = append(wb.entries, &writeEntry{
+		key: KeyWithCF(cf, key),
 	})
 	wb.size += len(key)
 }
@@ -78,31 +84,15 @@ func (wb *WriteBatch) RollbackToSafePoint() {
 	wb.size = wb.safePointSize
 }
 
-func (wb *WriteBatch) WriteToDB(db *badger.DB) error {
+func (wb *WriteBatch) WriteToDB(engine Engine) error {
 	if len(wb.entries) > 0 {
-		err := db.Update(func(txn *badger.Txn) error {
-			for _, entry := range wb.entries {
-				var err1 error
-				if len(entry.Value) == 0 {
-					err1 = txn.Delete(entry.Key)
-				} else {
-					err1 = txn.SetEntry(entry)
-				}
-				if err1 != nil {
-					return err1
-				}
-			}
-			return nil
-		})
-		if err != nil {
-			return errors.WithStack(err)
-		}
+		return engine.Write(wb)
 	}
 	return nil
 }
 
-func (wb *WriteBatch) MustWriteToDB(db *badger.DB) {
-	err := wb.WriteToDB(db)
+func (wb *WriteBatch) MustWriteToDB(engine Engine) {
+	err := wb.WriteToDB(engine)
 	if err != nil {
 		panic(err)
 	}
@@ -8,10 +8,13 @@ const (
 	TaskTypeStop       TaskType = 0
 	TaskTypeRaftLogGC  TaskType = 1
 	TaskTypeSplitCheck TaskType = 2
+	// Carries a *config.Config after an online config change.
+	TaskTypeConfigChange TaskType = 3
 
 	TaskTypePDAskBatchSplit  TaskType = 102
 	TaskTypePDHeartbeat      TaskType = 103
 	TaskTypePDStoreHeartbeat TaskType = 104
+	TaskTypePDResolvedTs     TaskType = 105
 
 	TaskTypeRegionGen   TaskType = 401
 	TaskTypeRegionApply TaskType = 402
@@ -38,7 +38,7 @@ func (m *NotLeader) Reset()         { *m = NotLeader{} }
 func (m *NotLeader) String() string { return proto.CompactTextString(m) }
 func (*NotLeader) ProtoMessage()    {}
 func (*NotLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{0}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{0}
 }
 func (m *NotLeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -93,7 +93,7 @@ func (m *StoreNotMatch) Reset()         { *m = StoreNotMatch{} }
 func (m *StoreNotMatch) String() string { return proto.CompactTextString(m) }
 func (*StoreNotMatch) ProtoMessage()    {}
 func (*StoreNotMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{1}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{1}
 }
 func (m *StoreNotMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,7 +147,7 @@ func (m *RegionNotFound) Reset()         { *m = RegionNotFound{} }
 func (m *RegionNotFound) String() string { return proto.CompactTextString(m) }
 func (*RegionNotFound) ProtoMessage()    {}
 func (*RegionNotFound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{2}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{2}
 }
 func (m *RegionNotFound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +197,7 @@ func (m *KeyNotInRegion) Reset()         { *m = KeyNotInRegion{} }
 func (m *KeyNotInRegion) String() string { return proto.CompactTextString(m) }
 func (*KeyNotInRegion) ProtoMessage()    {}
 func (*KeyNotInRegion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{3}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{3}
 }
 func (m *KeyNotInRegion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +265,7 @@ func (m *EpochNotMatch) Reset()         { *m = EpochNotMatch{} }
 func (m *EpochNotMatch) String() string { return proto.CompactTextString(m) }
 func (*EpochNotMatch) ProtoMessage()    {}
 func (*EpochNotMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{4}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{4}
 }
 func (m *EpochNotMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +311,7 @@ func (m *StaleCommand) Reset()         { *m = StaleCommand{} }
 func (m *StaleCommand) String() string { return proto.CompactTextString(m) }
 func (*StaleCommand) ProtoMessage()    {}
 func (*StaleCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{5}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{5}
 }
 func (m *StaleCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,6 +340,71 @@ func (m *StaleCommand) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StaleCommand proto.InternalMessageInfo
 
+// DataIsNotReady is returned when a stale read at a ts greater than the safe ts of the peer, the data of the ts may
+// not be applied to the peer yet.
+type DataIsNotReady struct {
+	RegionId             uint64   `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	PeerId               uint64   `protobuf:"varint,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	SafeTs               uint64   `protobuf:"varint,3,opt,name=safe_ts,json=safeTs,proto3" json:"safe_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataIsNotReady) Reset()         { *m = DataIsNotReady{} }
+func (m *DataIsNotReady) String() string { return proto.CompactTextString(m) }
+func (*DataIsNotReady) ProtoMessage()    {}
+func (*DataIsNotReady) Descriptor() ([]byte, []int) {
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{6}
+}
+func (m *DataIsNotReady) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataIsNotReady) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataIsNotReady.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DataIsNotReady) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataIsNotReady.Merge(dst, src)
+}
+func (m *DataIsNotReady) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataIsNotReady) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataIsNotReady.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataIsNotReady proto.InternalMessageInfo
+
+func (m *DataIsNotReady) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *DataIsNotReady) GetPeerId() uint64 {
+	if m != nil {
+		return m.PeerId
+	}
+	return 0
+}
+
+func (m *DataIsNotReady) GetSafeTs() uint64 {
+	if m != nil {
+		return m.SafeTs
+	}
+	return 0
+}
+
 type Error struct {
 	Message              string          `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	NotLeader            *NotLeader      `protobuf:"bytes,2,opt,name=not_leader,json=notLeader" json:"not_leader,omitempty"`
@@ -348,6 +413,7 @@ type Error struct {
 	EpochNotMatch        *EpochNotMatch  `protobuf:"bytes,5,opt,name=epoch_not_match,json=epochNotMatch" json:"epoch_not_match,omitempty"`
 	StaleCommand         *StaleCommand   `protobuf:"bytes,7,opt,name=stale_command,json=staleCommand" json:"stale_command,omitempty"`
 	StoreNotMatch        *StoreNotMatch  `protobuf:"bytes,8,opt,name=store_not_match,json=storeNotMatch" json:"store_not_match,omitempty"`
+	DataIsNotReady       *DataIsNotReady `protobuf:"bytes,9,opt,name=data_is_not_ready,json=dataIsNotReady" json:"data_is_not_ready,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -357,7 +423,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_6ea187258f91197d, []int{6}
+	return fileDescriptor_errorpb_d49deda6bc5b1470, []int{7}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,6 +501,13 @@ func (m *Error) GetStoreNotMatch() *StoreNotMatch {
 	return nil
 }
 
+func (m *Error) GetDataIsNotReady() *DataIsNotReady {
+	if m != nil {
+		return m.DataIsNotReady
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NotLeader)(nil), "errorpb.NotLeader")
 	proto.RegisterType((*StoreNotMatch)(nil), "errorpb.StoreNotMatch")
@@ -442,6 +515,7 @@ func init() {
 	proto.RegisterType((*KeyNotInRegion)(nil), "errorpb.KeyNotInRegion")
 	proto.RegisterType((*EpochNotMatch)(nil), "errorpb.EpochNotMatch")
 	proto.RegisterType((*StaleCommand)(nil), "errorpb.StaleCommand")
+	proto.RegisterType((*DataIsNotReady)(nil), "errorpb.DataIsNotReady")
 	proto.RegisterType((*Error)(nil), "errorpb.Error")
 }
 func (m *NotLeader) Marshal() (dAtA []byte, err error) {
@@ -635,6 +709,42 @@ func (m *StaleCommand) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DataIsNotReady) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataIsNotReady) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.RegionId))
+	}
+	if m.PeerId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.PeerId))
+	}
+	if m.SafeTs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.SafeTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -716,6 +826,16 @@ func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.DataIsNotReady != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.DataIsNotReady.Size()))
+		n8, err := m.DataIsNotReady.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -822,6 +942,24 @@ func (m *StaleCommand) Size() (n int) {
 	return n
 }
 
+func (m *DataIsNotReady) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovErrorpb(uint64(m.RegionId))
+	}
+	if m.PeerId != 0 {
+		n += 1 + sovErrorpb(uint64(m.PeerId))
+	}
+	if m.SafeTs != 0 {
+		n += 1 + sovErrorpb(uint64(m.SafeTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Error) Size() (n int) {
 	var l int
 	_ = l
@@ -853,6 +991,10 @@ func (m *Error) Size() (n int) {
 		l = m.StoreNotMatch.Size()
 		n += 1 + l + sovErrorpb(uint64(l))
 	}
+	if m.DataIsNotReady != nil {
+		l = m.DataIsNotReady.Size()
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1430,6 +1572,114 @@ func (m *StaleCommand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DataIsNotReady) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErrorpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataIsNotReady: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataIsNotReady: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			m.PeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafeTs", wireType)
+			}
+			m.SafeTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafeTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErrorpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Error) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1686,6 +1936,39 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataIsNotReady", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataIsNotReady == nil {
+				m.DataIsNotReady = &DataIsNotReady{}
+			}
+			if err := m.DataIsNotReady.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrorpb(dAtA[iNdEx:])
@@ -1813,40 +2096,43 @@ var (
 	ErrIntOverflowErrorpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("errorpb.proto", fileDescriptor_errorpb_6ea187258f91197d) }
-
-var fileDescriptor_errorpb_6ea187258f91197d = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x8e, 0x12, 0x4d,
-	0x14, 0xfd, 0x1a, 0x66, 0xf8, 0xb9, 0xd0, 0x0d, 0x5f, 0x47, 0x9d, 0xce, 0x4c, 0x42, 0x48, 0xc7,
-	0x18, 0x36, 0x62, 0xc4, 0x85, 0x89, 0x0b, 0x13, 0xc7, 0x8c, 0x91, 0xa0, 0xc4, 0xd4, 0x3c, 0x40,
-	0xa7, 0x86, 0xbe, 0x32, 0x04, 0xa8, 0xc2, 0xaa, 0x62, 0xc1, 0x9b, 0xf8, 0x00, 0x3e, 0x8c, 0x4b,
-	0x1f, 0xc1, 0xe0, 0x8b, 0x98, 0xba, 0xd5, 0x34, 0xd4, 0x2c, 0xdc, 0xf5, 0xfd, 0x39, 0xe7, 0xde,
-	0x7b, 0x4e, 0x35, 0x84, 0xa8, 0x94, 0x54, 0x9b, 0xbb, 0xe1, 0x46, 0x49, 0x23, 0xe3, 0x7a, 0x11,
-	0x5e, 0xb6, 0xd7, 0x68, 0xf8, 0x21, 0x7d, 0xf9, 0x68, 0x2e, 0xe7, 0x92, 0x3e, 0x5f, 0xd8, 0x2f,
-	0x97, 0x4d, 0xa7, 0xd0, 0x9c, 0x4a, 0xf3, 0x09, 0x79, 0x8e, 0x2a, 0xbe, 0x82, 0xa6, 0xc2, 0xf9,
-	0x42, 0x8a, 0x6c, 0x91, 0x27, 0x41, 0x3f, 0x18, 0x9c, 0xb1, 0x86, 0x4b, 0x8c, 0xf3, 0xf8, 0x29,
-	0xd4, 0x56, 0xd4, 0x96, 0x54, 0xfa, 0xc1, 0xa0, 0x35, 0x6a, 0x0f, 0x0b, 0xfa, 0x2f, 0x88, 0x8a,
-	0x15, 0xb5, 0x94, 0x43, 0x78, 0x6b, 0xa4, 0xc2, 0xa9, 0x34, 0x9f, 0xb9, 0x99, 0xdd, 0xc7, 0x03,
-	0xe8, 0x2a, 0xfc, 0xb6, 0x45, 0x6d, 0x32, 0x6d, 0x0b, 0x47, 0xea, 0xa8, 0xc8, 0x53, 0xff, 0x38,
-	0x8f, 0x9f, 0x41, 0x87, 0xcf, 0xcc, 0x96, 0xaf, 0x8e, 0x8d, 0x15, 0x6a, 0x0c, 0x5d, 0xba, 0xe8,
-	0x4b, 0x9f, 0x43, 0xc4, 0x68, 0xa9, 0xa9, 0x34, 0x1f, 0xe4, 0x56, 0xe4, 0xff, 0xdc, 0x3b, 0xdd,
-	0x42, 0x34, 0xc1, 0xdd, 0x54, 0x9a, 0xb1, 0x70, 0xb0, 0xb8, 0x0b, 0xd5, 0x25, 0xee, 0xa8, 0xb1,
-	0xcd, 0xec, 0xa7, 0x4f, 0x50, 0x79, 0x70, 0xf8, 0x15, 0x34, 0xb5, 0xe1, 0xca, 0x64, 0x16, 0x54,
-	0x25, 0x50, 0x83, 0x12, 0x13, 0xdc, 0xc5, 0x17, 0x50, 0x47, 0x91, 0x53, 0xe9, 0x8c, 0x4a, 0x35,
-	0x14, 0xf9, 0x04, 0x77, 0xe9, 0x47, 0x08, 0x6f, 0x36, 0x72, 0x76, 0x5f, 0x0a, 0xf1, 0x1a, 0x3a,
-	0xb3, 0xad, 0x52, 0x28, 0x4c, 0xe6, 0xa8, 0x75, 0x12, 0xf4, 0xab, 0x83, 0xd6, 0x28, 0x3a, 0x08,
-	0xe9, 0xd6, 0x63, 0x51, 0xd1, 0xe6, 0x42, 0x9d, 0x46, 0xd0, 0xbe, 0x35, 0x7c, 0x85, 0xef, 0xe5,
-	0x7a, 0xcd, 0x45, 0x9e, 0xfe, 0xa8, 0xc2, 0xf9, 0x8d, 0xb5, 0x38, 0x4e, 0xa0, 0xbe, 0x46, 0xad,
-	0xf9, 0x1c, 0xe9, 0x98, 0x26, 0x3b, 0x84, 0xf1, 0x4b, 0x00, 0x21, 0x4d, 0xe6, 0x19, 0x16, 0x0f,
-	0x0f, 0xef, 0xa4, 0x74, 0x9c, 0x35, 0x45, 0x69, 0xfe, 0x3b, 0x6b, 0x14, 0x69, 0x60, 0x91, 0x5f,
-	0xad, 0xb0, 0x74, 0x6d, 0x6b, 0x74, 0x51, 0x02, 0x7d, 0xdd, 0xad, 0x83, 0x9e, 0x0f, 0xd7, 0xf0,
-	0xff, 0x12, 0x77, 0x84, 0x5f, 0x88, 0xe2, 0x4a, 0x92, 0xe5, 0x94, 0xc3, 0x37, 0x83, 0x45, 0x4b,
-	0xdf, 0x9c, 0xb7, 0xd0, 0x41, 0xab, 0x1b, 0xb1, 0xac, 0xad, 0x72, 0xc9, 0x39, 0x31, 0x3c, 0x29,
-	0x19, 0x3c, 0x5d, 0x59, 0x88, 0x9e, 0xcc, 0x6f, 0x20, 0xd4, 0x56, 0xad, 0x6c, 0xe6, 0xe4, 0x4a,
-	0xea, 0x84, 0x7e, 0x5c, 0xa2, 0x4f, 0xb5, 0x64, 0x6d, 0x7d, 0x12, 0xd9, 0xd9, 0xee, 0xe9, 0x1d,
-	0x67, 0x37, 0x1e, 0xcc, 0xf6, 0x1e, 0x37, 0x0b, 0xb5, 0x17, 0xb6, 0xdc, 0x64, 0x5a, 0xe8, 0xba,
-	0xfb, 0x73, 0xdf, 0x0b, 0x7e, 0xed, 0x7b, 0xc1, 0xef, 0x7d, 0x2f, 0xf8, 0xfe, 0xa7, 0xf7, 0xdf,
-	0x5d, 0x8d, 0x7e, 0xb9, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x26, 0x03, 0xaa, 0x44, 0xb0,
-	0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("errorpb.proto", fileDescriptor_errorpb_d49deda6bc5b1470) }
+
+var fileDescriptor_errorpb_d49deda6bc5b1470 = []byte{
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x8e, 0x12, 0x4f,
+	0x10, 0xfe, 0xcd, 0xc2, 0x02, 0x53, 0x30, 0x03, 0xbf, 0x89, 0xca, 0x64, 0x37, 0x21, 0x64, 0x62,
+	0x0c, 0x17, 0x31, 0xe2, 0xc1, 0xc4, 0x83, 0x89, 0xab, 0x6b, 0x24, 0xe8, 0xc4, 0xf4, 0x7a, 0x9f,
+	0xf4, 0xd2, 0xb5, 0x2c, 0x01, 0xa6, 0xb1, 0xbb, 0x39, 0xcc, 0x9b, 0xf8, 0x48, 0x1e, 0x7d, 0x04,
+	0x83, 0x17, 0x1f, 0xc3, 0x74, 0xf7, 0xf0, 0xa7, 0x39, 0xec, 0xad, 0xbf, 0xaa, 0xfa, 0xbe, 0xae,
+	0xea, 0xaf, 0x66, 0x20, 0x40, 0x21, 0xb8, 0x58, 0xdf, 0x0e, 0xd7, 0x82, 0x2b, 0x1e, 0xd5, 0x4b,
+	0x78, 0xd1, 0x5a, 0xa1, 0xa2, 0xbb, 0xf0, 0xc5, 0xa3, 0x19, 0x9f, 0x71, 0x73, 0x7c, 0xa1, 0x4f,
+	0x36, 0x9a, 0xa4, 0xe0, 0xa7, 0x5c, 0x7d, 0x46, 0xca, 0x50, 0x44, 0x97, 0xe0, 0x0b, 0x9c, 0xcd,
+	0x79, 0x9e, 0xcd, 0x59, 0xec, 0xf5, 0xbd, 0x41, 0x95, 0x34, 0x6c, 0x60, 0xcc, 0xa2, 0xa7, 0x50,
+	0x5b, 0x9a, 0xb2, 0xf8, 0xac, 0xef, 0x0d, 0x9a, 0xa3, 0xd6, 0xb0, 0x94, 0xff, 0x8a, 0x28, 0x48,
+	0x99, 0x4b, 0x28, 0x04, 0x37, 0x8a, 0x0b, 0x4c, 0xb9, 0xfa, 0x42, 0xd5, 0xf4, 0x3e, 0x1a, 0x40,
+	0x47, 0xe0, 0xf7, 0x0d, 0x4a, 0x95, 0x49, 0x9d, 0x38, 0x48, 0x87, 0x65, 0xdc, 0xd4, 0x8f, 0x59,
+	0xf4, 0x0c, 0xda, 0x74, 0xaa, 0x36, 0x74, 0x79, 0x28, 0x3c, 0x33, 0x85, 0x81, 0x0d, 0x97, 0x75,
+	0xc9, 0x73, 0x08, 0x89, 0x69, 0x2a, 0xe5, 0xea, 0x23, 0xdf, 0xe4, 0xec, 0xc1, 0xbe, 0x93, 0x0d,
+	0x84, 0x13, 0x2c, 0x52, 0xae, 0xc6, 0xb9, 0xa5, 0x45, 0x1d, 0xa8, 0x2c, 0xb0, 0x30, 0x85, 0x2d,
+	0xa2, 0x8f, 0xae, 0xc0, 0xd9, 0xc9, 0xe0, 0x97, 0xe0, 0x4b, 0x45, 0x85, 0xca, 0x34, 0xa9, 0x62,
+	0x48, 0x0d, 0x13, 0x98, 0x60, 0x11, 0x75, 0xa1, 0x8e, 0x39, 0x33, 0xa9, 0xaa, 0x49, 0xd5, 0x30,
+	0x67, 0x13, 0x2c, 0x92, 0x4f, 0x10, 0x5c, 0xaf, 0xf9, 0xf4, 0x7e, 0xff, 0x10, 0xaf, 0xa1, 0x3d,
+	0xdd, 0x08, 0x81, 0xb9, 0xca, 0xac, 0xb4, 0x8c, 0xbd, 0x7e, 0x65, 0xd0, 0x1c, 0x85, 0xbb, 0x87,
+	0xb4, 0xed, 0x91, 0xb0, 0x2c, 0xb3, 0x50, 0x26, 0x21, 0xb4, 0x6e, 0x14, 0x5d, 0xe2, 0x7b, 0xbe,
+	0x5a, 0xd1, 0x9c, 0x25, 0x19, 0x84, 0x1f, 0xa8, 0xa2, 0x63, 0x99, 0x72, 0x45, 0x90, 0xb2, 0xe2,
+	0x61, 0xdf, 0xba, 0x50, 0x5f, 0x23, 0x8a, 0xc3, 0x64, 0x35, 0x0d, 0x6d, 0x42, 0xd2, 0x3b, 0xcc,
+	0x94, 0x34, 0x53, 0x55, 0x49, 0x4d, 0xc3, 0x6f, 0x32, 0xf9, 0x5b, 0x81, 0xf3, 0x6b, 0xbd, 0x43,
+	0x51, 0x0c, 0xf5, 0x15, 0x4a, 0x49, 0x67, 0x68, 0x64, 0x7d, 0xb2, 0x83, 0xd1, 0x4b, 0x80, 0x9c,
+	0xab, 0xcc, 0xd9, 0x88, 0x68, 0xb8, 0x5b, 0xc4, 0xfd, 0x4a, 0x11, 0x3f, 0xdf, 0x1d, 0xa3, 0x77,
+	0xd0, 0xb1, 0x4d, 0x65, 0x9a, 0x79, 0xa7, 0x9d, 0x33, 0x17, 0x37, 0x47, 0xdd, 0x3d, 0xd1, 0x35,
+	0x56, 0xaf, 0x88, 0x63, 0xf4, 0x15, 0xfc, 0xbf, 0xc0, 0xc2, 0xf0, 0xe7, 0x79, 0xf9, 0x8c, 0x71,
+	0xf5, 0x44, 0xc3, 0x75, 0x9b, 0x84, 0x0b, 0xd7, 0xfd, 0xb7, 0xd0, 0x46, 0x6d, 0x8c, 0x51, 0x59,
+	0x69, 0x6b, 0xe2, 0x73, 0xa3, 0xf0, 0x64, 0xaf, 0xe0, 0x18, 0x47, 0x02, 0x74, 0x7c, 0x7c, 0x03,
+	0x81, 0xd4, 0x76, 0x64, 0x53, 0xeb, 0x47, 0x5c, 0x37, 0xec, 0xc7, 0x7b, 0xf6, 0xb1, 0x59, 0xa4,
+	0x25, 0x8f, 0x90, 0xbe, 0xdb, 0xee, 0xf6, 0xe1, 0xee, 0xc6, 0xc9, 0xdd, 0xce, 0xd7, 0x43, 0x02,
+	0x79, 0x0c, 0xf5, 0xfc, 0x8c, 0x2a, 0x9a, 0xcd, 0xa5, 0x51, 0x10, 0xda, 0xfd, 0xd8, 0x3f, 0x99,
+	0xdf, 0x5d, 0x0e, 0x12, 0x32, 0x17, 0x37, 0x6d, 0xf7, 0x66, 0xa8, 0xab, 0xce, 0xcf, 0x6d, 0xcf,
+	0xfb, 0xb5, 0xed, 0x79, 0xbf, 0xb7, 0x3d, 0xef, 0xc7, 0x9f, 0xde, 0x7f, 0xb7, 0x35, 0xf3, 0x5f,
+	0x78, 0xf5, 0x6f, 0x00, 0xf1, 0xfa, 0x27, 0xb1, 0x55, 0x04, 0x00, 0x00,
 }
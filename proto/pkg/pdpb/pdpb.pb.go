@@ -67,7 +67,7 @@ func (x ErrorType) String() string {
 	return proto.EnumName(ErrorType_name, int32(x))
 }
 func (ErrorType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{0}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{0}
 }
 
 type OperatorStatus int32
@@ -99,7 +99,7 @@ func (x OperatorStatus) String() string {
 	return proto.EnumName(OperatorStatus_name, int32(x))
 }
 func (OperatorStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{1}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{1}
 }
 
 type RequestHeader struct {
@@ -114,7 +114,7 @@ func (m *RequestHeader) Reset()         { *m = RequestHeader{} }
 func (m *RequestHeader) String() string { return proto.CompactTextString(m) }
 func (*RequestHeader) ProtoMessage()    {}
 func (*RequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{0}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{0}
 }
 func (m *RequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +163,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{1}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{1}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +218,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{2}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{2}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +273,7 @@ func (m *TsoRequest) Reset()         { *m = TsoRequest{} }
 func (m *TsoRequest) String() string { return proto.CompactTextString(m) }
 func (*TsoRequest) ProtoMessage()    {}
 func (*TsoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{3}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{3}
 }
 func (m *TsoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +328,7 @@ func (m *Timestamp) Reset()         { *m = Timestamp{} }
 func (m *Timestamp) String() string { return proto.CompactTextString(m) }
 func (*Timestamp) ProtoMessage()    {}
 func (*Timestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{4}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{4}
 }
 func (m *Timestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +384,7 @@ func (m *TsoResponse) Reset()         { *m = TsoResponse{} }
 func (m *TsoResponse) String() string { return proto.CompactTextString(m) }
 func (*TsoResponse) ProtoMessage()    {}
 func (*TsoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{5}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{5}
 }
 func (m *TsoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +446,7 @@ func (m *BootstrapRequest) Reset()         { *m = BootstrapRequest{} }
 func (m *BootstrapRequest) String() string { return proto.CompactTextString(m) }
 func (*BootstrapRequest) ProtoMessage()    {}
 func (*BootstrapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{6}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{6}
 }
 func (m *BootstrapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +500,7 @@ func (m *BootstrapResponse) Reset()         { *m = BootstrapResponse{} }
 func (m *BootstrapResponse) String() string { return proto.CompactTextString(m) }
 func (*BootstrapResponse) ProtoMessage()    {}
 func (*BootstrapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{7}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{7}
 }
 func (m *BootstrapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +547,7 @@ func (m *IsBootstrappedRequest) Reset()         { *m = IsBootstrappedRequest{} }
 func (m *IsBootstrappedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedRequest) ProtoMessage()    {}
 func (*IsBootstrappedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{8}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{8}
 }
 func (m *IsBootstrappedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +595,7 @@ func (m *IsBootstrappedResponse) Reset()         { *m = IsBootstrappedResponse{}
 func (m *IsBootstrappedResponse) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedResponse) ProtoMessage()    {}
 func (*IsBootstrappedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{9}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{9}
 }
 func (m *IsBootstrappedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +649,7 @@ func (m *AllocIDRequest) Reset()         { *m = AllocIDRequest{} }
 func (m *AllocIDRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocIDRequest) ProtoMessage()    {}
 func (*AllocIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{10}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{10}
 }
 func (m *AllocIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +697,7 @@ func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
 func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocIDResponse) ProtoMessage()    {}
 func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{11}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{11}
 }
 func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +752,7 @@ func (m *GetStoreRequest) Reset()         { *m = GetStoreRequest{} }
 func (m *GetStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStoreRequest) ProtoMessage()    {}
 func (*GetStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{12}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{12}
 }
 func (m *GetStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +808,7 @@ func (m *GetStoreResponse) Reset()         { *m = GetStoreResponse{} }
 func (m *GetStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStoreResponse) ProtoMessage()    {}
 func (*GetStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{13}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{13}
 }
 func (m *GetStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +870,7 @@ func (m *PutStoreRequest) Reset()         { *m = PutStoreRequest{} }
 func (m *PutStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*PutStoreRequest) ProtoMessage()    {}
 func (*PutStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{14}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{14}
 }
 func (m *PutStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +924,7 @@ func (m *PutStoreResponse) Reset()         { *m = PutStoreResponse{} }
 func (m *PutStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*PutStoreResponse) ProtoMessage()    {}
 func (*PutStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{15}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{15}
 }
 func (m *PutStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +973,7 @@ func (m *GetAllStoresRequest) Reset()         { *m = GetAllStoresRequest{} }
 func (m *GetAllStoresRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresRequest) ProtoMessage()    {}
 func (*GetAllStoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{16}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{16}
 }
 func (m *GetAllStoresRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1028,7 @@ func (m *GetAllStoresResponse) Reset()         { *m = GetAllStoresResponse{} }
 func (m *GetAllStoresResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresResponse) ProtoMessage()    {}
 func (*GetAllStoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{17}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{17}
 }
 func (m *GetAllStoresResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1083,7 @@ func (m *GetRegionRequest) Reset()         { *m = GetRegionRequest{} }
 func (m *GetRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionRequest) ProtoMessage()    {}
 func (*GetRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{18}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{18}
 }
 func (m *GetRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,7 +1140,7 @@ func (m *GetRegionResponse) Reset()         { *m = GetRegionResponse{} }
 func (m *GetRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegionResponse) ProtoMessage()    {}
 func (*GetRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{19}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{19}
 }
 func (m *GetRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1209,7 @@ func (m *GetRegionByIDRequest) Reset()         { *m = GetRegionByIDRequest{} }
 func (m *GetRegionByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionByIDRequest) ProtoMessage()    {}
 func (*GetRegionByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{20}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{20}
 }
 func (m *GetRegionByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1266,7 @@ func (m *ScanRegionsRequest) Reset()         { *m = ScanRegionsRequest{} }
 func (m *ScanRegionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsRequest) ProtoMessage()    {}
 func (*ScanRegionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{21}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{21}
 }
 func (m *ScanRegionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1336,7 @@ func (m *ScanRegionsResponse) Reset()         { *m = ScanRegionsResponse{} }
 func (m *ScanRegionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsResponse) ProtoMessage()    {}
 func (*ScanRegionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{22}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{22}
 }
 func (m *ScanRegionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1397,7 @@ func (m *GetClusterConfigRequest) Reset()         { *m = GetClusterConfigRequest
 func (m *GetClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigRequest) ProtoMessage()    {}
 func (*GetClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{23}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{23}
 }
 func (m *GetClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1445,7 +1445,7 @@ func (m *GetClusterConfigResponse) Reset()         { *m = GetClusterConfigRespon
 func (m *GetClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigResponse) ProtoMessage()    {}
 func (*GetClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{24}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{24}
 }
 func (m *GetClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1500,7 @@ func (m *PutClusterConfigRequest) Reset()         { *m = PutClusterConfigRequest
 func (m *PutClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigRequest) ProtoMessage()    {}
 func (*PutClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{25}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{25}
 }
 func (m *PutClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1554,7 +1554,7 @@ func (m *PutClusterConfigResponse) Reset()         { *m = PutClusterConfigRespon
 func (m *PutClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigResponse) ProtoMessage()    {}
 func (*PutClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{26}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{26}
 }
 func (m *PutClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1607,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{27}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{27}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1682,7 @@ func (m *GetMembersRequest) Reset()         { *m = GetMembersRequest{} }
 func (m *GetMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMembersRequest) ProtoMessage()    {}
 func (*GetMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{28}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{28}
 }
 func (m *GetMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +1732,7 @@ func (m *GetMembersResponse) Reset()         { *m = GetMembersResponse{} }
 func (m *GetMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMembersResponse) ProtoMessage()    {}
 func (*GetMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{29}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{29}
 }
 func (m *GetMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,11 +1789,68 @@ func (m *GetMembersResponse) GetEtcdLeader() *Member {
 	return nil
 }
 
+type PeerStats struct {
+	Peer                 *metapb.Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	DownSeconds          uint64       `protobuf:"varint,2,opt,name=down_seconds,json=downSeconds,proto3" json:"down_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PeerStats) Reset()         { *m = PeerStats{} }
+func (m *PeerStats) String() string { return proto.CompactTextString(m) }
+func (*PeerStats) ProtoMessage()    {}
+func (*PeerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{30}
+}
+func (m *PeerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PeerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerStats.Merge(dst, src)
+}
+func (m *PeerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerStats proto.InternalMessageInfo
+
+func (m *PeerStats) GetPeer() *metapb.Peer {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *PeerStats) GetDownSeconds() uint64 {
+	if m != nil {
+		return m.DownSeconds
+	}
+	return 0
+}
+
 type RegionHeartbeatRequest struct {
 	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Region *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
 	// Leader Peer sending the heartbeat.
 	Leader *metapb.Peer `protobuf:"bytes,3,opt,name=leader" json:"leader,omitempty"`
+	// Leader considers that these peers are down.
+	DownPeers []*PeerStats `protobuf:"bytes,4,rep,name=down_peers,json=downPeers" json:"down_peers,omitempty"`
 	// Pending peers are the peers that the leader can't consider as
 	// working followers.
 	PendingPeers []*metapb.Peer `protobuf:"bytes,5,rep,name=pending_peers,json=pendingPeers" json:"pending_peers,omitempty"`
@@ -1814,7 +1871,7 @@ func (m *RegionHeartbeatRequest) Reset()         { *m = RegionHeartbeatRequest{}
 func (m *RegionHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatRequest) ProtoMessage()    {}
 func (*RegionHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{30}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{31}
 }
 func (m *RegionHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,6 +1921,13 @@ func (m *RegionHeartbeatRequest) GetLeader() *metapb.Peer {
 	return nil
 }
 
+func (m *RegionHeartbeatRequest) GetDownPeers() []*PeerStats {
+	if m != nil {
+		return m.DownPeers
+	}
+	return nil
+}
+
 func (m *RegionHeartbeatRequest) GetPendingPeers() []*metapb.Peer {
 	if m != nil {
 		return m.PendingPeers
@@ -1911,7 +1975,7 @@ func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
 func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
 func (*ChangePeer) ProtoMessage()    {}
 func (*ChangePeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{31}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{32}
 }
 func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2029,7 @@ func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
 func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
 func (*TransferLeader) ProtoMessage()    {}
 func (*TransferLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{32}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{33}
 }
 func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2098,7 @@ func (m *RegionHeartbeatResponse) Reset()         { *m = RegionHeartbeatResponse
 func (m *RegionHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatResponse) ProtoMessage()    {}
 func (*RegionHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{33}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{34}
 }
 func (m *RegionHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2117,7 +2181,7 @@ func (m *AskSplitRequest) Reset()         { *m = AskSplitRequest{} }
 func (m *AskSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*AskSplitRequest) ProtoMessage()    {}
 func (*AskSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{34}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{35}
 }
 func (m *AskSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2177,7 +2241,7 @@ func (m *AskSplitResponse) Reset()         { *m = AskSplitResponse{} }
 func (m *AskSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*AskSplitResponse) ProtoMessage()    {}
 func (*AskSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{35}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{36}
 }
 func (m *AskSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2240,7 +2304,7 @@ func (m *ReportSplitRequest) Reset()         { *m = ReportSplitRequest{} }
 func (m *ReportSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitRequest) ProtoMessage()    {}
 func (*ReportSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{36}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{37}
 }
 func (m *ReportSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2301,7 +2365,7 @@ func (m *ReportSplitResponse) Reset()         { *m = ReportSplitResponse{} }
 func (m *ReportSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitResponse) ProtoMessage()    {}
 func (*ReportSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{37}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{38}
 }
 func (m *ReportSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2350,7 +2414,7 @@ func (m *AskBatchSplitRequest) Reset()         { *m = AskBatchSplitRequest{} }
 func (m *AskBatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitRequest) ProtoMessage()    {}
 func (*AskBatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{38}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{39}
 }
 func (m *AskBatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2412,7 +2476,7 @@ func (m *SplitID) Reset()         { *m = SplitID{} }
 func (m *SplitID) String() string { return proto.CompactTextString(m) }
 func (*SplitID) ProtoMessage()    {}
 func (*SplitID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{39}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{40}
 }
 func (m *SplitID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2467,7 +2531,7 @@ func (m *AskBatchSplitResponse) Reset()         { *m = AskBatchSplitResponse{} }
 func (m *AskBatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitResponse) ProtoMessage()    {}
 func (*AskBatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{40}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{41}
 }
 func (m *AskBatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2522,7 +2586,7 @@ func (m *ReportBatchSplitRequest) Reset()         { *m = ReportBatchSplitRequest
 func (m *ReportBatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportBatchSplitRequest) ProtoMessage()    {}
 func (*ReportBatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{41}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{42}
 }
 func (m *ReportBatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +2640,7 @@ func (m *ReportBatchSplitResponse) Reset()         { *m = ReportBatchSplitRespon
 func (m *ReportBatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportBatchSplitResponse) ProtoMessage()    {}
 func (*ReportBatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{42}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{43}
 }
 func (m *ReportBatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,7 +2690,7 @@ func (m *TimeInterval) Reset()         { *m = TimeInterval{} }
 func (m *TimeInterval) String() string { return proto.CompactTextString(m) }
 func (*TimeInterval) ProtoMessage()    {}
 func (*TimeInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{43}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{44}
 }
 func (m *TimeInterval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2681,7 +2745,7 @@ func (m *RecordPair) Reset()         { *m = RecordPair{} }
 func (m *RecordPair) String() string { return proto.CompactTextString(m) }
 func (*RecordPair) ProtoMessage()    {}
 func (*RecordPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{44}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{45}
 }
 func (m *RecordPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2763,7 +2827,7 @@ func (m *StoreStats) Reset()         { *m = StoreStats{} }
 func (m *StoreStats) String() string { return proto.CompactTextString(m) }
 func (*StoreStats) ProtoMessage()    {}
 func (*StoreStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{45}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{46}
 }
 func (m *StoreStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,7 +2973,7 @@ func (m *StoreHeartbeatRequest) Reset()         { *m = StoreHeartbeatRequest{} }
 func (m *StoreHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatRequest) ProtoMessage()    {}
 func (*StoreHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{46}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{47}
 }
 func (m *StoreHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2963,7 +3027,7 @@ func (m *StoreHeartbeatResponse) Reset()         { *m = StoreHeartbeatResponse{}
 func (m *StoreHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatResponse) ProtoMessage()    {}
 func (*StoreHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{47}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{48}
 }
 func (m *StoreHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3015,7 +3079,7 @@ func (m *ScatterRegionRequest) Reset()         { *m = ScatterRegionRequest{} }
 func (m *ScatterRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionRequest) ProtoMessage()    {}
 func (*ScatterRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{48}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{49}
 }
 func (m *ScatterRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3083,7 +3147,7 @@ func (m *ScatterRegionResponse) Reset()         { *m = ScatterRegionResponse{} }
 func (m *ScatterRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionResponse) ProtoMessage()    {}
 func (*ScatterRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{49}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{50}
 }
 func (m *ScatterRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3130,7 +3194,7 @@ func (m *GetGCSafePointRequest) Reset()         { *m = GetGCSafePointRequest{} }
 func (m *GetGCSafePointRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGCSafePointRequest) ProtoMessage()    {}
 func (*GetGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{50}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{51}
 }
 func (m *GetGCSafePointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3178,7 +3242,7 @@ func (m *GetGCSafePointResponse) Reset()         { *m = GetGCSafePointResponse{}
 func (m *GetGCSafePointResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGCSafePointResponse) ProtoMessage()    {}
 func (*GetGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{51}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{52}
 }
 func (m *GetGCSafePointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3233,7 +3297,7 @@ func (m *UpdateGCSafePointRequest) Reset()         { *m = UpdateGCSafePointReque
 func (m *UpdateGCSafePointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGCSafePointRequest) ProtoMessage()    {}
 func (*UpdateGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{52}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{53}
 }
 func (m *UpdateGCSafePointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3288,7 +3352,7 @@ func (m *UpdateGCSafePointResponse) Reset()         { *m = UpdateGCSafePointResp
 func (m *UpdateGCSafePointResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateGCSafePointResponse) ProtoMessage()    {}
 func (*UpdateGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{53}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{54}
 }
 func (m *UpdateGCSafePointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3407,7 @@ func (m *GetOperatorRequest) Reset()         { *m = GetOperatorRequest{} }
 func (m *GetOperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOperatorRequest) ProtoMessage()    {}
 func (*GetOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{54}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{55}
 }
 func (m *GetOperatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3401,7 +3465,7 @@ func (m *GetOperatorResponse) Reset()         { *m = GetOperatorResponse{} }
 func (m *GetOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOperatorResponse) ProtoMessage()    {}
 func (*GetOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_50227df4ceb18ee4, []int{55}
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{56}
 }
 func (m *GetOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3465,1622 +3529,1453 @@ func (m *GetOperatorResponse) GetKind() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RequestHeader)(nil), "pdpb.RequestHeader")
-	proto.RegisterType((*ResponseHeader)(nil), "pdpb.ResponseHeader")
-	proto.RegisterType((*Error)(nil), "pdpb.Error")
-	proto.RegisterType((*TsoRequest)(nil), "pdpb.TsoRequest")
-	proto.RegisterType((*Timestamp)(nil), "pdpb.Timestamp")
-	proto.RegisterType((*TsoResponse)(nil), "pdpb.TsoResponse")
-	proto.RegisterType((*BootstrapRequest)(nil), "pdpb.BootstrapRequest")
-	proto.RegisterType((*BootstrapResponse)(nil), "pdpb.BootstrapResponse")
-	proto.RegisterType((*IsBootstrappedRequest)(nil), "pdpb.IsBootstrappedRequest")
-	proto.RegisterType((*IsBootstrappedResponse)(nil), "pdpb.IsBootstrappedResponse")
-	proto.RegisterType((*AllocIDRequest)(nil), "pdpb.AllocIDRequest")
-	proto.RegisterType((*AllocIDResponse)(nil), "pdpb.AllocIDResponse")
-	proto.RegisterType((*GetStoreRequest)(nil), "pdpb.GetStoreRequest")
-	proto.RegisterType((*GetStoreResponse)(nil), "pdpb.GetStoreResponse")
-	proto.RegisterType((*PutStoreRequest)(nil), "pdpb.PutStoreRequest")
-	proto.RegisterType((*PutStoreResponse)(nil), "pdpb.PutStoreResponse")
-	proto.RegisterType((*GetAllStoresRequest)(nil), "pdpb.GetAllStoresRequest")
-	proto.RegisterType((*GetAllStoresResponse)(nil), "pdpb.GetAllStoresResponse")
-	proto.RegisterType((*GetRegionRequest)(nil), "pdpb.GetRegionRequest")
-	proto.RegisterType((*GetRegionResponse)(nil), "pdpb.GetRegionResponse")
-	proto.RegisterType((*GetRegionByIDRequest)(nil), "pdpb.GetRegionByIDRequest")
-	proto.RegisterType((*ScanRegionsRequest)(nil), "pdpb.ScanRegionsRequest")
-	proto.RegisterType((*ScanRegionsResponse)(nil), "pdpb.ScanRegionsResponse")
-	proto.RegisterType((*GetClusterConfigRequest)(nil), "pdpb.GetClusterConfigRequest")
-	proto.RegisterType((*GetClusterConfigResponse)(nil), "pdpb.GetClusterConfigResponse")
-	proto.RegisterType((*PutClusterConfigRequest)(nil), "pdpb.PutClusterConfigRequest")
-	proto.RegisterType((*PutClusterConfigResponse)(nil), "pdpb.PutClusterConfigResponse")
-	proto.RegisterType((*Member)(nil), "pdpb.Member")
-	proto.RegisterType((*GetMembersRequest)(nil), "pdpb.GetMembersRequest")
-	proto.RegisterType((*GetMembersResponse)(nil), "pdpb.GetMembersResponse")
-	proto.RegisterType((*RegionHeartbeatRequest)(nil), "pdpb.RegionHeartbeatRequest")
-	proto.RegisterType((*ChangePeer)(nil), "pdpb.ChangePeer")
-	proto.RegisterType((*TransferLeader)(nil), "pdpb.TransferLeader")
-	proto.RegisterType((*RegionHeartbeatResponse)(nil), "pdpb.RegionHeartbeatResponse")
-	proto.RegisterType((*AskSplitRequest)(nil), "pdpb.AskSplitRequest")
-	proto.RegisterType((*AskSplitResponse)(nil), "pdpb.AskSplitResponse")
-	proto.RegisterType((*ReportSplitRequest)(nil), "pdpb.ReportSplitRequest")
-	proto.RegisterType((*ReportSplitResponse)(nil), "pdpb.ReportSplitResponse")
-	proto.RegisterType((*AskBatchSplitRequest)(nil), "pdpb.AskBatchSplitRequest")
-	proto.RegisterType((*SplitID)(nil), "pdpb.SplitID")
-	proto.RegisterType((*AskBatchSplitResponse)(nil), "pdpb.AskBatchSplitResponse")
-	proto.RegisterType((*ReportBatchSplitRequest)(nil), "pdpb.ReportBatchSplitRequest")
-	proto.RegisterType((*ReportBatchSplitResponse)(nil), "pdpb.ReportBatchSplitResponse")
-	proto.RegisterType((*TimeInterval)(nil), "pdpb.TimeInterval")
-	proto.RegisterType((*RecordPair)(nil), "pdpb.RecordPair")
-	proto.RegisterType((*StoreStats)(nil), "pdpb.StoreStats")
-	proto.RegisterType((*StoreHeartbeatRequest)(nil), "pdpb.StoreHeartbeatRequest")
-	proto.RegisterType((*StoreHeartbeatResponse)(nil), "pdpb.StoreHeartbeatResponse")
-	proto.RegisterType((*ScatterRegionRequest)(nil), "pdpb.ScatterRegionRequest")
-	proto.RegisterType((*ScatterRegionResponse)(nil), "pdpb.ScatterRegionResponse")
-	proto.RegisterType((*GetGCSafePointRequest)(nil), "pdpb.GetGCSafePointRequest")
-	proto.RegisterType((*GetGCSafePointResponse)(nil), "pdpb.GetGCSafePointResponse")
-	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "pdpb.UpdateGCSafePointRequest")
-	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "pdpb.UpdateGCSafePointResponse")
-	proto.RegisterType((*GetOperatorRequest)(nil), "pdpb.GetOperatorRequest")
-	proto.RegisterType((*GetOperatorResponse)(nil), "pdpb.GetOperatorResponse")
-	proto.RegisterEnum("pdpb.ErrorType", ErrorType_name, ErrorType_value)
-	proto.RegisterEnum("pdpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
+type GetOperatorHistoryRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// The filters of the history, 0 means no filter.
+	RegionId uint64 `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	StoreId  uint64 `protobuf:"varint,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	// The time window of the finish time in unix nanoseconds, [start_time, end_time).
+	StartTime            int64    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetOperatorHistoryRequest) Reset()         { *m = GetOperatorHistoryRequest{} }
+func (m *GetOperatorHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorHistoryRequest) ProtoMessage()    {}
+func (*GetOperatorHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{57}
+}
+func (m *GetOperatorHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetOperatorHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorHistoryRequest.Merge(dst, src)
+}
+func (m *GetOperatorHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorHistoryRequest.DiscardUnknown(m)
+}
 
-// Client API for PD service
+var xxx_messageInfo_GetOperatorHistoryRequest proto.InternalMessageInfo
 
-type PDClient interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
-	Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error)
-	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
-	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
-	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
-	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
-	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
-	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
-	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error)
-	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
-	AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error)
-	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
-	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
-	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
-	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
-	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+func (m *GetOperatorHistoryRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-type pDClient struct {
-	cc *grpc.ClientConn
+func (m *GetOperatorHistoryRequest) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
 }
 
-func NewPDClient(cc *grpc.ClientConn) PDClient {
-	return &pDClient{cc}
+func (m *GetOperatorHistoryRequest) GetStoreId() uint64 {
+	if m != nil {
+		return m.StoreId
+	}
+	return 0
 }
 
-func (c *pDClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
-	out := new(GetMembersResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOperatorHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[0], "/pdpb.PD/Tso", opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOperatorHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	x := &pDTsoClient{stream}
-	return x, nil
+	return 0
 }
 
-type PD_TsoClient interface {
-	Send(*TsoRequest) error
-	Recv() (*TsoResponse, error)
-	grpc.ClientStream
+type OperatorStepRecord struct {
+	Step string `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	// The finish time in unix nanoseconds, 0 if the step is not finished.
+	FinishTime           int64    `protobuf:"varint,2,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type pDTsoClient struct {
-	grpc.ClientStream
+func (m *OperatorStepRecord) Reset()         { *m = OperatorStepRecord{} }
+func (m *OperatorStepRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorStepRecord) ProtoMessage()    {}
+func (*OperatorStepRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{58}
 }
-
-func (x *pDTsoClient) Send(m *TsoRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *OperatorStepRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *pDTsoClient) Recv() (*TsoResponse, error) {
-	m := new(TsoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *OperatorStepRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorStepRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *pDClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
-	out := new(BootstrapResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/Bootstrap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (dst *OperatorStepRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorStepRecord.Merge(dst, src)
 }
-
-func (c *pDClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
-	out := new(IsBootstrappedResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/IsBootstrapped", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OperatorStepRecord) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *pDClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
-	out := new(AllocIDResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/AllocID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OperatorStepRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorStepRecord.DiscardUnknown(m)
 }
 
-func (c *pDClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
-	out := new(GetStoreResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperatorStepRecord proto.InternalMessageInfo
+
+func (m *OperatorStepRecord) GetStep() string {
+	if m != nil {
+		return m.Step
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pDClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
-	out := new(PutStoreResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/PutStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorStepRecord) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
-	out := new(GetAllStoresResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetAllStores", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OperatorRecord struct {
+	RegionId uint64 `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// The scheduler or checker creates the operator.
+	Desc   string         `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Brief  string         `protobuf:"bytes,3,opt,name=brief,proto3" json:"brief,omitempty"`
+	Kind   string         `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status OperatorStatus `protobuf:"varint,5,opt,name=status,proto3,enum=pdpb.OperatorStatus" json:"status,omitempty"`
+	// Why the operator is not finished successfully.
+	Reason   string                `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	StoreIds []uint64              `protobuf:"varint,7,rep,packed,name=store_ids,json=storeIds" json:"store_ids,omitempty"`
+	Steps    []*OperatorStepRecord `protobuf:"bytes,8,rep,name=steps" json:"steps,omitempty"`
+	// The times in unix nanoseconds.
+	CreateTime           int64    `protobuf:"varint,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	StartTime            int64    `protobuf:"varint,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	FinishTime           int64    `protobuf:"varint,11,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *pDClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
-	out := new(StoreHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/StoreHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OperatorRecord) Reset()         { *m = OperatorRecord{} }
+func (m *OperatorRecord) String() string { return proto.CompactTextString(m) }
+func (*OperatorRecord) ProtoMessage()    {}
+func (*OperatorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{59}
 }
-
-func (c *pDClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[1], "/pdpb.PD/RegionHeartbeat", opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &pDRegionHeartbeatClient{stream}
-	return x, nil
 }
-
-type PD_RegionHeartbeatClient interface {
-	Send(*RegionHeartbeatRequest) error
-	Recv() (*RegionHeartbeatResponse, error)
-	grpc.ClientStream
+func (dst *OperatorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorRecord.Merge(dst, src)
 }
-
-type pDRegionHeartbeatClient struct {
-	grpc.ClientStream
+func (m *OperatorRecord) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *pDRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *OperatorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorRecord.DiscardUnknown(m)
 }
 
-func (x *pDRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
-	m := new(RegionHeartbeatResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_OperatorRecord proto.InternalMessageInfo
 
-func (c *pDClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetPrevRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetDesc() string {
+	if m != nil {
+		return m.Desc
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pDClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegionByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetBrief() string {
+	if m != nil {
+		return m.Brief
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pDClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
-	out := new(ScanRegionsResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/ScanRegions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pDClient) AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error) {
-	out := new(AskBatchSplitResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/AskBatchSplit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetStatus() OperatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return OperatorStatus_SUCCESS
 }
 
-func (c *pDClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
-	out := new(GetClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pDClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
-	out := new(PutClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/PutClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetStoreIds() []uint64 {
+	if m != nil {
+		return m.StoreIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
-	out := new(ScatterRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/ScatterRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetSteps() []*OperatorStepRecord {
+	if m != nil {
+		return m.Steps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
-	out := new(GetGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
-	out := new(UpdateGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/UpdateGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
-	out := new(GetOperatorResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperatorRecord) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
 	}
-	return out, nil
+	return 0
 }
 
-// Server API for PD service
-
-type PDServer interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
-	Tso(PD_TsoServer) error
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
-	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
-	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
-	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
-	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
-	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
-	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(PD_RegionHeartbeatServer) error
-	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
-	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
-	AskBatchSplit(context.Context, *AskBatchSplitRequest) (*AskBatchSplitResponse, error)
-	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
-	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
-	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
-	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
-	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+type GetOperatorHistoryResponse struct {
+	Header               *ResponseHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Records              []*OperatorRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func RegisterPDServer(s *grpc.Server, srv PDServer) {
-	s.RegisterService(&_PD_serviceDesc, srv)
+func (m *GetOperatorHistoryResponse) Reset()         { *m = GetOperatorHistoryResponse{} }
+func (m *GetOperatorHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorHistoryResponse) ProtoMessage()    {}
+func (*GetOperatorHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{60}
 }
-
-func _PD_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).GetMembers(ctx, in)
+func (m *GetOperatorHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetMembers",
+}
+func (dst *GetOperatorHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorHistoryResponse.Merge(dst, src)
+}
+func (m *GetOperatorHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperatorHistoryResponse proto.InternalMessageInfo
+
+func (m *GetOperatorHistoryResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetMembers(ctx, req.(*GetMembersRequest))
+	return nil
+}
+
+func (m *GetOperatorHistoryResponse) GetRecords() []*OperatorRecord {
+	if m != nil {
+		return m.Records
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PD_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PDServer).Tso(&pDTsoServer{stream})
+type SyncRegionRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Member *Member        `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// the follower PD will use the start index to locate historical changes
+	// that require synchronization.
+	StartIndex           uint64   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type PD_TsoServer interface {
-	Send(*TsoResponse) error
-	Recv() (*TsoRequest, error)
-	grpc.ServerStream
+func (m *SyncRegionRequest) Reset()         { *m = SyncRegionRequest{} }
+func (m *SyncRegionRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncRegionRequest) ProtoMessage()    {}
+func (*SyncRegionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{61}
+}
+func (m *SyncRegionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncRegionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SyncRegionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRegionRequest.Merge(dst, src)
+}
+func (m *SyncRegionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncRegionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRegionRequest.DiscardUnknown(m)
 }
 
-type pDTsoServer struct {
-	grpc.ServerStream
+var xxx_messageInfo_SyncRegionRequest proto.InternalMessageInfo
+
+func (m *SyncRegionRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-func (x *pDTsoServer) Send(m *TsoResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *SyncRegionRequest) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
 }
 
-func (x *pDTsoServer) Recv() (*TsoRequest, error) {
-	m := new(TsoRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SyncRegionRequest) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	return m, nil
+	return 0
 }
 
-func _PD_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).Bootstrap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/Bootstrap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).Bootstrap(ctx, req.(*BootstrapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SyncRegionResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// the leader PD will send the responds include
+	// changed regions records and the index of the first record.
+	Regions    []*metapb.Region `protobuf:"bytes,2,rep,name=regions" json:"regions,omitempty"`
+	StartIndex uint64           `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	// the leaders of the regions, a peer with id 0 if the leader is unknown.
+	RegionLeaders        []*metapb.Peer `protobuf:"bytes,4,rep,name=region_leaders,json=regionLeaders" json:"region_leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _PD_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsBootstrappedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).IsBootstrapped(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/IsBootstrapped",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SyncRegionResponse) Reset()         { *m = SyncRegionResponse{} }
+func (m *SyncRegionResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncRegionResponse) ProtoMessage()    {}
+func (*SyncRegionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_8dc000caa63611fa, []int{62}
 }
-
-func _PD_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).AllocID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/AllocID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).AllocID(ctx, req.(*AllocIDRequest))
+func (m *SyncRegionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncRegionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *SyncRegionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRegionResponse.Merge(dst, src)
+}
+func (m *SyncRegionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncRegionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRegionResponse.DiscardUnknown(m)
 }
 
-func _PD_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).GetStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetStore(ctx, req.(*GetStoreRequest))
+var xxx_messageInfo_SyncRegionResponse proto.InternalMessageInfo
+
+func (m *SyncRegionResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PD_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).PutStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/PutStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).PutStore(ctx, req.(*PutStoreRequest))
+func (m *SyncRegionResponse) GetRegions() []*metapb.Region {
+	if m != nil {
+		return m.Regions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PD_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStoresRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).GetAllStores(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetAllStores",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
+func (m *SyncRegionResponse) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PD_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreHeartbeatRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).StoreHeartbeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/StoreHeartbeat",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
+func (m *SyncRegionResponse) GetRegionLeaders() []*metapb.Peer {
+	if m != nil {
+		return m.RegionLeaders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PD_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PDServer).RegionHeartbeat(&pDRegionHeartbeatServer{stream})
+func init() {
+	proto.RegisterType((*RequestHeader)(nil), "pdpb.RequestHeader")
+	proto.RegisterType((*ResponseHeader)(nil), "pdpb.ResponseHeader")
+	proto.RegisterType((*Error)(nil), "pdpb.Error")
+	proto.RegisterType((*TsoRequest)(nil), "pdpb.TsoRequest")
+	proto.RegisterType((*Timestamp)(nil), "pdpb.Timestamp")
+	proto.RegisterType((*TsoResponse)(nil), "pdpb.TsoResponse")
+	proto.RegisterType((*BootstrapRequest)(nil), "pdpb.BootstrapRequest")
+	proto.RegisterType((*BootstrapResponse)(nil), "pdpb.BootstrapResponse")
+	proto.RegisterType((*IsBootstrappedRequest)(nil), "pdpb.IsBootstrappedRequest")
+	proto.RegisterType((*IsBootstrappedResponse)(nil), "pdpb.IsBootstrappedResponse")
+	proto.RegisterType((*AllocIDRequest)(nil), "pdpb.AllocIDRequest")
+	proto.RegisterType((*AllocIDResponse)(nil), "pdpb.AllocIDResponse")
+	proto.RegisterType((*GetStoreRequest)(nil), "pdpb.GetStoreRequest")
+	proto.RegisterType((*GetStoreResponse)(nil), "pdpb.GetStoreResponse")
+	proto.RegisterType((*PutStoreRequest)(nil), "pdpb.PutStoreRequest")
+	proto.RegisterType((*PutStoreResponse)(nil), "pdpb.PutStoreResponse")
+	proto.RegisterType((*GetAllStoresRequest)(nil), "pdpb.GetAllStoresRequest")
+	proto.RegisterType((*GetAllStoresResponse)(nil), "pdpb.GetAllStoresResponse")
+	proto.RegisterType((*GetRegionRequest)(nil), "pdpb.GetRegionRequest")
+	proto.RegisterType((*GetRegionResponse)(nil), "pdpb.GetRegionResponse")
+	proto.RegisterType((*GetRegionByIDRequest)(nil), "pdpb.GetRegionByIDRequest")
+	proto.RegisterType((*ScanRegionsRequest)(nil), "pdpb.ScanRegionsRequest")
+	proto.RegisterType((*ScanRegionsResponse)(nil), "pdpb.ScanRegionsResponse")
+	proto.RegisterType((*GetClusterConfigRequest)(nil), "pdpb.GetClusterConfigRequest")
+	proto.RegisterType((*GetClusterConfigResponse)(nil), "pdpb.GetClusterConfigResponse")
+	proto.RegisterType((*PutClusterConfigRequest)(nil), "pdpb.PutClusterConfigRequest")
+	proto.RegisterType((*PutClusterConfigResponse)(nil), "pdpb.PutClusterConfigResponse")
+	proto.RegisterType((*Member)(nil), "pdpb.Member")
+	proto.RegisterType((*GetMembersRequest)(nil), "pdpb.GetMembersRequest")
+	proto.RegisterType((*GetMembersResponse)(nil), "pdpb.GetMembersResponse")
+	proto.RegisterType((*PeerStats)(nil), "pdpb.PeerStats")
+	proto.RegisterType((*RegionHeartbeatRequest)(nil), "pdpb.RegionHeartbeatRequest")
+	proto.RegisterType((*ChangePeer)(nil), "pdpb.ChangePeer")
+	proto.RegisterType((*TransferLeader)(nil), "pdpb.TransferLeader")
+	proto.RegisterType((*RegionHeartbeatResponse)(nil), "pdpb.RegionHeartbeatResponse")
+	proto.RegisterType((*AskSplitRequest)(nil), "pdpb.AskSplitRequest")
+	proto.RegisterType((*AskSplitResponse)(nil), "pdpb.AskSplitResponse")
+	proto.RegisterType((*ReportSplitRequest)(nil), "pdpb.ReportSplitRequest")
+	proto.RegisterType((*ReportSplitResponse)(nil), "pdpb.ReportSplitResponse")
+	proto.RegisterType((*AskBatchSplitRequest)(nil), "pdpb.AskBatchSplitRequest")
+	proto.RegisterType((*SplitID)(nil), "pdpb.SplitID")
+	proto.RegisterType((*AskBatchSplitResponse)(nil), "pdpb.AskBatchSplitResponse")
+	proto.RegisterType((*ReportBatchSplitRequest)(nil), "pdpb.ReportBatchSplitRequest")
+	proto.RegisterType((*ReportBatchSplitResponse)(nil), "pdpb.ReportBatchSplitResponse")
+	proto.RegisterType((*TimeInterval)(nil), "pdpb.TimeInterval")
+	proto.RegisterType((*RecordPair)(nil), "pdpb.RecordPair")
+	proto.RegisterType((*StoreStats)(nil), "pdpb.StoreStats")
+	proto.RegisterType((*StoreHeartbeatRequest)(nil), "pdpb.StoreHeartbeatRequest")
+	proto.RegisterType((*StoreHeartbeatResponse)(nil), "pdpb.StoreHeartbeatResponse")
+	proto.RegisterType((*ScatterRegionRequest)(nil), "pdpb.ScatterRegionRequest")
+	proto.RegisterType((*ScatterRegionResponse)(nil), "pdpb.ScatterRegionResponse")
+	proto.RegisterType((*GetGCSafePointRequest)(nil), "pdpb.GetGCSafePointRequest")
+	proto.RegisterType((*GetGCSafePointResponse)(nil), "pdpb.GetGCSafePointResponse")
+	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "pdpb.UpdateGCSafePointRequest")
+	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "pdpb.UpdateGCSafePointResponse")
+	proto.RegisterType((*GetOperatorRequest)(nil), "pdpb.GetOperatorRequest")
+	proto.RegisterType((*GetOperatorResponse)(nil), "pdpb.GetOperatorResponse")
+	proto.RegisterType((*GetOperatorHistoryRequest)(nil), "pdpb.GetOperatorHistoryRequest")
+	proto.RegisterType((*OperatorStepRecord)(nil), "pdpb.OperatorStepRecord")
+	proto.RegisterType((*OperatorRecord)(nil), "pdpb.OperatorRecord")
+	proto.RegisterType((*GetOperatorHistoryResponse)(nil), "pdpb.GetOperatorHistoryResponse")
+	proto.RegisterType((*SyncRegionRequest)(nil), "pdpb.SyncRegionRequest")
+	proto.RegisterType((*SyncRegionResponse)(nil), "pdpb.SyncRegionResponse")
+	proto.RegisterEnum("pdpb.ErrorType", ErrorType_name, ErrorType_value)
+	proto.RegisterEnum("pdpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
 }
 
-type PD_RegionHeartbeatServer interface {
-	Send(*RegionHeartbeatResponse) error
-	Recv() (*RegionHeartbeatRequest, error)
-	grpc.ServerStream
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PD service
+
+type PDClient interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error)
+	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
+	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
+	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
+	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
+	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
+	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error)
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
+	AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error)
+	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
+	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
+	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
+	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
+	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+	GetOperatorHistory(ctx context.Context, in *GetOperatorHistoryRequest, opts ...grpc.CallOption) (*GetOperatorHistoryResponse, error)
+	SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error)
 }
 
-type pDRegionHeartbeatServer struct {
-	grpc.ServerStream
+type pDClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *pDRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
-	return x.ServerStream.SendMsg(m)
+func NewPDClient(cc *grpc.ClientConn) PDClient {
+	return &pDClient{cc}
 }
 
-func (x *pDRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
-	m := new(RegionHeartbeatRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *pDClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetMembers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _PD_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[0], "/pdpb.PD/Tso", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetRegion(ctx, req.(*GetRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	x := &pDTsoClient{stream}
+	return x, nil
 }
 
-func _PD_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
+type PD_TsoClient interface {
+	Send(*TsoRequest) error
+	Recv() (*TsoResponse, error)
+	grpc.ClientStream
+}
+
+type pDTsoClient struct {
+	grpc.ClientStream
+}
+
+func (x *pDTsoClient) Send(m *TsoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pDTsoClient) Recv() (*TsoResponse, error) {
+	m := new(TsoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetPrevRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetPrevRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _PD_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionByIDRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
+	out := new(BootstrapResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/Bootstrap", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetRegionByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetRegionByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegionsRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
+	out := new(IsBootstrappedResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/IsBootstrapped", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).ScanRegions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/ScanRegions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_AskBatchSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AskBatchSplitRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
+	out := new(AllocIDResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/AllocID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).AskBatchSplit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/AskBatchSplit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).AskBatchSplit(ctx, req.(*AskBatchSplitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClusterConfigRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
+	out := new(GetStoreResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetClusterConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetClusterConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutClusterConfigRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
+	out := new(PutStoreResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/PutStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).PutClusterConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/PutClusterConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScatterRegionRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
+	out := new(GetAllStoresResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetAllStores", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).ScatterRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/ScatterRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGCSafePointRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
+	out := new(StoreHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/StoreHeartbeat", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetGCSafePoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetGCSafePoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGCSafePointRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[1], "/pdpb.PD/RegionHeartbeat", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).UpdateGCSafePoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/UpdateGCSafePoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	x := &pDRegionHeartbeatClient{stream}
+	return x, nil
 }
 
-func _PD_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOperatorRequest)
-	if err := dec(in); err != nil {
+type PD_RegionHeartbeatClient interface {
+	Send(*RegionHeartbeatRequest) error
+	Recv() (*RegionHeartbeatResponse, error)
+	grpc.ClientStream
+}
+
+type pDRegionHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *pDRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pDRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
+	m := new(RegionHeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetOperator(ctx, in)
+	return m, nil
+}
+
+func (c *pDClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetOperator",
+	return out, nil
+}
+
+func (c *pDClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetPrevRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetOperator(ctx, req.(*GetOperatorRequest))
+	return out, nil
+}
+
+func (c *pDClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegionByID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _PD_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pdpb.PD",
-	HandlerType: (*PDServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetMembers",
-			Handler:    _PD_GetMembers_Handler,
-		},
-		{
-			MethodName: "Bootstrap",
-			Handler:    _PD_Bootstrap_Handler,
-		},
-		{
-			MethodName: "IsBootstrapped",
-			Handler:    _PD_IsBootstrapped_Handler,
-		},
-		{
-			MethodName: "AllocID",
-			Handler:    _PD_AllocID_Handler,
-		},
-		{
-			MethodName: "GetStore",
-			Handler:    _PD_GetStore_Handler,
-		},
-		{
-			MethodName: "PutStore",
-			Handler:    _PD_PutStore_Handler,
-		},
-		{
-			MethodName: "GetAllStores",
-			Handler:    _PD_GetAllStores_Handler,
-		},
-		{
-			MethodName: "StoreHeartbeat",
-			Handler:    _PD_StoreHeartbeat_Handler,
-		},
-		{
-			MethodName: "GetRegion",
-			Handler:    _PD_GetRegion_Handler,
-		},
-		{
-			MethodName: "GetPrevRegion",
-			Handler:    _PD_GetPrevRegion_Handler,
-		},
-		{
-			MethodName: "GetRegionByID",
-			Handler:    _PD_GetRegionByID_Handler,
-		},
-		{
-			MethodName: "ScanRegions",
-			Handler:    _PD_ScanRegions_Handler,
-		},
-		{
-			MethodName: "AskBatchSplit",
-			Handler:    _PD_AskBatchSplit_Handler,
-		},
-		{
-			MethodName: "GetClusterConfig",
-			Handler:    _PD_GetClusterConfig_Handler,
-		},
-		{
-			MethodName: "PutClusterConfig",
-			Handler:    _PD_PutClusterConfig_Handler,
-		},
-		{
-			MethodName: "ScatterRegion",
-			Handler:    _PD_ScatterRegion_Handler,
-		},
-		{
-			MethodName: "GetGCSafePoint",
-			Handler:    _PD_GetGCSafePoint_Handler,
-		},
-		{
-			MethodName: "UpdateGCSafePoint",
-			Handler:    _PD_UpdateGCSafePoint_Handler,
-		},
-		{
-			MethodName: "GetOperator",
-			Handler:    _PD_GetOperator_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Tso",
-			Handler:       _PD_Tso_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "RegionHeartbeat",
-			Handler:       _PD_RegionHeartbeat_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pdpb.proto",
+func (c *pDClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
+	out := new(ScanRegionsResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/ScanRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *pDClient) AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error) {
+	out := new(AskBatchSplitResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/AskBatchSplit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *pDClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
+	out := new(GetClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetClusterConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *pDClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
+	out := new(PutClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/PutClusterConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Error.Size()))
-		n1, err := m.Error.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *pDClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
+	out := new(ScatterRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/ScatterRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *pDClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
+	out := new(GetGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetGCSafePoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Type))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *pDClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
+	out := new(UpdateGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/UpdateGCSafePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *pDClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
+	out := new(GetOperatorResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n2, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *pDClient) GetOperatorHistory(ctx context.Context, in *GetOperatorHistoryRequest, opts ...grpc.CallOption) (*GetOperatorHistoryResponse, error) {
+	out := new(GetOperatorHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetOperatorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Timestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *pDClient) SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[2], "/pdpb.PD/SyncRegions", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &pDSyncRegionsClient{stream}
+	return x, nil
 }
 
-func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Physical))
-	}
-	if m.Logical != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Logical))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type PD_SyncRegionsClient interface {
+	Send(*SyncRegionRequest) error
+	Recv() (*SyncRegionResponse, error)
+	grpc.ClientStream
 }
 
-func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type pDSyncRegionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pDSyncRegionsClient) Send(m *SyncRegionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pDSyncRegionsClient) Recv() (*SyncRegionResponse, error) {
+	m := new(SyncRegionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n3, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+// Server API for PD service
+
+type PDServer interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	Tso(PD_TsoServer) error
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
+	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
+	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
+	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
+	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
+	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(PD_RegionHeartbeatServer) error
+	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
+	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
+	AskBatchSplit(context.Context, *AskBatchSplitRequest) (*AskBatchSplitResponse, error)
+	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
+	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
+	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
+	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
+	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+	GetOperatorHistory(context.Context, *GetOperatorHistoryRequest) (*GetOperatorHistoryResponse, error)
+	SyncRegions(PD_SyncRegionsServer) error
+}
+
+func RegisterPDServer(s *grpc.Server, srv PDServer) {
+	s.RegisterService(&_PD_serviceDesc, srv)
+}
+
+func _PD_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
+	if interceptor == nil {
+		return srv.(PDServer).GetMembers(ctx, in)
 	}
-	if m.Timestamp != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Timestamp.Size()))
-		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetMembers",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetMembers(ctx, req.(*GetMembersRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).Tso(&pDTsoServer{stream})
+}
+
+type PD_TsoServer interface {
+	Send(*TsoResponse) error
+	Recv() (*TsoRequest, error)
+	grpc.ServerStream
+}
+
+type pDTsoServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDTsoServer) Send(m *TsoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDTsoServer) Recv() (*TsoRequest, error) {
+	m := new(TsoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n5, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func _PD_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n6, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if interceptor == nil {
+		return srv.(PDServer).Bootstrap(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/Bootstrap",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).Bootstrap(ctx, req.(*BootstrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBootstrappedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n7, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	if interceptor == nil {
+		return srv.(PDServer).IsBootstrapped(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/IsBootstrapped",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n8, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	if interceptor == nil {
+		return srv.(PDServer).AllocID(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/AllocID",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).AllocID(ctx, req.(*AllocIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetStore(ctx, req.(*GetStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n9, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func _PD_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Bootstrapped {
-		dAtA[i] = 0x10
-		i++
-		if m.Bootstrapped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(PDServer).PutStore(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/PutStore",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).PutStore(ctx, req.(*PutStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStoresRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n10, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+	if interceptor == nil {
+		return srv.(PDServer).GetAllStores(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetAllStores",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreHeartbeatRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n11, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+	if interceptor == nil {
+		return srv.(PDServer).StoreHeartbeat(ctx, in)
 	}
-	if m.Id != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/StoreHeartbeat",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).RegionHeartbeat(&pDRegionHeartbeatServer{stream})
+}
+
+type PD_RegionHeartbeatServer interface {
+	Send(*RegionHeartbeatResponse) error
+	Recv() (*RegionHeartbeatRequest, error)
+	grpc.ServerStream
+}
+
+type pDRegionHeartbeatServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
+	m := new(RegionHeartbeatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n12, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+func _PD_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StoreId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+	if interceptor == nil {
+		return srv.(PDServer).GetRegion(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetRegion",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetPrevRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetPrevRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n13, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+func _PD_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n14, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+	if interceptor == nil {
+		return srv.(PDServer).GetRegionByID(ctx, in)
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
-		n15, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetRegionByID",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n16, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+	if interceptor == nil {
+		return srv.(PDServer).ScanRegions(ctx, in)
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n17, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/ScanRegions",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_AskBatchSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskBatchSplitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n18, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+	if interceptor == nil {
+		return srv.(PDServer).AskBatchSplit(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/AskBatchSplit",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).AskBatchSplit(ctx, req.(*AskBatchSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n19, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(PDServer).GetClusterConfig(ctx, in)
 	}
-	if m.ExcludeTombstoneStores {
-		dAtA[i] = 0x10
-		i++
-		if m.ExcludeTombstoneStores {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetClusterConfig",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutClusterConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).PutClusterConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/PutClusterConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n20, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+func _PD_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScatterRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Stores) > 0 {
-		for _, msg := range m.Stores {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(PDServer).ScatterRegion(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/ScatterRegion",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCSafePointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetGCSafePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetGCSafePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n21, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+func _PD_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGCSafePointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RegionKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.RegionKey)))
-		i += copy(dAtA[i:], m.RegionKey)
+	if interceptor == nil {
+		return srv.(PDServer).UpdateGCSafePoint(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/UpdateGCSafePoint",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetOperator(ctx, req.(*GetOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n22, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
+func _PD_GetOperatorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperatorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n23, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+	if interceptor == nil {
+		return srv.(PDServer).GetOperatorHistory(ctx, in)
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n24, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetOperatorHistory",
 	}
-	if len(m.Slaves) > 0 {
-		for _, msg := range m.Slaves {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetOperatorHistory(ctx, req.(*GetOperatorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PD_SyncRegions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).SyncRegions(&pDSyncRegionsServer{stream})
+}
+
+type PD_SyncRegionsServer interface {
+	Send(*SyncRegionResponse) error
+	Recv() (*SyncRegionRequest, error)
+	grpc.ServerStream
+}
+
+type pDSyncRegionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDSyncRegionsServer) Send(m *SyncRegionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDSyncRegionsServer) Recv() (*SyncRegionRequest, error) {
+	m := new(SyncRegionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _PD_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pdpb.PD",
+	HandlerType: (*PDServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMembers",
+			Handler:    _PD_GetMembers_Handler,
+		},
+		{
+			MethodName: "Bootstrap",
+			Handler:    _PD_Bootstrap_Handler,
+		},
+		{
+			MethodName: "IsBootstrapped",
+			Handler:    _PD_IsBootstrapped_Handler,
+		},
+		{
+			MethodName: "AllocID",
+			Handler:    _PD_AllocID_Handler,
+		},
+		{
+			MethodName: "GetStore",
+			Handler:    _PD_GetStore_Handler,
+		},
+		{
+			MethodName: "PutStore",
+			Handler:    _PD_PutStore_Handler,
+		},
+		{
+			MethodName: "GetAllStores",
+			Handler:    _PD_GetAllStores_Handler,
+		},
+		{
+			MethodName: "StoreHeartbeat",
+			Handler:    _PD_StoreHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _PD_GetRegion_Handler,
+		},
+		{
+			MethodName: "GetPrevRegion",
+			Handler:    _PD_GetPrevRegion_Handler,
+		},
+		{
+			MethodName: "GetRegionByID",
+			Handler:    _PD_GetRegionByID_Handler,
+		},
+		{
+			MethodName: "ScanRegions",
+			Handler:    _PD_ScanRegions_Handler,
+		},
+		{
+			MethodName: "AskBatchSplit",
+			Handler:    _PD_AskBatchSplit_Handler,
+		},
+		{
+			MethodName: "GetClusterConfig",
+			Handler:    _PD_GetClusterConfig_Handler,
+		},
+		{
+			MethodName: "PutClusterConfig",
+			Handler:    _PD_PutClusterConfig_Handler,
+		},
+		{
+			MethodName: "ScatterRegion",
+			Handler:    _PD_ScatterRegion_Handler,
+		},
+		{
+			MethodName: "GetGCSafePoint",
+			Handler:    _PD_GetGCSafePoint_Handler,
+		},
+		{
+			MethodName: "UpdateGCSafePoint",
+			Handler:    _PD_UpdateGCSafePoint_Handler,
+		},
+		{
+			MethodName: "GetOperator",
+			Handler:    _PD_GetOperator_Handler,
+		},
+		{
+			MethodName: "GetOperatorHistory",
+			Handler:    _PD_GetOperatorHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tso",
+			Handler:       _PD_Tso_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RegionHeartbeat",
+			Handler:       _PD_RegionHeartbeat_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyncRegions",
+			Handler:       _PD_SyncRegions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pdpb.proto",
+}
+
+func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5088,7 +4983,7 @@ func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5098,25 +4993,25 @@ func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n25, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+		i += n1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5124,7 +5019,7 @@ func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5134,37 +5029,21 @@ func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.Type != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Type))
 	}
-	if len(m.StartKey) > 0 {
+	if len(m.Message) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Limit))
-	}
-	if len(m.EndKey) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5172,7 +5051,7 @@ func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5182,7 +5061,7 @@ func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5191,35 +5070,47 @@ func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n27, err := m.Header.MarshalTo(dAtA[i:])
+		n2, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n2
 	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
 	}
-	if len(m.Leaders) > 0 {
-		for _, msg := range m.Leaders {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Timestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Physical != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Physical))
+	}
+	if m.Logical != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Logical))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5227,7 +5118,7 @@ func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5237,7 +5128,7 @@ func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5246,11 +5137,26 @@ func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
+		n3, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n3
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
+	}
+	if m.Timestamp != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Timestamp.Size()))
+		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5258,7 +5164,7 @@ func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5268,7 +5174,7 @@ func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5277,21 +5183,21 @@ func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n29, err := m.Header.MarshalTo(dAtA[i:])
+		n5, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n5
 	}
-	if m.Cluster != nil {
+	if m.Store != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
-		n30, err := m.Cluster.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n6, err := m.Store.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5299,7 +5205,7 @@ func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5309,7 +5215,7 @@ func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5318,21 +5224,11 @@ func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n31, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
-	}
-	if m.Cluster != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
-		n32, err := m.Cluster.MarshalTo(dAtA[i:])
+		n7, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n7
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5340,7 +5236,7 @@ func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5350,7 +5246,7 @@ func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5359,11 +5255,11 @@ func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n33, err := m.Header.MarshalTo(dAtA[i:])
+		n8, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5371,7 +5267,7 @@ func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5381,56 +5277,30 @@ func (m *Member) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n9, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	if m.MemberId != 0 {
+	if m.Bootstrapped {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.MemberId))
-	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		if m.Bootstrapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.LeaderPriority != 0 {
-		dAtA[i] = 0x28
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.LeaderPriority))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5438,7 +5308,7 @@ func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5448,7 +5318,7 @@ func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5457,11 +5327,11 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n34, err := m.Header.MarshalTo(dAtA[i:])
+		n10, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5469,7 +5339,7 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5479,7 +5349,7 @@ func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5488,43 +5358,52 @@ func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n35, err := m.Header.MarshalTo(dAtA[i:])
+		n11, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n11
 	}
-	if len(m.Members) > 0 {
-		for _, msg := range m.Members {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.Id != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Id))
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n36, err := m.Leader.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n12, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n12
 	}
-	if m.EtcdLeader != nil {
-		dAtA[i] = 0x22
+	if m.StoreId != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.EtcdLeader.Size()))
-		n37, err := m.EtcdLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5532,7 +5411,7 @@ func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5542,7 +5421,7 @@ func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5551,68 +5430,31 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n38, err := m.Header.MarshalTo(dAtA[i:])
+		n13, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n13
 	}
-	if m.Region != nil {
+	if m.Store != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n39, err := m.Region.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n14, err := m.Store.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n14
 	}
-	if m.Leader != nil {
+	if m.Stats != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n40, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n40
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, msg := range m.PendingPeers {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.ApproximateSize != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateSize))
-	}
-	if m.Interval != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
-		n41, err := m.Interval.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
+		n15, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
-	}
-	if m.ApproximateKeys != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateKeys))
-	}
-	if m.Term != 0 {
-		dAtA[i] = 0x70
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Term))
+		i += n15
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5620,7 +5462,7 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5630,25 +5472,30 @@ func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
-		n42, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n16, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n16
 	}
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x10
+	if m.Store != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangeType))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n17, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5656,7 +5503,7 @@ func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5666,20 +5513,20 @@ func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
-		n43, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n18, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5687,7 +5534,7 @@ func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5697,7 +5544,7 @@ func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5706,56 +5553,21 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n44, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
-	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n45, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
-	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n46, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		n19, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+		i += n19
 	}
-	if m.RegionEpoch != nil {
-		dAtA[i] = 0x2a
+	if m.ExcludeTombstoneStores {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n47, err := m.RegionEpoch.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.ExcludeTombstoneStores {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n47
-	}
-	if m.TargetPeer != nil {
-		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.TargetPeer.Size()))
-		n48, err := m.TargetPeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n48
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5763,7 +5575,7 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5773,7 +5585,7 @@ func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5782,21 +5594,23 @@ func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n49, err := m.Header.MarshalTo(dAtA[i:])
+		n20, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n20
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n50, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Stores) > 0 {
+		for _, msg := range m.Stores {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n50
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5804,7 +5618,7 @@ func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5814,7 +5628,7 @@ func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5823,33 +5637,17 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n51, err := m.Header.MarshalTo(dAtA[i:])
+		n21, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
-	}
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
+		i += n21
 	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA53 := make([]byte, len(m.NewPeerIds)*10)
-		var j52 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j52++
-			}
-			dAtA53[j52] = uint8(num)
-			j52++
-		}
-		dAtA[i] = 0x1a
+	if len(m.RegionKey) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(j52))
-		i += copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.RegionKey)))
+		i += copy(dAtA[i:], m.RegionKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5857,7 +5655,7 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5867,7 +5665,7 @@ func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5876,31 +5674,43 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n54, err := m.Header.MarshalTo(dAtA[i:])
+		n22, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n22
 	}
-	if m.Left != nil {
+	if m.Region != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Left.Size()))
-		n55, err := m.Left.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n23, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n23
 	}
-	if m.Right != nil {
+	if m.Leader != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Right.Size()))
-		n56, err := m.Right.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n24, err := m.Leader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n24
+	}
+	if len(m.Slaves) > 0 {
+		for _, msg := range m.Slaves {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5908,7 +5718,7 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5918,7 +5728,7 @@ func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5927,11 +5737,16 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n57, err := m.Header.MarshalTo(dAtA[i:])
+		n25, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n25
+	}
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5939,7 +5754,7 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5949,7 +5764,7 @@ func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5958,26 +5773,28 @@ func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n58, err := m.Header.MarshalTo(dAtA[i:])
+		n26, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n26
 	}
-	if m.Region != nil {
+	if len(m.StartKey) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n59, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n59
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
 	}
-	if m.SplitCount != 0 {
+	if m.Limit != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitCount))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5985,7 +5802,7 @@ func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitID) Marshal() (dAtA []byte, err error) {
+func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5995,32 +5812,44 @@ func (m *SplitID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA61 := make([]byte, len(m.NewPeerIds)*10)
-		var j60 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j60++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n27, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			dAtA61[j60] = uint8(num)
-			j60++
+			i += n
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, msg := range m.Leaders {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(j60))
-		i += copy(dAtA[i:], dAtA61[:j60])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6028,7 +5857,7 @@ func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6038,7 +5867,7 @@ func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6047,23 +5876,11 @@ func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n62, err := m.Header.MarshalTo(dAtA[i:])
+		n28, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
-	}
-	if len(m.Ids) > 0 {
-		for _, msg := range m.Ids {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n28
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6071,7 +5888,7 @@ func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6081,7 +5898,7 @@ func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6090,23 +5907,21 @@ func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n63, err := m.Header.MarshalTo(dAtA[i:])
+		n29, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n29
 	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Cluster != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
+		n30, err := m.Cluster.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n30
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6114,7 +5929,7 @@ func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6124,7 +5939,7 @@ func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6133,11 +5948,21 @@ func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n64, err := m.Header.MarshalTo(dAtA[i:])
+		n31, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n31
+	}
+	if m.Cluster != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
+		n32, err := m.Cluster.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6145,7 +5970,7 @@ func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6155,20 +5980,20 @@ func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTimestamp))
-	}
-	if m.EndTimestamp != 0 {
-		dAtA[i] = 0x10
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.EndTimestamp))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n33, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6176,7 +6001,7 @@ func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RecordPair) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6186,21 +6011,56 @@ func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Value != 0 {
+	if m.MemberId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Value))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.MemberId))
+	}
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.LeaderPriority != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.LeaderPriority))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6208,7 +6068,7 @@ func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreStats) Marshal() (dAtA []byte, err error) {
+func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6218,95 +6078,174 @@ func (m *StoreStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n34, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
-	if m.Capacity != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Capacity))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Available != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Available))
+	return i, nil
+}
+
+func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.RegionCount != 0 {
-		dAtA[i] = 0x20
+	return dAtA[:n], nil
+}
+
+func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionCount))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n35, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	if m.SendingSnapCount != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SendingSnapCount))
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.ReceivingSnapCount != 0 {
-		dAtA[i] = 0x30
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ReceivingSnapCount))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n36, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x38
+	if m.EtcdLeader != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.EtcdLeader.Size()))
+		n37, err := m.EtcdLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
-	if m.ApplyingSnapCount != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApplyingSnapCount))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsBusy {
-		dAtA[i] = 0x48
+	return i, nil
+}
+
+func (m *PeerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Peer != nil {
+		dAtA[i] = 0xa
 		i++
-		if m.IsBusy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n38, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n38
+	}
+	if m.DownSeconds != 0 {
+		dAtA[i] = 0x10
 		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.DownSeconds))
 	}
-	if m.UsedSize != 0 {
-		dAtA[i] = 0x50
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.UsedSize))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n39, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
-	if m.Interval != nil {
-		dAtA[i] = 0x7a
+	if m.Region != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
-		n65, err := m.Interval.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n40, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n40
 	}
-	if len(m.CpuUsages) > 0 {
-		for _, msg := range m.CpuUsages {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n41, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n41
 	}
-	if len(m.ReadIoRates) > 0 {
-		for _, msg := range m.ReadIoRates {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x1
+	if len(m.DownPeers) > 0 {
+		for _, msg := range m.DownPeers {
+			dAtA[i] = 0x22
 			i++
 			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6316,11 +6255,9 @@ func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.WriteIoRates) > 0 {
-		for _, msg := range m.WriteIoRates {
-			dAtA[i] = 0x92
-			i++
-			dAtA[i] = 0x1
+	if len(m.PendingPeers) > 0 {
+		for _, msg := range m.PendingPeers {
+			dAtA[i] = 0x2a
 			i++
 			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6330,19 +6267,30 @@ func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.OpLatencies) > 0 {
-		for _, msg := range m.OpLatencies {
-			dAtA[i] = 0x9a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.ApproximateSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
+		n42, err := m.Interval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n42
+	}
+	if m.ApproximateKeys != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateKeys))
+	}
+	if m.Term != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Term))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6350,7 +6298,7 @@ func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6360,30 +6308,25 @@ func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Peer != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n66, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n43, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n43
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x12
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
-		n67, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n67
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangeType))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6391,7 +6334,7 @@ func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6401,20 +6344,20 @@ func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Peer != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n68, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n44, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n44
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6422,7 +6365,7 @@ func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6432,7 +6375,7 @@ func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6441,36 +6384,56 @@ func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n69, err := m.Header.MarshalTo(dAtA[i:])
+		n45, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n45
+	}
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n46, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n47, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
 	if m.RegionId != 0 {
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x1a
+	if m.RegionEpoch != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n70, err := m.Region.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n48, err := m.RegionEpoch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n48
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x22
+	if m.TargetPeer != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n71, err := m.Leader.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.TargetPeer.Size()))
+		n49, err := m.TargetPeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n49
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6478,7 +6441,7 @@ func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6488,7 +6451,7 @@ func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6497,11 +6460,21 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n72, err := m.Header.MarshalTo(dAtA[i:])
+		n50, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n50
+	}
+	if m.Region != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n51, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6509,7 +6482,7 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6519,7 +6492,7 @@ func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6528,11 +6501,33 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n73, err := m.Header.MarshalTo(dAtA[i:])
+		n52, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n52
+	}
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA54 := make([]byte, len(m.NewPeerIds)*10)
+		var j53 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j53++
+			}
+			dAtA54[j53] = uint8(num)
+			j53++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(j53))
+		i += copy(dAtA[i:], dAtA54[:j53])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6540,7 +6535,7 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6550,7 +6545,7 @@ func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6559,16 +6554,31 @@ func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n74, err := m.Header.MarshalTo(dAtA[i:])
+		n55, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n55
 	}
-	if m.SafePoint != 0 {
-		dAtA[i] = 0x10
+	if m.Left != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Left.Size()))
+		n56, err := m.Left.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.Right != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Right.Size()))
+		n57, err := m.Right.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6576,7 +6586,7 @@ func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6586,7 +6596,7 @@ func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6595,16 +6605,11 @@ func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n75, err := m.Header.MarshalTo(dAtA[i:])
+		n58, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
-	}
-	if m.SafePoint != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
+		i += n58
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6612,7 +6617,7 @@ func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6622,7 +6627,7 @@ func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6631,16 +6636,26 @@ func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n76, err := m.Header.MarshalTo(dAtA[i:])
+		n59, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n59
 	}
-	if m.NewSafePoint != 0 {
-		dAtA[i] = 0x10
+	if m.Region != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewSafePoint))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n60, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	if m.SplitCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitCount))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6648,7 +6663,7 @@ func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SplitID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6658,7 +6673,50 @@ func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA62 := make([]byte, len(m.NewPeerIds)*10)
+		var j61 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j61++
+			}
+			dAtA62[j61] = uint8(num)
+			j61++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(j61))
+		i += copy(dAtA[i:], dAtA62[:j61])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6667,16 +6725,23 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n77, err := m.Header.MarshalTo(dAtA[i:])
+		n63, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n63
 	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+	if len(m.Ids) > 0 {
+		for _, msg := range m.Ids {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6684,7 +6749,7 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6694,7 +6759,7 @@ func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6703,33 +6768,23 @@ func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n78, err := m.Header.MarshalTo(dAtA[i:])
+		n64, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
-	}
-	if len(m.Desc) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Desc)))
-		i += copy(dAtA[i:], m.Desc)
-	}
-	if m.Status != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Status))
+		i += n64
 	}
-	if len(m.Kind) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Kind)))
-		i += copy(dAtA[i:], m.Kind)
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6737,634 +6792,974 @@ func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintPdpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *RequestHeader) Size() (n int) {
+
+func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovPdpb(uint64(m.ClusterId))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n65, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResponseHeader) Size() (n int) {
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovPdpb(uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Error) Size() (n int) {
+func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPdpb(uint64(m.Type))
+	if m.StartTimestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTimestamp))
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.EndTimestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.EndTimestamp))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TsoRequest) Size() (n int) {
+func (m *RecordPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.Count != 0 {
-		n += 1 + sovPdpb(uint64(m.Count))
+	if m.Value != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Value))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Timestamp) Size() (n int) {
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		n += 1 + sovPdpb(uint64(m.Physical))
-	}
-	if m.Logical != 0 {
-		n += 1 + sovPdpb(uint64(m.Logical))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StoreStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TsoResponse) Size() (n int) {
+func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.StoreId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
 	}
-	if m.Count != 0 {
-		n += 1 + sovPdpb(uint64(m.Count))
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Capacity))
 	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Available != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Available))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RegionCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionCount))
 	}
-	return n
-}
-
-func (m *BootstrapRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.SendingSnapCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SendingSnapCount))
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.ReceivingSnapCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ReceivingSnapCount))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartTime != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
 	}
-	return n
-}
-
-func (m *BootstrapResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.ApplyingSnapCount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApplyingSnapCount))
+	}
+	if m.IsBusy {
+		dAtA[i] = 0x48
+		i++
+		if m.IsBusy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UsedSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.UsedSize))
+	}
+	if m.Interval != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
+		n66, err := m.Interval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	if len(m.CpuUsages) > 0 {
+		for _, msg := range m.CpuUsages {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReadIoRates) > 0 {
+		for _, msg := range m.ReadIoRates {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.WriteIoRates) > 0 {
+		for _, msg := range m.WriteIoRates {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OpLatencies) > 0 {
+		for _, msg := range m.OpLatencies {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *IsBootstrappedRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IsBootstrappedResponse) Size() (n int) {
+func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n67, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
 	}
-	if m.Bootstrapped {
-		n += 2
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
+		n68, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocIDRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllocIDResponse) Size() (n int) {
+func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovPdpb(uint64(m.Id))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n69, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetStoreRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.StoreId != 0 {
-		n += 1 + sovPdpb(uint64(m.StoreId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetStoreResponse) Size() (n int) {
+func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n70, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Region != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n71, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n72, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutStoreRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutStoreResponse) Size() (n int) {
+func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n73, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAllStoresRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.ExcludeTombstoneStores {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAllStoresResponse) Size() (n int) {
+func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Stores) > 0 {
-		for _, e := range m.Stores {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n74, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n74
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	l = len(m.RegionKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRegionResponse) Size() (n int) {
+func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Slaves) > 0 {
-		for _, e := range m.Slaves {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n75, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n75
+	}
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionByIDRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsRequest) Size() (n int) {
+func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovPdpb(uint64(m.Limit))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n76, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ScanRegionsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.Leaders) > 0 {
-		for _, e := range m.Leaders {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigRequest) Size() (n int) {
+func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n77, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
+	if m.NewSafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewSafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetClusterConfigResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigRequest) Size() (n int) {
+func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n78, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
 	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutClusterConfigResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Member) Size() (n int) {
+func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n79, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
-	if m.MemberId != 0 {
-		n += 1 + sovPdpb(uint64(m.MemberId))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			l = len(s)
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
 	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			l = len(s)
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.Status != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Status))
 	}
-	if m.LeaderPriority != 0 {
-		n += 1 + sovPdpb(uint64(m.LeaderPriority))
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetMembersRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOperatorHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetMembersResponse) Size() (n int) {
+func (m *GetOperatorHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n80, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n80
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.EtcdLeader != nil {
-		l = m.EtcdLeader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.StoreId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.EndTime))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegionHeartbeatRequest) Size() (n int) {
+func (m *OperatorStepRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorStepRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Step) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Step)))
+		i += copy(dAtA[i:], m.Step)
 	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.FinishTime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.FinishTime))
 	}
-	if m.ApproximateSize != 0 {
-		n += 1 + sovPdpb(uint64(m.ApproximateSize))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Interval != nil {
-		l = m.Interval.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	return i, nil
+}
+
+func (m *OperatorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ApproximateKeys != 0 {
-		n += 1 + sovPdpb(uint64(m.ApproximateKeys))
+	return dAtA[:n], nil
+}
+
+func (m *OperatorRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Term != 0 {
-		n += 1 + sovPdpb(uint64(m.Term))
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
+	}
+	if len(m.Brief) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Brief)))
+		i += copy(dAtA[i:], m.Brief)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.StoreIds) > 0 {
+		dAtA82 := make([]byte, len(m.StoreIds)*10)
+		var j81 int
+		for _, num := range m.StoreIds {
+			for num >= 1<<7 {
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j81++
+			}
+			dAtA82[j81] = uint8(num)
+			j81++
+		}
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(j81))
+		i += copy(dAtA[i:], dAtA82[:j81])
+	}
+	if len(m.Steps) > 0 {
+		for _, msg := range m.Steps {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CreateTime != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.CreateTime))
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.FinishTime))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeer) Size() (n int) {
+func (m *GetOperatorHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperatorHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n83, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
 	}
-	if m.ChangeType != 0 {
-		n += 1 + sovPdpb(uint64(m.ChangeType))
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TransferLeader) Size() (n int) {
+func (m *SyncRegionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n84, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if m.Member != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Member.Size()))
+		n85, err := m.Member.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	if m.StartIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegionHeartbeatResponse) Size() (n int) {
+func (m *SyncRegionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n86, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
 	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.StartIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
+	if len(m.RegionLeaders) > 0 {
+		for _, msg := range m.RegionLeaders {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.RegionEpoch != nil {
-		l = m.RegionEpoch.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	return i, nil
+}
+
+func encodeVarintPdpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovPdpb(uint64(m.ClusterId))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7372,15 +7767,14 @@ func (m *RegionHeartbeatResponse) Size() (n int) {
 	return n
 }
 
-func (m *AskSplitRequest) Size() (n int) {
+func (m *ResponseHeader) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.ClusterId != 0 {
+		n += 1 + sovPdpb(uint64(m.ClusterId))
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
+	if m.Error != nil {
+		l = m.Error.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -7389,22 +7783,15 @@ func (m *AskSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *AskSplitResponse) Size() (n int) {
+func (m *Error) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.NewRegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	if m.Type != 0 {
+		n += 1 + sovPdpb(uint64(m.Type))
 	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovPdpb(uint64(e))
-		}
-		n += 1 + sovPdpb(uint64(l)) + l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7412,20 +7799,30 @@ func (m *AskSplitResponse) Size() (n int) {
 	return n
 }
 
-func (m *ReportSplitRequest) Size() (n int) {
+func (m *TsoRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Left != nil {
-		l = m.Left.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovPdpb(uint64(m.Count))
 	}
-	if m.Right != nil {
-		l = m.Right.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Timestamp) Size() (n int) {
+	var l int
+	_ = l
+	if m.Physical != 0 {
+		n += 1 + sovPdpb(uint64(m.Physical))
+	}
+	if m.Logical != 0 {
+		n += 1 + sovPdpb(uint64(m.Logical))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7433,51 +7830,49 @@ func (m *ReportSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *ReportSplitResponse) Size() (n int) {
+func (m *TsoResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Count != 0 {
+		n += 1 + sovPdpb(uint64(m.Count))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AskBatchSplitRequest) Size() (n int) {
+func (m *BootstrapRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
+	if m.Store != nil {
+		l = m.Store.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.SplitCount != 0 {
-		n += 1 + sovPdpb(uint64(m.SplitCount))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SplitID) Size() (n int) {
+func (m *BootstrapResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovPdpb(uint64(e))
-		}
-		n += 1 + sovPdpb(uint64(l)) + l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7485,37 +7880,28 @@ func (m *SplitID) Size() (n int) {
 	return n
 }
 
-func (m *AskBatchSplitResponse) Size() (n int) {
+func (m *IsBootstrappedRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReportBatchSplitRequest) Size() (n int) {
+func (m *IsBootstrappedResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.Bootstrapped {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7523,7 +7909,7 @@ func (m *ReportBatchSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *ReportBatchSplitResponse) Size() (n int) {
+func (m *AllocIDRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -7536,14 +7922,15 @@ func (m *ReportBatchSplitResponse) Size() (n int) {
 	return n
 }
 
-func (m *TimeInterval) Size() (n int) {
+func (m *AllocIDResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		n += 1 + sovPdpb(uint64(m.StartTimestamp))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.EndTimestamp != 0 {
-		n += 1 + sovPdpb(uint64(m.EndTimestamp))
+	if m.Id != 0 {
+		n += 1 + sovPdpb(uint64(m.Id))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7551,15 +7938,15 @@ func (m *TimeInterval) Size() (n int) {
 	return n
 }
 
-func (m *RecordPair) Size() (n int) {
+func (m *GetStoreRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Value != 0 {
-		n += 1 + sovPdpb(uint64(m.Value))
+	if m.StoreId != 0 {
+		n += 1 + sovPdpb(uint64(m.StoreId))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7567,66 +7954,20 @@ func (m *RecordPair) Size() (n int) {
 	return n
 }
 
-func (m *StoreStats) Size() (n int) {
+func (m *GetStoreResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		n += 1 + sovPdpb(uint64(m.StoreId))
-	}
-	if m.Capacity != 0 {
-		n += 1 + sovPdpb(uint64(m.Capacity))
-	}
-	if m.Available != 0 {
-		n += 1 + sovPdpb(uint64(m.Available))
-	}
-	if m.RegionCount != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionCount))
-	}
-	if m.SendingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.SendingSnapCount))
-	}
-	if m.ReceivingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.ReceivingSnapCount))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovPdpb(uint64(m.StartTime))
-	}
-	if m.ApplyingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.ApplyingSnapCount))
-	}
-	if m.IsBusy {
-		n += 2
-	}
-	if m.UsedSize != 0 {
-		n += 1 + sovPdpb(uint64(m.UsedSize))
-	}
-	if m.Interval != nil {
-		l = m.Interval.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.CpuUsages) > 0 {
-		for _, e := range m.CpuUsages {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.ReadIoRates) > 0 {
-		for _, e := range m.ReadIoRates {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.WriteIoRates) > 0 {
-		for _, e := range m.WriteIoRates {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.OpLatencies) > 0 {
-		for _, e := range m.OpLatencies {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7634,15 +7975,15 @@ func (m *StoreStats) Size() (n int) {
 	return n
 }
 
-func (m *StoreHeartbeatRequest) Size() (n int) {
+func (m *PutStoreRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
+	if m.Store != nil {
+		l = m.Store.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -7651,7 +7992,7 @@ func (m *StoreHeartbeatRequest) Size() (n int) {
 	return n
 }
 
-func (m *StoreHeartbeatResponse) Size() (n int) {
+func (m *PutStoreResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -7664,65 +8005,94 @@ func (m *StoreHeartbeatResponse) Size() (n int) {
 	return n
 }
 
-func (m *ScatterRegionRequest) Size() (n int) {
+func (m *GetAllStoresRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
+	if m.ExcludeTombstoneStores {
+		n += 2
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
+	return n
+}
+
+func (m *GetAllStoresResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ScatterRegionResponse) Size() (n int) {
+func (m *GetRegionRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	l = len(m.RegionKey)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetGCSafePointRequest) Size() (n int) {
+func (m *GetRegionResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Slaves) > 0 {
+		for _, e := range m.Slaves {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetGCSafePointResponse) Size() (n int) {
+func (m *GetRegionByIDRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.SafePoint))
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7730,15 +8100,23 @@ func (m *GetGCSafePointResponse) Size() (n int) {
 	return n
 }
 
-func (m *UpdateGCSafePointRequest) Size() (n int) {
+func (m *ScanRegionsRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.SafePoint))
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPdpb(uint64(m.Limit))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7746,15 +8124,24 @@ func (m *UpdateGCSafePointRequest) Size() (n int) {
 	return n
 }
 
-func (m *UpdateGCSafePointResponse) Size() (n int) {
+func (m *ScanRegionsResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.NewSafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.NewSafePoint))
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, e := range m.Leaders {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7762,63 +8149,1585 @@ func (m *UpdateGCSafePointResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetOperatorRequest) Size() (n int) {
+func (m *GetClusterConfigRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetOperatorResponse) Size() (n int) {
+func (m *GetClusterConfigResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutClusterConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutClusterConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.MemberId != 0 {
+		n += 1 + sovPdpb(uint64(m.MemberId))
+	}
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			l = len(s)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			l = len(s)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.LeaderPriority != 0 {
+		n += 1 + sovPdpb(uint64(m.LeaderPriority))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMembersRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMembersResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.EtcdLeader != nil {
+		l = m.EtcdLeader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.DownSeconds != 0 {
+		n += 1 + sovPdpb(uint64(m.DownSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionHeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.DownPeers) > 0 {
+		for _, e := range m.DownPeers {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.ApproximateSize != 0 {
+		n += 1 + sovPdpb(uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.ApproximateKeys != 0 {
+		n += 1 + sovPdpb(uint64(m.ApproximateKeys))
+	}
+	if m.Term != 0 {
+		n += 1 + sovPdpb(uint64(m.Term))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeer) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.ChangeType != 0 {
+		n += 1 + sovPdpb(uint64(m.ChangeType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionHeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
 	if m.RegionId != 0 {
 		n += 1 + sovPdpb(uint64(m.RegionId))
 	}
-	l = len(m.Desc)
-	if l > 0 {
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovPdpb(uint64(m.Status))
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	l = len(m.Kind)
-	if l > 0 {
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *AskSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.NewRegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovPdpb(uint64(e))
+		}
+		n += 1 + sovPdpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SplitCount != 0 {
+		n += 1 + sovPdpb(uint64(m.SplitCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitID) Size() (n int) {
+	var l int
+	_ = l
+	if m.NewRegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovPdpb(uint64(e))
+		}
+		n += 1 + sovPdpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportBatchSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportBatchSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeInterval) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovPdpb(uint64(m.EndTimestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordPair) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovPdpb(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.StoreId != 0 {
+		n += 1 + sovPdpb(uint64(m.StoreId))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovPdpb(uint64(m.Capacity))
+	}
+	if m.Available != 0 {
+		n += 1 + sovPdpb(uint64(m.Available))
+	}
+	if m.RegionCount != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionCount))
+	}
+	if m.SendingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.SendingSnapCount))
+	}
+	if m.ReceivingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.ReceivingSnapCount))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTime))
+	}
+	if m.ApplyingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.ApplyingSnapCount))
+	}
+	if m.IsBusy {
+		n += 2
+	}
+	if m.UsedSize != 0 {
+		n += 1 + sovPdpb(uint64(m.UsedSize))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.CpuUsages) > 0 {
+		for _, e := range m.CpuUsages {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.ReadIoRates) > 0 {
+		for _, e := range m.ReadIoRates {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.WriteIoRates) > 0 {
+		for _, e := range m.WriteIoRates {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.OpLatencies) > 0 {
+		for _, e := range m.OpLatencies {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.NewSafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.NewSafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPdpb(uint64(m.Status))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.StoreId != 0 {
+		n += 1 + sovPdpb(uint64(m.StoreId))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovPdpb(uint64(m.EndTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperatorStepRecord) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovPdpb(uint64(m.FinishTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperatorRecord) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	l = len(m.Brief)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPdpb(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.StoreIds) > 0 {
+		l = 0
+		for _, e := range m.StoreIds {
+			l += sovPdpb(uint64(e))
+		}
+		n += 1 + sovPdpb(uint64(l)) + l
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovPdpb(uint64(m.CreateTime))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovPdpb(uint64(m.FinishTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorHistoryResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovPdpb(uint64(m.StartIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovPdpb(uint64(m.StartIndex))
+	}
+	if len(m.RegionLeaders) > 0 {
+		for _, e := range m.RegionLeaders {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPdpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPdpb(x uint64) (n int) {
+	return sovPdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ErrorType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			}
+			m.Physical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Physical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+			}
+			m.Logical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Logical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovPdpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPdpb(x uint64) (n int) {
-	return sovPdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7841,17 +9750,17 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ClusterId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -7861,11 +9770,25 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -7888,7 +9811,7 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7911,34 +9834,15 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			m.ClusterId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7962,10 +9866,10 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7991,7 +9895,7 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8014,17 +9918,17 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8034,16 +9938,30 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (ErrorType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8053,21 +9971,12 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Bootstrapped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8090,7 +9999,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8113,10 +10022,10 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8152,25 +10061,6 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8193,7 +10083,7 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timestamp) Unmarshal(dAtA []byte) error {
+func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8216,17 +10106,17 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Physical = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8236,16 +10126,30 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Physical |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Logical = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8255,7 +10159,7 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Logical |= (int64(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8282,7 +10186,7 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8305,10 +10209,10 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8338,7 +10242,7 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8346,28 +10250,9 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
 			}
-			var msglen int
+			m.StoreId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8377,25 +10262,11 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StoreId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Timestamp == nil {
-				m.Timestamp = &Timestamp{}
-			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8418,7 +10289,7 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8441,10 +10312,10 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8474,7 +10345,7 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8513,60 +10384,9 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8590,10 +10410,10 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8619,7 +10439,7 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
+func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8642,10 +10462,10 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8681,6 +10501,39 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8703,7 +10556,7 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
+func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8726,10 +10579,10 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8765,26 +10618,6 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Bootstrapped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8807,7 +10640,7 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8830,10 +10663,10 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8869,6 +10702,26 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeTombstoneStores = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8891,7 +10744,7 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8914,10 +10767,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8954,10 +10807,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8967,11 +10820,23 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &metapb.Store{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8994,7 +10859,7 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9017,10 +10882,10 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9057,10 +10922,10 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
 			}
-			m.StoreId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9070,11 +10935,23 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegionKey == nil {
+				m.RegionKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9097,7 +10974,7 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9120,10 +10997,10 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9161,7 +11038,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9185,16 +11062,49 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9218,10 +11128,8 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slaves = append(m.Slaves, &metapb.Peer{})
+			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9247,7 +11155,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9270,10 +11178,10 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9310,10 +11218,10 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			var msglen int
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9323,25 +11231,11 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
-			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9364,7 +11258,7 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9387,10 +11281,10 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9420,12 +11314,93 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9448,7 +11423,7 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9471,10 +11446,10 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9504,17 +11479,17 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9524,12 +11499,54 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeTombstoneStores = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaders = append(m.Leaders, &metapb.Peer{})
+			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9552,7 +11569,7 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9575,10 +11592,10 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9608,43 +11625,12 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stores = append(m.Stores, &metapb.Store{})
-			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9667,7 +11653,7 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9690,10 +11676,10 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9723,7 +11709,7 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9731,9 +11717,9 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9743,21 +11729,23 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.RegionKey == nil {
-				m.RegionKey = []byte{}
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9782,7 +11770,7 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
+func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9805,10 +11793,10 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9838,7 +11826,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9846,40 +11834,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9903,41 +11858,10 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
 			}
-			m.Slaves = append(m.Slaves, &metapb.Peer{})
-			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9963,7 +11887,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9986,10 +11910,10 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10019,31 +11943,12 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
-			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10066,7 +11971,7 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10089,17 +11994,17 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10109,30 +12014,45 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10142,28 +12062,55 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
+			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
 			}
-			m.Limit = 0
+			m.LeaderPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10173,16 +12120,67 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (int32(b) & 0x7F) << shift
+				m.LeaderPriority |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10192,21 +12190,23 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10231,7 +12231,7 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10254,10 +12254,10 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10295,7 +12295,7 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10319,14 +12319,14 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10350,8 +12350,43 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leaders = append(m.Leaders, &metapb.Peer{})
-			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Leader == nil {
+				m.Leader = &Member{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EtcdLeader == nil {
+				m.EtcdLeader = &Member{}
+			}
+			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10377,7 +12412,7 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *PeerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10400,15 +12435,15 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10432,13 +12467,32 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
+			}
+			m.DownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownSeconds |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10461,7 +12515,7 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10484,10 +12538,10 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10517,7 +12571,7 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10525,7 +12579,7 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10549,67 +12603,130 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
+			m.DownPeers = append(m.DownPeers, &PeerStats{})
+			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			}
+			m.ApproximateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10633,18 +12750,18 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
 			}
-			var msglen int
+			m.ApproximateKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10654,25 +12771,30 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ApproximateKeys |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10695,7 +12817,7 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10718,15 +12840,15 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10750,13 +12872,32 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10779,7 +12920,7 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10802,65 +12943,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
-			}
-			m.MemberId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MemberId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10870,69 +12963,25 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPdpb
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
-			}
-			m.LeaderPriority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LeaderPriority |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10955,7 +13004,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10978,10 +13027,10 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11011,66 +13060,15 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11094,16 +13092,16 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11127,14 +13125,35 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeader{}
+			}
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11158,16 +13177,16 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &Member{}
+			if m.RegionEpoch == nil {
+				m.RegionEpoch = &metapb.RegionEpoch{}
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11191,10 +13210,10 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EtcdLeader == nil {
-				m.EtcdLeader = &Member{}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
 			}
-			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11220,7 +13239,7 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11243,10 +13262,10 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11315,92 +13334,60 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ApproximateSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11424,18 +13411,18 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			m.ApproximateKeys = 0
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11445,29 +13432,72 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateKeys |= (uint64(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
-			}
-			m.Term = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Term |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -11491,7 +13521,7 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeer) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11514,15 +13544,15 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11546,18 +13576,18 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
 			}
-			m.ChangeType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11567,11 +13597,58 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Left == nil {
+				m.Left = &metapb.Region{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Right == nil {
+				m.Right = &metapb.Region{}
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -11594,7 +13671,7 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeader) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11617,15 +13694,15 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11649,10 +13726,10 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11678,7 +13755,7 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11701,10 +13778,10 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskBatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11734,7 +13811,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -11742,7 +13819,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11766,18 +13843,18 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeer{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitCount", wireType)
 			}
-			var msglen int
+			m.SplitCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11787,47 +13864,197 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SplitCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
+			}
+			m.NewRegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeader{}
-			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskBatchSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11851,16 +14078,16 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegionEpoch == nil {
-				m.RegionEpoch = &metapb.RegionEpoch{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11884,10 +14111,8 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
-			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, &SplitID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11913,7 +14138,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11936,10 +14161,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportBatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11977,7 +14202,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12001,10 +14226,8 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12030,7 +14253,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12053,10 +14276,10 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportBatchSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12092,87 +14315,6 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
-			}
-			m.NewRegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPdpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12195,7 +14337,7 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12218,17 +14360,17 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
-			var msglen int
+			m.StartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12238,63 +14380,16 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Left == nil {
-				m.Left = &metapb.Region{}
-			}
-			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
 			}
-			var msglen int
+			m.EndTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12304,25 +14399,11 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Right == nil {
-				m.Right = &metapb.Region{}
-			}
-			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12345,7 +14426,7 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12368,17 +14449,17 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12388,25 +14469,40 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12429,7 +14525,7 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12452,17 +14548,17 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
 			}
-			var msglen int
+			m.StoreId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12472,30 +14568,16 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StoreId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var msglen int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12505,30 +14587,16 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Capacity |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.SplitCount = 0
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12538,67 +14606,54 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SplitCount |= (uint32(b) & 0x7F) << shift
+				m.Available |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SplitID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SendingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
 			}
-			m.NewRegionId = 0
+			m.ReceivingSnapCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12608,127 +14663,91 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
+				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
 				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPdpb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			}
+			m.ApplyingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.IsBusy = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UsedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12752,16 +14771,16 @@ func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12785,65 +14804,14 @@ func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, &SplitID{})
-			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
+			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportBatchSplitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12867,16 +14835,14 @@ func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
+			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12900,65 +14866,14 @@ func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
+			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportBatchSplitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12982,10 +14897,8 @@ func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
+			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13011,7 +14924,7 @@ func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeInterval) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13034,17 +14947,17 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StartTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13054,16 +14967,30 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			m.EndTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13073,11 +15000,25 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13100,7 +15041,7 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordPair) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13123,17 +15064,17 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13143,40 +15084,25 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13199,7 +15125,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreStats) Unmarshal(dAtA []byte) error {
+func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13222,17 +15148,17 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StoreId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13242,16 +15168,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			m.Capacity = 0
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13261,16 +15201,16 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= (uint64(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Available = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13280,16 +15220,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Available |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.RegionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13299,54 +15253,81 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionCount |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.SendingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-			m.ReceivingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StartTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13356,55 +15337,81 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.ApplyingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsBusy = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UsedSize = 0
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGCSafePointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13414,14 +15421,79 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGCSafePointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13445,18 +15517,18 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
 			}
-			var msglen int
+			m.SafePoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13466,26 +15538,65 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SafePoint |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
-			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			iNdEx = postIndex
-		case 17:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateGCSafePointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13509,16 +15620,18 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
-			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
 			}
-			var msglen int
+			m.SafePoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13528,26 +15641,65 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SafePoint |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
-			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			iNdEx = postIndex
-		case 19:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateGCSafePointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13571,11 +15723,32 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
-			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSafePoint", wireType)
+			}
+			m.NewSafePoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewSafePoint |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13598,7 +15771,7 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13621,10 +15794,10 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13661,10 +15834,10 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			var msglen int
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13674,25 +15847,11 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13715,7 +15874,7 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13738,10 +15897,10 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13777,6 +15936,106 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = append(m.Desc[:0], dAtA[iNdEx:postIndex]...)
+			if m.Desc == nil {
+				m.Desc = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (OperatorStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = append(m.Kind[:0], dAtA[iNdEx:postIndex]...)
+			if m.Kind == nil {
+				m.Kind = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13799,7 +16058,7 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13822,10 +16081,10 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13865,7 +16124,26 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			m.RegionId = 0
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			}
+			m.StoreId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13875,16 +16153,16 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.StoreId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13894,30 +16172,16 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var msglen int
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13927,25 +16191,11 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.EndTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13968,7 +16218,7 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
+func (m *OperatorStepRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13991,17 +16241,17 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorStepRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorStepRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14011,25 +16261,40 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FinishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -14052,7 +16317,7 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
+func (m *OperatorRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14075,17 +16340,36 @@ func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGCSafePointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14095,79 +16379,192 @@ func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brief", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Brief = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if iNdEx >= l {
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (OperatorStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StoreIds = append(m.StoreIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StoreIds = append(m.StoreIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetGCSafePointResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14191,18 +16588,16 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, &OperatorStepRecord{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			m.SafePoint = 0
+			m.CreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14212,67 +16607,16 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SafePoint |= (uint64(b) & 0x7F) << shift
+				m.CreateTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGCSafePointRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14282,30 +16626,16 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			m.SafePoint = 0
+			m.FinishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14315,7 +16645,7 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SafePoint |= (uint64(b) & 0x7F) << shift
+				m.FinishTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14342,7 +16672,7 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14365,10 +16695,10 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGCSafePointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14405,10 +16735,10 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSafePoint", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.NewSafePoint = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14418,11 +16748,23 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewSafePoint |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &OperatorRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -14445,7 +16787,7 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14468,10 +16810,10 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14508,10 +16850,43 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
 			}
-			m.RegionId = 0
+			m.StartIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14521,7 +16896,7 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.StartIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14548,7 +16923,7 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14571,10 +16946,10 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14611,29 +16986,10 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
-			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14643,28 +16999,28 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = append(m.Desc[:0], dAtA[iNdEx:postIndex]...)
-			if m.Desc == nil {
-				m.Desc = []byte{}
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
 			}
-			m.Status = 0
+			m.StartIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14674,16 +17030,16 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (OperatorStatus(b) & 0x7F) << shift
+				m.StartIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionLeaders", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14693,21 +17049,21 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = append(m.Kind[:0], dAtA[iNdEx:postIndex]...)
-			if m.Kind == nil {
-				m.Kind = []byte{}
+			m.RegionLeaders = append(m.RegionLeaders, &metapb.Peer{})
+			if err := m.RegionLeaders[len(m.RegionLeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14837,159 +17193,180 @@ var (
 	ErrIntOverflowPdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("pdpb.proto", fileDescriptor_pdpb_50227df4ceb18ee4) }
-
-var fileDescriptor_pdpb_50227df4ceb18ee4 = []byte{
-	// 2412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x52, 0x14, 0x3f, 0x1e, 0x29, 0x92, 0x1a, 0x7d, 0xd1, 0xf4, 0x67, 0xd6, 0xae, 0xeb,
-	0xa4, 0x89, 0xe2, 0x38, 0x41, 0x60, 0xa0, 0x48, 0x11, 0x8a, 0xa2, 0x65, 0xc6, 0x32, 0x49, 0x2c,
-	0xa9, 0x14, 0x01, 0x8a, 0x6c, 0x57, 0xdc, 0x91, 0xb4, 0xd5, 0x6a, 0x77, 0xb3, 0x33, 0x94, 0xc3,
-	0xa0, 0x87, 0x9e, 0x7a, 0x69, 0x7b, 0x0c, 0xd0, 0x63, 0xff, 0x82, 0xde, 0xda, 0x5b, 0xd1, 0x6b,
-	0x8f, 0xfd, 0x13, 0x0a, 0x17, 0xe8, 0xa5, 0xe8, 0xff, 0x50, 0xcc, 0xcc, 0xce, 0x7e, 0x91, 0xb2,
-	0x95, 0x75, 0x72, 0xe3, 0xbc, 0xf7, 0xe6, 0xf7, 0x3e, 0xe6, 0xcd, 0xcc, 0x9b, 0xb7, 0x04, 0xf0,
-	0x4c, 0xef, 0x68, 0xc7, 0xf3, 0x5d, 0xea, 0xa2, 0x3c, 0xfb, 0xdd, 0xaa, 0x9e, 0x63, 0x6a, 0x48,
-	0x5a, 0x6b, 0x15, 0xfb, 0xc6, 0x31, 0x0d, 0x87, 0x1b, 0x27, 0xee, 0x89, 0xcb, 0x7f, 0xbe, 0xcf,
-	0x7e, 0x09, 0xaa, 0xba, 0x03, 0xab, 0x1a, 0xfe, 0x6a, 0x8a, 0x09, 0x7d, 0x8a, 0x0d, 0x13, 0xfb,
-	0xe8, 0x26, 0xc0, 0xc4, 0x9e, 0x12, 0x8a, 0x7d, 0xdd, 0x32, 0x9b, 0xca, 0x1d, 0xe5, 0x41, 0x5e,
-	0x2b, 0x07, 0x94, 0x9e, 0xa9, 0x6a, 0x50, 0xd3, 0x30, 0xf1, 0x5c, 0x87, 0xe0, 0x2b, 0x4d, 0x40,
-	0x6f, 0xc1, 0x0a, 0xf6, 0x7d, 0xd7, 0x6f, 0xe6, 0xee, 0x28, 0x0f, 0x2a, 0x8f, 0x2a, 0x3b, 0xdc,
-	0xea, 0x2e, 0x23, 0x69, 0x82, 0xa3, 0x3e, 0x81, 0x15, 0x3e, 0x46, 0x77, 0x21, 0x4f, 0x67, 0x1e,
-	0xe6, 0x20, 0xb5, 0x47, 0xf5, 0x98, 0xe8, 0x78, 0xe6, 0x61, 0x8d, 0x33, 0x51, 0x13, 0x8a, 0xe7,
-	0x98, 0x10, 0xe3, 0x04, 0x73, 0xc8, 0xb2, 0x26, 0x87, 0xea, 0x00, 0x60, 0x4c, 0xdc, 0xc0, 0x1d,
-	0xf4, 0x13, 0x28, 0x9c, 0x72, 0x0b, 0x39, 0x5c, 0xe5, 0xd1, 0xba, 0x80, 0x4b, 0x78, 0xab, 0x05,
-	0x22, 0x68, 0x03, 0x56, 0x26, 0xee, 0xd4, 0xa1, 0x1c, 0x72, 0x55, 0x13, 0x03, 0xb5, 0x0d, 0xe5,
-	0xb1, 0x75, 0x8e, 0x09, 0x35, 0xce, 0x3d, 0xd4, 0x82, 0x92, 0x77, 0x3a, 0x23, 0xd6, 0xc4, 0xb0,
-	0x39, 0xe2, 0xb2, 0x16, 0x8e, 0x99, 0x4d, 0xb6, 0x7b, 0xc2, 0x59, 0x39, 0xce, 0x92, 0x43, 0xf5,
-	0x37, 0x0a, 0x54, 0xb8, 0x51, 0x22, 0x66, 0xe8, 0xdd, 0x94, 0x55, 0x1b, 0xd2, 0xaa, 0x78, 0x4c,
-	0x5f, 0x6d, 0x16, 0x7a, 0x0f, 0xca, 0x54, 0x9a, 0xd5, 0x5c, 0xe6, 0x30, 0x41, 0xac, 0x42, 0x6b,
-	0xb5, 0x48, 0x42, 0x35, 0xa1, 0xb1, 0xeb, 0xba, 0x94, 0x50, 0xdf, 0xf0, 0x32, 0x05, 0xe7, 0x2e,
-	0xac, 0x10, 0xea, 0xfa, 0x38, 0x58, 0xc2, 0xd5, 0x9d, 0x20, 0xcd, 0x46, 0x8c, 0xa8, 0x09, 0x9e,
-	0xda, 0x86, 0xb5, 0x98, 0x96, 0x2c, 0xde, 0xaa, 0x7b, 0xb0, 0xd9, 0x23, 0x21, 0x88, 0x87, 0xcd,
-	0x2c, 0xd6, 0xaa, 0xbf, 0x82, 0xad, 0x34, 0x4a, 0xa6, 0xd8, 0xab, 0x50, 0x3d, 0x8a, 0xa1, 0x70,
-	0xe7, 0x4b, 0x5a, 0x82, 0xa6, 0x7e, 0x02, 0xb5, 0xb6, 0x6d, 0xbb, 0x93, 0xde, 0x5e, 0x26, 0x53,
-	0x07, 0x50, 0x0f, 0xa7, 0x67, 0xb2, 0xb1, 0x06, 0x39, 0x4b, 0x58, 0x96, 0xd7, 0x72, 0x96, 0xa9,
-	0x7e, 0x01, 0xf5, 0x7d, 0x4c, 0xc5, 0xba, 0x64, 0x59, 0xe9, 0x6b, 0x50, 0xe2, 0xab, 0xa9, 0x87,
-	0xa8, 0x45, 0x3e, 0xee, 0x99, 0xea, 0x1f, 0x14, 0x68, 0x44, 0xd8, 0x99, 0xac, 0xbd, 0x4a, 0x1e,
-	0xa1, 0xfb, 0x4c, 0xc8, 0xa0, 0x24, 0x48, 0xec, 0x86, 0x40, 0xe4, 0x22, 0x23, 0x46, 0xd7, 0x04,
-	0x5b, 0x9d, 0x40, 0x7d, 0x38, 0x7d, 0x03, 0x57, 0xaf, 0x94, 0xd4, 0x9f, 0x42, 0x23, 0x52, 0x92,
-	0x29, 0xa7, 0x7f, 0x0d, 0xeb, 0xfb, 0x98, 0xb6, 0x6d, 0x9b, 0x83, 0x90, 0x4c, 0xa6, 0x3e, 0x86,
-	0x26, 0xfe, 0x7a, 0x62, 0x4f, 0x4d, 0xac, 0x53, 0xf7, 0xfc, 0x88, 0x50, 0xd7, 0xc1, 0x3a, 0x37,
-	0x90, 0x04, 0x59, 0xb9, 0x15, 0xf0, 0xc7, 0x92, 0x2d, 0xb4, 0xa9, 0x67, 0xb0, 0x91, 0xd4, 0x9e,
-	0x69, 0xdd, 0x7e, 0x04, 0x85, 0x50, 0xdb, 0xf2, 0x7c, 0xac, 0x02, 0xa6, 0xfa, 0x25, 0x4f, 0x10,
-	0x0d, 0x9f, 0x58, 0xae, 0x93, 0xc9, 0xcf, 0x9b, 0x00, 0x3e, 0x9f, 0xad, 0x9f, 0xe1, 0x19, 0xf7,
-	0xac, 0xaa, 0x95, 0x05, 0xe5, 0x19, 0x9e, 0xa9, 0x7f, 0x51, 0x60, 0x2d, 0xa6, 0x20, 0x93, 0x2b,
-	0xf7, 0xa1, 0x20, 0x00, 0x83, 0x65, 0xaf, 0x49, 0x57, 0x02, 0xd4, 0x80, 0x8b, 0xee, 0x41, 0xc1,
-	0x16, 0xa8, 0x22, 0x0d, 0xab, 0x52, 0x6e, 0x88, 0x19, 0x9a, 0xe0, 0x31, 0x29, 0x62, 0x1b, 0x17,
-	0x98, 0x34, 0xf3, 0x3c, 0x30, 0x29, 0x29, 0xc1, 0x53, 0x7f, 0xc9, 0x17, 0x41, 0x28, 0xd8, 0x9d,
-	0x65, 0x3b, 0x2a, 0xd0, 0x75, 0x08, 0x22, 0x11, 0x6d, 0xcd, 0x92, 0x20, 0x88, 0xbd, 0x89, 0x46,
-	0x13, 0xc3, 0x11, 0x3a, 0x48, 0x56, 0x05, 0x84, 0x1a, 0x3e, 0x8d, 0xc5, 0xbe, 0xc4, 0x09, 0xcf,
-	0xf0, 0x8c, 0xdd, 0x43, 0xb6, 0x75, 0x6e, 0x51, 0x1e, 0x8d, 0x15, 0x4d, 0x0c, 0xd0, 0x36, 0x14,
-	0xb1, 0x63, 0xf2, 0x09, 0x79, 0x3e, 0xa1, 0x80, 0x1d, 0x93, 0xad, 0xd4, 0xb7, 0x0a, 0xac, 0x27,
-	0xec, 0xc9, 0xb4, 0x56, 0x0f, 0xa0, 0x28, 0x3c, 0x94, 0x79, 0x97, 0x5e, 0x2c, 0xc9, 0x46, 0xf7,
-	0xa1, 0x28, 0x56, 0x84, 0x9d, 0x1a, 0xf3, 0x0b, 0x21, 0x99, 0xea, 0x13, 0xd8, 0xde, 0xc7, 0xb4,
-	0x23, 0x6a, 0x93, 0x8e, 0xeb, 0x1c, 0x5b, 0x27, 0x99, 0xce, 0x6d, 0x02, 0xcd, 0x79, 0x9c, 0x4c,
-	0x3e, 0xbe, 0x0d, 0xc5, 0xa0, 0x54, 0x0a, 0x12, 0xb2, 0x2e, 0x2d, 0x0f, 0xd0, 0x35, 0xc9, 0x57,
-	0xbf, 0x82, 0xed, 0xe1, 0xf4, 0xcd, 0x8d, 0xff, 0x2e, 0x2a, 0x9f, 0x42, 0x73, 0x5e, 0x65, 0xa6,
-	0x63, 0xf0, 0x4f, 0x0a, 0x14, 0x9e, 0xe3, 0xf3, 0x23, 0xec, 0x23, 0x04, 0x79, 0xc7, 0x38, 0x17,
-	0x45, 0x5e, 0x59, 0xe3, 0xbf, 0x59, 0xf2, 0x9d, 0x73, 0x6e, 0x2c, 0xbb, 0x05, 0xa1, 0x67, 0x32,
-	0xa6, 0x87, 0xb1, 0xaf, 0x4f, 0x7d, 0x5b, 0xac, 0x6f, 0x59, 0x2b, 0x31, 0xc2, 0xa1, 0x6f, 0x13,
-	0x74, 0x1b, 0x2a, 0x13, 0xdb, 0xc2, 0x0e, 0x15, 0xec, 0x3c, 0x67, 0x83, 0x20, 0x71, 0x81, 0x1f,
-	0x43, 0x5d, 0x2c, 0xbf, 0xee, 0xf9, 0x96, 0xeb, 0x5b, 0x74, 0xd6, 0x5c, 0xe1, 0x49, 0x5c, 0x13,
-	0xe4, 0x61, 0x40, 0x55, 0x3f, 0xe5, 0xa7, 0x8b, 0x30, 0x32, 0xd3, 0x16, 0x52, 0xff, 0xae, 0x00,
-	0x8a, 0x43, 0x64, 0x3c, 0xa1, 0x8a, 0xc2, 0x73, 0x99, 0xf5, 0x55, 0x21, 0x2e, 0x50, 0x35, 0xc9,
-	0x5c, 0x70, 0x42, 0xc5, 0xc5, 0xe4, 0x09, 0xf5, 0x1e, 0x54, 0x30, 0x9d, 0x98, 0x7a, 0x20, 0x9a,
-	0x5f, 0x20, 0x0a, 0x4c, 0xe0, 0x40, 0x78, 0xf0, 0x9f, 0x1c, 0x6c, 0x89, 0xcd, 0xf5, 0x14, 0x1b,
-	0x3e, 0x3d, 0xc2, 0x06, 0xcd, 0x94, 0x63, 0xdf, 0xef, 0x31, 0xfb, 0x01, 0xac, 0x7a, 0xd8, 0x31,
-	0x2d, 0xe7, 0x44, 0x67, 0xeb, 0x4e, 0x9a, 0x2b, 0x0b, 0x36, 0x79, 0x35, 0x10, 0x61, 0x03, 0x82,
-	0xde, 0x86, 0x86, 0xe1, 0x79, 0xbe, 0xfb, 0xb5, 0x75, 0x6e, 0x50, 0xac, 0x13, 0xeb, 0x1b, 0xdc,
-	0x04, 0x9e, 0x57, 0xf5, 0x18, 0x7d, 0x64, 0x7d, 0x83, 0xd1, 0x0e, 0x94, 0x2c, 0x87, 0x62, 0xff,
-	0xc2, 0xb0, 0x9b, 0x55, 0x6e, 0x05, 0x8a, 0x8a, 0xe9, 0x5e, 0xc0, 0xd1, 0x42, 0x99, 0x34, 0xf4,
-	0x19, 0x9e, 0x91, 0xe6, 0xea, 0x1c, 0xf4, 0x33, 0x3c, 0x23, 0x2c, 0xd5, 0x29, 0xf6, 0xcf, 0x9b,
-	0x35, 0xce, 0xe6, 0xbf, 0x3f, 0xcb, 0x97, 0x2a, 0x8d, 0xaa, 0x7a, 0x0a, 0xd0, 0x39, 0x35, 0x9c,
-	0x13, 0xcc, 0xcc, 0x45, 0x77, 0x20, 0xcf, 0x1c, 0x0b, 0x22, 0x9b, 0xf4, 0x8b, 0x73, 0xd0, 0x63,
-	0xa8, 0x4c, 0xb8, 0xbc, 0xce, 0x1f, 0x48, 0x39, 0xfe, 0x40, 0xda, 0xde, 0x91, 0x2f, 0x3c, 0xb6,
-	0x37, 0x05, 0x1e, 0x7f, 0x28, 0xc1, 0x24, 0xfc, 0xad, 0x3e, 0x82, 0xda, 0xd8, 0x37, 0x1c, 0x72,
-	0x8c, 0x7d, 0xb1, 0xc8, 0xaf, 0xd7, 0xa6, 0xfe, 0x2d, 0x07, 0xdb, 0x73, 0x69, 0x90, 0x29, 0x9b,
-	0x3f, 0x08, 0xed, 0xe6, 0x2a, 0x73, 0xf1, 0x9a, 0x2e, 0x0a, 0x80, 0x34, 0x98, 0x07, 0xe3, 0x13,
-	0xa8, 0xd3, 0xc0, 0x60, 0x3d, 0x91, 0x1c, 0x81, 0xa6, 0xa4, 0x37, 0x5a, 0x8d, 0x26, 0xbd, 0x4b,
-	0x5c, 0x94, 0xf9, 0xe4, 0x45, 0x89, 0x3e, 0x86, 0x6a, 0xc0, 0xc4, 0x9e, 0x3b, 0x39, 0xe5, 0x27,
-	0x01, 0x4b, 0xe5, 0x44, 0x76, 0x76, 0x19, 0x4b, 0xab, 0xf8, 0xd1, 0x80, 0x6d, 0x23, 0x6a, 0xf8,
-	0x27, 0x98, 0x0a, 0x37, 0x0a, 0x0b, 0x22, 0x07, 0x42, 0x80, 0xfd, 0x56, 0x8f, 0xa1, 0xde, 0x26,
-	0x67, 0x23, 0xcf, 0xb6, 0x7e, 0xd0, 0xed, 0xa3, 0xfe, 0x56, 0x81, 0x46, 0xa4, 0x28, 0xe3, 0x2b,
-	0x67, 0xd5, 0xc1, 0x2f, 0xf4, 0x74, 0x6d, 0x51, 0x71, 0xf0, 0x0b, 0x4d, 0x46, 0xed, 0x0e, 0x54,
-	0x99, 0x0c, 0x3f, 0x84, 0x2d, 0x53, 0x9c, 0xc1, 0x79, 0x0d, 0x1c, 0xfc, 0x82, 0x79, 0xdb, 0x33,
-	0x89, 0xfa, 0x3b, 0x05, 0x90, 0x86, 0x3d, 0xd7, 0xa7, 0xd9, 0x9d, 0x56, 0x21, 0x6f, 0xe3, 0x63,
-	0x7a, 0x89, 0xcb, 0x9c, 0x87, 0xee, 0xc1, 0x8a, 0x6f, 0x9d, 0x9c, 0xd2, 0x20, 0x23, 0xd2, 0x42,
-	0x82, 0xa9, 0x76, 0x60, 0x3d, 0x61, 0x4c, 0xa6, 0x1b, 0xeb, 0xf7, 0x0a, 0x6c, 0xb4, 0xc9, 0xd9,
-	0xae, 0x41, 0x27, 0xa7, 0x3f, 0xf8, 0x4a, 0xb2, 0x6b, 0x8c, 0x30, 0x25, 0xba, 0x78, 0xee, 0x2f,
-	0xf3, 0xe7, 0x3e, 0x70, 0x52, 0x87, 0xb7, 0x22, 0x06, 0x50, 0xe4, 0x56, 0xf4, 0xf6, 0xe6, 0x97,
-	0x4c, 0x79, 0xfd, 0x92, 0xe5, 0xe6, 0x96, 0xec, 0x18, 0x36, 0x53, 0xee, 0x65, 0xca, 0x9f, 0xdb,
-	0xb0, 0x2c, 0xf1, 0xd9, 0xc3, 0x40, 0x3c, 0xd6, 0x84, 0xa1, 0x1a, 0xe3, 0xa8, 0x1e, 0x3b, 0x4a,
-	0xd8, 0x62, 0xbc, 0x61, 0x24, 0xaf, 0x5c, 0x0d, 0xb2, 0xaa, 0x65, 0x5e, 0x63, 0xa6, 0x1c, 0xf8,
-	0x05, 0x54, 0xe3, 0x97, 0x00, 0xab, 0x25, 0x44, 0x8d, 0x1c, 0xb5, 0x5f, 0x44, 0xec, 0x6b, 0x9c,
-	0x1c, 0xf5, 0x8a, 0xee, 0xc2, 0x2a, 0xab, 0x8c, 0x23, 0x31, 0xb1, 0xab, 0xaa, 0xd8, 0x31, 0x43,
-	0x21, 0xf5, 0x23, 0x00, 0x0d, 0x4f, 0x5c, 0xdf, 0x1c, 0x1a, 0x96, 0x8f, 0x1a, 0xb0, 0xcc, 0x0a,
-	0x69, 0x51, 0x15, 0xb1, 0x9f, 0xac, 0xe8, 0xbe, 0x30, 0xec, 0x29, 0x0e, 0x26, 0x8b, 0x81, 0xfa,
-	0xbf, 0x3c, 0x40, 0xf4, 0x1a, 0x4e, 0xbc, 0xd8, 0x95, 0xc4, 0x8b, 0x1d, 0xb5, 0xa0, 0x34, 0x31,
-	0x3c, 0x63, 0xc2, 0x4a, 0x9e, 0xa0, 0xa6, 0x92, 0x63, 0x74, 0x03, 0xca, 0xc6, 0x85, 0x61, 0xd9,
-	0xc6, 0x91, 0x8d, 0x79, 0xb6, 0xe5, 0xb5, 0x88, 0x80, 0xde, 0x0a, 0x8f, 0x49, 0x91, 0x8e, 0x79,
-	0x9e, 0x8e, 0xc1, 0x89, 0xc8, 0xf3, 0x11, 0xbd, 0x0b, 0x88, 0x04, 0x77, 0x32, 0x71, 0x0c, 0x2f,
-	0x10, 0x5c, 0xe1, 0x82, 0x8d, 0x80, 0x33, 0x72, 0x0c, 0x4f, 0x48, 0x3f, 0x84, 0x0d, 0x1f, 0x4f,
-	0xb0, 0x75, 0x91, 0x92, 0x2f, 0x70, 0x79, 0x14, 0xf2, 0xa2, 0x19, 0x37, 0x01, 0xa2, 0x50, 0x37,
-	0x8b, 0x5c, 0xae, 0x1c, 0x46, 0x19, 0xed, 0xc0, 0xba, 0xe1, 0x79, 0xf6, 0x2c, 0x85, 0x57, 0xe2,
-	0x72, 0x6b, 0x92, 0x15, 0xc1, 0x6d, 0x43, 0xd1, 0x22, 0xfa, 0xd1, 0x94, 0xcc, 0x9a, 0x65, 0xfe,
-	0x62, 0x2e, 0x58, 0x64, 0x77, 0x4a, 0x66, 0xec, 0xba, 0x98, 0x12, 0x6c, 0xc6, 0x2b, 0x84, 0x12,
-	0x23, 0xcc, 0x95, 0x06, 0xf5, 0x2b, 0x94, 0x06, 0xef, 0x03, 0x4c, 0xbc, 0xa9, 0x3e, 0x25, 0xc6,
-	0x09, 0x26, 0xcd, 0x06, 0x4f, 0xd3, 0x86, 0xcc, 0x30, 0xb9, 0xd2, 0x5a, 0x79, 0xe2, 0x4d, 0x0f,
-	0xb9, 0x08, 0xfa, 0x08, 0x56, 0x7d, 0x6c, 0x98, 0xba, 0xe5, 0xea, 0xbe, 0x41, 0x31, 0x69, 0xae,
-	0x5d, 0x32, 0xa7, 0xc2, 0xc4, 0x7a, 0xae, 0xc6, 0x84, 0xd0, 0xc7, 0x50, 0x7b, 0xe1, 0x5b, 0x14,
-	0x47, 0xd3, 0xd0, 0x25, 0xd3, 0xaa, 0x5c, 0x4e, 0xce, 0xfb, 0x10, 0xaa, 0xae, 0xa7, 0xdb, 0x06,
-	0xc5, 0xce, 0xc4, 0xc2, 0xa4, 0xb9, 0x7e, 0x99, 0x32, 0xd7, 0x3b, 0x90, 0x42, 0xaa, 0x0d, 0x9b,
-	0x3c, 0xdd, 0xde, 0xb4, 0x20, 0x0c, 0xba, 0x3a, 0xb9, 0x57, 0x77, 0x75, 0x9e, 0xc0, 0x56, 0x5a,
-	0x5b, 0xa6, 0x9d, 0xfb, 0x67, 0x05, 0x36, 0x46, 0x13, 0x83, 0xb2, 0xe7, 0x4c, 0xf6, 0x86, 0xc4,
-	0xab, 0x1e, 0xdd, 0xb1, 0xa3, 0x7d, 0xf9, 0x8a, 0x35, 0x6e, 0xfe, 0xf2, 0x1a, 0x57, 0xed, 0xc2,
-	0x66, 0xca, 0xde, 0xac, 0x2d, 0xd4, 0x7d, 0x4c, 0xf7, 0x3b, 0x23, 0xe3, 0x18, 0x0f, 0x5d, 0xcb,
-	0xc9, 0xb4, 0x5a, 0x2a, 0x86, 0xad, 0x34, 0x4a, 0xa6, 0xcb, 0x81, 0x6d, 0x62, 0xe3, 0x18, 0xeb,
-	0x1e, 0xc3, 0x08, 0x02, 0x58, 0x26, 0x12, 0x54, 0x3d, 0x86, 0xe6, 0xa1, 0x67, 0x1a, 0x14, 0xbf,
-	0xa1, 0xbd, 0xaf, 0xd3, 0xe3, 0xc2, 0xb5, 0x05, 0x7a, 0x32, 0x79, 0x74, 0x0f, 0x6a, 0xec, 0x5e,
-	0x9d, 0xd3, 0xc6, 0x6e, 0xdb, 0x10, 0x5b, 0xfd, 0x92, 0xbf, 0x03, 0x07, 0x1e, 0xf6, 0x0d, 0xea,
-	0xfa, 0xdf, 0x7f, 0xbf, 0xe7, 0xaf, 0x0a, 0xef, 0x2a, 0x46, 0x0a, 0x32, 0xf9, 0xf2, 0xca, 0xec,
-	0x46, 0x90, 0x37, 0x31, 0x99, 0xf0, 0xdc, 0xae, 0x6a, 0xfc, 0x37, 0x83, 0x67, 0xbb, 0x74, 0x4a,
-	0x78, 0x26, 0xd7, 0x24, 0xbc, 0x34, 0x63, 0xc4, 0x79, 0x5a, 0x20, 0xc3, 0x10, 0xce, 0x2c, 0xc7,
-	0xe4, 0x77, 0x42, 0x55, 0xe3, 0xbf, 0xdf, 0xf9, 0x56, 0x81, 0x72, 0xf8, 0x3d, 0x07, 0x15, 0x20,
-	0x37, 0x78, 0xd6, 0x58, 0x42, 0x15, 0x28, 0x1e, 0xf6, 0x9f, 0xf5, 0x07, 0x3f, 0xef, 0x37, 0x14,
-	0xb4, 0x01, 0x8d, 0xfe, 0x60, 0xac, 0xef, 0x0e, 0x06, 0xe3, 0xd1, 0x58, 0x6b, 0x0f, 0x87, 0xdd,
-	0xbd, 0x46, 0x0e, 0xad, 0x43, 0x7d, 0x34, 0x1e, 0x68, 0x5d, 0x7d, 0x3c, 0x78, 0xbe, 0x3b, 0x1a,
-	0x0f, 0xfa, 0xdd, 0xc6, 0x32, 0x6a, 0xc2, 0x46, 0xfb, 0x40, 0xeb, 0xb6, 0xf7, 0xbe, 0x48, 0x8a,
-	0xe7, 0x19, 0xa7, 0xd7, 0xef, 0x0c, 0x9e, 0x0f, 0xdb, 0xe3, 0xde, 0xee, 0x41, 0x57, 0xff, 0xbc,
-	0xab, 0x8d, 0x7a, 0x83, 0x7e, 0x63, 0x85, 0xc1, 0x6b, 0xdd, 0xfd, 0xde, 0xa0, 0xaf, 0x33, 0x2d,
-	0x4f, 0x06, 0x87, 0xfd, 0xbd, 0x46, 0xe1, 0x9d, 0x21, 0xd4, 0x92, 0x5e, 0x30, 0x9b, 0x46, 0x87,
-	0x9d, 0x4e, 0x77, 0x34, 0x12, 0x06, 0x8e, 0x7b, 0xcf, 0xbb, 0x83, 0xc3, 0x71, 0x43, 0x41, 0x00,
-	0x85, 0x4e, 0xbb, 0xdf, 0xe9, 0x1e, 0x34, 0x72, 0x8c, 0xa1, 0x75, 0x87, 0x07, 0xed, 0x0e, 0x33,
-	0x87, 0x0d, 0x0e, 0xfb, 0xfd, 0x5e, 0x7f, 0xbf, 0x91, 0x7f, 0xf4, 0xdf, 0x0a, 0xe4, 0x86, 0x7b,
-	0xa8, 0x0d, 0x10, 0x75, 0x04, 0xd0, 0xb6, 0x08, 0xd8, 0x5c, 0x9b, 0xa1, 0xd5, 0x9c, 0x67, 0x88,
-	0x25, 0x53, 0x97, 0xd0, 0x43, 0x58, 0x1e, 0x13, 0x17, 0x05, 0x47, 0x66, 0xf4, 0x81, 0xab, 0xb5,
-	0x16, 0xa3, 0x48, 0xe9, 0x07, 0xca, 0x43, 0x05, 0xfd, 0x0c, 0xca, 0xe1, 0xf7, 0x0f, 0xb4, 0x25,
-	0xa4, 0xd2, 0x5f, 0x80, 0x5a, 0xdb, 0x73, 0xf4, 0x50, 0xe3, 0x73, 0xa8, 0x25, 0xbf, 0xa0, 0xa0,
-	0xeb, 0x42, 0x78, 0xe1, 0xd7, 0x99, 0xd6, 0x8d, 0xc5, 0xcc, 0x10, 0xee, 0x31, 0x14, 0x83, 0xaf,
-	0x1c, 0x28, 0xc8, 0x98, 0xe4, 0x37, 0x93, 0xd6, 0x66, 0x8a, 0x1a, 0xce, 0xfc, 0x29, 0x94, 0xe4,
-	0x27, 0x07, 0xb4, 0x19, 0x86, 0x28, 0xde, 0xf3, 0x6f, 0x6d, 0xa5, 0xc9, 0xf1, 0xc9, 0xb2, 0x77,
-	0x2f, 0x27, 0xa7, 0x3e, 0x18, 0xc8, 0xc9, 0xe9, 0x16, 0xbf, 0xba, 0x84, 0xf6, 0xa1, 0x1a, 0x6f,
-	0x9c, 0xa3, 0x6b, 0xa1, 0x9a, 0x74, 0x2b, 0xbf, 0xd5, 0x5a, 0xc4, 0x8a, 0xc7, 0x32, 0x79, 0xa1,
-	0xc9, 0x58, 0x2e, 0xbc, 0x54, 0x65, 0x2c, 0x17, 0xdf, 0x81, 0xea, 0x12, 0x1a, 0x43, 0x3d, 0xf5,
-	0x30, 0x47, 0x37, 0xe4, 0x26, 0x5f, 0xd4, 0xb6, 0x69, 0xdd, 0xbc, 0x84, 0x9b, 0x4e, 0x98, 0xb0,
-	0x43, 0x8d, 0xa2, 0x88, 0x26, 0x6e, 0xce, 0xd6, 0xf6, 0x1c, 0x3d, 0xb4, 0x6a, 0x17, 0x56, 0xf7,
-	0x31, 0x1d, 0xfa, 0xf8, 0x22, 0x3b, 0xc6, 0x13, 0x8e, 0x11, 0x75, 0xc9, 0x51, 0x2b, 0x25, 0x1b,
-	0x6b, 0x9d, 0xbf, 0x0a, 0x67, 0x0f, 0x2a, 0xb1, 0xd6, 0x33, 0x0a, 0x76, 0xd6, 0x7c, 0x77, 0xbc,
-	0x75, 0x6d, 0x01, 0x27, 0x44, 0xf9, 0x0c, 0x56, 0x13, 0xaf, 0x23, 0x69, 0xcd, 0xa2, 0x17, 0x61,
-	0xeb, 0xfa, 0x42, 0x5e, 0x88, 0x35, 0xe2, 0xdf, 0x45, 0x12, 0x5d, 0x54, 0x74, 0x33, 0x74, 0x60,
-	0x51, 0x43, 0xb7, 0x75, 0xeb, 0x32, 0x76, 0x1c, 0x34, 0xdd, 0x9a, 0x95, 0xa0, 0x97, 0x74, 0x89,
-	0x25, 0xe8, 0x65, 0x1d, 0x5d, 0xe1, 0x75, 0xa2, 0x08, 0x91, 0x5e, 0x2f, 0xaa, 0xa4, 0xa4, 0xd7,
-	0x0b, 0xab, 0x16, 0x91, 0xf8, 0xc9, 0x1a, 0x42, 0x26, 0xfe, 0xc2, 0xfa, 0x44, 0x26, 0xfe, 0xe2,
-	0xb2, 0x43, 0x5d, 0x42, 0x9f, 0xc3, 0xda, 0xdc, 0x1d, 0x8e, 0x02, 0x8f, 0x2e, 0x2b, 0x22, 0x5a,
-	0xb7, 0x2f, 0xe5, 0xc7, 0xd3, 0x25, 0x76, 0x93, 0xa2, 0xe8, 0x20, 0x4e, 0xdd, 0xde, 0xad, 0x6b,
-	0x0b, 0x38, 0x12, 0x65, 0xb7, 0xf1, 0x8f, 0x97, 0xb7, 0x94, 0x7f, 0xbe, 0xbc, 0xa5, 0xfc, 0xeb,
-	0xe5, 0x2d, 0xe5, 0x8f, 0xff, 0xbe, 0xb5, 0x74, 0x54, 0xe0, 0x7f, 0xaf, 0xf8, 0xf0, 0xff, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa5, 0x2f, 0x87, 0x03, 0xa5, 0x21, 0x00, 0x00,
+func init() { proto.RegisterFile("pdpb.proto", fileDescriptor_pdpb_8dc000caa63611fa) }
+
+var fileDescriptor_pdpb_8dc000caa63611fa = []byte{
+	// 2751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4b, 0x6f, 0x23, 0xc7,
+	0xd1, 0x3b, 0x24, 0xc5, 0x47, 0xf1, 0x21, 0xaa, 0xa5, 0x95, 0x46, 0xb3, 0x2f, 0x79, 0xbc, 0x9f,
+	0xbf, 0xb5, 0x63, 0xcb, 0xf6, 0xda, 0x30, 0x0c, 0x04, 0x0e, 0x4c, 0x49, 0x5c, 0x2d, 0xbd, 0xbb,
+	0x24, 0x31, 0xa4, 0x1c, 0x18, 0x08, 0xcc, 0x8c, 0x66, 0x5a, 0xd2, 0x44, 0xd4, 0xcc, 0x78, 0x7a,
+	0xb8, 0x6b, 0x1a, 0x39, 0xe4, 0x94, 0x1c, 0xf2, 0xb8, 0x39, 0xc8, 0x31, 0xbf, 0x20, 0xb7, 0xe4,
+	0x10, 0x20, 0x08, 0x90, 0x53, 0x8e, 0xf9, 0x07, 0x09, 0x9c, 0x73, 0xfe, 0x43, 0xd0, 0x8f, 0x79,
+	0x72, 0xa8, 0x55, 0x46, 0xf6, 0x6d, 0xba, 0xaa, 0xba, 0xba, 0x5e, 0xdd, 0x55, 0x5d, 0x3d, 0x00,
+	0xae, 0xe9, 0x1e, 0xef, 0xba, 0x9e, 0xe3, 0x3b, 0xa8, 0x44, 0xbf, 0x95, 0xc6, 0x05, 0xf6, 0xf5,
+	0x00, 0xa6, 0x34, 0xb1, 0xa7, 0x9f, 0xf8, 0xe1, 0x70, 0xe3, 0xd4, 0x39, 0x75, 0xd8, 0xe7, 0xdb,
+	0xf4, 0x8b, 0x43, 0xd5, 0x5d, 0x68, 0x6a, 0xf8, 0x8b, 0x19, 0x26, 0xfe, 0x63, 0xac, 0x9b, 0xd8,
+	0x43, 0x77, 0x00, 0x8c, 0xe9, 0x8c, 0xf8, 0xd8, 0x9b, 0x58, 0xa6, 0x2c, 0xed, 0x48, 0x0f, 0x4a,
+	0x5a, 0x4d, 0x40, 0x7a, 0xa6, 0xaa, 0x41, 0x4b, 0xc3, 0xc4, 0x75, 0x6c, 0x82, 0xaf, 0x34, 0x01,
+	0xbd, 0x02, 0x2b, 0xd8, 0xf3, 0x1c, 0x4f, 0x2e, 0xec, 0x48, 0x0f, 0xea, 0x0f, 0xeb, 0xbb, 0x4c,
+	0xea, 0x2e, 0x05, 0x69, 0x1c, 0xa3, 0x3e, 0x82, 0x15, 0x36, 0x46, 0xaf, 0x42, 0xc9, 0x9f, 0xbb,
+	0x98, 0x31, 0x69, 0x3d, 0x5c, 0x8d, 0x91, 0x8e, 0xe7, 0x2e, 0xd6, 0x18, 0x12, 0xc9, 0x50, 0xb9,
+	0xc0, 0x84, 0xe8, 0xa7, 0x98, 0xb1, 0xac, 0x69, 0xc1, 0x50, 0x1d, 0x00, 0x8c, 0x89, 0x23, 0xd4,
+	0x41, 0xdf, 0x83, 0xf2, 0x19, 0x93, 0x90, 0xb1, 0xab, 0x3f, 0x5c, 0xe7, 0xec, 0x12, 0xda, 0x6a,
+	0x82, 0x04, 0x6d, 0xc0, 0x8a, 0xe1, 0xcc, 0x6c, 0x9f, 0xb1, 0x6c, 0x6a, 0x7c, 0xa0, 0x76, 0xa0,
+	0x36, 0xb6, 0x2e, 0x30, 0xf1, 0xf5, 0x0b, 0x17, 0x29, 0x50, 0x75, 0xcf, 0xe6, 0xc4, 0x32, 0xf4,
+	0x29, 0xe3, 0x58, 0xd4, 0xc2, 0x31, 0x95, 0x69, 0xea, 0x9c, 0x32, 0x54, 0x81, 0xa1, 0x82, 0xa1,
+	0xfa, 0x33, 0x09, 0xea, 0x4c, 0x28, 0x6e, 0x33, 0xf4, 0x66, 0x4a, 0xaa, 0x8d, 0x40, 0xaa, 0xb8,
+	0x4d, 0x2f, 0x17, 0x0b, 0xbd, 0x05, 0x35, 0x3f, 0x10, 0x4b, 0x2e, 0x32, 0x36, 0xc2, 0x56, 0xa1,
+	0xb4, 0x5a, 0x44, 0xa1, 0x9a, 0xd0, 0xde, 0x73, 0x1c, 0x9f, 0xf8, 0x9e, 0xee, 0xe6, 0x32, 0xce,
+	0xab, 0xb0, 0x42, 0x7c, 0xc7, 0xc3, 0xc2, 0x85, 0xcd, 0x5d, 0x11, 0x66, 0x23, 0x0a, 0xd4, 0x38,
+	0x4e, 0xed, 0xc0, 0x5a, 0x6c, 0x95, 0x3c, 0xda, 0xaa, 0x07, 0x70, 0xb3, 0x47, 0x42, 0x26, 0x2e,
+	0x36, 0xf3, 0x48, 0xab, 0xfe, 0x04, 0x36, 0xd3, 0x5c, 0x72, 0xd9, 0x5e, 0x85, 0xc6, 0x71, 0x8c,
+	0x0b, 0x53, 0xbe, 0xaa, 0x25, 0x60, 0xea, 0x47, 0xd0, 0xea, 0x4c, 0xa7, 0x8e, 0xd1, 0x3b, 0xc8,
+	0x25, 0xea, 0x00, 0x56, 0xc3, 0xe9, 0xb9, 0x64, 0x6c, 0x41, 0xc1, 0xe2, 0x92, 0x95, 0xb4, 0x82,
+	0x65, 0xaa, 0x9f, 0xc1, 0xea, 0x21, 0xf6, 0xb9, 0x5f, 0xf2, 0x78, 0x7a, 0x1b, 0xaa, 0xcc, 0x9b,
+	0x93, 0x90, 0x6b, 0x85, 0x8d, 0x7b, 0xa6, 0xfa, 0x6b, 0x09, 0xda, 0x11, 0xef, 0x5c, 0xd2, 0x5e,
+	0x25, 0x8e, 0xd0, 0x6b, 0x94, 0x48, 0xf7, 0x89, 0x08, 0xec, 0x36, 0xe7, 0xc8, 0x48, 0x46, 0x14,
+	0xae, 0x71, 0xb4, 0x6a, 0xc0, 0xea, 0x70, 0x76, 0x0d, 0x55, 0xaf, 0x14, 0xd4, 0x1f, 0x43, 0x3b,
+	0x5a, 0x24, 0x57, 0x4c, 0xff, 0x14, 0xd6, 0x0f, 0xb1, 0xdf, 0x99, 0x4e, 0x19, 0x13, 0x92, 0x4b,
+	0xd4, 0x0f, 0x41, 0xc6, 0x5f, 0x1a, 0xd3, 0x99, 0x89, 0x27, 0xbe, 0x73, 0x71, 0x4c, 0x7c, 0xc7,
+	0xc6, 0x13, 0x26, 0x20, 0x11, 0x51, 0xb9, 0x29, 0xf0, 0xe3, 0x00, 0xcd, 0x57, 0x53, 0xcf, 0x61,
+	0x23, 0xb9, 0x7a, 0x2e, 0xbf, 0xfd, 0x1f, 0x94, 0xc3, 0xd5, 0x8a, 0x8b, 0xb6, 0x12, 0x48, 0xf5,
+	0x73, 0x16, 0x20, 0x1a, 0x3e, 0xb5, 0x1c, 0x3b, 0x97, 0x9e, 0x77, 0x00, 0x3c, 0x36, 0x7b, 0x72,
+	0x8e, 0xe7, 0x4c, 0xb3, 0x86, 0x56, 0xe3, 0x90, 0x27, 0x78, 0xae, 0xfe, 0x51, 0x82, 0xb5, 0xd8,
+	0x02, 0xb9, 0x54, 0x79, 0x0d, 0xca, 0x9c, 0xa1, 0x70, 0x7b, 0x2b, 0x50, 0x45, 0x70, 0x15, 0x58,
+	0x74, 0x1f, 0xca, 0x53, 0xce, 0x95, 0x87, 0x61, 0x23, 0xa0, 0x1b, 0x62, 0xca, 0x8d, 0xe3, 0x28,
+	0x15, 0x99, 0xea, 0xcf, 0x31, 0x91, 0x4b, 0x3b, 0xc5, 0x45, 0x2a, 0x8e, 0x53, 0x7f, 0xcc, 0x9c,
+	0xc0, 0x17, 0xd8, 0x9b, 0xe7, 0x3b, 0x2a, 0xd0, 0x2d, 0x10, 0x96, 0x88, 0xb6, 0x66, 0x95, 0x03,
+	0xf8, 0xde, 0x44, 0x23, 0x43, 0xb7, 0xf9, 0x1a, 0x24, 0xef, 0x02, 0xc4, 0xd7, 0x3d, 0x3f, 0x66,
+	0xfb, 0x2a, 0x03, 0x3c, 0xc1, 0x73, 0x9a, 0x87, 0xa6, 0xd6, 0x85, 0xe5, 0x33, 0x6b, 0xac, 0x68,
+	0x7c, 0x80, 0xb6, 0xa0, 0x82, 0x6d, 0x93, 0x4d, 0x28, 0xb1, 0x09, 0x65, 0x6c, 0x9b, 0xd4, 0x53,
+	0x5f, 0x4b, 0xb0, 0x9e, 0x90, 0x27, 0x97, 0xaf, 0x1e, 0x40, 0x85, 0x6b, 0x18, 0xc4, 0x5d, 0xda,
+	0x59, 0x01, 0x1a, 0xbd, 0x06, 0x15, 0xee, 0x11, 0x7a, 0x6a, 0x2c, 0x3a, 0x22, 0x40, 0xaa, 0x8f,
+	0x60, 0xeb, 0x10, 0xfb, 0xfb, 0xbc, 0x36, 0xd9, 0x77, 0xec, 0x13, 0xeb, 0x34, 0xd7, 0xb9, 0x4d,
+	0x40, 0x5e, 0xe4, 0x93, 0x4b, 0xc7, 0xd7, 0xa1, 0x22, 0x4a, 0x25, 0x11, 0x90, 0xab, 0x81, 0xe4,
+	0x82, 0xbb, 0x16, 0xe0, 0xd5, 0x2f, 0x60, 0x6b, 0x38, 0xbb, 0xbe, 0xf0, 0xff, 0xcb, 0x92, 0x8f,
+	0x41, 0x5e, 0x5c, 0x32, 0xd7, 0x31, 0xf8, 0x7b, 0x09, 0xca, 0xcf, 0xf0, 0xc5, 0x31, 0xf6, 0x10,
+	0x82, 0x92, 0xad, 0x5f, 0xf0, 0x22, 0xaf, 0xa6, 0xb1, 0x6f, 0x1a, 0x7c, 0x17, 0x0c, 0x1b, 0x8b,
+	0x6e, 0x0e, 0xe8, 0x99, 0x14, 0xe9, 0x62, 0xec, 0x4d, 0x66, 0xde, 0x94, 0xfb, 0xb7, 0xa6, 0x55,
+	0x29, 0xe0, 0xc8, 0x9b, 0x12, 0x74, 0x0f, 0xea, 0xc6, 0xd4, 0xc2, 0xb6, 0xcf, 0xd1, 0x25, 0x86,
+	0x06, 0x0e, 0x62, 0x04, 0xff, 0x0f, 0xab, 0xdc, 0xfd, 0x13, 0xd7, 0xb3, 0x1c, 0xcf, 0xf2, 0xe7,
+	0xf2, 0x0a, 0x0b, 0xe2, 0x16, 0x07, 0x0f, 0x05, 0x54, 0xfd, 0x98, 0x9d, 0x2e, 0x5c, 0xc8, 0x5c,
+	0x5b, 0x48, 0xfd, 0xab, 0x04, 0x28, 0xce, 0x22, 0xe7, 0x09, 0x55, 0xe1, 0x9a, 0x07, 0x51, 0xdf,
+	0xe0, 0xe4, 0x9c, 0xab, 0x16, 0x20, 0x33, 0x4e, 0xa8, 0x38, 0x99, 0xc0, 0xa1, 0xb7, 0xa0, 0x8e,
+	0x7d, 0xc3, 0x9c, 0x08, 0xd2, 0x52, 0x06, 0x29, 0x50, 0x82, 0xa7, 0x5c, 0x83, 0x21, 0xd4, 0xe8,
+	0x8e, 0x61, 0x89, 0x16, 0xed, 0x40, 0xc9, 0xc5, 0xa1, 0xd4, 0xc9, 0x2d, 0xc5, 0x30, 0xe8, 0x15,
+	0x68, 0x98, 0xce, 0x0b, 0x7b, 0x42, 0xb0, 0xe1, 0xd8, 0x26, 0x11, 0x9e, 0xab, 0x53, 0xd8, 0x88,
+	0x83, 0xd4, 0xdf, 0x16, 0x61, 0x93, 0x6f, 0xd7, 0xc7, 0x58, 0xf7, 0xfc, 0x63, 0xac, 0xfb, 0xb9,
+	0xa2, 0xf6, 0xdb, 0x3d, 0xb8, 0x77, 0x01, 0x98, 0xe0, 0x54, 0x8b, 0xe0, 0xf0, 0x16, 0x25, 0x74,
+	0xa8, 0xbf, 0x56, 0xa3, 0x24, 0x74, 0x48, 0xd0, 0xbb, 0xd0, 0x74, 0xb1, 0x6d, 0x5a, 0xf6, 0xa9,
+	0x98, 0xb2, 0x92, 0x71, 0xcc, 0x34, 0x04, 0x09, 0x9f, 0xf2, 0x3a, 0xb4, 0x75, 0xd7, 0xf5, 0x9c,
+	0x2f, 0xad, 0x0b, 0xdd, 0xc7, 0x13, 0x62, 0x7d, 0x85, 0x65, 0x60, 0xf6, 0x59, 0x8d, 0xc1, 0x47,
+	0xd6, 0x57, 0x18, 0xed, 0x42, 0xd5, 0xb2, 0x7d, 0xec, 0x3d, 0xd7, 0xa7, 0x72, 0x83, 0x49, 0x8d,
+	0xa2, 0x72, 0xbe, 0x27, 0x30, 0x5a, 0x48, 0x93, 0x66, 0x7d, 0x8e, 0xe7, 0x44, 0x6e, 0x2e, 0xb0,
+	0x7e, 0x82, 0xe7, 0x84, 0x6e, 0x36, 0x1f, 0x7b, 0x17, 0x72, 0x8b, 0xa1, 0xd9, 0xf7, 0x27, 0xa5,
+	0x6a, 0xbd, 0xdd, 0x50, 0xcf, 0x00, 0xf6, 0xcf, 0x74, 0xfb, 0x14, 0x53, 0x71, 0xaf, 0xe0, 0xeb,
+	0x0f, 0xa1, 0x6e, 0x30, 0xfa, 0x09, 0xbb, 0xa2, 0x15, 0xd8, 0x15, 0x6d, 0x6b, 0x37, 0xb8, 0x63,
+	0xd2, 0xd3, 0x81, 0xf3, 0x63, 0x57, 0x35, 0x30, 0xc2, 0x6f, 0xf5, 0x21, 0xb4, 0xc6, 0x9e, 0x6e,
+	0x93, 0x13, 0xec, 0xf1, 0x30, 0x7b, 0xf9, 0x6a, 0xea, 0x5f, 0x0a, 0xb0, 0xb5, 0x10, 0x36, 0xb9,
+	0xf6, 0xd3, 0xbb, 0xa1, 0xdc, 0x6c, 0xc9, 0x42, 0xbc, 0xaa, 0x8c, 0x0c, 0x10, 0x08, 0x4c, 0xbf,
+	0xd1, 0x47, 0xb0, 0xea, 0x0b, 0x81, 0x27, 0x89, 0x60, 0x12, 0x2b, 0x25, 0xb5, 0xd1, 0x5a, 0x7e,
+	0x52, 0xbb, 0x44, 0xaa, 0x2e, 0x25, 0x53, 0x35, 0xfa, 0x00, 0x1a, 0x02, 0x89, 0x5d, 0xc7, 0x38,
+	0x93, 0x57, 0x44, 0xe8, 0x27, 0xa2, 0xb9, 0x4b, 0x51, 0x5a, 0xdd, 0x8b, 0x06, 0x74, 0x23, 0xfb,
+	0xba, 0x77, 0x8a, 0x7d, 0xae, 0x46, 0x39, 0xc3, 0x72, 0xc0, 0x09, 0xe8, 0xb7, 0x7a, 0x02, 0xab,
+	0x1d, 0x72, 0x3e, 0x72, 0xa7, 0xd6, 0x77, 0xba, 0xdd, 0xd4, 0x9f, 0x4b, 0xd0, 0x8e, 0x16, 0xca,
+	0x79, 0xcf, 0x6a, 0xda, 0xf8, 0xc5, 0x24, 0x5d, 0xdd, 0xd4, 0x6d, 0xfc, 0x42, 0x0b, 0xac, 0xb6,
+	0x03, 0x0d, 0x4a, 0xc3, 0xd2, 0x80, 0x65, 0xf2, 0x2c, 0x50, 0xd2, 0xc0, 0xc6, 0x2f, 0xa8, 0xb6,
+	0x3d, 0x93, 0xa8, 0xbf, 0x94, 0x00, 0x69, 0xd8, 0x75, 0x3c, 0x3f, 0xbf, 0xd2, 0x2a, 0x94, 0xa6,
+	0xf8, 0xc4, 0x5f, 0xa2, 0x32, 0xc3, 0xa1, 0xfb, 0xb0, 0xe2, 0x59, 0xa7, 0x67, 0xbe, 0x5c, 0xcc,
+	0x24, 0xe2, 0x48, 0x75, 0x1f, 0xd6, 0x13, 0xc2, 0xe4, 0xca, 0x99, 0xbf, 0x92, 0x60, 0xa3, 0x43,
+	0xce, 0xf7, 0x74, 0xdf, 0x38, 0xfb, 0xce, 0x3d, 0x49, 0x13, 0x29, 0xa1, 0x8b, 0x4c, 0x78, 0xc3,
+	0xa1, 0xc8, 0x1a, 0x0e, 0xc0, 0x40, 0xfb, 0x14, 0xa2, 0x0e, 0xa0, 0xc2, 0xa4, 0xe8, 0x1d, 0x2c,
+	0xba, 0x4c, 0x7a, 0xb9, 0xcb, 0x0a, 0x0b, 0x2e, 0x3b, 0x81, 0x9b, 0x29, 0xf5, 0x72, 0xc5, 0xcf,
+	0x3d, 0x28, 0x5a, 0x66, 0x74, 0x35, 0xe1, 0xd7, 0x45, 0x2e, 0xa8, 0x46, 0x31, 0xaa, 0x0b, 0x5b,
+	0xdc, 0x19, 0xd7, 0xb4, 0xe4, 0x95, 0xeb, 0x51, 0x5a, 0x37, 0x2d, 0xae, 0x98, 0x2b, 0x06, 0x7e,
+	0x04, 0x8d, 0x78, 0x12, 0xa0, 0xd5, 0x0c, 0xaf, 0xd2, 0xa3, 0x06, 0x10, 0xb7, 0x7d, 0x8b, 0x81,
+	0xa3, 0x6e, 0xd5, 0xab, 0xd0, 0xa4, 0xb5, 0x79, 0x44, 0xc6, 0x77, 0x55, 0x03, 0xdb, 0x66, 0x48,
+	0xa4, 0xbe, 0x0f, 0xa0, 0x61, 0xc3, 0xf1, 0xcc, 0xa1, 0x6e, 0x79, 0xa8, 0x0d, 0x45, 0x5a, 0xca,
+	0xf3, 0xba, 0xac, 0x78, 0xce, 0xcb, 0xfe, 0xe7, 0xfa, 0x74, 0x86, 0xc5, 0x64, 0x3e, 0x50, 0xff,
+	0x53, 0x02, 0x88, 0xee, 0xe3, 0x89, 0x9e, 0x81, 0x94, 0xe8, 0x19, 0xd0, 0x96, 0x99, 0xa1, 0xbb,
+	0xba, 0x41, 0x8b, 0x2e, 0x51, 0xd5, 0x05, 0x63, 0x74, 0x1b, 0x6a, 0xfa, 0x73, 0xdd, 0x9a, 0xea,
+	0xc7, 0x53, 0xcc, 0xa2, 0xad, 0xa4, 0x45, 0x00, 0x5a, 0x59, 0x88, 0xe8, 0xe2, 0xe1, 0x58, 0x62,
+	0xe1, 0x28, 0x4e, 0x44, 0x16, 0x8f, 0xe8, 0x4d, 0x40, 0x44, 0xe4, 0x64, 0x62, 0xeb, 0xae, 0x20,
+	0x5c, 0x61, 0x84, 0x6d, 0x81, 0x19, 0xd9, 0xba, 0xcb, 0xa9, 0xdf, 0x81, 0x0d, 0x0f, 0x1b, 0xd8,
+	0x7a, 0x9e, 0xa2, 0x2f, 0x33, 0x7a, 0x14, 0xe2, 0xa2, 0x19, 0x77, 0x00, 0x22, 0x53, 0xcb, 0x15,
+	0x46, 0x57, 0x0b, 0xad, 0x8c, 0x76, 0x61, 0x5d, 0x77, 0xdd, 0xe9, 0x3c, 0xc5, 0xaf, 0xca, 0xe8,
+	0xd6, 0x02, 0x54, 0xc4, 0x6e, 0x0b, 0x2a, 0x16, 0x99, 0x1c, 0xcf, 0xc8, 0x5c, 0xae, 0xb1, 0x3b,
+	0x7b, 0xd9, 0x22, 0x7b, 0x33, 0x32, 0xa7, 0xe9, 0x62, 0x46, 0xb0, 0x19, 0xaf, 0x10, 0xaa, 0x14,
+	0xb0, 0x50, 0x1a, 0xac, 0x5e, 0xa1, 0x34, 0x78, 0x1b, 0xc0, 0x70, 0x67, 0x93, 0x19, 0xed, 0x87,
+	0x12, 0xb9, 0xbd, 0x53, 0x8c, 0x92, 0x5d, 0xe4, 0x69, 0xad, 0x66, 0xb8, 0xb3, 0x23, 0x46, 0x82,
+	0xde, 0x87, 0xa6, 0x87, 0x75, 0x73, 0x62, 0x39, 0x13, 0x4f, 0xf7, 0x31, 0x91, 0xd7, 0x96, 0xcc,
+	0xa9, 0x53, 0xb2, 0x9e, 0xa3, 0x51, 0x22, 0xf4, 0x01, 0xb4, 0x5e, 0x78, 0x96, 0x8f, 0xa3, 0x69,
+	0x68, 0xc9, 0xb4, 0x06, 0xa3, 0x0b, 0xe6, 0xbd, 0x07, 0x0d, 0xc7, 0x9d, 0x4c, 0x75, 0x1f, 0xdb,
+	0x86, 0x85, 0x89, 0xbc, 0xbe, 0x6c, 0x31, 0xc7, 0x7d, 0x1a, 0x10, 0xa9, 0x53, 0xb8, 0xc9, 0xc2,
+	0xed, 0xba, 0x05, 0xa4, 0xe8, 0x2b, 0x15, 0x2e, 0xef, 0x2b, 0x3d, 0x82, 0xcd, 0xf4, 0x6a, 0xb9,
+	0x76, 0xee, 0x1f, 0x24, 0xd8, 0x18, 0x19, 0xba, 0xef, 0x63, 0xef, 0x1a, 0x2d, 0x91, 0xcb, 0xae,
+	0xfd, 0xb1, 0xa3, 0xbd, 0x78, 0xc5, 0x9a, 0xb8, 0xb4, 0xbc, 0x26, 0x56, 0xbb, 0x70, 0x33, 0x25,
+	0x6f, 0xde, 0x26, 0xee, 0x21, 0xf6, 0x0f, 0xf7, 0x47, 0xfa, 0x09, 0x1e, 0x3a, 0x96, 0x9d, 0xcb,
+	0x5b, 0x2a, 0x86, 0xcd, 0x34, 0x97, 0x5c, 0xc9, 0x81, 0x6e, 0x62, 0xfd, 0x04, 0x4f, 0x5c, 0xca,
+	0x43, 0x18, 0xb0, 0x46, 0x02, 0xa6, 0xea, 0x09, 0xc8, 0x47, 0xae, 0xa9, 0xfb, 0xf8, 0x9a, 0xf2,
+	0xbe, 0x6c, 0x1d, 0x07, 0xb6, 0x33, 0xd6, 0xc9, 0xa5, 0xd1, 0x7d, 0x68, 0xd1, 0xbc, 0xba, 0xb0,
+	0x1a, 0xcd, 0xb6, 0x21, 0x6f, 0xf5, 0x73, 0x76, 0x13, 0x1d, 0xb8, 0xd8, 0xd3, 0x7d, 0xc7, 0xfb,
+	0xf6, 0x3b, 0x4e, 0x7f, 0x92, 0x60, 0x3d, 0xb1, 0x40, 0x2e, 0x5d, 0x2e, 0x8d, 0x6e, 0x04, 0x25,
+	0x13, 0x13, 0x83, 0xc5, 0x76, 0x43, 0x63, 0xdf, 0x94, 0x3d, 0xdd, 0xa5, 0x33, 0xc2, 0x22, 0xb9,
+	0x15, 0xb0, 0x0f, 0xc4, 0x18, 0x31, 0x9c, 0x26, 0x68, 0x28, 0x87, 0x73, 0xcb, 0x36, 0x59, 0x4e,
+	0x68, 0x68, 0xec, 0x5b, 0xfd, 0xb3, 0x04, 0xdb, 0x31, 0xc1, 0x1f, 0x5b, 0x34, 0x57, 0xcd, 0xbf,
+	0xfd, 0xbd, 0x19, 0xcf, 0x8a, 0xc5, 0x64, 0x56, 0x4c, 0x26, 0x96, 0x12, 0x7b, 0x2f, 0x8a, 0x25,
+	0x96, 0x6d, 0xa8, 0x06, 0x99, 0x9b, 0x49, 0x5e, 0xd4, 0x2a, 0x22, 0x69, 0xab, 0x3d, 0x40, 0x91,
+	0xaa, 0xd8, 0xe5, 0x07, 0x26, 0x55, 0x93, 0xf8, 0xd8, 0x0d, 0x1a, 0x2a, 0xf4, 0x9b, 0x56, 0x73,
+	0x27, 0x96, 0x6d, 0x91, 0x33, 0xce, 0x87, 0x3f, 0x4a, 0x01, 0x07, 0x31, 0x56, 0xff, 0x2c, 0x40,
+	0x2b, 0xf2, 0x1e, 0xe3, 0x93, 0xd0, 0x47, 0x5a, 0xe2, 0x0d, 0xfe, 0xe4, 0xc6, 0xbe, 0x69, 0x79,
+	0x70, 0xec, 0x59, 0xf8, 0x84, 0x29, 0x58, 0xd3, 0xf8, 0x20, 0xb4, 0x7a, 0x89, 0x53, 0xd2, 0xef,
+	0x98, 0xdf, 0x56, 0xae, 0xe0, 0xb7, 0x4d, 0x7a, 0xae, 0xe9, 0xc4, 0xb1, 0x59, 0x76, 0xae, 0x69,
+	0x62, 0xc4, 0x5b, 0x94, 0xdc, 0xa6, 0x44, 0xae, 0xb0, 0x7a, 0xb2, 0x2a, 0x8c, 0x4a, 0xd0, 0x2e,
+	0x3d, 0xdf, 0xb1, 0x4b, 0xe4, 0x2a, 0xcb, 0x29, 0x72, 0x7a, 0x85, 0xc0, 0x5c, 0x1a, 0x27, 0x63,
+	0x8d, 0x23, 0x0f, 0xd3, 0xfb, 0x33, 0xb3, 0x50, 0x8d, 0x5b, 0x88, 0x83, 0x98, 0x1f, 0x92, 0x6e,
+	0x82, 0xb4, 0x9b, 0x52, 0x16, 0xae, 0x2f, 0x58, 0xf8, 0x2b, 0x50, 0xb2, 0x02, 0x2d, 0xd7, 0x46,
+	0xd9, 0xa5, 0xa5, 0x27, 0x95, 0x3e, 0x28, 0x3d, 0x53, 0x06, 0x14, 0xaa, 0x05, 0x44, 0xea, 0x2f,
+	0x24, 0x58, 0x1b, 0xcd, 0x6d, 0xe3, 0x1a, 0x99, 0xe7, 0x3e, 0x94, 0x79, 0xab, 0x49, 0x2e, 0x64,
+	0x34, 0x8d, 0x04, 0x8e, 0xdd, 0x1a, 0x98, 0x91, 0x2c, 0xdb, 0xc4, 0x5f, 0x8a, 0x48, 0xe7, 0x76,
+	0xeb, 0x51, 0x88, 0xfa, 0x37, 0xda, 0x9a, 0x8e, 0x49, 0xf2, 0x1d, 0x77, 0x82, 0x5f, 0x26, 0x0f,
+	0x7a, 0x0f, 0x5a, 0x22, 0xc8, 0x83, 0x8e, 0x71, 0x56, 0xeb, 0xbe, 0xc9, 0x69, 0xf8, 0x85, 0x9e,
+	0xbc, 0xf1, 0xb5, 0x04, 0xb5, 0xf0, 0x19, 0x1a, 0x95, 0xa1, 0x30, 0x78, 0xd2, 0xbe, 0x81, 0xea,
+	0x50, 0x39, 0xea, 0x3f, 0xe9, 0x0f, 0x7e, 0xd8, 0x6f, 0x4b, 0x68, 0x03, 0xda, 0xfd, 0xc1, 0x78,
+	0xb2, 0x37, 0x18, 0x8c, 0x47, 0x63, 0xad, 0x33, 0x1c, 0x76, 0x0f, 0xda, 0x05, 0xb4, 0x0e, 0xab,
+	0xa3, 0xf1, 0x40, 0xeb, 0x4e, 0xc6, 0x83, 0x67, 0x7b, 0xa3, 0xf1, 0xa0, 0xdf, 0x6d, 0x17, 0x91,
+	0x0c, 0x1b, 0x9d, 0xa7, 0x5a, 0xb7, 0x73, 0xf0, 0x59, 0x92, 0xbc, 0x44, 0x31, 0xbd, 0xfe, 0xfe,
+	0xe0, 0xd9, 0xb0, 0x33, 0xee, 0xed, 0x3d, 0xed, 0x4e, 0x3e, 0xed, 0x6a, 0xa3, 0xde, 0xa0, 0xdf,
+	0x5e, 0xa1, 0xec, 0xb5, 0xee, 0x61, 0x6f, 0xd0, 0x9f, 0xd0, 0x55, 0x1e, 0x0d, 0x8e, 0xfa, 0x07,
+	0xed, 0xf2, 0x1b, 0x43, 0x68, 0x25, 0xb7, 0x10, 0x95, 0x69, 0x74, 0xb4, 0xbf, 0xdf, 0x1d, 0x8d,
+	0xb8, 0x80, 0xe3, 0xde, 0xb3, 0xee, 0xe0, 0x68, 0xdc, 0x96, 0x10, 0x40, 0x79, 0xbf, 0xd3, 0xdf,
+	0xef, 0x3e, 0x6d, 0x17, 0x28, 0x42, 0xeb, 0x0e, 0x9f, 0x76, 0xf6, 0xa9, 0x38, 0x74, 0x70, 0xd4,
+	0xef, 0xf7, 0xfa, 0x87, 0xed, 0xd2, 0xc3, 0xdf, 0x34, 0xa1, 0x30, 0x3c, 0x40, 0x1d, 0x80, 0xa8,
+	0x91, 0x89, 0xb6, 0xb8, 0x73, 0x16, 0xba, 0xa3, 0x8a, 0xbc, 0x88, 0xe0, 0xfe, 0x53, 0x6f, 0xa0,
+	0x77, 0xa0, 0x38, 0x26, 0x0e, 0x12, 0x75, 0x56, 0xf4, 0x2e, 0xaf, 0xac, 0xc5, 0x20, 0x01, 0xf5,
+	0x03, 0xe9, 0x1d, 0x09, 0xfd, 0x00, 0x6a, 0xe1, 0xb3, 0x2d, 0xda, 0xe4, 0x54, 0xe9, 0x87, 0x6b,
+	0x65, 0x6b, 0x01, 0x1e, 0xae, 0xf8, 0x0c, 0x5a, 0xc9, 0x87, 0x5f, 0x74, 0x8b, 0x13, 0x67, 0x3e,
+	0x2a, 0x2b, 0xb7, 0xb3, 0x91, 0x21, 0xbb, 0x0f, 0xa1, 0x22, 0x1e, 0x67, 0x91, 0x88, 0xce, 0xe4,
+	0x53, 0xaf, 0x72, 0x33, 0x05, 0x0d, 0x67, 0x7e, 0x1f, 0xaa, 0xc1, 0x4b, 0x29, 0xba, 0x19, 0x9a,
+	0x28, 0xfe, 0x54, 0xa9, 0x6c, 0xa6, 0xc1, 0xf1, 0xc9, 0xc3, 0x59, 0x72, 0xf2, 0x70, 0x96, 0x39,
+	0x39, 0xfd, 0x32, 0xa9, 0xde, 0x40, 0x87, 0xd0, 0x88, 0xbf, 0xf7, 0xa1, 0xed, 0x70, 0x99, 0xf4,
+	0x0b, 0xa4, 0xa2, 0x64, 0xa1, 0xe2, 0xb6, 0x4c, 0x56, 0xc1, 0x81, 0x2d, 0x33, 0x2b, 0x71, 0xe5,
+	0x76, 0x36, 0x32, 0x64, 0x37, 0x86, 0xd5, 0x54, 0x37, 0x0f, 0xdd, 0x0e, 0x76, 0x7c, 0x56, 0x6f,
+	0x58, 0xb9, 0xb3, 0x04, 0x9b, 0x0e, 0x98, 0xf0, 0x61, 0x0d, 0x45, 0x16, 0x4d, 0x1c, 0x7a, 0xca,
+	0xd6, 0x02, 0x3c, 0x94, 0x6a, 0x0f, 0x9a, 0x87, 0xd8, 0x1f, 0x7a, 0xf8, 0x79, 0x7e, 0x1e, 0x8f,
+	0xa0, 0x19, 0x82, 0xe9, 0xe3, 0x1e, 0x52, 0x52, 0xb4, 0xb1, 0x17, 0xbf, 0xcb, 0xf8, 0x1c, 0x40,
+	0x3d, 0xf6, 0x62, 0x86, 0xc4, 0xce, 0x5a, 0x7c, 0xd4, 0x53, 0xb6, 0x33, 0x30, 0x21, 0x97, 0x4f,
+	0xa0, 0x99, 0x68, 0xa9, 0x04, 0xd2, 0x64, 0xb5, 0x91, 0x94, 0x5b, 0x99, 0xb8, 0x90, 0xd7, 0x88,
+	0x3d, 0xe7, 0x26, 0x1e, 0x7f, 0xd0, 0x9d, 0x50, 0x81, 0xac, 0x77, 0x28, 0xe5, 0xee, 0x32, 0x74,
+	0x9c, 0xe9, 0x70, 0x96, 0xcd, 0x74, 0x38, 0xbb, 0x94, 0xe9, 0xb2, 0x87, 0x28, 0xae, 0x75, 0xe2,
+	0xe6, 0x12, 0x68, 0x9d, 0x75, 0xfd, 0x52, 0x6e, 0x65, 0xe2, 0xe2, 0x81, 0x9f, 0xbc, 0x78, 0x04,
+	0x81, 0x9f, 0x79, 0xa9, 0x51, 0x6e, 0x67, 0x23, 0x43, 0x76, 0x9f, 0xc2, 0xda, 0x42, 0xe1, 0x8f,
+	0x84, 0x46, 0xcb, 0x6e, 0x1e, 0xca, 0xbd, 0xa5, 0xf8, 0x78, 0xb8, 0xc4, 0x8a, 0x0b, 0x14, 0x1d,
+	0xc4, 0xa9, 0x92, 0x5f, 0xd9, 0xce, 0xc0, 0x84, 0x5c, 0x3e, 0x03, 0xb4, 0x58, 0xa2, 0xa0, 0x7b,
+	0x0b, 0x53, 0x92, 0x55, 0xb2, 0xb2, 0xb3, 0x9c, 0x20, 0xb6, 0x2f, 0xea, 0x51, 0xda, 0x0f, 0x53,
+	0xc8, 0x42, 0x4d, 0xa2, 0xc8, 0x8b, 0x88, 0xf8, 0x1e, 0xdf, 0x6b, 0xff, 0xfd, 0x9b, 0xbb, 0xd2,
+	0x3f, 0xbe, 0xb9, 0x2b, 0xfd, 0xeb, 0x9b, 0xbb, 0xd2, 0xef, 0xfe, 0x7d, 0xf7, 0xc6, 0x71, 0x99,
+	0xfd, 0xb8, 0xf6, 0xde, 0x7f, 0x07, 0x00, 0x58, 0x0f, 0xb0, 0x87, 0xff, 0x26, 0x00, 0x00,
 }
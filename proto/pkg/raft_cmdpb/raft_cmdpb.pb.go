@@ -11,8 +11,11 @@ import (
 	proto "github.com/golang/protobuf/proto"
 
 	eraftpb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+
 	errorpb "github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 
+	import_sstpb "github.com/pingcap-incubator/tinykv/proto/pkg/import_sstpb"
+
 	metapb "github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
@@ -30,11 +33,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type CmdType int32
 
 const (
-	CmdType_Invalid CmdType = 0
-	CmdType_Get     CmdType = 1
-	CmdType_Put     CmdType = 3
-	CmdType_Delete  CmdType = 4
-	CmdType_Snap    CmdType = 5
+	CmdType_Invalid     CmdType = 0
+	CmdType_Get         CmdType = 1
+	CmdType_Put         CmdType = 3
+	CmdType_Delete      CmdType = 4
+	CmdType_Snap        CmdType = 5
+	CmdType_DeleteRange CmdType = 7
 )
 
 var CmdType_name = map[int32]string{
@@ -43,30 +47,34 @@ var CmdType_name = map[int32]string{
 	3: "Put",
 	4: "Delete",
 	5: "Snap",
+	7: "DeleteRange",
 }
 var CmdType_value = map[string]int32{
-	"Invalid": 0,
-	"Get":     1,
-	"Put":     3,
-	"Delete":  4,
-	"Snap":    5,
+	"Invalid":     0,
+	"Get":         1,
+	"Put":         3,
+	"Delete":      4,
+	"Snap":        5,
+	"DeleteRange": 7,
 }
 
 func (x CmdType) String() string {
 	return proto.EnumName(CmdType_name, int32(x))
 }
 func (CmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{0}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{0}
 }
 
 type AdminCmdType int32
 
 const (
-	AdminCmdType_InvalidAdmin   AdminCmdType = 0
-	AdminCmdType_ChangePeer     AdminCmdType = 1
-	AdminCmdType_CompactLog     AdminCmdType = 3
-	AdminCmdType_TransferLeader AdminCmdType = 4
-	AdminCmdType_BatchSplit     AdminCmdType = 10
+	AdminCmdType_InvalidAdmin     AdminCmdType = 0
+	AdminCmdType_ChangePeer       AdminCmdType = 1
+	AdminCmdType_CompactLog       AdminCmdType = 3
+	AdminCmdType_TransferLeader   AdminCmdType = 4
+	AdminCmdType_BatchSplit       AdminCmdType = 10
+	AdminCmdType_UpdateResolvedTs AdminCmdType = 11
+	AdminCmdType_IngestSST        AdminCmdType = 12
 )
 
 var AdminCmdType_name = map[int32]string{
@@ -75,20 +83,24 @@ var AdminCmdType_name = map[int32]string{
 	3:  "CompactLog",
 	4:  "TransferLeader",
 	10: "BatchSplit",
+	11: "UpdateResolvedTs",
+	12: "IngestSST",
 }
 var AdminCmdType_value = map[string]int32{
-	"InvalidAdmin":   0,
-	"ChangePeer":     1,
-	"CompactLog":     3,
-	"TransferLeader": 4,
-	"BatchSplit":     10,
+	"InvalidAdmin":     0,
+	"ChangePeer":       1,
+	"CompactLog":       3,
+	"TransferLeader":   4,
+	"BatchSplit":       10,
+	"UpdateResolvedTs": 11,
+	"IngestSST":        12,
 }
 
 func (x AdminCmdType) String() string {
 	return proto.EnumName(AdminCmdType_name, int32(x))
 }
 func (AdminCmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{1}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{1}
 }
 
 type GetRequest struct {
@@ -103,7 +115,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{0}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +169,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{1}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{1}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +218,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{2}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{2}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +278,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{3}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{3}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +319,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{4}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{4}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +372,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{5}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{5}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +401,117 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+// Delete all the keys of cf in [start_key, end_key). An empty end_key means the end
+// of the region.
+type DeleteRangeRequest struct {
+	Cf                   string   `protobuf:"bytes,1,opt,name=cf,proto3" json:"cf,omitempty"`
+	StartKey             []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey               []byte   `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
+func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeRequest) ProtoMessage()    {}
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{6}
+}
+func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeRequest.Merge(dst, src)
+}
+func (m *DeleteRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRangeRequest proto.InternalMessageInfo
+
+func (m *DeleteRangeRequest) GetCf() string {
+	if m != nil {
+		return m.Cf
+	}
+	return ""
+}
+
+func (m *DeleteRangeRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+type DeleteRangeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
+func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeResponse) ProtoMessage()    {}
+func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{7}
+}
+func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeResponse.Merge(dst, src)
+}
+func (m *DeleteRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRangeResponse proto.InternalMessageInfo
+
 type SnapRequest struct {
+	// If it is not 0, the snapshot is taken from the local data of the peer without Raft, which requires the resolved
+	// ts of the peer not less than it. The peer is not required to be the leader.
+	StaleReadTs uint64 `protobuf:"varint,1,opt,name=stale_read_ts,json=staleReadTs,proto3" json:"stale_read_ts,omitempty"`
+	// If it is true, the snapshot is taken from the local data of a follower once it applies the read index from the
+	// leader, so it sees every write finished before the request.
+	ReplicaRead          bool     `protobuf:"varint,2,opt,name=replica_read,json=replicaRead,proto3" json:"replica_read,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -399,7 +521,7 @@ func (m *SnapRequest) Reset()         { *m = SnapRequest{} }
 func (m *SnapRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapRequest) ProtoMessage()    {}
 func (*SnapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{6}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{8}
 }
 func (m *SnapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,6 +550,20 @@ func (m *SnapRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SnapRequest proto.InternalMessageInfo
 
+func (m *SnapRequest) GetStaleReadTs() uint64 {
+	if m != nil {
+		return m.StaleReadTs
+	}
+	return 0
+}
+
+func (m *SnapRequest) GetReplicaRead() bool {
+	if m != nil {
+		return m.ReplicaRead
+	}
+	return false
+}
+
 type SnapResponse struct {
 	Region               *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -439,7 +575,7 @@ func (m *SnapResponse) Reset()         { *m = SnapResponse{} }
 func (m *SnapResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapResponse) ProtoMessage()    {}
 func (*SnapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{7}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{9}
 }
 func (m *SnapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,21 +612,22 @@ func (m *SnapResponse) GetRegion() *metapb.Region {
 }
 
 type Request struct {
-	CmdType              CmdType        `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
-	Get                  *GetRequest    `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put                  *PutRequest    `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
-	Delete               *DeleteRequest `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
-	Snap                 *SnapRequest   `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	CmdType              CmdType             `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
+	Get                  *GetRequest         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put                  *PutRequest         `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
+	Delete               *DeleteRequest      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	Snap                 *SnapRequest        `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
+	DeleteRange          *DeleteRangeRequest `protobuf:"bytes,7,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{8}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{10}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,22 +691,30 @@ func (m *Request) GetSnap() *SnapRequest {
 	return nil
 }
 
+func (m *Request) GetDeleteRange() *DeleteRangeRequest {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 type Response struct {
-	CmdType              CmdType         `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
-	Get                  *GetResponse    `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put                  *PutResponse    `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
-	Delete               *DeleteResponse `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
-	Snap                 *SnapResponse   `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	CmdType              CmdType              `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
+	Get                  *GetResponse         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put                  *PutResponse         `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
+	Delete               *DeleteResponse      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	Snap                 *SnapResponse        `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
+	DeleteRange          *DeleteRangeResponse `protobuf:"bytes,7,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{9}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{11}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,6 +778,13 @@ func (m *Response) GetSnap() *SnapResponse {
 	return nil
 }
 
+func (m *Response) GetDeleteRange() *DeleteRangeResponse {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 type ChangePeerRequest struct {
 	// This can be only called in internal RaftStore now.
 	ChangeType           eraftpb.ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
@@ -646,7 +798,7 @@ func (m *ChangePeerRequest) Reset()         { *m = ChangePeerRequest{} }
 func (m *ChangePeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerRequest) ProtoMessage()    {}
 func (*ChangePeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{10}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{12}
 }
 func (m *ChangePeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +852,7 @@ func (m *ChangePeerResponse) Reset()         { *m = ChangePeerResponse{} }
 func (m *ChangePeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerResponse) ProtoMessage()    {}
 func (*ChangePeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{11}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{13}
 }
 func (m *ChangePeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +907,7 @@ func (m *SplitRequest) Reset()         { *m = SplitRequest{} }
 func (m *SplitRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRequest) ProtoMessage()    {}
 func (*SplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{12}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{14}
 }
 func (m *SplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +968,7 @@ func (m *BatchSplitRequest) Reset()         { *m = BatchSplitRequest{} }
 func (m *BatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitRequest) ProtoMessage()    {}
 func (*BatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{13}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{15}
 }
 func (m *BatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1015,7 @@ func (m *BatchSplitResponse) Reset()         { *m = BatchSplitResponse{} }
 func (m *BatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitResponse) ProtoMessage()    {}
 func (*BatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{14}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{16}
 }
 func (m *BatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1063,7 @@ func (m *CompactLogRequest) Reset()         { *m = CompactLogRequest{} }
 func (m *CompactLogRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactLogRequest) ProtoMessage()    {}
 func (*CompactLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{15}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{17}
 }
 func (m *CompactLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,7 +1116,7 @@ func (m *CompactLogResponse) Reset()         { *m = CompactLogResponse{} }
 func (m *CompactLogResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactLogResponse) ProtoMessage()    {}
 func (*CompactLogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{16}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{18}
 }
 func (m *CompactLogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1156,7 @@ func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
 func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderRequest) ProtoMessage()    {}
 func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{17}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{19}
 }
 func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1202,7 @@ func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{}
 func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderResponse) ProtoMessage()    {}
 func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{18}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{20}
 }
 func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,22 +1231,199 @@ func (m *TransferLeaderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferLeaderResponse proto.InternalMessageInfo
 
+// UpdateResolvedTsRequest advances the resolved ts of the peers of the region once they apply it. No transaction
+// commits in the region at a ts not greater than resolved_ts after the request in the log.
+type UpdateResolvedTsRequest struct {
+	ResolvedTs           uint64   `protobuf:"varint,1,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateResolvedTsRequest) Reset()         { *m = UpdateResolvedTsRequest{} }
+func (m *UpdateResolvedTsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateResolvedTsRequest) ProtoMessage()    {}
+func (*UpdateResolvedTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{21}
+}
+func (m *UpdateResolvedTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateResolvedTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateResolvedTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UpdateResolvedTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResolvedTsRequest.Merge(dst, src)
+}
+func (m *UpdateResolvedTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateResolvedTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResolvedTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResolvedTsRequest proto.InternalMessageInfo
+
+func (m *UpdateResolvedTsRequest) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
+type UpdateResolvedTsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateResolvedTsResponse) Reset()         { *m = UpdateResolvedTsResponse{} }
+func (m *UpdateResolvedTsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateResolvedTsResponse) ProtoMessage()    {}
+func (*UpdateResolvedTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{22}
+}
+func (m *UpdateResolvedTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateResolvedTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateResolvedTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UpdateResolvedTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResolvedTsResponse.Merge(dst, src)
+}
+func (m *UpdateResolvedTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateResolvedTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResolvedTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResolvedTsResponse proto.InternalMessageInfo
+
+// IngestSSTRequest ingests the uploaded file into the kv engine of each peer of the region once they apply it.
+type IngestSSTRequest struct {
+	Sst                  *import_sstpb.SSTMeta `protobuf:"bytes,1,opt,name=sst" json:"sst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *IngestSSTRequest) Reset()         { *m = IngestSSTRequest{} }
+func (m *IngestSSTRequest) String() string { return proto.CompactTextString(m) }
+func (*IngestSSTRequest) ProtoMessage()    {}
+func (*IngestSSTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{23}
+}
+func (m *IngestSSTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestSSTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestSSTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *IngestSSTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestSSTRequest.Merge(dst, src)
+}
+func (m *IngestSSTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestSSTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestSSTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestSSTRequest proto.InternalMessageInfo
+
+func (m *IngestSSTRequest) GetSst() *import_sstpb.SSTMeta {
+	if m != nil {
+		return m.Sst
+	}
+	return nil
+}
+
+type IngestSSTResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IngestSSTResponse) Reset()         { *m = IngestSSTResponse{} }
+func (m *IngestSSTResponse) String() string { return proto.CompactTextString(m) }
+func (*IngestSSTResponse) ProtoMessage()    {}
+func (*IngestSSTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{24}
+}
+func (m *IngestSSTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestSSTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestSSTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *IngestSSTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestSSTResponse.Merge(dst, src)
+}
+func (m *IngestSSTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestSSTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestSSTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestSSTResponse proto.InternalMessageInfo
+
 type AdminRequest struct {
-	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Splits               *BatchSplitRequest     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	CmdType              AdminCmdType             `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerRequest       `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogRequest       `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderRequest   `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	Splits               *BatchSplitRequest       `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
+	UpdateResolvedTs     *UpdateResolvedTsRequest `protobuf:"bytes,11,opt,name=update_resolved_ts,json=updateResolvedTs" json:"update_resolved_ts,omitempty"`
+	IngestSst            *IngestSSTRequest        `protobuf:"bytes,12,opt,name=ingest_sst,json=ingestSst" json:"ingest_sst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
 func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminRequest) ProtoMessage()    {}
 func (*AdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{19}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{25}
 }
 func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,22 +1487,38 @@ func (m *AdminRequest) GetSplits() *BatchSplitRequest {
 	return nil
 }
 
+func (m *AdminRequest) GetUpdateResolvedTs() *UpdateResolvedTsRequest {
+	if m != nil {
+		return m.UpdateResolvedTs
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetIngestSst() *IngestSSTRequest {
+	if m != nil {
+		return m.IngestSst
+	}
+	return nil
+}
+
 type AdminResponse struct {
-	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Splits               *BatchSplitResponse     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CmdType              AdminCmdType              `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerResponse       `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogResponse       `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderResponse   `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	Splits               *BatchSplitResponse       `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
+	UpdateResolvedTs     *UpdateResolvedTsResponse `protobuf:"bytes,11,opt,name=update_resolved_ts,json=updateResolvedTs" json:"update_resolved_ts,omitempty"`
+	IngestSst            *IngestSSTResponse        `protobuf:"bytes,12,opt,name=ingest_sst,json=ingestSst" json:"ingest_sst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
 func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminResponse) ProtoMessage()    {}
 func (*AdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{20}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{26}
 }
 func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,6 +1582,20 @@ func (m *AdminResponse) GetSplits() *BatchSplitResponse {
 	return nil
 }
 
+func (m *AdminResponse) GetUpdateResolvedTs() *UpdateResolvedTsResponse {
+	if m != nil {
+		return m.UpdateResolvedTs
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetIngestSst() *IngestSSTResponse {
+	if m != nil {
+		return m.IngestSst
+	}
+	return nil
+}
+
 type RaftRequestHeader struct {
 	RegionId uint64       `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
 	Peer     *metapb.Peer `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
@@ -1253,7 +1612,7 @@ func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
 func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
 func (*RaftRequestHeader) ProtoMessage()    {}
 func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{21}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{27}
 }
 func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,7 +1689,7 @@ func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
 func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*RaftResponseHeader) ProtoMessage()    {}
 func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{22}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{28}
 }
 func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1754,7 @@ func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
 func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
 func (*RaftCmdRequest) ProtoMessage()    {}
 func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{23}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{29}
 }
 func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1458,7 +1817,7 @@ func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
 func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
 func (*RaftCmdResponse) ProtoMessage()    {}
 func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_d523fa45fdeafa92, []int{24}
+	return fileDescriptor_raft_cmdpb_0cb0edea6adee224, []int{30}
 }
 func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1515,6 +1874,8 @@ func init() {
 	proto.RegisterType((*PutResponse)(nil), "raft_cmdpb.PutResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "raft_cmdpb.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "raft_cmdpb.DeleteResponse")
+	proto.RegisterType((*DeleteRangeRequest)(nil), "raft_cmdpb.DeleteRangeRequest")
+	proto.RegisterType((*DeleteRangeResponse)(nil), "raft_cmdpb.DeleteRangeResponse")
 	proto.RegisterType((*SnapRequest)(nil), "raft_cmdpb.SnapRequest")
 	proto.RegisterType((*SnapResponse)(nil), "raft_cmdpb.SnapResponse")
 	proto.RegisterType((*Request)(nil), "raft_cmdpb.Request")
@@ -1528,6 +1889,10 @@ func init() {
 	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
 	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
 	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
+	proto.RegisterType((*UpdateResolvedTsRequest)(nil), "raft_cmdpb.UpdateResolvedTsRequest")
+	proto.RegisterType((*UpdateResolvedTsResponse)(nil), "raft_cmdpb.UpdateResolvedTsResponse")
+	proto.RegisterType((*IngestSSTRequest)(nil), "raft_cmdpb.IngestSSTRequest")
+	proto.RegisterType((*IngestSSTResponse)(nil), "raft_cmdpb.IngestSSTResponse")
 	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
 	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
 	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
@@ -1711,7 +2076,7 @@ func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1721,18 +2086,36 @@ func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1742,28 +2125,18 @@ func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Region != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n1, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1773,18 +2146,85 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
+	if m.StaleReadTs != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.StaleReadTs))
 	}
-	if m.Get != nil {
-		dAtA[i] = 0x12
+	if m.ReplicaRead {
+		dAtA[i] = 0x10
+		i++
+		if m.ReplicaRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Region != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n1, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+	}
+	if m.Get != nil {
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
 		n2, err := m.Get.MarshalTo(dAtA[i:])
@@ -1823,6 +2263,16 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n5
 	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n6, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1853,41 +2303,51 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
-		n6, err := m.Get.MarshalTo(dAtA[i:])
+		n7, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.Put != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
-		n7, err := m.Put.MarshalTo(dAtA[i:])
+		n8, err := m.Put.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.Delete != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
-		n8, err := m.Delete.MarshalTo(dAtA[i:])
+		n9, err := m.Delete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.Snap != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
-		n9, err := m.Snap.MarshalTo(dAtA[i:])
+		n10, err := m.Snap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
+	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n11, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1919,11 +2379,11 @@ func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n10, err := m.Peer.MarshalTo(dAtA[i:])
+		n12, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1950,11 +2410,11 @@ func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n11, err := m.Region.MarshalTo(dAtA[i:])
+		n13, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1989,21 +2449,21 @@ func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.NewRegionId))
 	}
 	if len(m.NewPeerIds) > 0 {
-		dAtA13 := make([]byte, len(m.NewPeerIds)*10)
-		var j12 int
+		dAtA15 := make([]byte, len(m.NewPeerIds)*10)
+		var j14 int
 		for _, num := range m.NewPeerIds {
 			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j14++
 			}
-			dAtA13[j12] = uint8(num)
-			j12++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j12))
-		i += copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2148,11 +2608,11 @@ func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n14, err := m.Peer.MarshalTo(dAtA[i:])
+		n16, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2181,6 +2641,105 @@ func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *UpdateResolvedTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResolvedTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedTs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpdateResolvedTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResolvedTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *IngestSSTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestSSTRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sst != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Sst.Size()))
+		n17, err := m.Sst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *IngestSSTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestSSTResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2205,41 +2764,61 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n15, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		n18, err := m.ChangePeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.CompactLog != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n16, err := m.CompactLog.MarshalTo(dAtA[i:])
+		n19, err := m.CompactLog.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.TransferLeader != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n17, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		n20, err := m.TransferLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.Splits != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n18, err := m.Splits.MarshalTo(dAtA[i:])
+		n21, err := m.Splits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
+	}
+	if m.UpdateResolvedTs != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.UpdateResolvedTs.Size()))
+		n22, err := m.UpdateResolvedTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.IngestSst != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.IngestSst.Size()))
+		n23, err := m.IngestSst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2271,41 +2850,61 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n19, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		n24, err := m.ChangePeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n24
 	}
 	if m.CompactLog != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n20, err := m.CompactLog.MarshalTo(dAtA[i:])
+		n25, err := m.CompactLog.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n25
 	}
 	if m.TransferLeader != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n21, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		n26, err := m.TransferLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n26
 	}
 	if m.Splits != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n22, err := m.Splits.MarshalTo(dAtA[i:])
+		n27, err := m.Splits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n27
+	}
+	if m.UpdateResolvedTs != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.UpdateResolvedTs.Size()))
+		n28, err := m.UpdateResolvedTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.IngestSst != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.IngestSst.Size()))
+		n29, err := m.IngestSst.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2337,11 +2936,11 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n23, err := m.Peer.MarshalTo(dAtA[i:])
+		n30, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n30
 	}
 	if len(m.Uuid) > 0 {
 		dAtA[i] = 0x1a
@@ -2353,11 +2952,11 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n24, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		n31, err := m.RegionEpoch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	if m.Term != 0 {
 		dAtA[i] = 0x28
@@ -2389,11 +2988,11 @@ func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
-		n25, err := m.Error.MarshalTo(dAtA[i:])
+		n32, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n32
 	}
 	if len(m.Uuid) > 0 {
 		dAtA[i] = 0x12
@@ -2431,11 +3030,11 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
+		n33, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n33
 	}
 	if len(m.Requests) > 0 {
 		for _, msg := range m.Requests {
@@ -2453,11 +3052,11 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
-		n27, err := m.AdminRequest.MarshalTo(dAtA[i:])
+		n34, err := m.AdminRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n34
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2484,11 +3083,11 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
+		n35, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n35
 	}
 	if len(m.Responses) > 0 {
 		for _, msg := range m.Responses {
@@ -2506,11 +3105,11 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
-		n29, err := m.AdminResponse.MarshalTo(dAtA[i:])
+		n36, err := m.AdminResponse.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n36
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2613,9 +3212,45 @@ func (m *DeleteResponse) Size() (n int) {
 	return n
 }
 
+func (m *DeleteRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SnapRequest) Size() (n int) {
 	var l int
 	_ = l
+	if m.StaleReadTs != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.StaleReadTs))
+	}
+	if m.ReplicaRead {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2657,6 +3292,10 @@ func (m *Request) Size() (n int) {
 		l = m.Snap.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2685,6 +3324,10 @@ func (m *Response) Size() (n int) {
 		l = m.Snap.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2819,15 +3462,58 @@ func (m *TransferLeaderResponse) Size() (n int) {
 	return n
 }
 
-func (m *AdminRequest) Size() (n int) {
+func (m *UpdateResolvedTsRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	if m.ResolvedTs != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.ResolvedTs))
 	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateResolvedTsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IngestSSTRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sst != nil {
+		l = m.Sst.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IngestSSTResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
 	if m.CompactLog != nil {
 		l = m.CompactLog.Size()
@@ -2841,6 +3527,14 @@ func (m *AdminRequest) Size() (n int) {
 		l = m.Splits.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.UpdateResolvedTs != nil {
+		l = m.UpdateResolvedTs.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.IngestSst != nil {
+		l = m.IngestSst.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2869,6 +3563,14 @@ func (m *AdminResponse) Size() (n int) {
 		l = m.Splits.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.UpdateResolvedTs != nil {
+		l = m.UpdateResolvedTs.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.IngestSst != nil {
+		l = m.IngestSst.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3529,7 +4231,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3552,12 +4254,103 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3580,7 +4373,7 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3603,45 +4396,12 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3664,7 +4424,7 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3687,17 +4447,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleReadTs", wireType)
 			}
-			m.CmdType = 0
+			m.StaleReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3707,16 +4467,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				m.StaleReadTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaRead", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3726,28 +4486,66 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.ReplicaRead = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3771,16 +4569,152 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetRequest{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3844,6 +4778,39 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4046,6 +5013,39 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4857,7 +5857,7 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateResolvedTsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4880,17 +5880,17 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateResolvedTsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateResolvedTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
 			}
-			m.CmdType = 0
+			m.ResolvedTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4900,51 +5900,307 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= (AdminCmdType(b) & 0x7F) << shift
+				m.ResolvedTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeerRequest{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResolvedTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResolvedTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResolvedTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestSSTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestSSTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestSSTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sst == nil {
+				m.Sst = &import_sstpb.SSTMeta{}
+			}
+			if err := m.Sst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestSSTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestSSTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestSSTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (AdminCmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeerRequest{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
 				}
 				if iNdEx >= l {
@@ -5037,6 +6293,72 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateResolvedTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateResolvedTs == nil {
+				m.UpdateResolvedTs = &UpdateResolvedTsRequest{}
+			}
+			if err := m.UpdateResolvedTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestSst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IngestSst == nil {
+				m.IngestSst = &IngestSSTRequest{}
+			}
+			if err := m.IngestSst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5239,6 +6561,72 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateResolvedTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateResolvedTs == nil {
+				m.UpdateResolvedTs = &UpdateResolvedTsResponse{}
+			}
+			if err := m.UpdateResolvedTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestSst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IngestSst == nil {
+				m.IngestSst = &IngestSSTResponse{}
+			}
+			if err := m.IngestSst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5982,77 +7370,95 @@ var (
 	ErrIntOverflowRaftCmdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_d523fa45fdeafa92) }
-
-var fileDescriptor_raft_cmdpb_d523fa45fdeafa92 = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x8f, 0xdb, 0x54,
-	0x10, 0x5f, 0xc7, 0xde, 0x24, 0x3b, 0x76, 0x52, 0xef, 0xeb, 0xd2, 0x75, 0xb7, 0x22, 0x4a, 0x5d,
-	0x84, 0xb6, 0x05, 0x05, 0x35, 0xa5, 0x2b, 0x90, 0x60, 0x0b, 0x4d, 0xab, 0x12, 0xda, 0xc3, 0xea,
-	0x75, 0x6f, 0x1c, 0x2c, 0xd7, 0x7e, 0xc9, 0x46, 0x24, 0xb6, 0xd7, 0x76, 0xba, 0xec, 0x37, 0xe1,
-	0x73, 0x70, 0xe1, 0xc8, 0x0d, 0x21, 0x71, 0xe1, 0x23, 0xa0, 0xe5, 0xcc, 0x85, 0x4f, 0x80, 0xde,
-	0x3f, 0xfb, 0x39, 0x4e, 0xa0, 0xed, 0x29, 0x6f, 0xe6, 0xcd, 0xfc, 0xfc, 0x9b, 0x9f, 0x67, 0x26,
-	0x06, 0x3b, 0xf5, 0x27, 0xb9, 0x17, 0x2c, 0xc2, 0xe4, 0xd5, 0x20, 0x49, 0xe3, 0x3c, 0x46, 0x50,
-	0x7a, 0x0e, 0xac, 0x05, 0xc9, 0x7d, 0x79, 0x73, 0xd0, 0x21, 0x69, 0x1a, 0xa7, 0xaa, 0xe9, 0x4f,
-	0x72, 0x69, 0xba, 0x03, 0x80, 0x67, 0x24, 0xc7, 0xe4, 0x7c, 0x49, 0xb2, 0x1c, 0x75, 0xa1, 0x11,
-	0x4c, 0x1c, 0xad, 0xaf, 0x1d, 0xee, 0xe0, 0x46, 0x30, 0x41, 0x36, 0xe8, 0xdf, 0x93, 0x4b, 0xa7,
-	0xd1, 0xd7, 0x0e, 0x2d, 0x4c, 0x8f, 0xee, 0x1d, 0x30, 0x59, 0x7c, 0x96, 0xc4, 0x51, 0x46, 0xd0,
-	0x1e, 0x6c, 0xbf, 0xf6, 0xe7, 0x4b, 0xc2, 0x72, 0x2c, 0xcc, 0x0d, 0xf7, 0x09, 0xc0, 0xc9, 0xf2,
-	0xcd, 0x41, 0x4b, 0x14, 0x5d, 0x45, 0xe9, 0x80, 0xc9, 0x50, 0xf8, 0xa3, 0xdc, 0xfb, 0xd0, 0x79,
-	0x42, 0xe6, 0x24, 0x27, 0x6f, 0x4e, 0xd6, 0x86, 0xae, 0x4c, 0x11, 0x20, 0x1d, 0x30, 0x5f, 0x46,
-	0x7e, 0x22, 0x20, 0xdc, 0x23, 0xb0, 0xb8, 0x29, 0xca, 0xf9, 0x10, 0x9a, 0x29, 0x99, 0xce, 0xe2,
-	0x88, 0xc1, 0x9a, 0xc3, 0xee, 0x40, 0x48, 0x89, 0x99, 0x17, 0x8b, 0x5b, 0xf7, 0x6f, 0x0d, 0x5a,
-	0x92, 0xc6, 0x00, 0xda, 0xc1, 0x22, 0xf4, 0xf2, 0xcb, 0x84, 0xab, 0xd0, 0x1d, 0x5e, 0x1f, 0x28,
-	0xaf, 0x67, 0xb4, 0x08, 0x4f, 0x2f, 0x13, 0x82, 0x5b, 0x01, 0x3f, 0xa0, 0x43, 0xd0, 0xa7, 0x24,
-	0x67, 0x34, 0xcd, 0xe1, 0x0d, 0x35, 0xb4, 0x7c, 0x11, 0x98, 0x86, 0xd0, 0xc8, 0x64, 0x99, 0x3b,
-	0x46, 0x3d, 0xb2, 0x54, 0x17, 0xd3, 0x10, 0x74, 0x1f, 0x9a, 0x21, 0x2b, 0xd4, 0xd9, 0x66, 0xc1,
-	0x37, 0xd5, 0xe0, 0x8a, 0x6a, 0x58, 0x04, 0xa2, 0x8f, 0xc0, 0xc8, 0x22, 0x3f, 0x71, 0x9a, 0x2c,
-	0x61, 0x5f, 0x4d, 0x50, 0x14, 0xc2, 0x2c, 0xc8, 0xfd, 0x47, 0x83, 0x76, 0x21, 0xd2, 0xdb, 0x16,
-	0x7c, 0x57, 0x2d, 0x78, 0xbf, 0x56, 0x30, 0x47, 0xe5, 0x15, 0xdf, 0x55, 0x2b, 0xde, 0xaf, 0x55,
-	0x2c, 0x43, 0x69, 0xc9, 0xc3, 0x95, 0x92, 0x0f, 0xd6, 0x95, 0x2c, 0x12, 0x64, 0xcd, 0x1f, 0x57,
-	0x6a, 0x76, 0xea, 0x35, 0x8b, 0x78, 0x5e, 0x74, 0x0c, 0xbb, 0xa3, 0x33, 0x3f, 0x9a, 0x92, 0x13,
-	0x42, 0x52, 0xf9, 0xb6, 0x3f, 0x03, 0x33, 0x60, 0x4e, 0xb5, 0xfe, 0xfd, 0x81, 0x1c, 0xaa, 0x51,
-	0x1c, 0x4d, 0x78, 0x12, 0xd3, 0x00, 0x82, 0xe2, 0x8c, 0xfa, 0x60, 0x24, 0x84, 0xa4, 0x42, 0x07,
-	0x4b, 0x76, 0x16, 0x03, 0x67, 0x37, 0xee, 0x17, 0x80, 0xd4, 0x07, 0xbe, 0x65, 0x4f, 0x9e, 0x83,
-	0xf5, 0x32, 0x99, 0xcf, 0x8a, 0xb1, 0xbb, 0x05, 0x3b, 0x19, 0xb5, 0x3d, 0x3a, 0x14, 0x7c, 0x3c,
-	0xdb, 0xcc, 0xf1, 0x9c, 0x5c, 0x22, 0x17, 0x3a, 0x11, 0xb9, 0xf0, 0x78, 0xaa, 0x37, 0x0b, 0x19,
-	0x2b, 0x03, 0x9b, 0x11, 0xb9, 0xe0, 0xb0, 0xe3, 0x10, 0xf5, 0xc1, 0xa2, 0x31, 0x94, 0x9a, 0x37,
-	0x0b, 0x33, 0x47, 0xef, 0xeb, 0x87, 0x06, 0x86, 0x88, 0x5c, 0x50, 0x7e, 0xe3, 0x30, 0x73, 0xc7,
-	0xb0, 0xfb, 0xd8, 0xcf, 0x83, 0xb3, 0xca, 0x73, 0x3f, 0x85, 0x76, 0xca, 0x8f, 0x99, 0xa3, 0xf5,
-	0xf5, 0x9a, 0xd0, 0x4a, 0x2c, 0x2e, 0x22, 0xdd, 0x63, 0x40, 0x2a, 0x94, 0xa8, 0xfd, 0x10, 0x5a,
-	0x9c, 0xa2, 0x84, 0x5a, 0x2d, 0x5e, 0x5e, 0xbb, 0xdf, 0xc1, 0xee, 0x28, 0x5e, 0x24, 0x7e, 0x90,
-	0xbf, 0x88, 0xa7, 0x92, 0xca, 0x1d, 0xe8, 0x04, 0xdc, 0xe9, 0xcd, 0xa2, 0x90, 0xfc, 0xc0, 0x64,
-	0x30, 0xb0, 0x25, 0x9c, 0x63, 0xea, 0x43, 0xb7, 0x41, 0xda, 0x5e, 0x4e, 0xd2, 0x85, 0x54, 0x42,
-	0xf8, 0x4e, 0x49, 0xba, 0x70, 0xf7, 0x00, 0xa9, 0xe0, 0x62, 0x97, 0x7c, 0x0e, 0xef, 0x9d, 0xa6,
-	0x7e, 0x94, 0x4d, 0x48, 0xfa, 0x82, 0xf8, 0x61, 0xd9, 0x23, 0xf2, 0x4d, 0x6b, 0x1b, 0xdf, 0xb4,
-	0x03, 0x37, 0x56, 0x53, 0x05, 0xe8, 0xaf, 0x0d, 0xb0, 0xbe, 0x0e, 0x17, 0xb3, 0x48, 0x82, 0x3d,
-	0xa8, 0x4d, 0x5b, 0x45, 0x4e, 0x16, 0x5b, 0x1b, 0xb9, 0xe3, 0xa2, 0x4b, 0x95, 0x96, 0x7b, 0xbf,
-	0x32, 0xa5, 0xab, 0x9d, 0x2d, 0x7b, 0x95, 0xba, 0x58, 0xbe, 0xd0, 0x64, 0x1e, 0x4f, 0xc5, 0x3c,
-	0x56, 0xf3, 0x57, 0xc5, 0xc6, 0x10, 0x14, 0x2e, 0xf4, 0x2d, 0x5c, 0xcb, 0x45, 0x7d, 0xde, 0x9c,
-	0x15, 0x28, 0xa6, 0xf4, 0xb6, 0x8a, 0xb1, 0x56, 0x3d, 0xdc, 0xcd, 0x2b, 0x6e, 0xf4, 0x10, 0x9a,
-	0xac, 0x6d, 0x33, 0x07, 0xea, 0x34, 0x6a, 0xed, 0x87, 0x45, 0xb0, 0xfb, 0x7b, 0x03, 0x3a, 0x42,
-	0x48, 0xd1, 0x4c, 0xef, 0xa4, 0xe4, 0xa3, 0x75, 0x4a, 0xf6, 0x36, 0x29, 0x29, 0xf6, 0x87, 0x2a,
-	0xe5, 0xa3, 0x75, 0x52, 0xf6, 0x36, 0x49, 0x59, 0x00, 0x94, 0x5a, 0x3e, 0xdf, 0xa4, 0xa5, 0xfb,
-	0x5f, 0x5a, 0x0a, 0xa0, 0x55, 0x31, 0x8f, 0x56, 0xc4, 0xec, 0x6d, 0x12, 0x53, 0x6e, 0x4e, 0xa1,
-	0xe6, 0x4f, 0x1a, 0xec, 0x62, 0x7f, 0x22, 0x55, 0xfe, 0x86, 0xa3, 0xdd, 0x82, 0x9d, 0x72, 0x83,
-	0xf0, 0xd9, 0x6a, 0xa7, 0xe5, 0xfa, 0xf8, 0x9f, 0x7d, 0x87, 0x10, 0x18, 0xcb, 0xe5, 0x2c, 0x14,
-	0xff, 0xfa, 0xec, 0x8c, 0x8e, 0xc0, 0x12, 0x90, 0x24, 0x89, 0x83, 0x33, 0xa1, 0xd7, 0xf5, 0xea,
-	0xd8, 0x3f, 0xa5, 0x57, 0xd8, 0x4c, 0x4b, 0x83, 0x62, 0xb1, 0xe9, 0xdd, 0x66, 0x2c, 0xd8, 0xd9,
-	0x3d, 0x07, 0xc4, 0x39, 0xf3, 0x62, 0x04, 0xe9, 0x0f, 0x60, 0x9b, 0x7d, 0x11, 0x15, 0xeb, 0x54,
-	0x7e, 0x1f, 0x3d, 0xa5, 0xbf, 0x98, 0x5f, 0x16, 0xdc, 0x1a, 0x0a, 0x37, 0xba, 0x29, 0x96, 0x69,
-	0x4a, 0x22, 0xb1, 0x29, 0x74, 0xb1, 0x29, 0xb8, 0x8f, 0x6d, 0x8a, 0x9f, 0x35, 0xe8, 0xd2, 0x67,
-	0x8e, 0x16, 0xa1, 0x1c, 0xe0, 0x87, 0xd0, 0x3c, 0xe3, 0xaf, 0x4d, 0xab, 0xf7, 0x6f, 0x4d, 0x53,
-	0x2c, 0x82, 0xd1, 0x27, 0xca, 0x1a, 0x6d, 0xb0, 0xdd, 0x57, 0xf9, 0x97, 0xad, 0x6d, 0x50, 0xf4,
-	0x25, 0x74, 0x7c, 0xda, 0xc2, 0x9e, 0xf0, 0x30, 0x7a, 0xe6, 0x9a, 0x1e, 0x97, 0xa9, 0x96, 0xaf,
-	0x58, 0xee, 0x2f, 0x1a, 0x5c, 0x2b, 0x98, 0x8b, 0x89, 0x39, 0x5a, 0xa1, 0xde, 0xab, 0x53, 0x57,
-	0xa5, 0x2d, 0xb8, 0x0f, 0x69, 0x5f, 0xf0, 0x1b, 0x49, 0x7e, 0xaf, 0x4a, 0x5e, 0xb4, 0x57, 0x19,
-	0x86, 0xbe, 0x82, 0xae, 0xa4, 0xcf, 0x5d, 0x82, 0xff, 0xcd, 0x35, 0xfc, 0x45, 0x76, 0xc7, 0x57,
-	0xcd, 0x7b, 0xc7, 0xd0, 0x12, 0xe3, 0x8b, 0x4c, 0x68, 0x8d, 0xa3, 0xd7, 0xfe, 0x7c, 0x16, 0xda,
-	0x5b, 0xa8, 0x05, 0xfa, 0x33, 0x92, 0xdb, 0x1a, 0x3d, 0x9c, 0x2c, 0x73, 0x5b, 0x47, 0x00, 0x4d,
-	0xfe, 0x85, 0x60, 0x1b, 0xa8, 0x0d, 0x06, 0xfd, 0xef, 0xb7, 0xb7, 0xef, 0x85, 0x62, 0xf3, 0x4a,
-	0x10, 0x1b, 0x2c, 0x01, 0xc2, 0xdc, 0xf6, 0x16, 0xea, 0x02, 0x94, 0xd3, 0x6e, 0x6b, 0xcc, 0x2e,
-	0x06, 0xd5, 0xd6, 0x11, 0x82, 0x6e, 0x75, 0x0e, 0x6d, 0x83, 0xc6, 0x94, 0x73, 0x65, 0xc3, 0x63,
-	0xfb, 0xb7, 0xab, 0x9e, 0xf6, 0xc7, 0x55, 0x4f, 0xfb, 0xf3, 0xaa, 0xa7, 0xfd, 0xf8, 0x57, 0x6f,
-	0xeb, 0x55, 0x93, 0x7d, 0x89, 0x3f, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xad, 0x08, 0x0b, 0x3a,
-	0xd5, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_0cb0edea6adee224) }
+
+var fileDescriptor_raft_cmdpb_0cb0edea6adee224 = []byte{
+	// 1377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdf, 0x72, 0xdb, 0xc4,
+	0x17, 0xae, 0x2c, 0xc7, 0x76, 0x8e, 0x64, 0x57, 0xd9, 0xa4, 0x8d, 0x7e, 0xe9, 0xaf, 0x6e, 0xaa,
+	0x76, 0x20, 0x2d, 0x8c, 0x99, 0xa6, 0x34, 0x03, 0xb4, 0xb4, 0xb4, 0x69, 0xa7, 0x84, 0x96, 0x21,
+	0xac, 0xcd, 0x0d, 0x5c, 0x68, 0x54, 0x6b, 0x9d, 0x78, 0xb0, 0x25, 0x55, 0x5a, 0xb7, 0xe4, 0x11,
+	0x78, 0x03, 0x1e, 0x83, 0xe1, 0x02, 0x2e, 0xb9, 0xe5, 0x92, 0x2b, 0xee, 0x98, 0x61, 0xca, 0x8b,
+	0x30, 0xfb, 0x4f, 0x5a, 0x59, 0x76, 0x68, 0xb9, 0xca, 0xee, 0xd9, 0x73, 0xbe, 0x3d, 0x3e, 0xdf,
+	0x39, 0xdf, 0x2a, 0xe0, 0xa4, 0xc1, 0x88, 0xfa, 0xc3, 0x69, 0x98, 0x3c, 0xeb, 0x25, 0x69, 0x4c,
+	0x63, 0x04, 0x85, 0x65, 0xcb, 0x9e, 0x12, 0x1a, 0xa8, 0x93, 0xad, 0x36, 0x49, 0xd3, 0x38, 0xd5,
+	0xb7, 0xc1, 0x88, 0xe6, 0x5b, 0x34, 0x9e, 0x26, 0x71, 0x4a, 0xfd, 0x2c, 0xcb, 0x6d, 0x5e, 0x0f,
+	0xe0, 0x31, 0xa1, 0x98, 0x3c, 0x9f, 0x91, 0x8c, 0xa2, 0x0e, 0xd4, 0x86, 0x23, 0xd7, 0xd8, 0x36,
+	0x76, 0x56, 0x71, 0x6d, 0x38, 0x42, 0x0e, 0x98, 0xdf, 0x92, 0x13, 0xb7, 0xb6, 0x6d, 0xec, 0xd8,
+	0x98, 0x2d, 0xbd, 0x2b, 0x60, 0x71, 0xff, 0x2c, 0x89, 0xa3, 0x8c, 0xa0, 0x0d, 0x58, 0x79, 0x11,
+	0x4c, 0x66, 0x84, 0xc7, 0xd8, 0x58, 0x6c, 0xbc, 0x87, 0x00, 0x87, 0xb3, 0xd7, 0x07, 0x2d, 0x50,
+	0x4c, 0x1d, 0xa5, 0x0d, 0xd6, 0xe1, 0x2c, 0xbf, 0xca, 0xbb, 0x01, 0xed, 0x87, 0x64, 0x42, 0x28,
+	0x79, 0xfd, 0x64, 0x1d, 0xe8, 0xa8, 0x10, 0x09, 0xf2, 0x35, 0x20, 0x69, 0x09, 0xa2, 0xa3, 0xa5,
+	0x48, 0x17, 0x60, 0x35, 0xa3, 0x41, 0x4a, 0xfd, 0x02, 0xaf, 0xc5, 0x0d, 0x4f, 0xc8, 0x09, 0xda,
+	0x84, 0x26, 0x89, 0x42, 0x7e, 0x24, 0xd2, 0x6d, 0x90, 0x28, 0x7c, 0x42, 0x4e, 0xbc, 0x73, 0xb0,
+	0x5e, 0xc2, 0x96, 0x57, 0x0e, 0xc0, 0xea, 0x47, 0x41, 0xa2, 0xee, 0xf2, 0xa0, 0x9d, 0xd1, 0x60,
+	0x42, 0xfc, 0x94, 0x04, 0xa1, 0x4f, 0x33, 0x7e, 0x6d, 0x1d, 0x5b, 0xdc, 0x88, 0x49, 0x10, 0x0e,
+	0x32, 0x74, 0x19, 0xec, 0x94, 0x24, 0x93, 0xf1, 0x30, 0xe0, 0x5e, 0x3c, 0x85, 0x16, 0xb6, 0xa4,
+	0x8d, 0x39, 0x79, 0x7b, 0x60, 0x0b, 0x54, 0x49, 0xc4, 0x5b, 0xd0, 0x48, 0xc9, 0xd1, 0x38, 0x8e,
+	0x38, 0x9e, 0xb5, 0xdb, 0xe9, 0xc9, 0xc6, 0xc0, 0xdc, 0x8a, 0xe5, 0xa9, 0xf7, 0x63, 0x0d, 0x9a,
+	0x2a, 0x95, 0x1e, 0xb4, 0x86, 0xd3, 0xd0, 0xa7, 0x27, 0x89, 0xe0, 0xaf, 0xb3, 0xbb, 0xde, 0xd3,
+	0x9a, 0x6d, 0x7f, 0x1a, 0x0e, 0x4e, 0x12, 0x82, 0x9b, 0x43, 0xb1, 0x40, 0x3b, 0x60, 0x1e, 0x11,
+	0xca, 0xb3, 0xb1, 0x76, 0xcf, 0xeb, 0xae, 0x45, 0x0b, 0x61, 0xe6, 0xc2, 0x3c, 0x93, 0x19, 0x75,
+	0xeb, 0x55, 0xcf, 0xa2, 0x2f, 0x30, 0x73, 0x41, 0x37, 0xa0, 0x11, 0xf2, 0xa2, 0xb9, 0x2b, 0xdc,
+	0xf9, 0x7f, 0xba, 0x73, 0x89, 0x6f, 0x2c, 0x1d, 0xd1, 0x3b, 0x50, 0xcf, 0xa2, 0x20, 0x71, 0x1b,
+	0x3c, 0x60, 0x53, 0x0f, 0xd0, 0x0a, 0x8d, 0xb9, 0x13, 0xba, 0x0f, 0xb6, 0x08, 0xf3, 0x53, 0xc6,
+	0x8a, 0xdb, 0xe4, 0x41, 0xdd, 0x05, 0xb7, 0x68, 0x0d, 0x81, 0xad, 0xb0, 0xb0, 0x79, 0x3f, 0xd7,
+	0xa0, 0x95, 0xd7, 0xf9, 0x4d, 0x6b, 0x76, 0x4d, 0xaf, 0xd9, 0x66, 0xa5, 0x66, 0x02, 0x55, 0x14,
+	0xed, 0x9a, 0x5e, 0xb4, 0xcd, 0x4a, 0xd1, 0x94, 0x2b, 0xab, 0xda, 0xee, 0x5c, 0xd5, 0xb6, 0x16,
+	0x55, 0x4d, 0x06, 0xa8, 0xb2, 0xbd, 0x5b, 0x2a, 0x9b, 0x5b, 0x2d, 0x9b, 0xf4, 0x17, 0x75, 0x7b,
+	0xb0, 0xb0, 0x6e, 0x97, 0x96, 0xd6, 0x4d, 0x06, 0x97, 0x0a, 0x17, 0xc3, 0xda, 0xfe, 0x31, 0x5b,
+	0x1d, 0x12, 0x92, 0xaa, 0xa6, 0xfb, 0x00, 0xac, 0x21, 0x37, 0xea, 0x35, 0xdc, 0xec, 0x29, 0xa5,
+	0xda, 0x8f, 0xa3, 0x91, 0x08, 0xe2, 0x75, 0x84, 0x61, 0xbe, 0x46, 0xdb, 0x50, 0x4f, 0x08, 0x49,
+	0x65, 0x2d, 0x6d, 0xd5, 0xe0, 0x1c, 0x9c, 0x9f, 0x78, 0x77, 0x00, 0xe9, 0x17, 0xbe, 0xe1, 0x68,
+	0x3c, 0x07, 0xbb, 0x9f, 0x4c, 0xc6, 0xb9, 0x6e, 0x31, 0x15, 0x60, 0x7b, 0x3e, 0xea, 0x86, 0x54,
+	0x01, 0x66, 0x60, 0x2a, 0xe0, 0x41, 0x3b, 0x22, 0x2f, 0x7d, 0x11, 0xea, 0x8f, 0xc5, 0x8c, 0xd6,
+	0xb1, 0x15, 0x91, 0x97, 0x02, 0xf6, 0x20, 0x44, 0xdb, 0x60, 0x33, 0x1f, 0x96, 0x9a, 0x3f, 0x0e,
+	0x33, 0xd7, 0xdc, 0x36, 0x77, 0xea, 0x18, 0x22, 0xf2, 0x92, 0xe5, 0x77, 0x10, 0x66, 0xde, 0x01,
+	0xac, 0x3d, 0x08, 0xe8, 0xf0, 0xb8, 0x74, 0xef, 0xfb, 0xd0, 0x4a, 0xc5, 0x92, 0x89, 0x83, 0x59,
+	0x21, 0x4b, 0xf3, 0xc5, 0xb9, 0xa7, 0x77, 0x17, 0x90, 0x0e, 0x25, 0x7f, 0xfb, 0x0e, 0x34, 0x45,
+	0x8a, 0x0a, 0x6a, 0xfe, 0xc7, 0xab, 0x63, 0xef, 0x1b, 0x58, 0xdb, 0x8f, 0xa7, 0x49, 0x30, 0xa4,
+	0x4f, 0xe3, 0x23, 0x95, 0xca, 0x15, 0x68, 0x0f, 0x85, 0xd1, 0x1f, 0x47, 0x21, 0xf9, 0x4e, 0x8a,
+	0x95, 0x2d, 0x8d, 0x07, 0xcc, 0xc6, 0xd4, 0x4a, 0x39, 0x51, 0x92, 0x4e, 0x55, 0x25, 0xa4, 0x6d,
+	0x40, 0xd2, 0xa9, 0xb7, 0x01, 0x48, 0x07, 0x97, 0xca, 0xf8, 0x21, 0x9c, 0x1b, 0xa4, 0x41, 0x94,
+	0x8d, 0x48, 0xfa, 0x94, 0x04, 0x61, 0xd1, 0x23, 0x8a, 0x69, 0x63, 0x29, 0xd3, 0x2e, 0x9c, 0x9f,
+	0x0f, 0x95, 0xa0, 0x1f, 0xc1, 0xe6, 0x57, 0x49, 0x18, 0xf0, 0x01, 0x88, 0x27, 0x2f, 0x48, 0x38,
+	0xc8, 0x14, 0xec, 0x25, 0xb0, 0x52, 0x69, 0x2c, 0x84, 0x17, 0xd2, 0xdc, 0xcf, 0xdb, 0x02, 0xb7,
+	0x1a, 0x2b, 0x71, 0x6f, 0x83, 0x73, 0x10, 0x1d, 0x91, 0x8c, 0xf6, 0xfb, 0x03, 0x05, 0xf8, 0x36,
+	0x98, 0x59, 0x46, 0x65, 0x9a, 0xe7, 0x7a, 0xa5, 0xe7, 0xb5, 0xdf, 0x1f, 0x7c, 0x4e, 0x68, 0x80,
+	0x99, 0x87, 0xb7, 0x0e, 0x6b, 0x5a, 0xb0, 0x44, 0xfc, 0xc3, 0x04, 0xfb, 0x7e, 0x38, 0x1d, 0x47,
+	0x0a, 0xee, 0x66, 0x45, 0x5b, 0x4a, 0xc4, 0x73, 0xdf, 0x8a, 0xc0, 0xdc, 0xcd, 0xe7, 0x49, 0x1b,
+	0x8e, 0x8b, 0x25, 0x4d, 0x9a, 0x9f, 0x41, 0x35, 0x55, 0xcc, 0xc4, 0xe3, 0x25, 0x7b, 0x93, 0xf8,
+	0xc8, 0xad, 0x2f, 0x88, 0x9f, 0x6f, 0x0b, 0x0c, 0xc3, 0xdc, 0x84, 0x3e, 0x83, 0xb3, 0x54, 0x32,
+	0xe1, 0x4f, 0x38, 0x15, 0x52, 0x93, 0x2e, 0xeb, 0x18, 0x0b, 0x79, 0xc6, 0x1d, 0x5a, 0x32, 0xa3,
+	0x5b, 0xd0, 0xe0, 0x03, 0x96, 0xb9, 0x50, 0x4d, 0xa3, 0x32, 0x28, 0x58, 0x3a, 0xa3, 0x2f, 0x01,
+	0xcd, 0x38, 0x6d, 0xbe, 0x4e, 0xaf, 0xc5, 0x21, 0xae, 0xe8, 0x10, 0x4b, 0x1a, 0x03, 0x3b, 0xb3,
+	0xb9, 0x03, 0x74, 0x1b, 0x60, 0xcc, 0x09, 0x63, 0x6c, 0xba, 0x36, 0x87, 0xfa, 0xbf, 0x0e, 0x35,
+	0xdf, 0x0b, 0x78, 0x55, 0xf8, 0xf7, 0x33, 0xea, 0xfd, 0x69, 0x42, 0x5b, 0x12, 0x2b, 0xc7, 0xf0,
+	0x3f, 0x31, 0x7b, 0x6f, 0x11, 0xb3, 0xdd, 0x65, 0xcc, 0x4a, 0x01, 0xd6, 0xa9, 0xbd, 0xb7, 0x88,
+	0xda, 0xee, 0x32, 0x6a, 0x73, 0x80, 0x82, 0xdb, 0x27, 0xcb, 0xb8, 0xf5, 0x4e, 0xe3, 0x56, 0x02,
+	0xcd, 0x93, 0xbb, 0x37, 0x47, 0x6e, 0x77, 0x19, 0xb9, 0xea, 0xdd, 0x92, 0xec, 0xe2, 0x53, 0xd8,
+	0xbd, 0x7a, 0x3a, 0xbb, 0x12, 0xa9, 0x4a, 0xef, 0x9d, 0x05, 0xf4, 0x5e, 0x5c, 0x42, 0xaf, 0x04,
+	0xd1, 0xf8, 0xfd, 0xc9, 0x80, 0x35, 0x1c, 0x8c, 0x54, 0x1f, 0x7e, 0x2a, 0x7e, 0xdf, 0x05, 0x58,
+	0x2d, 0x5e, 0x03, 0xa1, 0x2d, 0xad, 0xb4, 0x78, 0x0a, 0xfe, 0xe5, 0xed, 0x42, 0x08, 0xea, 0xb3,
+	0xd9, 0x38, 0x94, 0xdf, 0x94, 0x7c, 0x8d, 0xf6, 0xc0, 0x16, 0x08, 0x3e, 0x49, 0xe2, 0xe1, 0xb1,
+	0x64, 0x70, 0xbd, 0x2c, 0xe1, 0x8f, 0xd8, 0x11, 0xfb, 0x38, 0xcc, 0x37, 0x0c, 0x8b, 0x2b, 0xf1,
+	0x0a, 0xcf, 0x82, 0xaf, 0xbd, 0xe7, 0x80, 0x44, 0xce, 0xe2, 0xf7, 0xc8, 0xa4, 0xaf, 0xc2, 0x0a,
+	0xff, 0x97, 0x21, 0x7f, 0x1a, 0xd5, 0x3f, 0x10, 0x8f, 0xd8, 0x5f, 0x2c, 0x0e, 0xf3, 0xdc, 0x6a,
+	0x5a, 0x6e, 0x4c, 0xf5, 0x67, 0x69, 0x4a, 0x22, 0xa9, 0xfa, 0xa6, 0x54, 0x7d, 0x61, 0xe3, 0xaa,
+	0xff, 0x8b, 0x01, 0x1d, 0x76, 0xe7, 0xfe, 0x34, 0x54, 0x12, 0x77, 0x0b, 0x1a, 0xc7, 0xa2, 0x91,
+	0x8c, 0x6a, 0xd1, 0x2b, 0x35, 0xc5, 0xd2, 0x19, 0xbd, 0xa7, 0x3d, 0x89, 0x35, 0xfe, 0x8e, 0x95,
+	0xbe, 0xba, 0x2a, 0xaf, 0x21, 0xfa, 0x18, 0xda, 0x01, 0x1b, 0x2a, 0x5f, 0x5a, 0x78, 0x7a, 0xd6,
+	0x82, 0xa9, 0x53, 0xa1, 0x76, 0xa0, 0xed, 0xbc, 0x5f, 0x0d, 0x38, 0x9b, 0x67, 0x2e, 0x67, 0x78,
+	0x6f, 0x2e, 0xf5, 0x6e, 0x35, 0x75, 0xbd, 0xb4, 0x79, 0xee, 0xbb, 0xac, 0x2f, 0xc4, 0x89, 0x4a,
+	0x7e, 0xa3, 0x9c, 0xbc, 0xea, 0xb0, 0xdc, 0x0d, 0x7d, 0x02, 0x1d, 0x95, 0xbe, 0x30, 0xb9, 0x66,
+	0xf5, 0xeb, 0xb8, 0x24, 0x31, 0xb8, 0x1d, 0xe8, 0xdb, 0xeb, 0x5f, 0x40, 0x53, 0x0a, 0x0a, 0xb2,
+	0xa0, 0x79, 0x10, 0xbd, 0x08, 0x26, 0xe3, 0xd0, 0x39, 0x83, 0x9a, 0x60, 0x3e, 0x26, 0xd4, 0x31,
+	0xd8, 0xe2, 0x70, 0x46, 0x1d, 0x13, 0x01, 0x34, 0xc4, 0x97, 0x9c, 0x53, 0x47, 0x2d, 0xa8, 0xb3,
+	0x6f, 0x41, 0x67, 0x05, 0x9d, 0x05, 0x4b, 0xfb, 0xbe, 0x73, 0x9a, 0xd7, 0xbf, 0x37, 0xe4, 0x6b,
+	0xa5, 0x60, 0x1d, 0xb0, 0x25, 0x2c, 0x37, 0x3b, 0x67, 0x50, 0x07, 0xa0, 0x50, 0x24, 0xc7, 0xe0,
+	0xfb, 0x5c, 0x4c, 0x1c, 0x13, 0x21, 0xe8, 0x94, 0xb5, 0xc2, 0xa9, 0x33, 0x9f, 0x62, 0xf6, 0x1d,
+	0x40, 0x1b, 0xe0, 0xcc, 0xcf, 0xb1, 0x63, 0xa1, 0x36, 0xac, 0xe6, 0x13, 0xe9, 0xd8, 0x0f, 0x9c,
+	0xdf, 0x5e, 0x75, 0x8d, 0xdf, 0x5f, 0x75, 0x8d, 0xbf, 0x5e, 0x75, 0x8d, 0x1f, 0xfe, 0xee, 0x9e,
+	0x79, 0xd6, 0xe0, 0xff, 0xcd, 0xde, 0xfc, 0x67, 0x00, 0xb8, 0x9d, 0x48, 0x90, 0x2d, 0x0f, 0x00,
+	0x00,
 }
@@ -11,6 +11,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 
 	eraftpb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+
 	metapb "github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
@@ -48,7 +49,7 @@ func (x PeerState) String() string {
 	return proto.EnumName(PeerState_name, int32(x))
 }
 func (PeerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{0}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{0}
 }
 
 type RaftMessage struct {
@@ -60,18 +61,22 @@ type RaftMessage struct {
 	// true means to_peer is a tombstone peer and it should remove itself.
 	IsTombstone bool `protobuf:"varint,6,opt,name=is_tombstone,json=isTombstone,proto3" json:"is_tombstone,omitempty"`
 	// Region key range [start_key, end_key).
-	StartKey             []byte   `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	EndKey               []byte   `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	StartKey []byte `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   []byte `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// A follower serving a replica read asks the leader for the read index, instead of sending a raft message.
+	ReadIndexRequest *ReadIndexRequest `protobuf:"bytes,9,opt,name=read_index_request,json=readIndexRequest" json:"read_index_request,omitempty"`
+	// The leader replies the read index to the follower, instead of sending a raft message.
+	ReadIndexResponse    *ReadIndexResponse `protobuf:"bytes,10,opt,name=read_index_response,json=readIndexResponse" json:"read_index_response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
 func (m *RaftMessage) String() string { return proto.CompactTextString(m) }
 func (*RaftMessage) ProtoMessage()    {}
 func (*RaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{0}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{0}
 }
 func (m *RaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,6 +161,125 @@ func (m *RaftMessage) GetEndKey() []byte {
 	return nil
 }
 
+func (m *RaftMessage) GetReadIndexRequest() *ReadIndexRequest {
+	if m != nil {
+		return m.ReadIndexRequest
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetReadIndexResponse() *ReadIndexResponse {
+	if m != nil {
+		return m.ReadIndexResponse
+	}
+	return nil
+}
+
+type ReadIndexRequest struct {
+	// Identifies the read on the follower.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadIndexRequest) Reset()         { *m = ReadIndexRequest{} }
+func (m *ReadIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadIndexRequest) ProtoMessage()    {}
+func (*ReadIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{1}
+}
+func (m *ReadIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadIndexRequest.Merge(dst, src)
+}
+func (m *ReadIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadIndexRequest proto.InternalMessageInfo
+
+func (m *ReadIndexRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ReadIndexResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The index of a read the leader commits through Raft after the request arrives, so it is not less than the
+	// index of any write finished before. It is 0 if the leader fails to commit the read.
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadIndexResponse) Reset()         { *m = ReadIndexResponse{} }
+func (m *ReadIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadIndexResponse) ProtoMessage()    {}
+func (*ReadIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{2}
+}
+func (m *ReadIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadIndexResponse.Merge(dst, src)
+}
+func (m *ReadIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadIndexResponse proto.InternalMessageInfo
+
+func (m *ReadIndexResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReadIndexResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 type RaftTruncatedState struct {
 	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Term                 uint64   `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
@@ -168,7 +292,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{1}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{3}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +348,7 @@ func (m *SnapshotCFFile) Reset()         { *m = SnapshotCFFile{} }
 func (m *SnapshotCFFile) String() string { return proto.CompactTextString(m) }
 func (*SnapshotCFFile) ProtoMessage()    {}
 func (*SnapshotCFFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{2}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{4}
 }
 func (m *SnapshotCFFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +409,7 @@ func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
 func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMeta) ProtoMessage()    {}
 func (*SnapshotMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{3}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{5}
 }
 func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +457,7 @@ func (m *SnapshotChunk) Reset()         { *m = SnapshotChunk{} }
 func (m *SnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*SnapshotChunk) ProtoMessage()    {}
 func (*SnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{4}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{6}
 }
 func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +510,7 @@ func (m *Done) Reset()         { *m = Done{} }
 func (m *Done) String() string { return proto.CompactTextString(m) }
 func (*Done) ProtoMessage()    {}
 func (*Done) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{5}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{7}
 }
 func (m *Done) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +551,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{6}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{8}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +608,7 @@ func (m *RaftSnapshotData) Reset()         { *m = RaftSnapshotData{} }
 func (m *RaftSnapshotData) String() string { return proto.CompactTextString(m) }
 func (*RaftSnapshotData) ProtoMessage()    {}
 func (*RaftSnapshotData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{7}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{9}
 }
 func (m *RaftSnapshotData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +677,7 @@ func (m *StoreIdent) Reset()         { *m = StoreIdent{} }
 func (m *StoreIdent) String() string { return proto.CompactTextString(m) }
 func (*StoreIdent) ProtoMessage()    {}
 func (*StoreIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{8}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{10}
 }
 func (m *StoreIdent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +732,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{9}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{11}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +787,7 @@ func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{10}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{12}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +842,7 @@ func (m *RegionLocalState) Reset()         { *m = RegionLocalState{} }
 func (m *RegionLocalState) String() string { return proto.CompactTextString(m) }
 func (*RegionLocalState) ProtoMessage()    {}
 func (*RegionLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_ccc0fdece28854e0, []int{11}
+	return fileDescriptor_raft_serverpb_da1c5a9ba657fede, []int{13}
 }
 func (m *RegionLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,6 +887,8 @@ func (m *RegionLocalState) GetRegion() *metapb.Region {
 
 func init() {
 	proto.RegisterType((*RaftMessage)(nil), "raft_serverpb.RaftMessage")
+	proto.RegisterType((*ReadIndexRequest)(nil), "raft_serverpb.ReadIndexRequest")
+	proto.RegisterType((*ReadIndexResponse)(nil), "raft_serverpb.ReadIndexResponse")
 	proto.RegisterType((*RaftTruncatedState)(nil), "raft_serverpb.RaftTruncatedState")
 	proto.RegisterType((*SnapshotCFFile)(nil), "raft_serverpb.SnapshotCFFile")
 	proto.RegisterType((*SnapshotMeta)(nil), "raft_serverpb.SnapshotMeta")
@@ -858,6 +984,83 @@ func (m *RaftMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.EndKey)))
 		i += copy(dAtA[i:], m.EndKey)
 	}
+	if m.ReadIndexRequest != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ReadIndexRequest.Size()))
+		n5, err := m.ReadIndexRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.ReadIndexResponse != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ReadIndexResponse.Size()))
+		n6, err := m.ReadIndexResponse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Id))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Index))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -984,11 +1187,11 @@ func (m *SnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Message.Size()))
-		n5, err := m.Message.MarshalTo(dAtA[i:])
+		n7, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
@@ -1075,11 +1278,11 @@ func (m *RaftSnapshotData) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Region.Size()))
-		n6, err := m.Region.MarshalTo(dAtA[i:])
+		n8, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	if m.FileSize != 0 {
 		dAtA[i] = 0x10
@@ -1102,11 +1305,11 @@ func (m *RaftSnapshotData) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Meta.Size()))
-		n7, err := m.Meta.MarshalTo(dAtA[i:])
+		n9, err := m.Meta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1164,11 +1367,11 @@ func (m *RaftLocalState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.HardState.Size()))
-		n8, err := m.HardState.MarshalTo(dAtA[i:])
+		n10, err := m.HardState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.LastIndex != 0 {
 		dAtA[i] = 0x10
@@ -1205,11 +1408,11 @@ func (m *RaftApplyState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.TruncatedState.Size()))
-		n9, err := m.TruncatedState.MarshalTo(dAtA[i:])
+		n11, err := m.TruncatedState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1241,11 +1444,11 @@ func (m *RegionLocalState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Region.Size()))
-		n10, err := m.Region.MarshalTo(dAtA[i:])
+		n12, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1295,6 +1498,41 @@ func (m *RaftMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.ReadIndexRequest != nil {
+		l = m.ReadIndexRequest.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.ReadIndexResponse != nil {
+		l = m.ReadIndexResponse.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadIndexRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadIndexResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Id))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Index))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1757,6 +1995,231 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIndexRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadIndexRequest == nil {
+				m.ReadIndexRequest = &ReadIndexRequest{}
+			}
+			if err := m.ReadIndexRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIndexResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadIndexResponse == nil {
+				m.ReadIndexResponse = &ReadIndexResponse{}
+			}
+			if err := m.ReadIndexResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -3017,55 +3480,60 @@ var (
 	ErrIntOverflowRaftServerpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_ccc0fdece28854e0) }
-
-var fileDescriptor_raft_serverpb_ccc0fdece28854e0 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xbe, 0x4e, 0xd2, 0xc4, 0x3e, 0x71, 0x82, 0x35, 0x17, 0xe9, 0x9a, 0x54, 0x8d, 0x52, 0x23,
-	0x50, 0x28, 0x52, 0x10, 0xa1, 0x42, 0xac, 0x90, 0x80, 0x52, 0x35, 0x94, 0xa2, 0x6a, 0x52, 0x21,
-	0xb1, 0xb2, 0x26, 0xf6, 0x71, 0x63, 0xea, 0xd8, 0xd6, 0xcc, 0xa4, 0x22, 0xec, 0x78, 0x0b, 0x5e,
-	0x84, 0x05, 0x6f, 0xc0, 0x92, 0x47, 0x40, 0xe5, 0x45, 0xd0, 0xcc, 0xd8, 0xf9, 0xa9, 0xca, 0x5d,
-	0xf9, 0xfc, 0xff, 0x7c, 0xe7, 0xf3, 0xc0, 0x6b, 0xce, 0x12, 0x19, 0x0a, 0xe4, 0x8f, 0xc8, 0xcb,
-	0xc5, 0xa4, 0xe4, 0x85, 0x2c, 0x48, 0xef, 0xc0, 0x38, 0xe8, 0xa1, 0xd2, 0x6b, 0xef, 0xc0, 0x5d,
-	0xa1, 0x64, 0xb5, 0x16, 0xfc, 0xd9, 0x80, 0x2e, 0x65, 0x89, 0xbc, 0x41, 0x21, 0xd8, 0x3d, 0x92,
-	0x63, 0x70, 0x38, 0xde, 0xa7, 0x45, 0x1e, 0xa6, 0xb1, 0x6f, 0x8d, 0xac, 0x71, 0x8b, 0xda, 0xc6,
-	0x30, 0x8b, 0xc9, 0x47, 0xe0, 0x24, 0xbc, 0x58, 0x85, 0x25, 0x22, 0xf7, 0x1b, 0x23, 0x6b, 0xdc,
-	0x9d, 0xba, 0x93, 0xaa, 0xdc, 0x2d, 0x22, 0xa7, 0xb6, 0x72, 0x2b, 0x89, 0x7c, 0x00, 0x1d, 0x59,
-	0x98, 0xc0, 0xe6, 0x0b, 0x81, 0x6d, 0x59, 0xe8, 0xb0, 0x33, 0xe8, 0xac, 0x4c, 0x67, 0xbf, 0xa5,
-	0xc3, 0xbc, 0x49, 0x3d, 0x6d, 0x35, 0x11, 0xad, 0x03, 0xc8, 0xe7, 0xe0, 0x56, 0xa3, 0x61, 0x59,
-	0x44, 0x4b, 0xff, 0x48, 0x27, 0xbc, 0xae, 0xeb, 0x52, 0xed, 0xfb, 0x56, 0xb9, 0x68, 0x97, 0xef,
-	0x14, 0x72, 0x0a, 0x6e, 0x2a, 0x42, 0x59, 0xac, 0x16, 0x42, 0x16, 0x39, 0xfa, 0xed, 0x91, 0x35,
-	0xb6, 0x69, 0x37, 0x15, 0x77, 0xb5, 0x49, 0x6d, 0x2d, 0x24, 0xe3, 0x32, 0x7c, 0xc0, 0x8d, 0xdf,
-	0x19, 0x59, 0x63, 0x97, 0xda, 0xda, 0x70, 0x8d, 0x1b, 0xf2, 0x06, 0x3a, 0x98, 0xc7, 0xda, 0x65,
-	0x6b, 0x57, 0x1b, 0xf3, 0xf8, 0x1a, 0x37, 0xc1, 0x97, 0x40, 0x14, 0x74, 0x77, 0x7c, 0x9d, 0x47,
-	0x4c, 0x62, 0x3c, 0x97, 0x4c, 0x22, 0x79, 0x17, 0x8e, 0xd2, 0x3c, 0xc6, 0x5f, 0x2a, 0xf4, 0x8c,
-	0x42, 0x08, 0xb4, 0x24, 0xf2, 0x95, 0x46, 0xad, 0x45, 0xb5, 0x1c, 0xdc, 0x42, 0x7f, 0x9e, 0xb3,
-	0x52, 0x2c, 0x0b, 0xf9, 0xcd, 0xe5, 0x65, 0x9a, 0x21, 0xe9, 0x43, 0x23, 0x4a, 0x74, 0xa2, 0x43,
-	0x1b, 0x51, 0xa2, 0xb2, 0x44, 0xfa, 0x2b, 0xd6, 0x59, 0x4a, 0x26, 0x03, 0xb0, 0xa3, 0x25, 0x46,
-	0x0f, 0x62, 0xbd, 0xd2, 0xd0, 0xf6, 0xe8, 0x56, 0x0f, 0xae, 0xc0, 0xad, 0x2b, 0xde, 0xa0, 0x64,
-	0xe4, 0x0b, 0xb0, 0xa3, 0x24, 0x4c, 0xd2, 0x0c, 0x85, 0x6f, 0x8d, 0x9a, 0xe3, 0xee, 0xf4, 0x64,
-	0x72, 0xc8, 0x98, 0xc3, 0x01, 0x68, 0x27, 0x4a, 0xd4, 0x57, 0x04, 0x3f, 0x41, 0x6f, 0xeb, 0x5a,
-	0xae, 0xf3, 0x07, 0x72, 0xbe, 0xbb, 0x94, 0xa5, 0x81, 0x1f, 0x3c, 0xab, 0xb4, 0xc7, 0xa2, 0xdd,
-	0xcd, 0x08, 0xb4, 0x62, 0x26, 0x99, 0x5e, 0xc0, 0xa5, 0x5a, 0x0e, 0xda, 0xd0, 0xba, 0x28, 0x72,
-	0x0c, 0xa6, 0x60, 0x5f, 0xe3, 0xe6, 0x47, 0x96, 0xad, 0x91, 0x78, 0xd0, 0x54, 0xf8, 0x5a, 0x3a,
-	0x4c, 0x89, 0x0a, 0xc6, 0x47, 0xe5, 0xaa, 0x52, 0x8d, 0x12, 0xfc, 0x61, 0x81, 0xa7, 0x1a, 0xd5,
-	0xb3, 0x5d, 0x30, 0xc9, 0xc8, 0x87, 0xd0, 0x36, 0xf7, 0xae, 0x26, 0xeb, 0x1f, 0x52, 0x82, 0x56,
-	0x5e, 0x75, 0x65, 0x05, 0x45, 0xb8, 0x07, 0xa9, 0xad, 0x0c, 0x73, 0x05, 0xeb, 0xc7, 0xd5, 0xa4,
-	0x4d, 0x0d, 0xd3, 0x9b, 0x67, 0xcb, 0xd5, 0x83, 0x9a, 0x15, 0xc8, 0x27, 0xd0, 0x52, 0x2d, 0x2a,
-	0x0a, 0x1e, 0xff, 0x0f, 0xa6, 0xea, 0x04, 0x54, 0x07, 0x06, 0x97, 0x00, 0x73, 0x59, 0x70, 0x9c,
-	0xc5, 0x98, 0x4b, 0x72, 0x02, 0x10, 0x65, 0x6b, 0x21, 0x91, 0xef, 0xfe, 0x32, 0xa7, 0xb2, 0xcc,
-	0x62, 0xf2, 0x1e, 0xd8, 0x42, 0x05, 0x2b, 0xa7, 0x19, 0xb3, 0x23, 0x4c, 0x72, 0xb0, 0x80, 0xbe,
-	0x5a, 0xff, 0xfb, 0x22, 0x62, 0x99, 0xa1, 0xdb, 0xa7, 0x00, 0x4b, 0xc6, 0xe3, 0x50, 0x28, 0xad,
-	0x02, 0x80, 0x6c, 0x7f, 0xa2, 0x2b, 0xc6, 0x0d, 0x2d, 0xa9, 0xb3, 0xac, 0x45, 0xd5, 0x3e, 0x63,
-	0x42, 0x86, 0x86, 0xa6, 0xa6, 0x83, 0xa3, 0x2c, 0x33, 0x65, 0x08, 0x7e, 0xb3, 0x4c, 0x93, 0xaf,
-	0xca, 0x32, 0xdb, 0x98, 0x8c, 0xf7, 0xa1, 0xc7, 0xca, 0x32, 0x4b, 0x31, 0x0e, 0xf7, 0xb9, 0xed,
-	0x56, 0x46, 0x9d, 0x47, 0xbe, 0x83, 0x77, 0x64, 0xfd, 0x2b, 0x54, 0xe3, 0x98, 0x37, 0xe2, 0xf4,
-	0x05, 0xa6, 0x1c, 0xfe, 0x34, 0xb4, 0x2f, 0x0f, 0xf4, 0xe0, 0x67, 0xf0, 0xcc, 0xf1, 0xf6, 0x36,
-	0x9d, 0xc0, 0xd1, 0x6e, 0xc9, 0xfe, 0xd4, 0x7f, 0x56, 0x55, 0xbd, 0x27, 0xa6, 0x98, 0x09, 0xdb,
-	0xa3, 0x45, 0xe3, 0x6d, 0xb4, 0x38, 0x3b, 0x07, 0x67, 0x9b, 0x4b, 0x00, 0xda, 0x3f, 0x14, 0x7c,
-	0xc5, 0x32, 0xef, 0x15, 0x71, 0xc1, 0xd6, 0x18, 0xa4, 0xf9, 0xbd, 0x67, 0x91, 0x1e, 0x38, 0xdb,
-	0x07, 0xc3, 0x6b, 0x7c, 0xed, 0xfd, 0xf5, 0x34, 0xb4, 0xfe, 0x7e, 0x1a, 0x5a, 0xff, 0x3c, 0x0d,
-	0xad, 0xdf, 0xff, 0x1d, 0xbe, 0x5a, 0xb4, 0xf5, 0x8b, 0xfa, 0xd9, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc0, 0x9b, 0x91, 0x8c, 0x94, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_da1c5a9ba657fede) }
+
+var fileDescriptor_raft_serverpb_da1c5a9ba657fede = []byte{
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xae, 0x93, 0x6c, 0x62, 0x9f, 0x38, 0xc1, 0x9d, 0x45, 0xaa, 0x49, 0xd5, 0x90, 0x1a, 0x81,
+	0x42, 0x91, 0x82, 0x08, 0x15, 0x82, 0x1b, 0x24, 0xa0, 0xac, 0x1a, 0xca, 0xa2, 0xd5, 0xa4, 0x42,
+	0xe2, 0xca, 0x9a, 0xd8, 0xc7, 0x1b, 0xb3, 0x8e, 0x6d, 0x66, 0x26, 0x15, 0xe1, 0x8e, 0xb7, 0xe0,
+	0x45, 0x78, 0x07, 0x2e, 0xb9, 0xe6, 0x0a, 0x2d, 0x2f, 0x82, 0x66, 0xc6, 0xce, 0x8f, 0xe9, 0x72,
+	0x95, 0x39, 0xe7, 0x7c, 0xe7, 0xff, 0x3b, 0x0e, 0x9c, 0x73, 0x96, 0xc8, 0x50, 0x20, 0x7f, 0x85,
+	0xbc, 0x5c, 0xcd, 0x4a, 0x5e, 0xc8, 0x82, 0x0c, 0x4e, 0x94, 0xa3, 0x01, 0x2a, 0xb9, 0xb6, 0x8e,
+	0xdc, 0x0d, 0x4a, 0x56, 0x4b, 0xc1, 0x5f, 0x6d, 0xe8, 0x53, 0x96, 0xc8, 0x4b, 0x14, 0x82, 0x5d,
+	0x23, 0x79, 0x08, 0x0e, 0xc7, 0xeb, 0xb4, 0xc8, 0xc3, 0x34, 0xf6, 0xad, 0x89, 0x35, 0xed, 0x50,
+	0xdb, 0x28, 0x16, 0x31, 0x79, 0x1f, 0x9c, 0x84, 0x17, 0x9b, 0xb0, 0x44, 0xe4, 0x7e, 0x6b, 0x62,
+	0x4d, 0xfb, 0x73, 0x77, 0x56, 0x85, 0xbb, 0x42, 0xe4, 0xd4, 0x56, 0x66, 0xf5, 0x22, 0xef, 0x42,
+	0x4f, 0x16, 0x06, 0xd8, 0x7e, 0x0d, 0xb0, 0x2b, 0x0b, 0x0d, 0x7b, 0x02, 0xbd, 0x8d, 0xc9, 0xec,
+	0x77, 0x34, 0xcc, 0x9b, 0xd5, 0xd5, 0x56, 0x15, 0xd1, 0x1a, 0x40, 0x3e, 0x01, 0xb7, 0x2a, 0x0d,
+	0xcb, 0x22, 0x5a, 0xfb, 0x67, 0xda, 0xe1, 0xbc, 0x8e, 0x4b, 0xb5, 0xed, 0x6b, 0x65, 0xa2, 0x7d,
+	0x7e, 0x10, 0xc8, 0x63, 0x70, 0x53, 0x11, 0xca, 0x62, 0xb3, 0x12, 0xb2, 0xc8, 0xd1, 0xef, 0x4e,
+	0xac, 0xa9, 0x4d, 0xfb, 0xa9, 0x78, 0x59, 0xab, 0x54, 0xd7, 0x42, 0x32, 0x2e, 0xc3, 0x1b, 0xdc,
+	0xf9, 0xbd, 0x89, 0x35, 0x75, 0xa9, 0xad, 0x15, 0x2f, 0x70, 0x47, 0x1e, 0x40, 0x0f, 0xf3, 0x58,
+	0x9b, 0x6c, 0x6d, 0xea, 0x62, 0x1e, 0x2b, 0xc3, 0x25, 0x10, 0x8e, 0x2c, 0x0e, 0xd3, 0x3c, 0xc6,
+	0x9f, 0x43, 0x8e, 0x3f, 0x6d, 0x51, 0x48, 0xdf, 0xd1, 0x65, 0xbd, 0x3d, 0x3b, 0xdd, 0x0c, 0x45,
+	0x16, 0x2f, 0x14, 0x8e, 0x1a, 0x18, 0xf5, 0x78, 0x43, 0x43, 0xae, 0xe0, 0xfc, 0x24, 0x9c, 0x28,
+	0x8b, 0x5c, 0xa0, 0x0f, 0x3a, 0xde, 0xe4, 0xee, 0x78, 0x06, 0x47, 0xef, 0xf3, 0xa6, 0x2a, 0x08,
+	0xc0, 0x6b, 0xe6, 0x25, 0x43, 0x68, 0xed, 0x37, 0xdb, 0x4a, 0xe3, 0xe0, 0x33, 0xb8, 0xff, 0x9f,
+	0x58, 0x4d, 0x10, 0x79, 0x13, 0xce, 0x74, 0x55, 0x7a, 0xe9, 0x1d, 0x6a, 0x84, 0xe0, 0x73, 0x20,
+	0x8a, 0x3a, 0x2f, 0xf9, 0x36, 0x8f, 0x98, 0xc4, 0x78, 0x29, 0x99, 0xc4, 0x03, 0xd6, 0x3a, 0xc2,
+	0x12, 0x02, 0x1d, 0x89, 0x7c, 0x53, 0x05, 0xd0, 0xef, 0xe0, 0x0a, 0x86, 0xcb, 0x9c, 0x95, 0x62,
+	0x5d, 0xc8, 0xaf, 0x2e, 0x2e, 0xd2, 0x4c, 0xe7, 0x8d, 0x12, 0xed, 0xe8, 0xd0, 0x56, 0x94, 0x28,
+	0x2f, 0x91, 0xfe, 0x82, 0xb5, 0x97, 0x7a, 0x93, 0x11, 0xd8, 0xd1, 0x1a, 0xa3, 0x1b, 0xb1, 0xdd,
+	0x68, 0x6a, 0x0d, 0xe8, 0x5e, 0x0e, 0x9e, 0x83, 0x5b, 0x47, 0xbc, 0x44, 0xc9, 0xc8, 0xa7, 0x60,
+	0x47, 0x49, 0x98, 0xa4, 0x19, 0x0a, 0xdf, 0x9a, 0xb4, 0xa7, 0xfd, 0xf9, 0xa3, 0xc6, 0x1c, 0x4f,
+	0x0b, 0xa0, 0xbd, 0x28, 0x51, 0xbf, 0x22, 0xf8, 0x01, 0x06, 0x7b, 0xd3, 0x7a, 0x9b, 0xdf, 0x90,
+	0xa7, 0x07, 0xa6, 0x5a, 0x7a, 0x23, 0xa3, 0xe6, 0x46, 0x0e, 0x57, 0x74, 0xe0, 0x2c, 0x81, 0x4e,
+	0xcc, 0x24, 0xd3, 0x0d, 0xb8, 0x54, 0xbf, 0x83, 0x2e, 0x74, 0x9e, 0x15, 0x39, 0x06, 0x73, 0xb0,
+	0x5f, 0xe0, 0xee, 0x7b, 0x96, 0x6d, 0x91, 0x78, 0xd0, 0x56, 0xfc, 0xb2, 0x34, 0x4c, 0x3d, 0xd5,
+	0x18, 0x5f, 0x29, 0x53, 0xe5, 0x6a, 0x84, 0xe0, 0x77, 0x0b, 0x3c, 0x95, 0xa8, 0xae, 0xed, 0x19,
+	0x93, 0x8c, 0xbc, 0x07, 0x5d, 0xc3, 0xf7, 0xaa, 0xb2, 0xe1, 0xe9, 0x49, 0xd0, 0xca, 0xaa, 0x58,
+	0xae, 0x46, 0x11, 0x1e, 0x8d, 0xd4, 0x56, 0x8a, 0xa5, 0x1a, 0xeb, 0x07, 0x55, 0xa5, 0x6d, 0x3d,
+	0xa6, 0x07, 0x8d, 0xe6, 0xea, 0x42, 0x4d, 0x0b, 0xe4, 0x43, 0xe8, 0xa8, 0x14, 0xd5, 0x09, 0x3e,
+	0xbc, 0x63, 0xa6, 0x6a, 0x05, 0x54, 0x03, 0x83, 0x0b, 0x80, 0xa5, 0x2c, 0x38, 0x2e, 0x62, 0xcc,
+	0x25, 0x79, 0x04, 0x10, 0x65, 0x5b, 0x21, 0x91, 0x1f, 0xbe, 0x32, 0x4e, 0xa5, 0x59, 0xc4, 0xe4,
+	0x2d, 0xb0, 0x85, 0x02, 0x2b, 0xa3, 0x29, 0xb3, 0x27, 0x8c, 0x73, 0xb0, 0x82, 0xa1, 0x6a, 0xff,
+	0xdb, 0x22, 0x62, 0x99, 0xa1, 0xdb, 0x47, 0x00, 0x6b, 0xc6, 0xe3, 0x50, 0x28, 0xa9, 0x1a, 0x00,
+	0xd9, 0x7f, 0x44, 0x9e, 0x33, 0x6e, 0x68, 0x49, 0x9d, 0x75, 0xfd, 0x54, 0xe9, 0x33, 0x26, 0x64,
+	0x78, 0x4c, 0x69, 0x47, 0x69, 0xf4, 0x11, 0x04, 0xbf, 0x5a, 0x26, 0xc9, 0x17, 0x65, 0x99, 0xed,
+	0x8c, 0xc7, 0x3b, 0x30, 0x60, 0x65, 0x99, 0xa5, 0x58, 0x5d, 0x67, 0x55, 0xb3, 0x5b, 0x29, 0xb5,
+	0x1f, 0xf9, 0x06, 0xde, 0x90, 0xf5, 0x29, 0x54, 0xe5, 0x98, 0x6f, 0xe4, 0xe3, 0xd7, 0x30, 0xe5,
+	0xf4, 0x68, 0xe8, 0x50, 0x9e, 0xc8, 0xc1, 0x8f, 0xea, 0x72, 0xd5, 0xd2, 0x8e, 0x3a, 0x9d, 0xc1,
+	0xd9, 0xa1, 0xc9, 0xe1, 0xdc, 0x6f, 0x44, 0x55, 0xdf, 0x53, 0x13, 0xcc, 0xc0, 0x8e, 0x68, 0xd1,
+	0xfa, 0x3f, 0x5a, 0x3c, 0x79, 0x0a, 0xce, 0xde, 0x97, 0x00, 0x74, 0xbf, 0x2b, 0xf8, 0x86, 0x65,
+	0xde, 0x3d, 0xe2, 0x82, 0xad, 0x67, 0x90, 0xe6, 0xd7, 0x9e, 0x45, 0x06, 0xe0, 0xec, 0x3f, 0x98,
+	0x5e, 0xeb, 0x4b, 0xef, 0x8f, 0xdb, 0xb1, 0xf5, 0xe7, 0xed, 0xd8, 0xfa, 0xfb, 0x76, 0x6c, 0xfd,
+	0xf6, 0xcf, 0xf8, 0xde, 0xaa, 0xab, 0xff, 0x51, 0x3e, 0xfe, 0x77, 0x00, 0x66, 0x5a, 0x45, 0xa7,
+	0x94, 0x06, 0x00, 0x00,
 }
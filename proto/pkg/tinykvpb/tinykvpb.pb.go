@@ -52,6 +52,16 @@ type TinyKvClient interface {
 	KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error)
 	KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
+	KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error)
+	KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error)
+	KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error)
+	KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error)
+	// Change data capture.
+	EventFeed(ctx context.Context, in *kvrpcpb.ChangeDataRequest, opts ...grpc.CallOption) (TinyKv_EventFeedClient, error)
+	// Backup and restore.
+	Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (TinyKv_BackupClient, error)
+	RestoreFile(ctx context.Context, in *kvrpcpb.RestoreFileRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreFileResponse, error)
+	SplitRegion(ctx context.Context, in *kvrpcpb.SplitRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.SplitRegionResponse, error)
 	// RawKV commands.
 	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
 	RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error)
@@ -135,6 +145,124 @@ func (c *tinyKvClient) KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLoc
 	return out, nil
 }
 
+func (c *tinyKvClient) KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error) {
+	out := new(kvrpcpb.BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvBatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error) {
+	out := new(kvrpcpb.ScanLockResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvScanLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error) {
+	out := new(kvrpcpb.CleanupResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvCleanup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error) {
+	out := new(kvrpcpb.DeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) EventFeed(ctx context.Context, in *kvrpcpb.ChangeDataRequest, opts ...grpc.CallOption) (TinyKv_EventFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[0], "/tinykvpb.TinyKv/EventFeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tinyKvEventFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TinyKv_EventFeedClient interface {
+	Recv() (*kvrpcpb.ChangeDataEvent, error)
+	grpc.ClientStream
+}
+
+type tinyKvEventFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *tinyKvEventFeedClient) Recv() (*kvrpcpb.ChangeDataEvent, error) {
+	m := new(kvrpcpb.ChangeDataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tinyKvClient) Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (TinyKv_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[1], "/tinykvpb.TinyKv/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tinyKvBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TinyKv_BackupClient interface {
+	Recv() (*kvrpcpb.BackupResponse, error)
+	grpc.ClientStream
+}
+
+type tinyKvBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *tinyKvBackupClient) Recv() (*kvrpcpb.BackupResponse, error) {
+	m := new(kvrpcpb.BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tinyKvClient) RestoreFile(ctx context.Context, in *kvrpcpb.RestoreFileRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreFileResponse, error) {
+	out := new(kvrpcpb.RestoreFileResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RestoreFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) SplitRegion(ctx context.Context, in *kvrpcpb.SplitRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.SplitRegionResponse, error) {
+	out := new(kvrpcpb.SplitRegionResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/SplitRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
 	out := new(kvrpcpb.RawGetResponse)
 	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawGet", in, out, opts...)
@@ -172,7 +300,7 @@ func (c *tinyKvClient) RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest,
 }
 
 func (c *tinyKvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (TinyKv_RaftClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[0], "/tinykvpb.TinyKv/Raft", opts...)
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[2], "/tinykvpb.TinyKv/Raft", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +334,7 @@ func (x *tinyKvRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
 }
 
 func (c *tinyKvClient) Snapshot(ctx context.Context, opts ...grpc.CallOption) (TinyKv_SnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[1], "/tinykvpb.TinyKv/Snapshot", opts...)
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[3], "/tinykvpb.TinyKv/Snapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -259,6 +387,16 @@ type TinyKvServer interface {
 	KvCheckTxnStatus(context.Context, *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error)
 	KvBatchRollback(context.Context, *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
+	KvBatchGet(context.Context, *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error)
+	KvScanLock(context.Context, *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error)
+	KvCleanup(context.Context, *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error)
+	KvDeleteRange(context.Context, *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error)
+	// Change data capture.
+	EventFeed(*kvrpcpb.ChangeDataRequest, TinyKv_EventFeedServer) error
+	// Backup and restore.
+	Backup(*kvrpcpb.BackupRequest, TinyKv_BackupServer) error
+	RestoreFile(context.Context, *kvrpcpb.RestoreFileRequest) (*kvrpcpb.RestoreFileResponse, error)
+	SplitRegion(context.Context, *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error)
 	// RawKV commands.
 	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
 	RawPut(context.Context, *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error)
@@ -401,6 +539,156 @@ func _TinyKv_KvResolveLock_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_KvBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvBatchGet(ctx, req.(*kvrpcpb.BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvScanLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ScanLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvScanLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvScanLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvScanLock(ctx, req.(*kvrpcpb.ScanLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvCleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvCleanup(ctx, req.(*kvrpcpb.CleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, req.(*kvrpcpb.DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_EventFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ChangeDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TinyKvServer).EventFeed(m, &tinyKvEventFeedServer{stream})
+}
+
+type TinyKv_EventFeedServer interface {
+	Send(*kvrpcpb.ChangeDataEvent) error
+	grpc.ServerStream
+}
+
+type tinyKvEventFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *tinyKvEventFeedServer) Send(m *kvrpcpb.ChangeDataEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TinyKv_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TinyKvServer).Backup(m, &tinyKvBackupServer{stream})
+}
+
+type TinyKv_BackupServer interface {
+	Send(*kvrpcpb.BackupResponse) error
+	grpc.ServerStream
+}
+
+type tinyKvBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *tinyKvBackupServer) Send(m *kvrpcpb.BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TinyKv_RestoreFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RestoreFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RestoreFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RestoreFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RestoreFile(ctx, req.(*kvrpcpb.RestoreFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_SplitRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.SplitRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).SplitRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/SplitRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).SplitRegion(ctx, req.(*kvrpcpb.SplitRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.RawGetRequest)
 	if err := dec(in); err != nil {
@@ -575,6 +863,30 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvResolveLock",
 			Handler:    _TinyKv_KvResolveLock_Handler,
 		},
+		{
+			MethodName: "KvBatchGet",
+			Handler:    _TinyKv_KvBatchGet_Handler,
+		},
+		{
+			MethodName: "KvScanLock",
+			Handler:    _TinyKv_KvScanLock_Handler,
+		},
+		{
+			MethodName: "KvCleanup",
+			Handler:    _TinyKv_KvCleanup_Handler,
+		},
+		{
+			MethodName: "KvDeleteRange",
+			Handler:    _TinyKv_KvDeleteRange_Handler,
+		},
+		{
+			MethodName: "RestoreFile",
+			Handler:    _TinyKv_RestoreFile_Handler,
+		},
+		{
+			MethodName: "SplitRegion",
+			Handler:    _TinyKv_SplitRegion_Handler,
+		},
 		{
 			MethodName: "RawGet",
 			Handler:    _TinyKv_RawGet_Handler,
@@ -597,6 +909,16 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EventFeed",
+			Handler:       _TinyKv_EventFeed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _TinyKv_Backup_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Raft",
 			Handler:       _TinyKv_Raft_Handler,
@@ -611,37 +933,46 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 	Metadata: "tinykvpb.proto",
 }
 
-func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_71a6ae942ac295c5) }
-
-var fileDescriptor_tinykvpb_71a6ae942ac295c5 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdb, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x5b, 0x09, 0xba, 0x62, 0x34, 0x18, 0x6e, 0x81, 0x2d, 0x8c, 0x20, 0xed, 0x8a, 0xab,
-	0x22, 0x01, 0x12, 0x17, 0x1c, 0x24, 0x96, 0x4a, 0xbb, 0xf0, 0x90, 0x2a, 0x77, 0x5c, 0x23, 0xd7,
-	0xfa, 0xd6, 0x56, 0xe9, 0xec, 0x60, 0x3b, 0x2e, 0x7d, 0x13, 0x9e, 0x83, 0xa7, 0xe0, 0x92, 0x47,
-	0x40, 0xe5, 0x45, 0x50, 0x92, 0xd9, 0x39, 0x34, 0xbd, 0xb3, 0x7f, 0xff, 0x83, 0x5b, 0xc7, 0x1f,
-	0x7a, 0x60, 0x96, 0x62, 0x13, 0xdb, 0x64, 0x36, 0x4a, 0x94, 0x34, 0x12, 0xf7, 0xdd, 0x3e, 0x38,
-	0x8c, 0xad, 0x4a, 0xb8, 0x13, 0x82, 0x81, 0x62, 0xd7, 0xe6, 0x9b, 0x06, 0x65, 0x41, 0x79, 0xf8,
-	0x88, 0xcb, 0x44, 0x49, 0x0e, 0x5a, 0x4b, 0x75, 0x8b, 0x86, 0x73, 0x39, 0x97, 0xf9, 0xf2, 0x55,
-	0xb6, 0x2a, 0xe8, 0xeb, 0x5f, 0x07, 0xa8, 0x77, 0xb5, 0x14, 0x1b, 0x62, 0xf1, 0x5b, 0x74, 0x97,
-	0xd8, 0x0b, 0x30, 0x78, 0x30, 0x72, 0x27, 0x5c, 0x80, 0xa1, 0xf0, 0x3d, 0x05, 0x6d, 0x82, 0x61,
-	0x1d, 0xea, 0x44, 0x0a, 0x0d, 0x67, 0x1d, 0xfc, 0x0e, 0xf5, 0x88, 0x9d, 0x72, 0x26, 0x70, 0xe9,
-	0xc8, 0xb6, 0x2e, 0xf7, 0xb8, 0x41, 0x7d, 0x30, 0x42, 0x88, 0xd8, 0x89, 0x82, 0xb5, 0x5a, 0x1a,
-	0xc0, 0xc7, 0xde, 0xe6, 0x90, 0x2b, 0x38, 0x69, 0x51, 0x7c, 0xc9, 0x47, 0xd4, 0x27, 0x36, 0x92,
-	0x37, 0x37, 0x4b, 0x83, 0x9f, 0x78, 0x63, 0x01, 0x5c, 0xc1, 0xd3, 0x1d, 0xee, 0xe3, 0x5f, 0xd1,
-	0x11, 0xb1, 0xd1, 0x02, 0x78, 0x7c, 0xf5, 0x43, 0x4c, 0x0d, 0x33, 0xa9, 0xc6, 0x61, 0x69, 0xaf,
-	0x09, 0xae, 0xee, 0xc5, 0x5e, 0xdd, 0xd7, 0x52, 0xf4, 0x90, 0xd8, 0x73, 0x66, 0xf8, 0x82, 0xca,
-	0xd5, 0x6a, 0xc6, 0x78, 0x8c, 0x9f, 0xfb, 0x54, 0x8d, 0xbb, 0xd2, 0x70, 0x9f, 0xec, 0x3b, 0x2f,
-	0xd1, 0x21, 0xb1, 0x14, 0xb4, 0x5c, 0x59, 0xb8, 0x94, 0x3c, 0xc6, 0xcf, 0x7c, 0xa4, 0x42, 0x5d,
-	0xdf, 0x69, 0xbb, 0xe8, 0xdb, 0xde, 0xa3, 0x1e, 0x65, 0xeb, 0xec, 0x63, 0x97, 0xb7, 0x56, 0x80,
-	0xdd, 0x5b, 0x73, 0xbc, 0x11, 0x9e, 0xa4, 0x8d, 0xf0, 0x24, 0x6d, 0x0f, 0xe7, 0xdc, 0x87, 0xc7,
-	0xe8, 0x1e, 0x65, 0xeb, 0x31, 0xac, 0xc0, 0x00, 0x3e, 0xa9, 0xfa, 0x0a, 0xe6, 0x2a, 0x82, 0x36,
-	0xc9, 0xb7, 0x7c, 0x42, 0x07, 0x94, 0xad, 0xf3, 0x67, 0x57, 0x3b, 0xab, 0xfa, 0xf2, 0x8e, 0x77,
-	0x85, 0xca, 0x5f, 0xb8, 0x43, 0xd9, 0xb5, 0xc1, 0xc1, 0xa8, 0x3e, 0x3d, 0x19, 0xfc, 0x02, 0x5a,
-	0xb3, 0x39, 0x04, 0x83, 0x86, 0x36, 0x96, 0x02, 0xce, 0x3a, 0x2f, 0xbb, 0xf8, 0x33, 0xea, 0x4f,
-	0x05, 0x4b, 0xf4, 0x42, 0x1a, 0x7c, 0xda, 0x30, 0x39, 0x21, 0x5a, 0xa4, 0x22, 0xde, 0x5f, 0xf1,
-	0x01, 0xdd, 0x8f, 0xca, 0x09, 0xc5, 0xc3, 0x51, 0x75, 0x5e, 0xcb, 0xd1, 0xa9, 0x53, 0xf7, 0xeb,
-	0xcf, 0x8f, 0x7e, 0x6f, 0xc3, 0xee, 0x9f, 0x6d, 0xd8, 0xfd, 0xbb, 0x0d, 0xbb, 0x3f, 0xff, 0x85,
-	0x9d, 0x59, 0x2f, 0x9f, 0xe6, 0x37, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x6c, 0xc6, 0xd2,
-	0x36, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_27cc10c5d6ef4262) }
+
+var fileDescriptor_tinykvpb_27cc10c5d6ef4262 = []byte{
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x1b, 0x09, 0xca, 0xe6, 0x6a, 0x30, 0xbc, 0x01, 0x6d, 0x18, 0x41, 0xda, 0x15, 0x57,
+	0x65, 0x02, 0x24, 0x2e, 0x60, 0x08, 0x96, 0x6e, 0x93, 0x96, 0x21, 0x55, 0xe9, 0xb8, 0x46, 0x5e,
+	0x38, 0x6b, 0xa3, 0x64, 0x76, 0x88, 0x1d, 0x97, 0xbd, 0x09, 0x8f, 0xc4, 0x25, 0x8f, 0x80, 0xc6,
+	0x1d, 0x4f, 0x81, 0x92, 0xcc, 0x8e, 0xdd, 0x64, 0x77, 0xcd, 0xef, 0x3b, 0xe7, 0xf3, 0x9f, 0xcf,
+	0x3a, 0x45, 0xf7, 0x45, 0x4c, 0xaf, 0x12, 0x99, 0x9d, 0x8f, 0xb3, 0x9c, 0x09, 0x86, 0xd7, 0xd4,
+	0xb7, 0xbb, 0x91, 0xc8, 0x3c, 0x8b, 0x94, 0xe0, 0x6e, 0xe5, 0xe4, 0x42, 0x7c, 0xe5, 0x90, 0x4b,
+	0xc8, 0x35, 0x7c, 0x18, 0xb1, 0x2c, 0x67, 0x11, 0x70, 0xce, 0xf2, 0x1b, 0xb4, 0x3d, 0x67, 0x73,
+	0x56, 0xfd, 0x7c, 0x59, 0xfe, 0xaa, 0xe9, 0xab, 0x7f, 0x03, 0xd4, 0x3f, 0x8b, 0xe9, 0x55, 0x20,
+	0xf1, 0x1b, 0x74, 0x37, 0x90, 0xc7, 0x20, 0xf0, 0xd6, 0x58, 0xad, 0x70, 0x0c, 0x22, 0x84, 0xef,
+	0x05, 0x70, 0xe1, 0x6e, 0xdb, 0x90, 0x67, 0x8c, 0x72, 0xd8, 0xed, 0xe1, 0xb7, 0xa8, 0x1f, 0xc8,
+	0x59, 0x44, 0x28, 0x6e, 0x2a, 0xca, 0x4f, 0xd5, 0xf7, 0x68, 0x85, 0xea, 0x46, 0x1f, 0xa1, 0x40,
+	0x4e, 0x73, 0x58, 0xe6, 0xb1, 0x00, 0x3c, 0xd4, 0x65, 0x0a, 0x29, 0x83, 0x51, 0x87, 0xa2, 0x4d,
+	0xf6, 0xd1, 0x5a, 0x20, 0x7d, 0x76, 0x79, 0x19, 0x0b, 0xfc, 0x58, 0x17, 0xd6, 0x40, 0x19, 0x3c,
+	0x69, 0x71, 0xdd, 0xfe, 0x05, 0x6d, 0x06, 0xd2, 0x5f, 0x40, 0x94, 0x9c, 0xfd, 0xa0, 0x33, 0x41,
+	0x44, 0xc1, 0xb1, 0xd7, 0x94, 0x5b, 0x82, 0xb2, 0x7b, 0x7e, 0xab, 0xae, 0x6d, 0x43, 0xf4, 0x20,
+	0x90, 0x07, 0x44, 0x44, 0x8b, 0x90, 0xa5, 0xe9, 0x39, 0x89, 0x12, 0xfc, 0x4c, 0x77, 0x59, 0x5c,
+	0x99, 0x7a, 0xb7, 0xc9, 0xda, 0xf3, 0x14, 0x6d, 0x04, 0x32, 0x04, 0xce, 0x52, 0x09, 0xa7, 0x2c,
+	0x4a, 0xf0, 0x53, 0xdd, 0x62, 0x50, 0xe5, 0xb7, 0xd3, 0x2d, 0xda, 0x97, 0x5f, 0x2d, 0x55, 0x06,
+	0x3e, 0xb4, 0x57, 0x37, 0x52, 0x1f, 0x75, 0x28, 0xb6, 0x49, 0x99, 0x6a, 0xb5, 0x9f, 0xa1, 0x15,
+	0xb4, 0xb9, 0x99, 0x51, 0x87, 0xa2, 0x4d, 0x3e, 0xa2, 0xf5, 0x40, 0xfa, 0x29, 0x10, 0x5a, 0x64,
+	0xd8, 0x88, 0xaa, 0x26, 0xca, 0x62, 0xd8, 0x16, 0xec, 0x9b, 0x99, 0x40, 0x0a, 0x02, 0x42, 0x42,
+	0xe7, 0x60, 0xdc, 0x8c, 0x41, 0xdb, 0x37, 0x63, 0x89, 0xda, 0xed, 0x10, 0xad, 0x1f, 0x4a, 0xa0,
+	0xe2, 0x08, 0xe0, 0x1b, 0x76, 0x8d, 0xac, 0xcb, 0xba, 0x09, 0x11, 0xa4, 0x63, 0x4b, 0x5a, 0xab,
+	0x3a, 0x77, 0x7b, 0x7b, 0x0e, 0xde, 0x47, 0xfd, 0x03, 0x12, 0x25, 0x45, 0x66, 0x3c, 0xcb, 0x1a,
+	0xb4, 0x9f, 0xa5, 0xe2, 0x6a, 0x0f, 0x7b, 0x0e, 0x3e, 0x41, 0x83, 0x10, 0xb8, 0x60, 0x39, 0x1c,
+	0xc5, 0x29, 0xd8, 0x59, 0x2b, 0xda, 0x99, 0x75, 0x23, 0xea, 0x13, 0x9d, 0xa0, 0xc1, 0x2c, 0x4b,
+	0xcb, 0x77, 0x3f, 0x8f, 0x19, 0x35, 0xbc, 0x0c, 0xda, 0xf6, 0xb2, 0x44, 0xed, 0xf5, 0x0e, 0xf5,
+	0x43, 0xb2, 0x2c, 0xdf, 0x4c, 0x73, 0xac, 0x1a, 0xb4, 0x8f, 0xa5, 0xf8, 0x4a, 0xf3, 0xb4, 0x58,
+	0x69, 0x9e, 0x16, 0xdd, 0xcd, 0xd3, 0xc2, 0x6c, 0x9e, 0xa0, 0xf5, 0x90, 0x2c, 0xeb, 0xcc, 0xf0,
+	0xc8, 0xac, 0xbb, 0xc9, 0xf1, 0xc6, 0xc2, 0xed, 0x92, 0xb4, 0xcb, 0x07, 0x74, 0x2f, 0x24, 0xcb,
+	0x6a, 0x5c, 0x59, 0x6b, 0x99, 0x13, 0x6b, 0xd8, 0x16, 0x8c, 0x23, 0xdc, 0x09, 0xc9, 0x85, 0xc0,
+	0xee, 0xd8, 0x9e, 0xba, 0x25, 0xfc, 0x0c, 0x9c, 0x93, 0x39, 0xb8, 0x5b, 0x2b, 0xda, 0x84, 0x51,
+	0xd8, 0xed, 0xbd, 0x70, 0xf0, 0x27, 0xb4, 0x36, 0xa3, 0x24, 0xe3, 0x0b, 0x26, 0xf0, 0xce, 0x4a,
+	0x91, 0x12, 0xfc, 0x45, 0x41, 0x93, 0xdb, 0x2d, 0xde, 0xa3, 0x81, 0xdf, 0x4c, 0x76, 0xbc, 0x3d,
+	0x36, 0xe7, 0x7c, 0x33, 0x72, 0x6d, 0xaa, 0x76, 0x7f, 0xb0, 0xf9, 0xeb, 0xda, 0x73, 0x7e, 0x5f,
+	0x7b, 0xce, 0x9f, 0x6b, 0xcf, 0xf9, 0xf9, 0xd7, 0xeb, 0x9d, 0xf7, 0xab, 0x7f, 0x81, 0xd7, 0xff,
+	0x07, 0x00, 0xa7, 0x53, 0xc9, 0x89, 0x6e, 0x06, 0x00, 0x00,
 }
@@ -70,6 +70,10 @@ type Client interface {
 	ScatterRegion(ctx context.Context, regionID uint64) error
 	// GetOperator gets the status of operator of the specified region.
 	GetOperator(ctx context.Context, regionID uint64) (*pdpb.GetOperatorResponse, error)
+	// GetOperatorHistory gets the records of the operators finished in
+	// [start, end), filtered by the region and the store if they are not 0.
+	// A zero start or end time means the window is unbounded on that side.
+	GetOperatorHistory(ctx context.Context, regionID, storeID uint64, start, end time.Time) ([]*pdpb.OperatorRecord, error)
 	// Close closes the client.
 	Close()
 }
@@ -762,6 +766,35 @@ func (c *client) GetOperator(ctx context.Context, regionID uint64) (*pdpb.GetOpe
 	})
 }
 
+func (c *client) GetOperatorHistory(ctx context.Context, regionID, storeID uint64, start, end time.Time) ([]*pdpb.OperatorRecord, error) {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.GetOperatorHistory", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+
+	req := &pdpb.GetOperatorHistoryRequest{
+		Header:   c.requestHeader(),
+		RegionId: regionID,
+		StoreId:  storeID,
+	}
+	if !start.IsZero() {
+		req.StartTime = start.UnixNano()
+	}
+	if !end.IsZero() {
+		req.EndTime = end.UnixNano()
+	}
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	defer cancel()
+	resp, err := c.leaderClient().GetOperatorHistory(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return nil, errors.Errorf("get operator history failed: %s", resp.Header.GetError().String())
+	}
+	return resp.GetRecords(), nil
+}
+
 func (c *client) requestHeader() *pdpb.RequestHeader {
 	return &pdpb.RequestHeader{
 		ClusterId: c.clusterID,
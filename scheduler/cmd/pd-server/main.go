@@ -22,6 +22,7 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
 	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/api"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
@@ -77,7 +78,7 @@ func main() {
 		log.Warn(msg)
 	}
 
-	svr, err := server.CreateServer(cfg)
+	svr, err := server.CreateServer(cfg, api.NewHandler)
 	if err != nil {
 		log.Fatal("create server failed", zap.Error(err))
 	}
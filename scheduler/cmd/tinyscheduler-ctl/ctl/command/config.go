@@ -0,0 +1,104 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	configPath          = "/config"
+	scheduleConfigPath  = "/config/schedule"
+	replicateConfigPath = "/config/replicate"
+)
+
+// replicationOptions are the options set through the replication config,
+// all the others belong to the schedule config.
+var replicationOptions = map[string]struct{}{
+	"max-replicas": {},
+}
+
+// NewConfigCommand returns the config subcommand.
+func NewConfigCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config <command>",
+		Short: "show or set the config of the scheduler",
+	}
+	c.AddCommand(NewShowConfigCommand())
+	c.AddCommand(NewSetConfigCommand())
+	return c
+}
+
+// NewShowConfigCommand returns the subcommand to show the config.
+func NewShowConfigCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:       "show [all|schedule|replication]",
+		Short:     "show the schedule config, or the config of the section",
+		Args:      rangeArgs(0, 1),
+		ValidArgs: []string{"all", "schedule", "replication"},
+		RunE:      showConfigCommandFunc,
+	}
+}
+
+// NewSetConfigCommand returns the subcommand to set a config option.
+func NewSetConfigCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <option> <value>",
+		Short: "set the option, e.g. leader-schedule-limit or max-replicas",
+		Args:  exactArgs(2),
+		RunE:  setConfigCommandFunc,
+	}
+}
+
+func showConfigCommandFunc(cmd *cobra.Command, args []string) error {
+	section := "schedule"
+	if len(args) == 1 {
+		section = args[0]
+	}
+	switch section {
+	case "all":
+		return requestAndPrint(cmd, http.MethodGet, configPath, nil)
+	case "schedule":
+		return requestAndPrint(cmd, http.MethodGet, scheduleConfigPath, nil)
+	case "replication":
+		return requestAndPrint(cmd, http.MethodGet, replicateConfigPath, nil)
+	default:
+		return errors.Errorf("unknown config section: %s", section)
+	}
+}
+
+func setConfigCommandFunc(cmd *cobra.Command, args []string) error {
+	opt, value := args[0], args[1]
+	path := scheduleConfigPath
+	if _, ok := replicationOptions[opt]; ok {
+		path = replicateConfigPath
+	}
+	return requestAndPrint(cmd, http.MethodPost, path, map[string]interface{}{opt: parseValue(value)})
+}
+
+// parseValue converts the value given on the command line into a number or a
+// bool if possible, e.g. durations like "30m" are kept as strings.
+func parseValue(value string) interface{} {
+	if v, err := strconv.ParseFloat(value, 64); err == nil {
+		return v
+	}
+	if v, err := strconv.ParseBool(value); err == nil {
+		return v
+	}
+	return value
+}
@@ -0,0 +1,150 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const apiPrefix = "/pd/api/v1"
+
+var dialClient = &http.Client{Timeout: 30 * time.Second}
+
+// getAddress returns the URL of the API path on the scheduler given by --pd.
+func getAddress(cmd *cobra.Command, path string) string {
+	addr, _ := cmd.Flags().GetString("pd")
+	if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+		addr = "http://" + addr
+	}
+	return strings.TrimSuffix(addr, "/") + apiPrefix + path
+}
+
+// doRequest sends a request with the JSON encoded body to the API path and
+// returns the body of the response. A non-OK response is returned as an error.
+func doRequest(cmd *cobra.Command, method, path string, body interface{}) ([]byte, error) {
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, getAddress(cmd, path), r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := dialClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// The API responds errors as a JSON string.
+		var msg string
+		if json.Unmarshal(content, &msg) != nil {
+			msg = strings.TrimSpace(string(content))
+		}
+		return nil, errors.Errorf("[%d] %s", resp.StatusCode, msg)
+	}
+	return content, nil
+}
+
+// requestAndPrint sends the request and prints the response.
+func requestAndPrint(cmd *cobra.Command, method, path string, body interface{}) error {
+	content, err := doRequest(cmd, method, path, body)
+	if err != nil {
+		return err
+	}
+	return printResponse(cmd, content)
+}
+
+// printResponse prints the JSON response of the API, compacted if --json is
+// set. An empty response is printed as "Success!".
+func printResponse(cmd *cobra.Command, content []byte) error {
+	content = bytes.TrimSpace(content)
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	if !jsonOutput {
+		if len(content) == 0 || string(content) == "null" {
+			cmd.Println("Success!")
+			return nil
+		}
+		cmd.Println(string(content))
+		return nil
+	}
+	if len(content) == 0 {
+		content = []byte("null")
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, content); err != nil {
+		return errors.WithStack(err)
+	}
+	cmd.Println(buf.String())
+	return nil
+}
+
+// printJSON prints v in the format chosen by --json.
+func printJSON(cmd *cobra.Command, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return printResponse(cmd, data)
+}
+
+func parseUint64(name, s string) (uint64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s: %s", name, s)
+	}
+	return v, nil
+}
+
+// exactArgs is cobra.ExactArgs with the usage of the command in the error.
+func exactArgs(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != n {
+			return errors.Errorf("accepts %d arg(s), received %d\nUsage: %s", n, len(args), cmd.UseLine())
+		}
+		return nil
+	}
+}
+
+// rangeArgs is cobra.RangeArgs with the usage of the command in the error.
+func rangeArgs(min, max int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < min || len(args) > max {
+			return errors.Errorf("accepts between %d and %d arg(s), received %d\nUsage: %s", min, max, len(args), cmd.UseLine())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+const labelsPath = "/schedule/labels"
+
+// NewLabelCommand returns the label subcommand.
+func NewLabelCommand() *cobra.Command {
+	l := &cobra.Command{
+		Use:   "label <command> [flags]",
+		Short: "scheduling label commands of the key ranges",
+	}
+	l.AddCommand(NewShowLabelCommand())
+	l.AddCommand(NewSetLabelCommand())
+	l.AddCommand(NewDeleteLabelCommand())
+	return l
+}
+
+// NewShowLabelCommand returns the subcommand to show the label rules.
+func NewShowLabelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [<rule_id>]",
+		Short: "show all label rules or the rule with the id",
+		Args:  rangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := labelsPath
+			if len(args) == 1 {
+				path += "/" + args[0]
+			}
+			return requestAndPrint(cmd, http.MethodGet, path, nil)
+		},
+	}
+}
+
+// NewSetLabelCommand returns the subcommand to set a label rule.
+func NewSetLabelCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "set <rule_id> <start_key> <end_key> <label>... [--ttl=<duration>]",
+		Short: "label the key range [start_key, end_key), the keys are hex encoded and an empty end_key means the end of the keys, e.g. set import 7480 \"\" no-balance no-split",
+		Args:  cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ttl, _ := cmd.Flags().GetString("ttl")
+			input := map[string]interface{}{
+				"id":        args[0],
+				"start_key": args[1],
+				"end_key":   args[2],
+				"labels":    args[3:],
+				"ttl":       ttl,
+			}
+			return requestAndPrint(cmd, http.MethodPost, labelsPath, input)
+		},
+	}
+	c.Flags().String("ttl", "", "remove the rule after the duration, e.g. 1h")
+	return c
+}
+
+// NewDeleteLabelCommand returns the subcommand to delete a label rule.
+func NewDeleteLabelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <rule_id>",
+		Short: "delete the label rule",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requestAndPrint(cmd, http.MethodDelete, labelsPath+"/"+args[0], nil)
+		},
+	}
+}
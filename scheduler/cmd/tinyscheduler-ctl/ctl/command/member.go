@@ -0,0 +1,60 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const membersPath = "/members"
+
+// NewMemberCommand returns the members subcommand.
+func NewMemberCommand() *cobra.Command {
+	m := &cobra.Command{
+		Use:   "member",
+		Short: "show the members of the scheduler cluster",
+		Args:  exactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requestAndPrint(cmd, http.MethodGet, membersPath, nil)
+		},
+	}
+	m.AddCommand(&cobra.Command{
+		Use:   "leader",
+		Short: "show the leader of the scheduler cluster",
+		Args:  exactArgs(0),
+		RunE:  showLeaderCommandFunc,
+	})
+	return m
+}
+
+func showLeaderCommandFunc(cmd *cobra.Command, args []string) error {
+	content, err := doRequest(cmd, http.MethodGet, membersPath, nil)
+	if err != nil {
+		return err
+	}
+	var members struct {
+		Leader json.RawMessage `json:"leader"`
+	}
+	if err = json.Unmarshal(content, &members); err != nil {
+		return errors.WithStack(err)
+	}
+	if len(members.Leader) == 0 {
+		return errors.New("no leader")
+	}
+	return printJSON(cmd, members.Leader)
+}
@@ -0,0 +1,157 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const operatorsPath = "/operators"
+
+// NewOperatorCommand returns the operator subcommand.
+func NewOperatorCommand() *cobra.Command {
+	o := &cobra.Command{
+		Use:   "operator <command> [flags]",
+		Short: "operator commands",
+	}
+	o.AddCommand(NewShowOperatorCommand())
+	o.AddCommand(NewAddOperatorCommand())
+	o.AddCommand(NewCancelOperatorCommand())
+	o.AddCommand(NewOperatorHistoryCommand())
+	return o
+}
+
+// NewShowOperatorCommand returns the subcommand to show operators.
+func NewShowOperatorCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [<region_id>|<kind>]",
+		Short: "show the operator of the region, or the operators of the kind, e.g. admin, leader or region",
+		Args:  rangeArgs(0, 1),
+		RunE:  showOperatorCommandFunc,
+	}
+}
+
+// NewAddOperatorCommand returns the subcommand to add operators.
+func NewAddOperatorCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add <operator>",
+		Short: "add an operator",
+	}
+	c.AddCommand(&cobra.Command{
+		Use:   "transfer-leader <region_id> <to_store_id>",
+		Short: "transfer the leader of the region to the store",
+		Args:  exactArgs(2),
+		RunE:  addOperatorCommandFunc("transfer-leader", "region_id", "to_store_id"),
+	})
+	c.AddCommand(&cobra.Command{
+		Use:   "transfer-peer <region_id> <from_store_id> <to_store_id>",
+		Short: "move the peer of the region from a store to another",
+		Args:  exactArgs(3),
+		RunE:  addOperatorCommandFunc("transfer-peer", "region_id", "from_store_id", "to_store_id"),
+	})
+	c.AddCommand(&cobra.Command{
+		Use:   "add-peer <region_id> <store_id>",
+		Short: "add a peer of the region on the store",
+		Args:  exactArgs(2),
+		RunE:  addOperatorCommandFunc("add-peer", "region_id", "store_id"),
+	})
+	c.AddCommand(&cobra.Command{
+		Use:   "remove-peer <region_id> <store_id>",
+		Short: "remove the peer of the region on the store",
+		Args:  exactArgs(2),
+		RunE:  addOperatorCommandFunc("remove-peer", "region_id", "store_id"),
+	})
+	return c
+}
+
+// NewCancelOperatorCommand returns the subcommand to cancel an operator.
+func NewCancelOperatorCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "cancel <region_id>",
+		Aliases: []string{"remove"},
+		Short:   "cancel the running operator of the region",
+		Args:    exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseUint64("region id", args[0])
+			if err != nil {
+				return err
+			}
+			return requestAndPrint(cmd, http.MethodDelete, operatorsPath+"/"+strconv.FormatUint(id, 10), nil)
+		},
+	}
+}
+
+// NewOperatorHistoryCommand returns the subcommand to show the finished
+// operators.
+func NewOperatorHistoryCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "history",
+		Short: "show the finished operators, e.g. history --store 1 --since 1h",
+		Args:  exactArgs(0),
+		RunE:  operatorHistoryCommandFunc,
+	}
+	c.Flags().Uint64("region", 0, "show only the operators of the region")
+	c.Flags().Uint64("store", 0, "show only the operators involving the store")
+	c.Flags().Duration("since", 0, "show only the operators finished in the duration")
+	return c
+}
+
+func operatorHistoryCommandFunc(cmd *cobra.Command, args []string) error {
+	query := url.Values{}
+	if region, _ := cmd.Flags().GetUint64("region"); region != 0 {
+		query.Set("region_id", strconv.FormatUint(region, 10))
+	}
+	if store, _ := cmd.Flags().GetUint64("store"); store != 0 {
+		query.Set("store_id", strconv.FormatUint(store, 10))
+	}
+	if since, _ := cmd.Flags().GetDuration("since"); since > 0 {
+		query.Set("start_time", strconv.FormatInt(time.Now().Add(-since).UnixNano(), 10))
+	}
+	path := operatorsPath + "/history"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return requestAndPrint(cmd, http.MethodGet, path, nil)
+}
+
+func showOperatorCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return requestAndPrint(cmd, http.MethodGet, operatorsPath, nil)
+	}
+	if id, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+		return requestAndPrint(cmd, http.MethodGet, operatorsPath+"/"+strconv.FormatUint(id, 10), nil)
+	}
+	return requestAndPrint(cmd, http.MethodGet, operatorsPath+"?kind="+args[0], nil)
+}
+
+// addOperatorCommandFunc returns the function to add the operator of the name,
+// which takes the ids of fields as its arguments.
+func addOperatorCommandFunc(name string, fields ...string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		input := map[string]interface{}{"name": name}
+		for i, field := range fields {
+			id, err := parseUint64(field, args[i])
+			if err != nil {
+				return err
+			}
+			input[field] = id
+		}
+		return requestAndPrint(cmd, http.MethodPost, operatorsPath, input)
+	}
+}
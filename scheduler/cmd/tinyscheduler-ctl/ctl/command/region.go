@@ -0,0 +1,171 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	regionPath  = "/region"
+	regionsPath = "/regions"
+)
+
+// NewRegionCommand returns the region subcommand.
+func NewRegionCommand() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "region [<region_id>]",
+		Short: "show the region with the id or all regions",
+		Args:  rangeArgs(0, 1),
+		RunE:  showRegionCommandFunc,
+	}
+	r.AddCommand(NewRegionWithKeyCommand())
+	r.AddCommand(NewScanRegionCommand())
+	r.AddCommand(NewRegionsOfStoreCommand())
+	r.AddCommand(NewTopSizeRegionCommand())
+	r.AddCommand(NewRegionWithCheckCommand())
+	return r
+}
+
+// NewRegionWithKeyCommand returns the subcommand to show the region of a key.
+func NewRegionWithKeyCommand() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "key [--format=raw|hex] <key>",
+		Short: "show the region containing the key",
+		Args:  exactArgs(1),
+		RunE:  showRegionWithKeyCommandFunc,
+	}
+	r.Flags().String("format", "raw", "the format of the key, raw or hex")
+	return r
+}
+
+// NewScanRegionCommand returns the subcommand to scan regions by key.
+func NewScanRegionCommand() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "scan [--format=raw|hex] [--limit=<n>] <start_key> [<end_key>]",
+		Short: "show the regions from the start key to the end key",
+		Args:  rangeArgs(1, 2),
+		RunE:  scanRegionCommandFunc,
+	}
+	r.Flags().String("format", "raw", "the format of the keys, raw or hex")
+	r.Flags().Int("limit", 16, "the max number of regions to show")
+	return r
+}
+
+// NewRegionsOfStoreCommand returns the subcommand to show the regions of a store.
+func NewRegionsOfStoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "store <store_id>",
+		Short: "show the regions with a peer in the store",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseUint64("store id", args[0])
+			if err != nil {
+				return err
+			}
+			return requestAndPrint(cmd, http.MethodGet, regionsPath+"/store/"+strconv.FormatUint(id, 10), nil)
+		},
+	}
+}
+
+// NewTopSizeRegionCommand returns the subcommand to show the largest regions.
+func NewTopSizeRegionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "topsize [<limit>]",
+		Short: "show the largest regions",
+		Args:  rangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := regionsPath + "/topsize"
+			if len(args) == 1 {
+				limit, err := strconv.Atoi(args[0])
+				if err != nil {
+					return errors.Errorf("invalid limit: %s", args[0])
+				}
+				path += "?limit=" + strconv.Itoa(limit)
+			}
+			return requestAndPrint(cmd, http.MethodGet, path, nil)
+		},
+	}
+}
+
+// NewRegionWithCheckCommand returns the subcommand to show abnormal regions.
+func NewRegionWithCheckCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:       "check <down-peer|pending-peer>",
+		Short:     "show the regions with down or pending peers",
+		Args:      exactArgs(1),
+		ValidArgs: []string{"down-peer", "pending-peer"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case "down-peer", "pending-peer":
+				return requestAndPrint(cmd, http.MethodGet, regionsPath+"/check/"+args[0], nil)
+			default:
+				return errors.Errorf("unknown check: %s", args[0])
+			}
+		},
+	}
+}
+
+func showRegionCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return requestAndPrint(cmd, http.MethodGet, regionsPath, nil)
+	}
+	id, err := parseUint64("region id", args[0])
+	if err != nil {
+		return err
+	}
+	return requestAndPrint(cmd, http.MethodGet, regionPath+"/id/"+strconv.FormatUint(id, 10), nil)
+}
+
+func showRegionWithKeyCommandFunc(cmd *cobra.Command, args []string) error {
+	query, err := formatQuery(cmd, url.Values{})
+	if err != nil {
+		return err
+	}
+	return requestAndPrint(cmd, http.MethodGet, regionPath+"/key/"+url.PathEscape(args[0])+query, nil)
+}
+
+func scanRegionCommandFunc(cmd *cobra.Command, args []string) error {
+	values := url.Values{"key": {args[0]}}
+	if len(args) == 2 {
+		values.Set("end_key", args[1])
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+	values.Set("limit", strconv.Itoa(limit))
+	query, err := formatQuery(cmd, values)
+	if err != nil {
+		return err
+	}
+	return requestAndPrint(cmd, http.MethodGet, regionsPath+"/key"+query, nil)
+}
+
+// formatQuery adds the --format flag to the query.
+func formatQuery(cmd *cobra.Command, values url.Values) (string, error) {
+	switch format, _ := cmd.Flags().GetString("format"); format {
+	case "raw":
+	case "hex":
+		values.Set("format", "hex")
+	default:
+		return "", errors.Errorf("invalid format: %s", format)
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const schedulersPath = "/schedulers"
+
+// NewSchedulerCommand returns the scheduler subcommand.
+func NewSchedulerCommand() *cobra.Command {
+	s := &cobra.Command{
+		Use:   "scheduler <command> [flags]",
+		Short: "scheduler commands",
+	}
+	s.AddCommand(NewShowSchedulerCommand())
+	s.AddCommand(NewAddSchedulerCommand())
+	s.AddCommand(NewRemoveSchedulerCommand())
+	s.AddCommand(NewPauseSchedulerCommand())
+	s.AddCommand(NewResumeSchedulerCommand())
+	return s
+}
+
+// NewShowSchedulerCommand returns the subcommand to show schedulers.
+func NewShowSchedulerCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [--status=paused]",
+		Short: "show the running schedulers",
+		Args:  exactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := schedulersPath
+			if status, _ := cmd.Flags().GetString("status"); status != "" {
+				path += "?status=" + status
+			}
+			return requestAndPrint(cmd, http.MethodGet, path, nil)
+		},
+	}
+	c.Flags().String("status", "", "show only the schedulers in the status, e.g. paused")
+	return c
+}
+
+// NewAddSchedulerCommand returns the subcommand to add a scheduler.
+func NewAddSchedulerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <scheduler> [<args>...]",
+		Short: "add a scheduler, e.g. balance-leader or evict-leader 1",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input := map[string]interface{}{
+				"name": args[0],
+				"args": args[1:],
+			}
+			return requestAndPrint(cmd, http.MethodPost, schedulersPath, input)
+		},
+	}
+}
+
+// NewRemoveSchedulerCommand returns the subcommand to remove a scheduler.
+func NewRemoveSchedulerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <scheduler>",
+		Short: "remove a scheduler, e.g. balance-leader-scheduler",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requestAndPrint(cmd, http.MethodDelete, schedulersPath+"/"+args[0], nil)
+		},
+	}
+}
+
+// NewPauseSchedulerCommand returns the subcommand to pause a scheduler.
+func NewPauseSchedulerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause <scheduler|all> <delay_seconds>",
+		Short: "pause the scheduler for the seconds",
+		Args:  exactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delay, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || delay <= 0 {
+				return errors.Errorf("invalid delay: %s", args[1])
+			}
+			return pauseOrResumeScheduler(cmd, args[0], delay)
+		},
+	}
+}
+
+// NewResumeSchedulerCommand returns the subcommand to resume a scheduler.
+func NewResumeSchedulerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume <scheduler|all>",
+		Short: "resume the paused scheduler",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pauseOrResumeScheduler(cmd, args[0], 0)
+		},
+	}
+}
+
+func pauseOrResumeScheduler(cmd *cobra.Command, name string, delay int64) error {
+	input := map[string]interface{}{"delay": delay}
+	return requestAndPrint(cmd, http.MethodPost, schedulersPath+"/"+name, input)
+}
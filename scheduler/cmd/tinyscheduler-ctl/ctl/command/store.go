@@ -0,0 +1,199 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storePath  = "/store"
+	storesPath = "/stores"
+)
+
+// NewStoreCommand returns the store subcommand.
+func NewStoreCommand() *cobra.Command {
+	s := &cobra.Command{
+		Use:   "store [<store_id>]",
+		Short: "show the store with the id or all stores",
+		Args:  rangeArgs(0, 1),
+		RunE:  showStoreCommandFunc,
+	}
+	s.Flags().StringSlice("state", nil, "show the stores in the states, e.g. Up,Offline,Tombstone")
+	s.AddCommand(NewDeleteStoreCommand())
+	s.AddCommand(NewStoreWeightCommand())
+	s.AddCommand(NewStoreStateCommand())
+	s.AddCommand(NewStoreLimitCommand())
+	s.AddCommand(NewRemoveStoreLimitCommand())
+	s.AddCommand(NewRemoveTombstoneCommand())
+	return s
+}
+
+// NewDeleteStoreCommand returns the subcommand to delete a store.
+func NewDeleteStoreCommand() *cobra.Command {
+	d := &cobra.Command{
+		Use:   "delete <store_id>",
+		Short: "make the store offline, or tombstone with --force",
+		Args:  exactArgs(1),
+		RunE:  deleteStoreCommandFunc,
+	}
+	d.Flags().Bool("force", false, "bury the store without migrating its regions")
+	return d
+}
+
+// NewStoreWeightCommand returns the subcommand to set the weight of a store.
+func NewStoreWeightCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "weight <store_id> <leader_weight> <region_weight>",
+		Short: "set the leader and region weight of the store",
+		Args:  exactArgs(3),
+		RunE:  setStoreWeightCommandFunc,
+	}
+}
+
+// NewStoreStateCommand returns the subcommand to set the state of a store.
+func NewStoreStateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "state <store_id> <Up|Offline|Tombstone>",
+		Short: "set the state of the store",
+		Args:  exactArgs(2),
+		RunE:  setStoreStateCommandFunc,
+	}
+}
+
+// NewStoreLimitCommand returns the subcommand to show or set the store limit.
+func NewStoreLimitCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit [<store_id> <rate> [add-peer|remove-peer]]",
+		Short: "show the limits of all stores, or set the peers can be added to or removed from the store per minute",
+		Args:  rangeArgs(0, 3),
+		RunE:  storeLimitCommandFunc,
+	}
+}
+
+// NewRemoveStoreLimitCommand returns the subcommand to remove the limit
+// override of a store.
+func NewRemoveStoreLimitCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-limit <store_id>",
+		Short: "remove the limit of the store, then it uses the store-balance-rate",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseUint64("store id", args[0])
+			if err != nil {
+				return err
+			}
+			return requestAndPrint(cmd, http.MethodDelete, storePath+"/"+strconv.FormatUint(id, 10)+"/limit", nil)
+		},
+	}
+}
+
+// NewRemoveTombstoneCommand returns the subcommand to remove tombstone stores.
+func NewRemoveTombstoneCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-tombstone",
+		Short: "remove all tombstone stores",
+		Args:  exactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return requestAndPrint(cmd, http.MethodDelete, storesPath+"/remove-tombstone", nil)
+		},
+	}
+}
+
+func showStoreCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 1 {
+		id, err := parseUint64("store id", args[0])
+		if err != nil {
+			return err
+		}
+		return requestAndPrint(cmd, http.MethodGet, storePath+"/"+strconv.FormatUint(id, 10), nil)
+	}
+	states, err := cmd.Flags().GetStringSlice("state")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	path := storesPath
+	if len(states) > 0 {
+		path += "?" + url.Values{"state": states}.Encode()
+	}
+	return requestAndPrint(cmd, http.MethodGet, path, nil)
+}
+
+func deleteStoreCommandFunc(cmd *cobra.Command, args []string) error {
+	id, err := parseUint64("store id", args[0])
+	if err != nil {
+		return err
+	}
+	path := storePath + "/" + strconv.FormatUint(id, 10)
+	if force, _ := cmd.Flags().GetBool("force"); force {
+		path += "?force=true"
+	}
+	return requestAndPrint(cmd, http.MethodDelete, path, nil)
+}
+
+func setStoreWeightCommandFunc(cmd *cobra.Command, args []string) error {
+	id, err := parseUint64("store id", args[0])
+	if err != nil {
+		return err
+	}
+	leader, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || leader < 0 {
+		return errors.Errorf("invalid leader weight: %s", args[1])
+	}
+	region, err := strconv.ParseFloat(args[2], 64)
+	if err != nil || region < 0 {
+		return errors.Errorf("invalid region weight: %s", args[2])
+	}
+	input := map[string]float64{
+		"leader": leader,
+		"region": region,
+	}
+	return requestAndPrint(cmd, http.MethodPost, storePath+"/"+strconv.FormatUint(id, 10)+"/weight", input)
+}
+
+func setStoreStateCommandFunc(cmd *cobra.Command, args []string) error {
+	id, err := parseUint64("store id", args[0])
+	if err != nil {
+		return err
+	}
+	path := storePath + "/" + strconv.FormatUint(id, 10) + "/state?" + url.Values{"state": {args[1]}}.Encode()
+	return requestAndPrint(cmd, http.MethodPost, path, nil)
+}
+
+func storeLimitCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return requestAndPrint(cmd, http.MethodGet, storesPath+"/limit", nil)
+	}
+	if len(args) == 1 {
+		return errors.Errorf("the rate is required\nUsage: %s", cmd.UseLine())
+	}
+	id, err := parseUint64("store id", args[0])
+	if err != nil {
+		return err
+	}
+	rate, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || rate <= 0 {
+		return errors.Errorf("invalid rate: %s", args[1])
+	}
+	input := map[string]interface{}{"rate": rate}
+	if len(args) == 3 {
+		input["type"] = args[2]
+	}
+	return requestAndPrint(cmd, http.MethodPost, storePath+"/"+strconv.FormatUint(id, 10)+"/limit", input)
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"strconv"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewTSOCommand returns the tso subcommand.
+func NewTSOCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tso <timestamp>",
+		Short: "decode the physical time and the logical counter of a timestamp",
+		Args:  exactArgs(1),
+		RunE:  showTSOCommandFunc,
+	}
+}
+
+func showTSOCommandFunc(cmd *cobra.Command, args []string) error {
+	ts, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid timestamp: %s", args[0])
+	}
+	physical, logical := tsoutil.ParseTS(ts)
+	if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+		return printJSON(cmd, map[string]interface{}{
+			"physical": physical,
+			"logical":  logical,
+		})
+	}
+	cmd.Println("system: ", physical)
+	cmd.Println("logic: ", logical)
+	return nil
+}
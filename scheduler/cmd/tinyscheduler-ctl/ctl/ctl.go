@@ -0,0 +1,141 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/pingcap-incubator/tinykv/scheduler/cmd/tinyscheduler-ctl/ctl/command"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cobra.EnablePrefixMatching = true
+}
+
+// GetRootCmd returns the root command of tinyscheduler-ctl with all the
+// subcommands registered.
+func GetRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:           "tinyscheduler-ctl",
+		Short:         "TinyScheduler control",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	rootCmd.PersistentFlags().StringP("pd", "u", "http://127.0.0.1:2379", "address of the scheduler")
+	rootCmd.PersistentFlags().BoolP("json", "j", false, "print the results as compact JSON for scripting")
+
+	rootCmd.AddCommand(
+		command.NewMemberCommand(),
+		command.NewStoreCommand(),
+		command.NewRegionCommand(),
+		command.NewOperatorCommand(),
+		command.NewSchedulerCommand(),
+		command.NewLabelCommand(),
+		command.NewConfigCommand(),
+		command.NewTSOCommand(),
+	)
+	return rootCmd
+}
+
+// MainStart runs tinyscheduler-ctl with the command line arguments and
+// returns the exit code.
+func MainStart(args []string) int {
+	rootCmd := GetRootCmd()
+	rootCmd.Flags().BoolP("interact", "i", false, "run the commands interactively")
+	rootCmd.Flags().BoolP("version", "V", false, "print the version and exit")
+	rootCmd.Run = func(cmd *cobra.Command, args []string) {
+		if version, _ := cmd.Flags().GetBool("version"); version {
+			server.PrintPDInfo()
+			return
+		}
+		if interact, _ := cmd.Flags().GetBool("interact"); interact {
+			loop(persistentArgs(cmd))
+			return
+		}
+		_ = cmd.Help()
+	}
+
+	if err := execute(rootCmd, args); err != nil {
+		return 1
+	}
+	return 0
+}
+
+// execute runs the command with args and prints the error if there is one.
+func execute(rootCmd *cobra.Command, args []string) error {
+	rootCmd.SetArgs(args)
+	rootCmd.SetOutput(os.Stdout)
+	err := rootCmd.Execute()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+	}
+	return err
+}
+
+// persistentArgs returns the flags given on the command line which should
+// also apply to every command of the interactive mode.
+func persistentArgs(cmd *cobra.Command) []string {
+	var args []string
+	if cmd.Flags().Changed("pd") {
+		addr, _ := cmd.Flags().GetString("pd")
+		args = append(args, "--pd", addr)
+	}
+	if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+		args = append(args, "--json")
+	}
+	return args
+}
+
+func loop(extraArgs []string) {
+	l, err := readline.NewEx(&readline.Config{
+		Prompt:            "\033[31m»\033[0m ",
+		HistoryFile:       "/tmp/readline.tmp",
+		InterruptPrompt:   "^C",
+		EOFPrompt:         "exit",
+		HistorySearchFold: true,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return
+	}
+	defer l.Close()
+
+	for {
+		line, err := l.Readline()
+		if err == readline.ErrInterrupt {
+			if len(line) == 0 {
+				break
+			}
+			continue
+		} else if err == io.EOF {
+			break
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+		if args[0] == "exit" || args[0] == "quit" {
+			return
+		}
+		// The commands keep the state of their flags, so each line gets a fresh tree.
+		_ = execute(GetRootCmd(), append(args, extraArgs...))
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/cmd/tinyscheduler-simulator/simulator"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tempurl"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/api"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+
+	// Register schedulers.
+	_ "github.com/pingcap-incubator/tinykv/scheduler/server/schedulers"
+)
+
+var (
+	caseName   = flag.String("case", "", fmt.Sprintf("case to run, one of %v", simulator.CaseNames()))
+	configFile = flag.String("config", "", "config file of the scheduler")
+	simConfig  = flag.String("sim-config", "", "config file of the simulator")
+	logLevel   = flag.String("L", "warn", "log level: debug, info, warn, error, fatal")
+)
+
+const waitLeaderTimeout = 10 * time.Second
+
+func main() {
+	flag.Parse()
+	if *caseName == "" {
+		fmt.Fprintf(os.Stderr, "-case is required, the cases are %v\n", simulator.CaseNames())
+		os.Exit(2)
+	}
+	simCase, err := simulator.NewCase(*caseName)
+	if err != nil {
+		exitWithError(err)
+	}
+	simCfg, err := simulator.NewConfig(*simConfig)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	go func() {
+		<-sc
+		cancel()
+	}()
+
+	report, err := run(ctx, simCase, simCfg)
+	cancel()
+	if err != nil {
+		exitWithError(err)
+	}
+	if err := report.Print(os.Stdout); err != nil {
+		exitWithError(err)
+	}
+}
+
+// run simulates the case against a scheduler running in process, and returns
+// the report once the simulation stops. The data of the scheduler is removed
+// before it returns.
+func run(ctx context.Context, simCase *simulator.Case, simCfg *simulator.Config) (*simulator.Report, error) {
+	dataDir, err := ioutil.TempDir("", "tinyscheduler-simulator")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dataDir)
+	cfg, err := newServerConfig(simCase, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	svr, err := server.CreateServer(cfg, api.NewHandler)
+	if err != nil {
+		return nil, err
+	}
+	defer svr.Close()
+	if err := svr.Run(ctx); err != nil {
+		return nil, err
+	}
+	if err := waitLeader(ctx, svr); err != nil {
+		return nil, err
+	}
+
+	driver, err := simulator.NewDriver(ctx, simCfg, simCase, cfg.ClientUrls)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close()
+	if err := driver.Prepare(); err != nil {
+		return nil, err
+	}
+	report := driver.Run()
+	records, err := svr.GetHandler().GetOperatorHistory(&schedule.OperatorHistoryFilter{})
+	if err != nil {
+		log.Warn("failed to get operator history", zap.Error(err))
+	}
+	report.AddOperatorRecords(records)
+	return report, nil
+}
+
+// newServerConfig creates the config of the scheduler running in process. The
+// scheduling config defaults to the values fit for the simulation, which are
+// overridden by the config file. The data of the scheduler is kept in
+// dataDir.
+func newServerConfig(simCase *simulator.Case, dataDir string) (*config.Config, error) {
+	cfg := config.NewConfig()
+	// The stores are simulated in the time of the ticks, so they are limited
+	// by the snapshot latencies instead of the store balance rate.
+	cfg.Schedule.StoreBalanceRate = 600
+	cfg.Schedule.MaxStoreDownTime = typeutil.NewDuration(10 * time.Second)
+	// The records in memory are limited, all records are kept in the storage
+	// for the report.
+	cfg.Schedule.OperatorHistoryRetention = typeutil.NewDuration(24 * time.Hour)
+	if simCase.Replicas > 0 {
+		cfg.Replication.MaxReplicas = uint64(simCase.Replicas)
+	}
+	args := []string{
+		"--name", "pd",
+		"--data-dir", dataDir,
+		"--client-urls", tempurl.Alloc(),
+		"--peer-urls", tempurl.Alloc(),
+		"-L", *logLevel,
+	}
+	if *configFile != "" {
+		args = append(args, "--config", *configFile)
+	}
+	if err := cfg.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := cfg.SetupLogger(); err != nil {
+		return nil, err
+	}
+	log.ReplaceGlobals(cfg.GetZapLogger(), cfg.GetZapLogProperties())
+	return cfg, nil
+}
+
+func waitLeader(ctx context.Context, svr *server.Server) error {
+	ctx, cancel := context.WithTimeout(ctx, waitLeaderTimeout)
+	defer cancel()
+	for !svr.IsLeader() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	return nil
+}
+
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	log.Sync()
+	os.Exit(1)
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/cmd/tinyscheduler-simulator/simulator"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testRunSuite{})
+
+type testRunSuite struct{}
+
+func (s *testRunSuite) TestRunImbalance(c *C) {
+	simCase, err := simulator.NewCase("imbalance")
+	c.Assert(err, IsNil)
+	simCfg, err := simulator.NewConfig("")
+	c.Assert(err, IsNil)
+	// The case converges in about 100 ticks, give it a few times more.
+	simCfg.StableTicks = 10
+	simCfg.MaxTicks = 600
+
+	report, err := run(context.Background(), simCase, simCfg)
+	c.Assert(err, IsNil)
+	c.Assert(report.ConvergedTick, Greater, int64(0))
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+// newAddStoreCase adds a store to a balanced cluster of 3 stores, the regions
+// and leaders should be moved to the new store.
+func newAddStoreCase() *Case {
+	ids := &idAllocator{}
+	stores := newStores(ids, 4)
+	return &Case{
+		Stores:   stores[:3],
+		Regions:  newRegions(ids, 300, roundRobin(stores[:3], 3)),
+		Events:   []*Event{{Tick: 10, Action: &AddStore{Store: stores[3]}}},
+		Replicas: 3,
+		Checker: func(r *RaftEngine) bool {
+			return len(r.GetUpNodes()) == 4 &&
+				r.IsReplicated(3) &&
+				r.IsRegionBalanced(0.1) &&
+				r.IsLeaderBalanced(0.1)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+// newHotWriteCase writes to the key range of a few regions of a cluster of 4
+// stores, the regions are split as they grow and the new regions should be
+// spread across the stores.
+func newHotWriteCase() *Case {
+	ids := &idAllocator{}
+	stores := newStores(ids, 4)
+	regions := newRegions(ids, 40, roundRobin(stores, 3))
+	const endTick = 600
+	return &Case{
+		Stores:  stores,
+		Regions: regions,
+		Writes: []*WriteFlow{{
+			StartTick:   1,
+			EndTick:     endTick,
+			StartKey:    regions[0].StartKey,
+			EndKey:      regions[1].EndKey,
+			SizePerTick: 32,
+		}},
+		Replicas: 3,
+		Checker: func(r *RaftEngine) bool {
+			return r.GetTick() >= endTick &&
+				r.IsReplicated(3) &&
+				r.IsSizeBalanced(0.05) &&
+				r.IsLeaderBalanced(0.15)
+		},
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+// newImbalanceCase starts with all regions on 3 of the 5 stores and all
+// leaders on the first store.
+func newImbalanceCase() *Case {
+	ids := &idAllocator{}
+	stores := newStores(ids, 5)
+	place := func(i int) []uint64 {
+		return []uint64{stores[0].ID, stores[1].ID, stores[2].ID}
+	}
+	return &Case{
+		Stores:   stores,
+		Regions:  newRegions(ids, 200, place),
+		Replicas: 3,
+		Checker: func(r *RaftEngine) bool {
+			return r.IsReplicated(3) &&
+				r.IsRegionBalanced(0.1) &&
+				r.IsLeaderBalanced(0.2)
+		},
+	}
+}
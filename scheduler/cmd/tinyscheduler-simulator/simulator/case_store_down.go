@@ -0,0 +1,33 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+// newStoreDownCase stops a store of a balanced cluster of 5 stores, the
+// replicas on it should be replaced once it is down for max-store-down-time.
+func newStoreDownCase() *Case {
+	ids := &idAllocator{}
+	stores := newStores(ids, 5)
+	return &Case{
+		Stores:   stores,
+		Regions:  newRegions(ids, 300, roundRobin(stores, 3)),
+		Events:   []*Event{{Tick: 10, Action: &DownStore{ID: stores[4].ID}}},
+		Replicas: 3,
+		Checker: func(r *RaftEngine) bool {
+			return len(r.GetUpNodes()) == 4 &&
+				r.IsReplicated(3) &&
+				r.IsRegionBalanced(0.1) &&
+				r.IsLeaderBalanced(0.1)
+		},
+	}
+}
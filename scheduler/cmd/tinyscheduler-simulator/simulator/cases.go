@@ -0,0 +1,213 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pkg/errors"
+)
+
+// Store describes a simulated store.
+type Store struct {
+	ID uint64
+	// Capacity is the disk capacity in MB.
+	Capacity int64
+}
+
+// Region describes a region at the start of the simulation.
+type Region struct {
+	ID       uint64
+	Peers    []*metapb.Peer
+	Leader   *metapb.Peer
+	StartKey []byte
+	EndKey   []byte
+	// Size is the approximate size in MB.
+	Size int64
+	Keys int64
+}
+
+// WriteFlow writes SizePerTick MB to the random keys of [StartKey, EndKey)
+// every tick in [StartTick, EndTick), an EndTick of 0 means forever.
+type WriteFlow struct {
+	StartTick   int64
+	EndTick     int64
+	StartKey    []byte
+	EndKey      []byte
+	SizePerTick int64
+}
+
+func (f *WriteFlow) active(tick int64) bool {
+	return tick >= f.StartTick && (f.EndTick == 0 || tick < f.EndTick)
+}
+
+// Action is a scripted change of the cluster.
+type Action interface {
+	fmt.Stringer
+	run(d *Driver) error
+}
+
+// Event runs the action at the tick.
+type Event struct {
+	Tick   int64
+	Action Action
+}
+
+// Case is a scripted scenario of the simulation.
+type Case struct {
+	Name    string
+	Stores  []*Store
+	Regions []*Region
+	Events  []*Event
+	Writes  []*WriteFlow
+	// Replicas is the number of the replicas of a region.
+	Replicas int
+	// Checker returns true once the cluster converges.
+	Checker func(r *RaftEngine) bool
+}
+
+var cases = map[string]func() *Case{
+	"add-store":  newAddStoreCase,
+	"store-down": newStoreDownCase,
+	"hot-write":  newHotWriteCase,
+	"imbalance":  newImbalanceCase,
+}
+
+// CaseNames returns the names of all cases.
+func CaseNames() []string {
+	names := make([]string, 0, len(cases))
+	for name := range cases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewCase creates the case with the name.
+func NewCase(name string) (*Case, error) {
+	f, ok := cases[name]
+	if !ok {
+		return nil, errors.Errorf("unknown case %q, the cases are %v", name, CaseNames())
+	}
+	c := f()
+	c.Name = name
+	return c, nil
+}
+
+// idAllocator allocates the IDs of the stores, regions and peers of a case,
+// the scheduler allocates IDs after them.
+type idAllocator struct {
+	id uint64
+}
+
+func (a *idAllocator) next() uint64 {
+	a.id++
+	return a.id
+}
+
+const (
+	defaultStoreCapacity = 1024 * 1024
+	defaultRegionSize    = 96
+	defaultRegionKeys    = 960000
+)
+
+// newStores creates the stores with the default capacity.
+func newStores(ids *idAllocator, count int) []*Store {
+	stores := make([]*Store, 0, count)
+	for i := 0; i < count; i++ {
+		stores = append(stores, &Store{ID: ids.next(), Capacity: defaultStoreCapacity})
+	}
+	return stores
+}
+
+// newRegions creates the regions splitting the key space evenly, place
+// returns the stores of the peers of the i-th region, and the first store
+// holds the leader.
+func newRegions(ids *idAllocator, count int, place func(i int) []uint64) []*Region {
+	regions := make([]*Region, 0, count)
+	step := uint64(keySpace / count)
+	for i := 0; i < count; i++ {
+		region := &Region{
+			ID:   ids.next(),
+			Size: defaultRegionSize,
+			Keys: defaultRegionKeys,
+		}
+		if i > 0 {
+			region.StartKey = Key(uint64(i) * step)
+		}
+		if i < count-1 {
+			region.EndKey = Key(uint64(i+1) * step)
+		}
+		for _, storeID := range place(i) {
+			region.Peers = append(region.Peers, &metapb.Peer{Id: ids.next(), StoreId: storeID})
+		}
+		region.Leader = region.Peers[0]
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// roundRobin places the replicas of the i-th region on the consecutive
+// stores starting from the i-th store, so the peers and leaders are balanced.
+func roundRobin(stores []*Store, replicas int) func(i int) []uint64 {
+	return func(i int) []uint64 {
+		ids := make([]uint64, 0, replicas)
+		for j := 0; j < replicas; j++ {
+			ids = append(ids, stores[(i+j)%len(stores)].ID)
+		}
+		return ids
+	}
+}
+
+// AddStore adds a new store to the cluster.
+type AddStore struct {
+	Store *Store
+}
+
+func (a *AddStore) String() string {
+	return fmt.Sprintf("add store %d", a.Store.ID)
+}
+
+func (a *AddStore) run(d *Driver) error {
+	return d.addNode(a.Store)
+}
+
+// DownStore stops the store, it stops sending heartbeats and its leaders are
+// elected on other stores.
+type DownStore struct {
+	ID uint64
+}
+
+func (a *DownStore) String() string {
+	return fmt.Sprintf("store %d down", a.ID)
+}
+
+func (a *DownStore) run(d *Driver) error {
+	return d.setNodeDown(a.ID, true)
+}
+
+// UpStore restarts the store stopped by DownStore.
+type UpStore struct {
+	ID uint64
+}
+
+func (a *UpStore) String() string {
+	return fmt.Sprintf("store %d up", a.ID)
+}
+
+func (a *UpStore) run(d *Driver) error {
+	return d.setNodeDown(a.ID, false)
+}
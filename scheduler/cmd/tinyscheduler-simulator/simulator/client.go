@@ -0,0 +1,115 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// client sends the requests of the simulated stores to the scheduler through
+// gRPC, the same way as the real stores do.
+type client struct {
+	conn      *grpc.ClientConn
+	pd        pdpb.PDClient
+	clusterID uint64
+}
+
+func newClient(ctx context.Context, addr string) (*client, error) {
+	conn, err := grpc.DialContext(ctx, strings.TrimPrefix(addr, "http://"), grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c := &client{conn: conn, pd: pdpb.NewPDClient(conn)}
+	resp, err := c.pd.GetMembers(ctx, &pdpb.GetMembersRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, errors.WithStack(err)
+	}
+	c.clusterID = resp.GetHeader().GetClusterId()
+	return c, nil
+}
+
+func (c *client) close() error {
+	return c.conn.Close()
+}
+
+func (c *client) header() *pdpb.RequestHeader {
+	return &pdpb.RequestHeader{ClusterId: c.clusterID}
+}
+
+func checkHeader(header *pdpb.ResponseHeader) error {
+	if err := header.GetError(); err != nil {
+		return errors.Errorf("%s: %s", err.GetType(), err.GetMessage())
+	}
+	return nil
+}
+
+func (c *client) bootstrap(ctx context.Context, store *metapb.Store) error {
+	resp, err := c.pd.Bootstrap(ctx, &pdpb.BootstrapRequest{Header: c.header(), Store: store})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return checkHeader(resp.GetHeader())
+}
+
+func (c *client) putStore(ctx context.Context, store *metapb.Store) error {
+	resp, err := c.pd.PutStore(ctx, &pdpb.PutStoreRequest{Header: c.header(), Store: store})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return checkHeader(resp.GetHeader())
+}
+
+func (c *client) allocID(ctx context.Context) (uint64, error) {
+	resp, err := c.pd.AllocID(ctx, &pdpb.AllocIDRequest{Header: c.header()})
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return resp.GetId(), checkHeader(resp.GetHeader())
+}
+
+func (c *client) storeHeartbeat(ctx context.Context, stats *pdpb.StoreStats) error {
+	resp, err := c.pd.StoreHeartbeat(ctx, &pdpb.StoreHeartbeatRequest{Header: c.header(), Stats: stats})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return checkHeader(resp.GetHeader())
+}
+
+// askSplit allocates the IDs of the new region and its peers for splitting
+// the region into two.
+func (c *client) askSplit(ctx context.Context, region *metapb.Region) (*pdpb.SplitID, error) {
+	resp, err := c.pd.AskBatchSplit(ctx, &pdpb.AskBatchSplitRequest{Header: c.header(), Region: region, SplitCount: 1})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := checkHeader(resp.GetHeader()); err != nil {
+		return nil, err
+	}
+	if len(resp.GetIds()) != 1 {
+		return nil, errors.Errorf("expect 1 split id, got %d", len(resp.GetIds()))
+	}
+	return resp.GetIds()[0], nil
+}
+
+func (c *client) regionHeartbeatStream(ctx context.Context) (pdpb.PD_RegionHeartbeatClient, error) {
+	stream, err := c.pd.RegionHeartbeat(ctx)
+	return stream, errors.WithStack(err)
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTickInterval         = 100 * time.Millisecond
+	defaultStoreHeartbeatTicks  = 10
+	defaultRegionHeartbeatTicks = 10
+	defaultLeaderTransferTicks  = 1
+	defaultElectionTicks        = 10
+	defaultSnapshotBaseTicks    = 2
+	defaultSnapshotSpeed        = 64
+	defaultRegionSplitSize      = 96
+	defaultStableTicks          = 50
+	defaultMaxTicks             = 6000
+)
+
+// Config is the configuration of the simulated cluster. The simulation runs
+// in ticks, all the latencies are in ticks and the sizes are in MB.
+type Config struct {
+	// TickInterval is the wall time of a tick, the scheduler runs in real
+	// time so it is also the simulated time of a tick.
+	TickInterval typeutil.Duration `toml:"tick-interval"`
+	// StoreHeartbeatTicks and RegionHeartbeatTicks are the intervals of the
+	// store heartbeats and the region heartbeats sent by the leaders, a region
+	// also sends a heartbeat once it is changed.
+	StoreHeartbeatTicks  int64 `toml:"store-heartbeat-ticks"`
+	RegionHeartbeatTicks int64 `toml:"region-heartbeat-ticks"`
+	// LeaderTransferTicks is the latency of transferring a leader.
+	LeaderTransferTicks int64 `toml:"leader-transfer-ticks"`
+	// ElectionTicks is the time to elect a new leader once the leader is down.
+	ElectionTicks int64 `toml:"election-ticks"`
+	// SnapshotBaseTicks and SnapshotSpeed model the latency of the snapshot
+	// sent to a new peer, which takes SnapshotBaseTicks plus the region size
+	// divided by SnapshotSpeed in MB per tick.
+	SnapshotBaseTicks int64 `toml:"snapshot-base-ticks"`
+	SnapshotSpeed     int64 `toml:"snapshot-speed"`
+	// RegionSplitSize is the size a region is split at.
+	RegionSplitSize int64 `toml:"region-split-size"`
+	// StableTicks is the number of ticks the cluster should stay converged
+	// before the simulation stops.
+	StableTicks int64 `toml:"stable-ticks"`
+	// MaxTicks is the number of ticks the simulation gives up after.
+	MaxTicks int64 `toml:"max-ticks"`
+	// Seed seeds the random generator of the workload.
+	Seed int64 `toml:"seed"`
+}
+
+// NewConfig loads the configuration from the file if the path is not empty,
+// the unset items are filled with the default values.
+func NewConfig(path string) (*Config, error) {
+	cfg := &Config{}
+	if path != "" {
+		if _, err := toml.DecodeFile(path, cfg); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	cfg.adjust()
+	return cfg, cfg.validate()
+}
+
+func (c *Config) adjust() {
+	if c.TickInterval.Duration == 0 {
+		c.TickInterval.Duration = defaultTickInterval
+	}
+	adjustInt64(&c.StoreHeartbeatTicks, defaultStoreHeartbeatTicks)
+	adjustInt64(&c.RegionHeartbeatTicks, defaultRegionHeartbeatTicks)
+	adjustInt64(&c.LeaderTransferTicks, defaultLeaderTransferTicks)
+	adjustInt64(&c.ElectionTicks, defaultElectionTicks)
+	adjustInt64(&c.SnapshotBaseTicks, defaultSnapshotBaseTicks)
+	adjustInt64(&c.SnapshotSpeed, defaultSnapshotSpeed)
+	adjustInt64(&c.RegionSplitSize, defaultRegionSplitSize)
+	adjustInt64(&c.StableTicks, defaultStableTicks)
+	adjustInt64(&c.MaxTicks, defaultMaxTicks)
+}
+
+func (c *Config) validate() error {
+	if c.TickInterval.Duration < 0 {
+		return errors.New("tick-interval should not be negative")
+	}
+	for name, v := range map[string]int64{
+		"store-heartbeat-ticks":  c.StoreHeartbeatTicks,
+		"region-heartbeat-ticks": c.RegionHeartbeatTicks,
+		"leader-transfer-ticks":  c.LeaderTransferTicks,
+		"election-ticks":         c.ElectionTicks,
+		"snapshot-base-ticks":    c.SnapshotBaseTicks,
+		"snapshot-speed":         c.SnapshotSpeed,
+		"region-split-size":      c.RegionSplitSize,
+		"stable-ticks":           c.StableTicks,
+		"max-ticks":              c.MaxTicks,
+	} {
+		if v < 0 {
+			return errors.Errorf("%s should not be negative", name)
+		}
+	}
+	return nil
+}
+
+// snapshotTicks returns the ticks to send and apply the snapshot of a region.
+func (c *Config) snapshotTicks(regionSize int64) int64 {
+	return c.SnapshotBaseTicks + regionSize/c.SnapshotSpeed
+}
+
+func adjustInt64(v *int64, defValue int64) {
+	if *v == 0 {
+		*v = defValue
+	}
+}
@@ -0,0 +1,517 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Driver runs a case against the scheduler. Every tick it runs the scripted
+// events and the commands of the scheduler which are due, applies the writes
+// and sends the heartbeats.
+type Driver struct {
+	ctx     context.Context
+	cfg     *Config
+	simCase *Case
+	client  *client
+	raft    *RaftEngine
+	events  eventQueue
+	rand    *rand.Rand
+	// steps counts the commands applied by the stores.
+	steps map[string]int
+}
+
+// NewDriver creates a Driver running the case against the scheduler at the
+// address.
+func NewDriver(ctx context.Context, cfg *Config, simCase *Case, addr string) (*Driver, error) {
+	c, err := newClient(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &Driver{
+		ctx:     ctx,
+		cfg:     cfg,
+		simCase: simCase,
+		client:  c,
+		raft:    newRaftEngine(),
+		rand:    rand.New(rand.NewSource(cfg.Seed)),
+		steps:   make(map[string]int),
+	}, nil
+}
+
+// GetRaftEngine returns the state of the simulated cluster.
+func (d *Driver) GetRaftEngine() *RaftEngine {
+	return d.raft
+}
+
+// Prepare bootstraps the cluster with the stores and regions of the case.
+func (d *Driver) Prepare() error {
+	for _, store := range d.simCase.Stores {
+		d.raft.nodes[store.ID] = newNode(store)
+	}
+	var maxID uint64
+	for _, r := range d.simCase.Regions {
+		meta := &metapb.Region{
+			Id:          r.ID,
+			StartKey:    r.StartKey,
+			EndKey:      r.EndKey,
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+			Peers:       r.Peers,
+		}
+		d.raft.setRegion(core.NewRegionInfo(meta, r.Leader, core.SetApproximateSize(r.Size), core.SetApproximateKeys(r.Keys)))
+		if r.ID > maxID {
+			maxID = r.ID
+		}
+		for _, p := range r.Peers {
+			if p.GetId() > maxID {
+				maxID = p.GetId()
+			}
+		}
+	}
+	for _, e := range d.simCase.Events {
+		if a, ok := e.Action.(*AddStore); ok && a.Store.ID > maxID {
+			maxID = a.Store.ID
+		}
+	}
+
+	nodes := d.raft.GetNodes()
+	if len(nodes) == 0 || d.raft.regions.GetRegionCount() == 0 {
+		return errors.New("the case has no store or region")
+	}
+	if err := d.client.bootstrap(d.ctx, nodes[0].meta); err != nil {
+		return err
+	}
+	for _, n := range nodes[1:] {
+		if err := d.client.putStore(d.ctx, n.meta); err != nil {
+			return err
+		}
+	}
+	for _, n := range nodes {
+		if err := n.start(d.ctx, d.client); err != nil {
+			return err
+		}
+	}
+	// The scheduler allocates the IDs after the ones used by the case.
+	for {
+		id, err := d.client.allocID(d.ctx)
+		if err != nil {
+			return err
+		}
+		if id > maxID {
+			break
+		}
+	}
+
+	for _, n := range nodes {
+		d.storeHeartbeat(n)
+	}
+	for _, region := range d.raft.regions.GetRegions() {
+		d.regionHeartbeat(region)
+	}
+	for _, e := range d.simCase.Events {
+		action := e.Action
+		d.events.schedule(e.Tick, func() {
+			log.Info("run event", zap.Int64("tick", d.raft.tick), zap.Stringer("action", action))
+			if err := action.run(d); err != nil {
+				log.Error("failed to run event", zap.Stringer("action", action), zap.Error(err))
+			}
+		})
+	}
+	return nil
+}
+
+// Tick advances the simulation by a tick.
+func (d *Driver) Tick() {
+	d.raft.tick++
+	tick := d.raft.tick
+	d.events.runUntil(tick)
+
+	for _, n := range d.raft.GetNodes() {
+		for _, resp := range n.drainResponses() {
+			if !n.IsDown() {
+				d.handleResponse(resp)
+			}
+		}
+	}
+	// The commands scheduled by the responses may be due at once.
+	d.events.runUntil(tick)
+
+	for _, flow := range d.simCase.Writes {
+		if flow.active(tick) {
+			d.write(flow)
+		}
+	}
+
+	if tick%d.cfg.RegionHeartbeatTicks == 0 {
+		for _, region := range d.raft.regions.GetRegions() {
+			if region.GetApproximateSize() > d.cfg.RegionSplitSize {
+				d.split(region)
+			}
+		}
+		for _, region := range d.raft.regions.GetRegions() {
+			d.regionHeartbeat(region)
+		}
+	}
+	if tick%d.cfg.StoreHeartbeatTicks == 0 {
+		for _, n := range d.raft.GetNodes() {
+			d.storeHeartbeat(n)
+		}
+	}
+}
+
+// Run runs the simulation until the cluster converges for StableTicks, or
+// MaxTicks is reached.
+func (d *Driver) Run() *Report {
+	ticker := time.NewTicker(d.cfg.TickInterval.Duration)
+	defer ticker.Stop()
+	var convergedTick int64
+	for d.raft.tick < d.cfg.MaxTicks {
+		select {
+		case <-d.ctx.Done():
+			return d.report(convergedTick)
+		case <-ticker.C:
+		}
+		d.Tick()
+		if !d.simCase.Checker(d.raft) {
+			convergedTick = 0
+			continue
+		}
+		if convergedTick == 0 {
+			convergedTick = d.raft.tick
+		}
+		if d.raft.tick-convergedTick >= d.cfg.StableTicks {
+			break
+		}
+	}
+	if d.raft.tick-convergedTick < d.cfg.StableTicks {
+		convergedTick = 0
+	}
+	return d.report(convergedTick)
+}
+
+// Close stops the simulated stores.
+func (d *Driver) Close() {
+	for _, n := range d.raft.nodes {
+		n.close()
+	}
+	if err := d.client.close(); err != nil {
+		log.Warn("failed to close client", zap.Error(err))
+	}
+}
+
+func (d *Driver) addNode(store *Store) error {
+	if _, ok := d.raft.nodes[store.ID]; ok {
+		return errors.Errorf("store %d already exists", store.ID)
+	}
+	n := newNode(store)
+	if err := d.client.putStore(d.ctx, n.meta); err != nil {
+		return err
+	}
+	if err := n.start(d.ctx, d.client); err != nil {
+		return err
+	}
+	d.raft.nodes[store.ID] = n
+	d.storeHeartbeat(n)
+	return nil
+}
+
+func (d *Driver) setNodeDown(storeID uint64, down bool) error {
+	n, ok := d.raft.nodes[storeID]
+	if !ok {
+		return errors.Errorf("store %d not found", storeID)
+	}
+	n.down = down
+	n.downTick = d.raft.tick
+	d.events.schedule(d.raft.tick+d.cfg.ElectionTicks, d.electLeaders)
+	return nil
+}
+
+// electLeaders elects the leaders of the regions whose leaders are down.
+func (d *Driver) electLeaders() {
+	for _, region := range d.raft.regions.GetRegions() {
+		if n := d.raft.nodes[region.GetLeader().GetStoreId()]; n != nil && !n.IsDown() {
+			continue
+		}
+		var candidates []*metapb.Peer
+		for _, p := range region.GetPeers() {
+			n := d.raft.nodes[p.GetStoreId()]
+			if n != nil && !n.IsDown() && region.GetPendingPeer(p.GetId()) == nil {
+				candidates = append(candidates, p)
+			}
+		}
+		// A leader can only be elected by the majority.
+		if len(candidates)*2 <= len(region.GetPeers()) {
+			continue
+		}
+		leader := candidates[d.rand.Intn(len(candidates))]
+		region = region.Clone(core.WithLeader(leader))
+		d.raft.setRegion(region)
+		d.steps["elect-leader"]++
+		d.regionHeartbeat(region)
+	}
+}
+
+func (d *Driver) handleResponse(resp *pdpb.RegionHeartbeatResponse) {
+	regionID := resp.GetRegionId()
+	region := d.raft.regions.GetRegion(regionID)
+	if region == nil {
+		return
+	}
+	if _, ok := d.raft.busy[regionID]; ok {
+		return
+	}
+	if tl := resp.GetTransferLeader(); tl != nil {
+		d.transferLeader(region, tl.GetPeer())
+		return
+	}
+	if cp := resp.GetChangePeer(); cp != nil {
+		switch cp.GetChangeType() {
+		case eraftpb.ConfChangeType_AddNode:
+			d.addPeer(region, cp.GetPeer())
+		case eraftpb.ConfChangeType_RemoveNode:
+			d.removePeer(region, cp.GetPeer())
+		}
+	}
+}
+
+func (d *Driver) transferLeader(region *core.RegionInfo, peer *metapb.Peer) {
+	storeID := peer.GetStoreId()
+	if region.GetStorePeer(storeID) == nil || region.GetLeader().GetStoreId() == storeID {
+		return
+	}
+	regionID := region.GetID()
+	d.raft.busy[regionID] = struct{}{}
+	d.events.schedule(d.raft.tick+d.cfg.LeaderTransferTicks, func() {
+		delete(d.raft.busy, regionID)
+		region := d.raft.regions.GetRegion(regionID)
+		if region == nil {
+			return
+		}
+		target := region.GetStorePeer(storeID)
+		if target == nil || region.GetPendingPeer(target.GetId()) != nil || d.raft.nodes[storeID].IsDown() {
+			return
+		}
+		region = region.Clone(core.WithLeader(target))
+		d.raft.setRegion(region)
+		d.steps["transfer-leader"]++
+		d.regionHeartbeat(region)
+	})
+}
+
+// addPeer adds the peer by a conf change in a tick, then the peer is pending
+// until the snapshot is sent and applied.
+func (d *Driver) addPeer(region *core.RegionInfo, peer *metapb.Peer) {
+	storeID := peer.GetStoreId()
+	target, ok := d.raft.nodes[storeID]
+	if !ok || target.IsDown() || region.GetStorePeer(storeID) != nil {
+		return
+	}
+	regionID := region.GetID()
+	d.raft.busy[regionID] = struct{}{}
+	d.events.schedule(d.raft.tick+1, func() {
+		delete(d.raft.busy, regionID)
+		region := d.raft.regions.GetRegion(regionID)
+		if region == nil || region.GetStorePeer(storeID) != nil {
+			return
+		}
+		leader := d.raft.nodes[region.GetLeader().GetStoreId()]
+		region = region.Clone(
+			core.WithAddPeer(peer),
+			core.WithIncConfVer(),
+			core.WithPendingPeers(append(region.GetPendingPeers(), peer)),
+		)
+		d.raft.setRegion(region)
+		d.regionHeartbeat(region)
+
+		leader.sendingSnap++
+		target.receivingSnap++
+		d.events.schedule(d.raft.tick+d.cfg.snapshotTicks(region.GetApproximateSize()), func() {
+			leader.sendingSnap--
+			target.receivingSnap--
+			region := d.raft.regions.GetRegion(regionID)
+			if region == nil || region.GetPendingPeer(peer.GetId()) == nil || target.IsDown() {
+				return
+			}
+			region = region.Clone(core.WithPendingPeers(removePendingPeer(region, peer.GetId())))
+			d.raft.setRegion(region)
+			d.steps["add-peer"]++
+			d.regionHeartbeat(region)
+		})
+	})
+}
+
+func (d *Driver) removePeer(region *core.RegionInfo, peer *metapb.Peer) {
+	storeID := peer.GetStoreId()
+	if region.GetStorePeer(storeID) == nil || region.GetLeader().GetStoreId() == storeID {
+		return
+	}
+	regionID := region.GetID()
+	d.raft.busy[regionID] = struct{}{}
+	d.events.schedule(d.raft.tick+1, func() {
+		delete(d.raft.busy, regionID)
+		region := d.raft.regions.GetRegion(regionID)
+		if region == nil {
+			return
+		}
+		p := region.GetStorePeer(storeID)
+		if p == nil || region.GetLeader().GetStoreId() == storeID {
+			return
+		}
+		region = region.Clone(
+			core.WithRemoveStorePeer(storeID),
+			core.WithIncConfVer(),
+			core.WithPendingPeers(removePendingPeer(region, p.GetId())),
+		)
+		d.raft.setRegion(region)
+		d.steps["remove-peer"]++
+		d.regionHeartbeat(region)
+	})
+}
+
+// write writes the flow of a tick in 1MB writes to the random keys.
+func (d *Driver) write(flow *WriteFlow) {
+	start := keyNumber(flow.StartKey, 0)
+	end := keyNumber(flow.EndKey, keySpace)
+	for i := int64(0); i < flow.SizePerTick; i++ {
+		key := Key(start + uint64(d.rand.Int63n(int64(end-start))))
+		region := d.raft.regions.SearchRegion(key)
+		if region == nil {
+			continue
+		}
+		region = region.Clone(
+			core.SetApproximateSize(region.GetApproximateSize()+1),
+			core.SetApproximateKeys(region.GetApproximateKeys()+defaultRegionKeys/defaultRegionSize),
+		)
+		d.raft.setRegion(region)
+	}
+}
+
+// split splits the region into halves, the new region takes the left half.
+// The regions with pending peers are not split, so the snapshots are not
+// split with them.
+func (d *Driver) split(region *core.RegionInfo) {
+	if _, ok := d.raft.busy[region.GetID()]; ok || len(region.GetPendingPeers()) != 0 {
+		return
+	}
+	if n := d.raft.nodes[region.GetLeader().GetStoreId()]; n == nil || n.IsDown() {
+		return
+	}
+	key := splitKey(region)
+	if key == nil {
+		return
+	}
+	ids, err := d.client.askSplit(d.ctx, region.GetMeta())
+	if err != nil {
+		log.Debug("failed to ask split", zap.Uint64("region-id", region.GetID()), zap.Error(err))
+		d.steps["split-rejected"]++
+		return
+	}
+	if len(ids.GetNewPeerIds()) != len(region.GetPeers()) {
+		log.Warn("split ids do not match the region", zap.Uint64("region-id", region.GetID()), zap.Reflect("ids", ids))
+		return
+	}
+
+	epoch := region.GetRegionEpoch()
+	left := &metapb.Region{
+		Id:          ids.GetNewRegionId(),
+		StartKey:    region.GetStartKey(),
+		EndKey:      key,
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: epoch.GetConfVer(), Version: epoch.GetVersion() + 1},
+	}
+	var leftLeader *metapb.Peer
+	for i, p := range region.GetPeers() {
+		peer := &metapb.Peer{Id: ids.GetNewPeerIds()[i], StoreId: p.GetStoreId()}
+		left.Peers = append(left.Peers, peer)
+		if p.GetId() == region.GetLeader().GetId() {
+			leftLeader = peer
+		}
+	}
+	size, keys := region.GetApproximateSize(), region.GetApproximateKeys()
+	right := region.Clone(
+		core.WithStartKey(key),
+		core.WithIncVersion(),
+		core.SetApproximateSize(size/2),
+		core.SetApproximateKeys(keys/2),
+	)
+	leftRegion := core.NewRegionInfo(left, leftLeader, core.SetApproximateSize(size-size/2), core.SetApproximateKeys(keys-keys/2))
+	d.raft.setRegion(right)
+	d.raft.setRegion(leftRegion)
+	d.steps["split"]++
+
+	d.regionHeartbeat(leftRegion)
+	d.regionHeartbeat(right)
+}
+
+// regionHeartbeat sends the heartbeat of the region from its leader, the
+// peers on the down stores are reported as down peers.
+func (d *Driver) regionHeartbeat(region *core.RegionInfo) {
+	n := d.raft.nodes[region.GetLeader().GetStoreId()]
+	if n == nil || n.IsDown() {
+		return
+	}
+	var downPeers []*pdpb.PeerStats
+	for _, p := range region.GetPeers() {
+		if peerNode := d.raft.nodes[p.GetStoreId()]; peerNode != nil && peerNode.IsDown() {
+			downTime := time.Duration(d.raft.tick-peerNode.downTick) * d.cfg.TickInterval.Duration
+			downPeers = append(downPeers, &pdpb.PeerStats{Peer: p, DownSeconds: uint64(downTime.Seconds())})
+		}
+	}
+	req := &pdpb.RegionHeartbeatRequest{
+		Header:          d.client.header(),
+		Region:          region.GetMeta(),
+		Leader:          region.GetLeader(),
+		DownPeers:       downPeers,
+		PendingPeers:    region.GetPendingPeers(),
+		ApproximateSize: uint64(region.GetApproximateSize()) << 20,
+		ApproximateKeys: uint64(region.GetApproximateKeys()),
+	}
+	if err := n.stream.Send(req); err != nil {
+		log.Warn("failed to send region heartbeat", zap.Uint64("region-id", region.GetID()), zap.Error(err))
+	}
+}
+
+func (d *Driver) storeHeartbeat(n *Node) {
+	if n.IsDown() {
+		return
+	}
+	storeID := n.GetID()
+	used := d.raft.GetStoreRegionSize(storeID)
+	available := n.capacity - used
+	if available < 0 {
+		available = 0
+	}
+	stats := &pdpb.StoreStats{
+		StoreId:            storeID,
+		Capacity:           uint64(n.capacity) << 20,
+		Available:          uint64(available) << 20,
+		UsedSize:           uint64(used) << 20,
+		RegionCount:        uint32(d.raft.GetStoreRegionCount(storeID)),
+		SendingSnapCount:   n.sendingSnap,
+		ReceivingSnapCount: n.receivingSnap,
+		StartTime:          uint32(n.startTime.Unix()),
+	}
+	if err := d.client.storeHeartbeat(d.ctx, stats); err != nil {
+		log.Warn("failed to send store heartbeat", zap.Uint64("store-id", storeID), zap.Error(err))
+	}
+}
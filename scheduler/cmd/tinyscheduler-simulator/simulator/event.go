@@ -0,0 +1,69 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"container/heap"
+)
+
+// event is an action happening at a tick of the simulation.
+type event struct {
+	tick int64
+	// seq keeps the events at the same tick in the order they are scheduled.
+	seq uint64
+	do  func()
+}
+
+type eventHeap []*event
+
+func (h eventHeap) Len() int { return len(h) }
+
+func (h eventHeap) Less(i, j int) bool {
+	if h[i].tick != h[j].tick {
+		return h[i].tick < h[j].tick
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h eventHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *eventHeap) Push(x interface{}) { *h = append(*h, x.(*event)) }
+
+func (h *eventHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// eventQueue runs the events in the order of their ticks.
+type eventQueue struct {
+	events eventHeap
+	seq    uint64
+}
+
+// schedule adds the event to run at the tick.
+func (q *eventQueue) schedule(tick int64, do func()) {
+	q.seq++
+	heap.Push(&q.events, &event{tick: tick, seq: q.seq, do: do})
+}
+
+// runUntil runs the events up to the tick, including the events scheduled
+// by them for the tick.
+func (q *eventQueue) runUntil(tick int64) {
+	for len(q.events) > 0 && q.events[0].tick <= tick {
+		heap.Pop(&q.events).(*event).do()
+	}
+}
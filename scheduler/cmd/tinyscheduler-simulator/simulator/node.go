@@ -0,0 +1,120 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const nodeResponseChanCap = 1024
+
+// Node is a simulated store. It sends the heartbeats of the store and of the
+// regions it leads, and receives the scheduling commands of the regions.
+type Node struct {
+	meta *metapb.Store
+	// capacity is the disk capacity in MB.
+	capacity int64
+	down     bool
+	// downTick is the tick the store went down at.
+	downTick  int64
+	startTime time.Time
+
+	sendingSnap   uint32
+	receivingSnap uint32
+
+	stream    pdpb.PD_RegionHeartbeatClient
+	responses chan *pdpb.RegionHeartbeatResponse
+}
+
+func newNode(store *Store) *Node {
+	return &Node{
+		meta: &metapb.Store{
+			Id:      store.ID,
+			Address: fmt.Sprintf("mock://tinykv-%d", store.ID),
+		},
+		capacity:  store.Capacity,
+		startTime: time.Now(),
+		responses: make(chan *pdpb.RegionHeartbeatResponse, nodeResponseChanCap),
+	}
+}
+
+// start opens the region heartbeat stream, the scheduler only accepts it
+// once the cluster is bootstrapped.
+func (n *Node) start(ctx context.Context, c *client) error {
+	stream, err := c.regionHeartbeatStream(ctx)
+	if err != nil {
+		return err
+	}
+	n.stream = stream
+	go n.receive()
+	return nil
+}
+
+// GetID returns the ID of the store.
+func (n *Node) GetID() uint64 {
+	return n.meta.GetId()
+}
+
+// IsDown returns true if the store is down.
+func (n *Node) IsDown() bool {
+	return n.down
+}
+
+func (n *Node) receive() {
+	for {
+		resp, err := n.stream.Recv()
+		if err != nil {
+			log.Debug("region heartbeat stream is closed", zap.Uint64("store-id", n.GetID()), zap.Error(err))
+			close(n.responses)
+			return
+		}
+		select {
+		case n.responses <- resp:
+		default:
+			log.Warn("drop region heartbeat response", zap.Uint64("store-id", n.GetID()), zap.Uint64("region-id", resp.GetRegionId()))
+		}
+	}
+}
+
+// drainResponses returns the responses received since the last call.
+func (n *Node) drainResponses() []*pdpb.RegionHeartbeatResponse {
+	var resps []*pdpb.RegionHeartbeatResponse
+	for {
+		select {
+		case resp, ok := <-n.responses:
+			if !ok {
+				return resps
+			}
+			resps = append(resps, resp)
+		default:
+			return resps
+		}
+	}
+}
+
+func (n *Node) close() {
+	if n.stream == nil {
+		return
+	}
+	if err := n.stream.CloseSend(); err != nil {
+		log.Debug("failed to close region heartbeat stream", zap.Uint64("store-id", n.GetID()), zap.Error(err))
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+)
+
+// keySpace is the number of the keys of the simulated cluster, the keys are
+// the numbers in [0, keySpace) formatted by Key.
+const keySpace = 1000000000000
+
+// Key returns the n-th key of the key space.
+func Key(n uint64) []byte {
+	return []byte(fmt.Sprintf("k%012d", n))
+}
+
+// keyNumber returns the number of the key, or def if the key is empty.
+func keyNumber(key []byte, def uint64) uint64 {
+	if len(key) == 0 {
+		return def
+	}
+	n, err := strconv.ParseUint(string(key[1:]), 10, 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// splitKey returns the key in the middle of the region, or nil if the region
+// cannot be split.
+func splitKey(region *core.RegionInfo) []byte {
+	start := keyNumber(region.GetStartKey(), 0)
+	end := keyNumber(region.GetEndKey(), keySpace)
+	mid := start + (end-start)/2
+	if mid == start {
+		return nil
+	}
+	return Key(mid)
+}
+
+// RaftEngine keeps the actual state of the regions and stores of the
+// simulated cluster, which the scheduler only learns from the heartbeats.
+type RaftEngine struct {
+	regions *core.RegionsInfo
+	nodes   map[uint64]*Node
+	tick    int64
+	// busy holds the regions which are applying a command, a region applies
+	// one command at a time.
+	busy map[uint64]struct{}
+}
+
+func newRaftEngine() *RaftEngine {
+	return &RaftEngine{
+		regions: core.NewRegionsInfo(),
+		nodes:   make(map[uint64]*Node),
+		busy:    make(map[uint64]struct{}),
+	}
+}
+
+// GetTick returns the current tick.
+func (r *RaftEngine) GetTick() int64 {
+	return r.tick
+}
+
+// GetRegions returns all regions.
+func (r *RaftEngine) GetRegions() []*core.RegionInfo {
+	return r.regions.GetRegions()
+}
+
+// GetNodes returns the stores ordered by ID.
+func (r *RaftEngine) GetNodes() []*Node {
+	nodes := make([]*Node, 0, len(r.nodes))
+	for _, n := range r.nodes {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GetID() < nodes[j].GetID() })
+	return nodes
+}
+
+// GetUpNodes returns the stores which are not down, ordered by ID.
+func (r *RaftEngine) GetUpNodes() []*Node {
+	var nodes []*Node
+	for _, n := range r.GetNodes() {
+		if !n.IsDown() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// GetStoreRegionCount returns the number of the peers on the store.
+func (r *RaftEngine) GetStoreRegionCount(storeID uint64) int {
+	return r.regions.GetStoreRegionCount(storeID)
+}
+
+// GetStoreLeaderCount returns the number of the leaders on the store.
+func (r *RaftEngine) GetStoreLeaderCount(storeID uint64) int {
+	return r.regions.GetStoreLeaderCount(storeID)
+}
+
+// GetStoreRegionSize returns the size of the peers on the store in MB.
+func (r *RaftEngine) GetStoreRegionSize(storeID uint64) int64 {
+	return r.regions.GetStoreRegionSize(storeID)
+}
+
+// IsReplicated returns true if every region has at least the replicas
+// which are up to date on the up stores. The regions being moved by the
+// balance schedulers have extra replicas for a while, which is fine.
+func (r *RaftEngine) IsReplicated(replicas int) bool {
+	for _, region := range r.regions.GetRegions() {
+		var healthy int
+		for _, p := range region.GetPeers() {
+			if n := r.nodes[p.GetStoreId()]; n != nil && !n.IsDown() && region.GetPendingPeer(p.GetId()) == nil {
+				healthy++
+			}
+		}
+		if healthy < replicas {
+			return false
+		}
+	}
+	return true
+}
+
+// isUniform returns true if all values are within the tolerance ratio of
+// their average.
+func isUniform(values []int64, tolerance float64) bool {
+	if len(values) == 0 {
+		return true
+	}
+	var sum int64
+	for _, v := range values {
+		sum += v
+	}
+	avg := float64(sum) / float64(len(values))
+	for _, v := range values {
+		if float64(v) > avg*(1+tolerance) || float64(v) < avg*(1-tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsRegionBalanced returns true if the region counts of the up stores are
+// within the tolerance ratio of the average.
+func (r *RaftEngine) IsRegionBalanced(tolerance float64) bool {
+	var counts []int64
+	for _, n := range r.GetUpNodes() {
+		counts = append(counts, int64(r.GetStoreRegionCount(n.GetID())))
+	}
+	return isUniform(counts, tolerance)
+}
+
+// IsLeaderBalanced returns true if the leader counts of the up stores are
+// within the tolerance ratio of the average.
+func (r *RaftEngine) IsLeaderBalanced(tolerance float64) bool {
+	var counts []int64
+	for _, n := range r.GetUpNodes() {
+		counts = append(counts, int64(r.GetStoreLeaderCount(n.GetID())))
+	}
+	return isUniform(counts, tolerance)
+}
+
+// IsSizeBalanced returns true if the region sizes of the up stores are
+// within the tolerance ratio of the average.
+func (r *RaftEngine) IsSizeBalanced(tolerance float64) bool {
+	var sizes []int64
+	for _, n := range r.GetUpNodes() {
+		sizes = append(sizes, r.GetStoreRegionSize(n.GetID()))
+	}
+	return isUniform(sizes, tolerance)
+}
+
+func (r *RaftEngine) setRegion(region *core.RegionInfo) {
+	r.regions.SetRegion(region)
+}
+
+// removePendingPeer returns the pending peers of the region without the peer.
+func removePendingPeer(region *core.RegionInfo, peerID uint64) []*metapb.Peer {
+	var pending []*metapb.Peer
+	for _, p := range region.GetPendingPeers() {
+		if p.GetId() != peerID {
+			pending = append(pending, p)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,170 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+)
+
+// StoreReport is the final state of a store.
+type StoreReport struct {
+	ID          uint64
+	Down        bool
+	RegionCount int
+	LeaderCount int
+	// RegionSize is the total size of the regions in MB.
+	RegionSize int64
+	UsedRatio  float64
+}
+
+// Report is the result of running a case.
+type Report struct {
+	Case string
+	// Ticks is the number of ticks run.
+	Ticks int64
+	// ConvergedTick is the tick since which the cluster stays converged, it
+	// is 0 if the cluster does not converge.
+	ConvergedTick int64
+	TickInterval  time.Duration
+	// Steps counts the commands applied by the stores.
+	Steps map[string]int
+	// Operators counts the finished operators by the creator, and by the
+	// status with the reason of the failure.
+	Operators map[string]map[string]int
+	Stores    []StoreReport
+}
+
+func (d *Driver) report(convergedTick int64) *Report {
+	r := &Report{
+		Case:          d.simCase.Name,
+		Ticks:         d.raft.tick,
+		ConvergedTick: convergedTick,
+		TickInterval:  d.cfg.TickInterval.Duration,
+		Steps:         d.steps,
+		Operators:     make(map[string]map[string]int),
+	}
+	for _, n := range d.raft.GetNodes() {
+		id := n.GetID()
+		size := d.raft.GetStoreRegionSize(id)
+		r.Stores = append(r.Stores, StoreReport{
+			ID:          id,
+			Down:        n.IsDown(),
+			RegionCount: d.raft.GetStoreRegionCount(id),
+			LeaderCount: d.raft.GetStoreLeaderCount(id),
+			RegionSize:  size,
+			UsedRatio:   float64(size) / float64(n.capacity),
+		})
+	}
+	return r
+}
+
+// AddOperatorRecords counts the finished operators of the scheduler.
+func (r *Report) AddOperatorRecords(records []*pdpb.OperatorRecord) {
+	for _, record := range records {
+		statuses, ok := r.Operators[record.GetDesc()]
+		if !ok {
+			statuses = make(map[string]int)
+			r.Operators[record.GetDesc()] = statuses
+		}
+		status := record.GetStatus().String()
+		if record.GetReason() != "" {
+			status = fmt.Sprintf("%s (%s)", status, record.GetReason())
+		}
+		statuses[status]++
+	}
+}
+
+// Print writes the report in a readable form.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "case:\t%s\n", r.Case)
+	fmt.Fprintf(tw, "ticks:\t%d (%v)\n", r.Ticks, time.Duration(r.Ticks)*r.TickInterval)
+	if r.ConvergedTick > 0 {
+		fmt.Fprintf(tw, "converged:\ttick %d (%v)\n", r.ConvergedTick, time.Duration(r.ConvergedTick)*r.TickInterval)
+	} else {
+		fmt.Fprintf(tw, "converged:\tno\n")
+	}
+
+	fmt.Fprintf(tw, "\nSTEP\tCOUNT\n")
+	for _, step := range sortedKeys(r.Steps) {
+		fmt.Fprintf(tw, "%s\t%d\n", step, r.Steps[step])
+	}
+
+	fmt.Fprintf(tw, "\nOPERATOR\tSTATUS\tCOUNT\n")
+	descs := make([]string, 0, len(r.Operators))
+	for desc := range r.Operators {
+		descs = append(descs, desc)
+	}
+	sort.Strings(descs)
+	for _, desc := range descs {
+		for _, status := range sortedKeys(r.Operators[desc]) {
+			fmt.Fprintf(tw, "%s\t%s\t%d\n", desc, status, r.Operators[desc][status])
+		}
+	}
+
+	fmt.Fprintf(tw, "\nSTORE\tDOWN\tREGIONS\tLEADERS\tSIZE(MB)\tUSED\n")
+	var regions, leaders []int64
+	for _, s := range r.Stores {
+		fmt.Fprintf(tw, "%d\t%v\t%d\t%d\t%d\t%.2f%%\n", s.ID, s.Down, s.RegionCount, s.LeaderCount, s.RegionSize, s.UsedRatio*100)
+		if !s.Down {
+			regions = append(regions, int64(s.RegionCount))
+			leaders = append(leaders, int64(s.LeaderCount))
+		}
+	}
+	fmt.Fprintf(tw, "\nBALANCE\tMIN\tMAX\tSTDDEV\n")
+	min, max, stddev := stats(regions)
+	fmt.Fprintf(tw, "regions\t%d\t%d\t%.2f\n", min, max, stddev)
+	min, max, stddev = stats(leaders)
+	fmt.Fprintf(tw, "leaders\t%d\t%d\t%.2f\n", min, max, stddev)
+	return tw.Flush()
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stats(values []int64) (min, max int64, stddev float64) {
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+	min, max = values[0], values[0]
+	var sum float64
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		sum += float64(v)
+	}
+	mean := sum / float64(len(values))
+	var variance float64
+	for _, v := range values {
+		variance += (float64(v) - mean) * (float64(v) - mean)
+	}
+	return min, max, math.Sqrt(variance / float64(len(values)))
+}
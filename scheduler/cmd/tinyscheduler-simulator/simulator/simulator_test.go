@@ -0,0 +1,88 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testSimulatorSuite{})
+
+type testSimulatorSuite struct{}
+
+func (s *testSimulatorSuite) TestEventQueue(c *C) {
+	var q eventQueue
+	var order []int
+	q.schedule(2, func() { order = append(order, 3) })
+	q.schedule(1, func() {
+		order = append(order, 1)
+		q.schedule(1, func() { order = append(order, 2) })
+	})
+	q.schedule(3, func() { order = append(order, 4) })
+
+	q.runUntil(0)
+	c.Assert(order, HasLen, 0)
+	q.runUntil(2)
+	c.Assert(order, DeepEquals, []int{1, 2, 3})
+	q.runUntil(3)
+	c.Assert(order, DeepEquals, []int{1, 2, 3, 4})
+}
+
+func (s *testSimulatorSuite) TestSplitKey(c *C) {
+	c.Assert(keyNumber(Key(42), 0), Equals, uint64(42))
+	c.Assert(keyNumber(nil, keySpace), Equals, uint64(keySpace))
+
+	region := core.NewRegionInfo(&metapb.Region{Id: 1}, nil)
+	c.Assert(splitKey(region), DeepEquals, Key(keySpace/2))
+	region = core.NewRegionInfo(&metapb.Region{Id: 1, StartKey: Key(10), EndKey: Key(20)}, nil)
+	c.Assert(splitKey(region), DeepEquals, Key(15))
+	region = core.NewRegionInfo(&metapb.Region{Id: 1, StartKey: Key(10), EndKey: Key(11)}, nil)
+	c.Assert(splitKey(region), IsNil)
+}
+
+func (s *testSimulatorSuite) TestIsUniform(c *C) {
+	c.Assert(isUniform(nil, 0), IsTrue)
+	c.Assert(isUniform([]int64{100, 100, 100}, 0), IsTrue)
+	c.Assert(isUniform([]int64{96, 100, 104}, 0.05), IsTrue)
+	c.Assert(isUniform([]int64{90, 100, 110}, 0.05), IsFalse)
+}
+
+func (s *testSimulatorSuite) TestConfig(c *C) {
+	cfg, err := NewConfig("")
+	c.Assert(err, IsNil)
+	c.Assert(cfg.TickInterval.Duration, Equals, 100*time.Millisecond)
+	c.Assert(cfg.RegionHeartbeatTicks, Equals, int64(defaultRegionHeartbeatTicks))
+	c.Assert(cfg.snapshotTicks(96), Equals, int64(3))
+}
+
+func (s *testSimulatorSuite) TestCases(c *C) {
+	for _, name := range CaseNames() {
+		simCase, err := NewCase(name)
+		c.Assert(err, IsNil)
+		c.Assert(simCase.Name, Equals, name)
+		c.Assert(simCase.Stores, Not(HasLen), 0)
+		c.Assert(simCase.Regions, Not(HasLen), 0)
+	}
+	_, err := NewCase("none")
+	c.Assert(err, NotNil)
+}
@@ -13,6 +13,30 @@
 
 package apiutil
 
+import (
+	"net/http"
+
+	"github.com/pingcap/errcode"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+// ErrorResp responds to the client about the given error, integrating with errcode.ErrorCode.
+func ErrorResp(rd *render.Render, w http.ResponseWriter, err error) {
+	if err == nil {
+		log.Error("nil is given to errorResp")
+		rd.JSON(w, http.StatusInternalServerError, "nil error")
+		return
+	}
+	if errCode := errcode.CodeChain(err); errCode != nil {
+		w.Header().Set("TiDB-Error-Code", errCode.Code().CodeStr().String())
+		rd.JSON(w, errCode.Code().HTTPCode(), errcode.NewJSONFormat(errCode))
+	} else {
+		rd.JSON(w, http.StatusInternalServerError, errors.Cause(err).Error())
+	}
+}
+
 // JSONError lets callers check for just one error type
 type JSONError struct {
 	Err error
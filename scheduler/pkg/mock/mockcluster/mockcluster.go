@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockid"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 )
@@ -32,7 +34,8 @@ type Cluster struct {
 	*core.BasicCluster
 	*mockid.IDAllocator
 	*mockoption.ScheduleOptions
-	ID uint64
+	RegionLabeler *labeler.RegionLabeler
+	ID            uint64
 }
 
 // NewCluster creates a new Cluster
@@ -41,9 +44,15 @@ func NewCluster(opt *mockoption.ScheduleOptions) *Cluster {
 		BasicCluster:    core.NewBasicCluster(),
 		IDAllocator:     mockid.NewIDAllocator(),
 		ScheduleOptions: opt,
+		RegionLabeler:   labeler.NewRegionLabeler(core.NewStorage(kv.NewMemoryKV())),
 	}
 }
 
+// GetRegionLabeler returns the labeler of the key ranges.
+func (mc *Cluster) GetRegionLabeler() *labeler.RegionLabeler {
+	return mc.RegionLabeler
+}
+
 func (mc *Cluster) allocID() (uint64, error) {
 	return mc.Alloc()
 }
@@ -15,6 +15,9 @@ package mockoption
 
 import (
 	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
 )
 
 const (
@@ -27,6 +30,11 @@ const (
 	defaultLeaderScheduleLimit  = 4
 	defaultRegionScheduleLimit  = 64
 	defaultReplicaScheduleLimit = 64
+	// defaultStoreBalanceRate is large enough to not limit the operators in tests.
+	defaultStoreBalanceRate          = 60 * 1024
+	defaultHighSpaceRatio            = 0.7
+	defaultLowSpaceRatio             = 0.8
+	defaultRegionScoreFormulaVersion = core.RegionScoreFormulaV2
 )
 
 // ScheduleOptions is a mock of ScheduleOptions
@@ -41,6 +49,11 @@ type ScheduleOptions struct {
 	MaxMergeRegionKeys   uint64
 	MaxStoreDownTime     time.Duration
 	MaxReplicas          int
+	StoreBalanceRate     float64
+	HighSpaceRatio       float64
+	LowSpaceRatio        float64
+	// RegionScoreFormulaVersion is the formula of the region score, v1 or v2.
+	RegionScoreFormulaVersion string
 }
 
 // NewScheduleOptions creates a mock schedule option.
@@ -55,6 +68,10 @@ func NewScheduleOptions() *ScheduleOptions {
 	mso.MaxStoreDownTime = defaultMaxStoreDownTime
 	mso.MaxReplicas = defaultMaxReplicas
 	mso.MaxPendingPeerCount = defaultMaxPendingPeerCount
+	mso.StoreBalanceRate = defaultStoreBalanceRate
+	mso.HighSpaceRatio = defaultHighSpaceRatio
+	mso.LowSpaceRatio = defaultLowSpaceRatio
+	mso.RegionScoreFormulaVersion = defaultRegionScoreFormulaVersion
 	return mso
 }
 
@@ -73,6 +90,11 @@ func (mso *ScheduleOptions) GetReplicaScheduleLimit() uint64 {
 	return mso.ReplicaScheduleLimit
 }
 
+// GetStoreLimitByType mocks method
+func (mso *ScheduleOptions) GetStoreLimitByType(storeID uint64, typ storelimit.Type) float64 {
+	return mso.StoreBalanceRate
+}
+
 // GetMaxMergeRegionSize mocks method
 func (mso *ScheduleOptions) GetMaxMergeRegionSize() uint64 {
 	return mso.MaxMergeRegionSize
@@ -88,6 +110,21 @@ func (mso *ScheduleOptions) GetMaxStoreDownTime() time.Duration {
 	return mso.MaxStoreDownTime
 }
 
+// GetHighSpaceRatio mocks method
+func (mso *ScheduleOptions) GetHighSpaceRatio() float64 {
+	return mso.HighSpaceRatio
+}
+
+// GetLowSpaceRatio mocks method
+func (mso *ScheduleOptions) GetLowSpaceRatio() float64 {
+	return mso.LowSpaceRatio
+}
+
+// GetRegionScoreFormulaVersion mocks method
+func (mso *ScheduleOptions) GetRegionScoreFormulaVersion() string {
+	return mso.RegionScoreFormulaVersion
+}
+
 // GetMaxReplicas mocks method
 func (mso *ScheduleOptions) GetMaxReplicas() int {
 	return mso.MaxReplicas
@@ -0,0 +1,77 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/unrolled/render"
+)
+
+type confHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newConfHandler(svr *server.Server, rd *render.Render) *confHandler {
+	return &confHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+func (h *confHandler) Get(w http.ResponseWriter, r *http.Request) {
+	h.rd.JSON(w, http.StatusOK, h.svr.GetConfig())
+}
+
+func (h *confHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	h.rd.JSON(w, http.StatusOK, h.svr.GetScheduleConfig())
+}
+
+// SetSchedule updates the schedule config with the options in the body, e.g.
+// {"leader-schedule-limit": 8}. Options which are not given keep their values.
+func (h *confHandler) SetSchedule(w http.ResponseWriter, r *http.Request) {
+	config := h.svr.GetScheduleConfig()
+	if err := readJSON(r.Body, config); err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svr.SetScheduleConfig(*config); err != nil {
+		h.rd.JSON(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+func (h *confHandler) GetReplication(w http.ResponseWriter, r *http.Request) {
+	h.rd.JSON(w, http.StatusOK, h.svr.GetReplicationConfig())
+}
+
+// SetReplication updates the replication config with the options in the body,
+// e.g. {"max-replicas": 5}.
+func (h *confHandler) SetReplication(w http.ResponseWriter, r *http.Request) {
+	config := h.svr.GetReplicationConfig()
+	if err := readJSON(r.Body, config); err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svr.SetReplicationConfig(*config); err != nil {
+		h.rd.JSON(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.rd.JSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,103 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testConfigSuite{})
+
+type testConfigSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testConfigSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+}
+
+func (s *testConfigSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func (s *testConfigSuite) TestConfigSchedule(c *C) {
+	url := apiURL(s.svr, "/config/schedule")
+	sc := &config.ScheduleConfig{}
+	c.Assert(readJSONWithURL(url, sc), IsNil)
+
+	sc.LeaderScheduleLimit = sc.LeaderScheduleLimit + 1
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"leader-schedule-limit": sc.LeaderScheduleLimit,
+	}), Equals, http.StatusOK)
+
+	sc1 := &config.ScheduleConfig{}
+	c.Assert(readJSONWithURL(url, sc1), IsNil)
+	c.Assert(sc1, DeepEquals, sc)
+
+	// The config is persisted and survives a reload.
+	c.Assert(s.svr.GetRaftCluster(), IsNil)
+	opt := config.NewScheduleOption(config.NewConfig())
+	c.Assert(opt.Reload(s.svr.GetStorage()), IsNil)
+	c.Assert(opt.GetLeaderScheduleLimit(), Equals, sc.LeaderScheduleLimit)
+
+	// Unknown schedulers are rejected.
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"schedulers-v2": []map[string]string{{"type": "no-such-scheduler"}},
+	}), Equals, http.StatusInternalServerError)
+	c.Assert(s.svr.GetScheduleConfig(), DeepEquals, sc)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"store-balance-rate": 0,
+	}), Equals, http.StatusInternalServerError)
+	c.Assert(s.svr.GetScheduleConfig(), DeepEquals, sc)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"high-space-ratio": 0.9,
+		"low-space-ratio":  0.8,
+	}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"region-score-formula-version": "v3",
+	}), Equals, http.StatusInternalServerError)
+	c.Assert(s.svr.GetScheduleConfig(), DeepEquals, sc)
+
+	// The region score formula is tunable.
+	c.Assert(sc.RegionScoreFormulaVersion, Equals, "v2")
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{
+		"region-score-formula-version": "v1",
+		"low-space-ratio":              0.9,
+	}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url, sc), IsNil)
+	c.Assert(sc.RegionScoreFormulaVersion, Equals, "v1")
+	c.Assert(sc.LowSpaceRatio, Equals, 0.9)
+}
+
+func (s *testConfigSuite) TestConfigReplication(c *C) {
+	url := apiURL(s.svr, "/config/replicate")
+	rc := &config.ReplicationConfig{}
+	c.Assert(readJSONWithURL(url, rc), IsNil)
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"max-replicas": 5}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url, rc), IsNil)
+	c.Assert(rc.MaxReplicas, Equals, uint64(5))
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"max-replicas": 0}), Equals, http.StatusInternalServerError)
+	c.Assert(s.svr.GetReplicationConfig().MaxReplicas, Equals, uint64(5))
+
+	cfg := &config.Config{}
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/config"), cfg), IsNil)
+	c.Assert(cfg.Replication.MaxReplicas, Equals, uint64(5))
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/apiutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+type labelHandler struct {
+	*server.Handler
+	r *render.Render
+}
+
+func newLabelHandler(handler *server.Handler, r *render.Render) *labelHandler {
+	return &labelHandler{
+		Handler: handler,
+		r:       r,
+	}
+}
+
+// List returns the label rules of the key ranges which are not expired.
+func (h *labelHandler) List(w http.ResponseWriter, r *http.Request) {
+	l, err := h.GetRegionLabeler()
+	if err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	h.r.JSON(w, http.StatusOK, l.GetAllLabelRules())
+}
+
+func (h *labelHandler) Get(w http.ResponseWriter, r *http.Request) {
+	l, err := h.GetRegionLabeler()
+	if err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	id := mux.Vars(r)["id"]
+	rule := l.GetLabelRule(id)
+	if rule == nil {
+		h.r.JSON(w, http.StatusNotFound, labeler.ErrLabelRuleNotFound.Error())
+		return
+	}
+	h.r.JSON(w, http.StatusOK, rule)
+}
+
+// Post sets a label rule, e.g. {"id": "import", "start_key": "7480",
+// "end_key": "7490", "labels": ["no-balance", "no-split"], "ttl": "1h"}. The
+// keys are hex encoded, the rule with the same id is replaced.
+func (h *labelHandler) Post(w http.ResponseWriter, r *http.Request) {
+	l, err := h.GetRegionLabeler()
+	if err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	rule := &labeler.LabelRule{}
+	if err := readJSON(r.Body, rule); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := l.SetLabelRule(rule); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	h.r.JSON(w, http.StatusOK, nil)
+}
+
+func (h *labelHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	l, err := h.GetRegionLabeler()
+	if err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	if err := l.DeleteLabelRule(mux.Vars(r)["id"]); err != nil {
+		if errors.Cause(err) == labeler.ErrLabelRuleNotFound {
+			h.r.JSON(w, http.StatusNotFound, err.Error())
+			return
+		}
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	h.r.JSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testLabelSuite{})
+
+type testLabelSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testLabelSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+	mustBootstrapCluster(c, s.svr, newTestStores(3)...)
+}
+
+func (s *testLabelSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func (s *testLabelSuite) TestLabelRules(c *C) {
+	url := apiURL(s.svr, "/schedule/labels")
+	var rules []*labeler.LabelRule
+	c.Assert(readJSONWithURL(url, &rules), IsNil)
+	c.Assert(rules, HasLen, 0)
+
+	for _, rule := range []map[string]interface{}{
+		{"id": "", "labels": []string{"no-balance"}},
+		{"id": "a", "labels": []string{}},
+		{"id": "a", "labels": []string{"no-schedule"}},
+		{"id": "a", "start_key": "zz", "labels": []string{"no-balance"}},
+		{"id": "a", "start_key": "02", "end_key": "01", "labels": []string{"no-balance"}},
+		{"id": "a", "labels": []string{"no-balance"}, "ttl": "-1s"},
+	} {
+		c.Assert(doRequest(c, http.MethodPost, url, rule), Equals, http.StatusBadRequest)
+	}
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"id": "import", "start_key": "01", "end_key": "02", "labels": []string{"no-balance", "no-split"}, "ttl": "1h"}), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"id": "ddl", "start_key": "03", "labels": []string{"no-merge"}}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url, &rules), IsNil)
+	c.Assert(rules, HasLen, 2)
+	c.Assert(rules[0].ID, Equals, "ddl")
+	c.Assert(rules[0].ExpireTime, IsNil)
+	c.Assert(rules[1].ID, Equals, "import")
+	c.Assert(rules[1].Labels, DeepEquals, []labeler.Label{labeler.NoBalance, labeler.NoSplit})
+	c.Assert(rules[1].ExpireTime, NotNil)
+
+	var rule labeler.LabelRule
+	c.Assert(readJSONWithURL(url+"/import", &rule), IsNil)
+	c.Assert(rule.StartKey, Equals, "01")
+	c.Assert(doRequest(c, http.MethodGet, url+"/none", nil), Equals, http.StatusNotFound)
+
+	c.Assert(doRequest(c, http.MethodDelete, url+"/import", nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/import", nil), Equals, http.StatusNotFound)
+	c.Assert(readJSONWithURL(url, &rules), IsNil)
+	c.Assert(rules, HasLen, 1)
+}
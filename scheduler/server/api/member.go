@@ -0,0 +1,64 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/apiutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/unrolled/render"
+)
+
+// MembersInfo is the members of the cluster together with the current
+// leader and the etcd leader.
+type MembersInfo struct {
+	Members    []*pdpb.Member `json:"members"`
+	Leader     *pdpb.Member   `json:"leader,omitempty"`
+	EtcdLeader *pdpb.Member   `json:"etcd_leader,omitempty"`
+}
+
+type memberHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newMemberHandler(svr *server.Server, rd *render.Render) *memberHandler {
+	return &memberHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+func (h *memberHandler) List(w http.ResponseWriter, r *http.Request) {
+	members, err := server.GetMembers(h.svr.GetClient())
+	if err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	info := &MembersInfo{
+		Members: members,
+		Leader:  h.svr.GetLeader(),
+	}
+	etcdLeaderID := h.svr.GetMember().GetEtcdLeader()
+	for _, m := range members {
+		if m.GetMemberId() == etcdLeaderID {
+			info.EtcdLeader = m
+			break
+		}
+	}
+	h.rd.JSON(w, http.StatusOK, info)
+}
@@ -0,0 +1,206 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/apiutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+type operatorHandler struct {
+	*server.Handler
+	r *render.Render
+}
+
+func newOperatorHandler(handler *server.Handler, r *render.Render) *operatorHandler {
+	return &operatorHandler{
+		Handler: handler,
+		r:       r,
+	}
+}
+
+func (h *operatorHandler) Get(w http.ResponseWriter, r *http.Request) {
+	regionID, err := parseUint64Var(r, "region_id")
+	if err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	op, err := h.GetOperatorStatus(regionID)
+	if err != nil {
+		h.respondError(w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, op)
+}
+
+// List lists the running operators, filtered by the kind query parameter
+// (e.g. admin, leader or region) if it is given.
+func (h *operatorHandler) List(w http.ResponseWriter, r *http.Request) {
+	var (
+		results []*operator.Operator
+		err     error
+	)
+
+	kind := r.URL.Query().Get("kind")
+	if kind == "" {
+		results, err = h.GetOperators()
+	} else {
+		var mask operator.OpKind
+		if mask, err = operator.ParseOperatorKind(kind); err != nil {
+			h.r.JSON(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		results, err = h.GetOperatorsOfKind(mask)
+	}
+	if err != nil {
+		h.respondError(w, err)
+		return
+	}
+	if results == nil {
+		results = []*operator.Operator{}
+	}
+	h.r.JSON(w, http.StatusOK, results)
+}
+
+// History lists the records of the finished operators in the order of finish
+// time. The records can be filtered by the region_id, store_id, and the time
+// window [start_time, end_time) of the finish time in unix nanoseconds.
+func (h *operatorHandler) History(w http.ResponseWriter, r *http.Request) {
+	var (
+		f   schedule.OperatorHistoryFilter
+		err error
+	)
+	if f.RegionID, err = parseUint64Query(r, "region_id"); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if f.StoreID, err = parseUint64Query(r, "store_id"); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if f.StartTime, err = parseInt64Query(r, "start_time"); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if f.EndTime, err = parseInt64Query(r, "end_time"); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	records, err := h.GetOperatorHistory(&f)
+	if err != nil {
+		h.respondError(w, err)
+		return
+	}
+	if records == nil {
+		records = []*pdpb.OperatorRecord{}
+	}
+	h.r.JSON(w, http.StatusOK, records)
+}
+
+// operatorInput is the body of a request to create an operator. The fields
+// used depend on the name:
+//
+//	transfer-leader  region_id, to_store_id
+//	transfer-peer    region_id, from_store_id, to_store_id
+//	add-peer         region_id, store_id
+//	remove-peer      region_id, store_id
+type operatorInput struct {
+	Name        string `json:"name"`
+	RegionID    uint64 `json:"region_id"`
+	StoreID     uint64 `json:"store_id"`
+	FromStoreID uint64 `json:"from_store_id"`
+	ToStoreID   uint64 `json:"to_store_id"`
+}
+
+func (h *operatorHandler) Post(w http.ResponseWriter, r *http.Request) {
+	var input operatorInput
+	if err := readJSON(r.Body, &input); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if input.RegionID == 0 {
+		h.r.JSON(w, http.StatusBadRequest, "missing region_id")
+		return
+	}
+
+	var err error
+	switch input.Name {
+	case "transfer-leader":
+		if input.ToStoreID == 0 {
+			h.r.JSON(w, http.StatusBadRequest, "missing to_store_id")
+			return
+		}
+		err = h.AddTransferLeaderOperator(input.RegionID, input.ToStoreID)
+	case "transfer-peer":
+		if input.FromStoreID == 0 || input.ToStoreID == 0 {
+			h.r.JSON(w, http.StatusBadRequest, "missing from_store_id or to_store_id")
+			return
+		}
+		err = h.AddTransferPeerOperator(input.RegionID, input.FromStoreID, input.ToStoreID)
+	case "add-peer":
+		if input.StoreID == 0 {
+			h.r.JSON(w, http.StatusBadRequest, "missing store_id")
+			return
+		}
+		err = h.AddAddPeerOperator(input.RegionID, input.StoreID)
+	case "remove-peer":
+		if input.StoreID == 0 {
+			h.r.JSON(w, http.StatusBadRequest, "missing store_id")
+			return
+		}
+		err = h.AddRemovePeerOperator(input.RegionID, input.StoreID)
+	default:
+		h.r.JSON(w, http.StatusBadRequest, "unknown operator: "+input.Name)
+		return
+	}
+	if err != nil {
+		h.respondError(w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, nil)
+}
+
+func (h *operatorHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	regionID, err := parseUint64Var(r, "region_id")
+	if err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err = h.RemoveOperator(regionID); err != nil {
+		h.respondError(w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, nil)
+}
+
+func (h *operatorHandler) respondError(w http.ResponseWriter, err error) {
+	if errors.Cause(err) == server.ErrOperatorNotFound {
+		h.r.JSON(w, http.StatusNotFound, err.Error())
+		return
+	}
+	apiutil.ErrorResp(h.r, w, err)
+}
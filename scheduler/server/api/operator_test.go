@@ -0,0 +1,159 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testOperatorSuite{})
+
+type testOperatorSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testOperatorSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+	mustBootstrapCluster(c, s.svr, newTestStores(3)...)
+	mustRegionHeartbeat(c, s.svr, newTestRegion(2, "", "", 10, 1, 2))
+}
+
+func (s *testOperatorSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func (s *testOperatorSuite) TestOperators(c *C) {
+	url := apiURL(s.svr, "/operators")
+	var ops []string
+	c.Assert(readJSONWithURL(url, &ops), IsNil)
+	c.Assert(ops, HasLen, 0)
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "add-peer", "region_id": 2, "store_id": 3}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url+"?kind=admin", &ops), IsNil)
+	c.Assert(ops, HasLen, 1)
+	c.Assert(strings.Contains(ops[0], "add peer: store 3"), IsTrue)
+	c.Assert(readJSONWithURL(url+"?kind=leader", &ops), IsNil)
+	c.Assert(ops, HasLen, 0)
+
+	var op string
+	c.Assert(readJSONWithURL(url+"/2", &op), IsNil)
+	c.Assert(strings.Contains(op, "status: RUNNING"), IsTrue)
+
+	// A region has at most one running operator.
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "transfer-leader", "region_id": 2, "to_store_id": 2}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/2", nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/2", nil), Equals, http.StatusNotFound)
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "transfer-leader", "region_id": 2, "to_store_id": 2}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url+"?kind=leader", &ops), IsNil)
+	c.Assert(ops, HasLen, 1)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/2", nil), Equals, http.StatusOK)
+
+	// Store 3 has just been added a peer, but the admin operators are not
+	// limited by the store limit.
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "transfer-peer", "region_id": 2, "from_store_id": 2, "to_store_id": 3}), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/2", nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "remove-peer", "region_id": 2, "store_id": 2}), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/2", nil), Equals, http.StatusOK)
+
+	// Invalid requests.
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "add-peer", "region_id": 2, "store_id": 1}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "add-peer", "region_id": 2, "store_id": 100}), Equals, http.StatusNotFound)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "remove-peer", "region_id": 2, "store_id": 3}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "add-peer", "region_id": 100, "store_id": 3}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "no-such-operator", "region_id": 2}), Equals, http.StatusBadRequest)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "add-peer", "region_id": 2}), Equals, http.StatusBadRequest)
+
+	var records []*pdpb.OperatorRecord
+	c.Assert(readJSONWithURL(url+"/history?region_id=2", &records), IsNil)
+	reasons := []string{
+		"already have operator with higher or same priority",
+		"removed by admin",
+		"removed by admin",
+		"removed by admin",
+		"removed by admin",
+	}
+	c.Assert(records, HasLen, len(reasons))
+	for i, record := range records {
+		c.Assert(record.GetStatus(), Equals, pdpb.OperatorStatus_CANCEL)
+		c.Assert(record.GetReason(), Equals, reasons[i])
+	}
+	c.Assert(records[1].GetBrief(), Equals, "add peer: store 3")
+	c.Assert(records[1].GetStoreIds(), DeepEquals, []uint64{3})
+	c.Assert(readJSONWithURL(url+"/history?region_id=3", &records), IsNil)
+	c.Assert(records, HasLen, 0)
+	c.Assert(readJSONWithURL(url+fmt.Sprintf("/history?store_id=3&start_time=%d", time.Now().UnixNano()), &records), IsNil)
+	c.Assert(records, HasLen, 0)
+	c.Assert(doRequest(c, http.MethodGet, url+"/history?start_time=now", nil), Equals, http.StatusBadRequest)
+}
+
+func (s *testOperatorSuite) TestSchedulers(c *C) {
+	url := apiURL(s.svr, "/schedulers")
+	var names []string
+	c.Assert(readJSONWithURL(url, &names), IsNil)
+	c.Assert(names, DeepEquals, []string{"balance-leader-scheduler", "balance-region-scheduler"})
+
+	c.Assert(doRequest(c, http.MethodDelete, url+"/balance-leader-scheduler", nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url+"/balance-leader-scheduler", nil), Equals, http.StatusNotFound)
+	c.Assert(readJSONWithURL(url, &names), IsNil)
+	c.Assert(names, DeepEquals, []string{"balance-region-scheduler"})
+
+	// Removing a default scheduler disables it in the persisted config.
+	opt := config.NewScheduleOption(config.NewConfig())
+	c.Assert(opt.Reload(s.svr.GetStorage()), IsNil)
+	var disabled []string
+	for _, sc := range opt.GetSchedulers() {
+		if sc.Disable {
+			disabled = append(disabled, sc.Type)
+		}
+	}
+	c.Assert(disabled, DeepEquals, []string{"balance-leader"})
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "balance-leader"}), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "balance-leader-scheduler"}), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"name": "no-such-scheduler"}), Equals, http.StatusBadRequest)
+	c.Assert(readJSONWithURL(url, &names), IsNil)
+	c.Assert(names, DeepEquals, []string{"balance-leader-scheduler", "balance-region-scheduler"})
+
+	c.Assert(opt.Reload(s.svr.GetStorage()), IsNil)
+	for _, sc := range opt.GetSchedulers() {
+		c.Assert(sc.Disable, IsFalse)
+	}
+}
+
+func (s *testOperatorSuite) TestPauseSchedulers(c *C) {
+	url := apiURL(s.svr, "/schedulers")
+	var names []string
+	c.Assert(doRequest(c, http.MethodPost, url+"/balance-region-scheduler", map[string]interface{}{"delay": 100}), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(url+"?status=paused", &names), IsNil)
+	c.Assert(names, DeepEquals, []string{"balance-region-scheduler"})
+
+	c.Assert(doRequest(c, http.MethodPost, url+"/all", map[string]interface{}{"delay": 0}), Equals, http.StatusOK)
+	names = nil
+	c.Assert(readJSONWithURL(url+"?status=paused", &names), IsNil)
+	c.Assert(names, HasLen, 0)
+
+	c.Assert(doRequest(c, http.MethodPost, url+"/no-such-scheduler", map[string]interface{}{"delay": 100}), Equals, http.StatusNotFound)
+	c.Assert(doRequest(c, http.MethodPost, url+"/balance-region-scheduler", map[string]interface{}{}), Equals, http.StatusBadRequest)
+	c.Assert(doRequest(c, http.MethodGet, url+"?status=unknown", nil), Equals, http.StatusBadRequest)
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	redirectorHeader = "PD-Redirector"
+)
+
+const (
+	errRedirectFailed      = "redirect failed"
+	errRedirectToNotLeader = "redirect to not leader"
+)
+
+// redirector forwards the requests received by a follower to the leader, as
+// only the leader runs the RaftCluster.
+type redirector struct {
+	s    *server.Server
+	next http.Handler
+}
+
+func newRedirector(s *server.Server, next http.Handler) *redirector {
+	return &redirector{s: s, next: next}
+}
+
+func (h *redirector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.s.IsLeader() {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	// Prevent more than one redirection.
+	if name := r.Header.Get(redirectorHeader); len(name) != 0 {
+		log.Error("redirect but server is not leader", zap.String("from", name), zap.String("server", h.s.Name()))
+		http.Error(w, errRedirectToNotLeader, http.StatusInternalServerError)
+		return
+	}
+
+	leader := h.s.GetLeader()
+	if leader == nil {
+		http.Error(w, "no leader", http.StatusServiceUnavailable)
+		return
+	}
+	urls, err := config.ParseUrls(strings.Join(leader.GetClientUrls(), ","))
+	if err != nil || len(urls) == 0 {
+		log.Error("failed to parse leader client urls", zap.Strings("urls", leader.GetClientUrls()), zap.Error(err))
+		http.Error(w, errRedirectFailed, http.StatusInternalServerError)
+		return
+	}
+	tlsConfig, err := h.s.GetSecurityConfig().ToTLSConfig()
+	if err != nil {
+		http.Error(w, errRedirectFailed, http.StatusInternalServerError)
+		return
+	}
+
+	r.Header.Set(redirectorHeader, h.s.Name())
+	proxy := httputil.NewSingleHostReverseProxy(&urls[0])
+	proxy.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	proxy.ServeHTTP(w, r)
+}
@@ -0,0 +1,288 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+const (
+	defaultRegionLimit = 16
+	maxRegionLimit     = 10240
+)
+
+// RegionInfo records detail region info for api usage.
+type RegionInfo struct {
+	ID          uint64              `json:"id"`
+	StartKey    string              `json:"start_key"`
+	EndKey      string              `json:"end_key"`
+	RegionEpoch *metapb.RegionEpoch `json:"epoch,omitempty"`
+	Peers       []*metapb.Peer      `json:"peers,omitempty"`
+
+	Leader          *metapb.Peer      `json:"leader,omitempty"`
+	DownPeers       []*pdpb.PeerStats `json:"down_peers,omitempty"`
+	PendingPeers    []*metapb.Peer    `json:"pending_peers,omitempty"`
+	ApproximateSize int64             `json:"approximate_size,omitempty"`
+	ApproximateKeys int64             `json:"approximate_keys,omitempty"`
+}
+
+// NewRegionInfo create a new api RegionInfo. The keys are hex encoded.
+func NewRegionInfo(r *core.RegionInfo) *RegionInfo {
+	if r == nil {
+		return nil
+	}
+	return &RegionInfo{
+		ID:              r.GetID(),
+		StartKey:        string(core.HexRegionKey(r.GetStartKey())),
+		EndKey:          string(core.HexRegionKey(r.GetEndKey())),
+		RegionEpoch:     r.GetRegionEpoch(),
+		Peers:           r.GetPeers(),
+		Leader:          r.GetLeader(),
+		DownPeers:       r.GetDownPeers(),
+		PendingPeers:    r.GetPendingPeers(),
+		ApproximateSize: r.GetApproximateSize(),
+		ApproximateKeys: r.GetApproximateKeys(),
+	}
+}
+
+// RegionsInfo contains some regions with the detailed region info.
+type RegionsInfo struct {
+	Count   int           `json:"count"`
+	Regions []*RegionInfo `json:"regions"`
+}
+
+func convertToAPIRegions(regions []*core.RegionInfo) *RegionsInfo {
+	regionInfos := make([]*RegionInfo, len(regions))
+	for i, r := range regions {
+		regionInfos[i] = NewRegionInfo(r)
+	}
+	return &RegionsInfo{
+		Count:   len(regions),
+		Regions: regionInfos,
+	}
+}
+
+// parseKey decodes a key given in the request. Keys are taken as is, unless
+// the format query parameter is hex.
+func parseKey(r *http.Request, key string) ([]byte, error) {
+	if r.URL.Query().Get("format") == "hex" {
+		k, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, errors.Errorf("invalid hex key: %s", key)
+		}
+		return k, nil
+	}
+	return []byte(key), nil
+}
+
+type regionHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newRegionHandler(svr *server.Server, rd *render.Render) *regionHandler {
+	return &regionHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+func (h *regionHandler) GetRegionByID(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	regionID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	regionInfo := cluster.GetRegion(regionID)
+	if regionInfo == nil {
+		h.rd.JSON(w, http.StatusNotFound, server.ErrRegionNotFound(regionID).Error())
+		return
+	}
+	h.rd.JSON(w, http.StatusOK, NewRegionInfo(regionInfo))
+}
+
+func (h *regionHandler) GetRegionByKey(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	key, err := parseKey(r, mux.Vars(r)["key"])
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	regionInfo := cluster.GetRegionInfoByKey(key)
+	if regionInfo == nil {
+		h.rd.JSON(w, http.StatusNotFound, "region not found")
+		return
+	}
+	h.rd.JSON(w, http.StatusOK, NewRegionInfo(regionInfo))
+}
+
+type regionsHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newRegionsHandler(svr *server.Server, rd *render.Render) *regionsHandler {
+	return &regionsHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+func (h *regionsHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(cluster.GetRegions()))
+}
+
+// ScanRegions lists at most limit regions from the region which contains key,
+// stopping before end_key if it is given.
+func (h *regionsHandler) ScanRegions(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	startKey, err := parseKey(r, r.URL.Query().Get("key"))
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	endKey, err := parseKey(r, r.URL.Query().Get("end_key"))
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	limit, err := parseLimit(r, defaultRegionLimit, maxRegionLimit)
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	regions := cluster.ScanRegions(startKey, endKey, limit)
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(regions))
+}
+
+func (h *regionsHandler) GetStoreRegions(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(cluster.GetStoreRegions(storeID)))
+}
+
+// GetTopSize lists the limit largest regions by approximate size.
+func (h *regionsHandler) GetTopSize(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	limit, err := parseLimit(r, defaultRegionLimit, maxRegionLimit)
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	regions := cluster.GetRegions()
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].GetApproximateSize() > regions[j].GetApproximateSize()
+	})
+	if len(regions) > limit {
+		regions = regions[:limit]
+	}
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(regions))
+}
+
+// GetPendingPeerRegions lists the regions which have a peer that is still
+// catching up with the leader.
+func (h *regionsHandler) GetPendingPeerRegions(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	var regions []*core.RegionInfo
+	for _, region := range cluster.GetRegions() {
+		if len(region.GetPendingPeers()) > 0 {
+			regions = append(regions, region)
+		}
+	}
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(regions))
+}
+
+// GetDownPeerRegions lists the regions which have a peer reported down by the
+// leader, or a peer on a store that has not sent a heartbeat for longer than
+// max-store-down-time.
+func (h *regionsHandler) GetDownPeerRegions(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	maxStoreDownTime := cluster.GetMaxStoreDownTime()
+	var regions []*core.RegionInfo
+	for _, region := range cluster.GetRegions() {
+		if len(region.GetDownPeers()) > 0 {
+			regions = append(regions, region)
+			continue
+		}
+		for _, peer := range region.GetPeers() {
+			store := cluster.GetStore(peer.GetStoreId())
+			if store != nil && !store.IsTombstone() && store.DownTime() > maxStoreDownTime {
+				regions = append(regions, region)
+				break
+			}
+		}
+	}
+	h.rd.JSON(w, http.StatusOK, convertToAPIRegions(regions))
+}
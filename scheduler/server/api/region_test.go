@@ -0,0 +1,91 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testRegionSuite{})
+
+type testRegionSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testRegionSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+	mustBootstrapCluster(c, s.svr, newTestStores(3)...)
+	mustRegionHeartbeat(c, s.svr, newTestRegion(2, "", "b", 10, 1, 2))
+	mustRegionHeartbeat(c, s.svr, newTestRegion(3, "b", "d", 30, 2, 3))
+	pending := newTestRegion(4, "d", "", 20, 1, 3)
+	mustRegionHeartbeat(c, s.svr, pending.Clone(core.WithPendingPeers(pending.GetPeers()[1:])))
+}
+
+func (s *testRegionSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func regionIDs(regions *RegionsInfo) []uint64 {
+	ids := make([]uint64, 0, len(regions.Regions))
+	for _, r := range regions.Regions {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func (s *testRegionSuite) TestRegion(c *C) {
+	region := &RegionInfo{}
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/region/id/3"), region), IsNil)
+	c.Assert(region.ID, Equals, uint64(3))
+	c.Assert(region.StartKey, Equals, "62")
+	c.Assert(region.Peers, HasLen, 2)
+	c.Assert(region.ApproximateSize, Equals, int64(30))
+	c.Assert(doRequest(c, http.MethodGet, apiURL(s.svr, "/region/id/100"), nil), Equals, http.StatusNotFound)
+
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/region/key/c"), region), IsNil)
+	c.Assert(region.ID, Equals, uint64(3))
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/region/key/"+hex.EncodeToString([]byte("e"))+"?format=hex"), region), IsNil)
+	c.Assert(region.ID, Equals, uint64(4))
+}
+
+func (s *testRegionSuite) TestRegions(c *C) {
+	regions := &RegionsInfo{}
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions"), regions), IsNil)
+	c.Assert(regions.Count, Equals, 3)
+
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/key?key=a&limit=2"), regions), IsNil)
+	c.Assert(regionIDs(regions), DeepEquals, []uint64{2, 3})
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/key?key=c&end_key=d"), regions), IsNil)
+	c.Assert(regionIDs(regions), DeepEquals, []uint64{3})
+	c.Assert(doRequest(c, http.MethodGet, apiURL(s.svr, "/regions/key?limit=-1"), nil), Equals, http.StatusBadRequest)
+
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/store/3"), regions), IsNil)
+	c.Assert(regions.Count, Equals, 2)
+
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/topsize?limit=2"), regions), IsNil)
+	c.Assert(regionIDs(regions), DeepEquals, []uint64{3, 4})
+
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/check/pending-peer"), regions), IsNil)
+	c.Assert(regionIDs(regions), DeepEquals, []uint64{4})
+
+	// The mock stores never send a heartbeat, so all of them are down.
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/regions/check/down-peer"), regions), IsNil)
+	c.Assert(regions.Count, Equals, 3)
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/unrolled/render"
+)
+
+func createRouter(prefix string, svr *server.Server) *mux.Router {
+	rd := render.New(render.Options{
+		IndentJSON: true,
+	})
+
+	rootRouter := mux.NewRouter().PathPrefix(prefix).Subrouter()
+	handler := svr.GetHandler()
+
+	apiRouter := rootRouter.PathPrefix("/api/v1").Subrouter()
+
+	storeHandler := newStoreHandler(svr, rd)
+	apiRouter.HandleFunc("/store/{id}", storeHandler.Get).Methods("GET")
+	apiRouter.HandleFunc("/store/{id}", storeHandler.Delete).Methods("DELETE")
+	apiRouter.HandleFunc("/store/{id}/state", storeHandler.SetState).Methods("POST")
+	apiRouter.HandleFunc("/store/{id}/weight", storeHandler.SetWeight).Methods("POST")
+	apiRouter.HandleFunc("/store/{id}/limit", storeHandler.SetLimit).Methods("POST")
+	apiRouter.HandleFunc("/store/{id}/limit", storeHandler.RemoveLimit).Methods("DELETE")
+
+	storesHandler := newStoresHandler(svr, rd)
+	apiRouter.HandleFunc("/stores", storesHandler.List).Methods("GET")
+	apiRouter.HandleFunc("/stores/limit", storesHandler.GetAllLimit).Methods("GET")
+	apiRouter.HandleFunc("/stores/remove-tombstone", storesHandler.RemoveTombStone).Methods("DELETE")
+
+	regionHandler := newRegionHandler(svr, rd)
+	apiRouter.HandleFunc("/region/id/{id}", regionHandler.GetRegionByID).Methods("GET")
+	apiRouter.HandleFunc("/region/key/{key}", regionHandler.GetRegionByKey).Methods("GET")
+
+	regionsHandler := newRegionsHandler(svr, rd)
+	apiRouter.HandleFunc("/regions", regionsHandler.GetAll).Methods("GET")
+	apiRouter.HandleFunc("/regions/key", regionsHandler.ScanRegions).Methods("GET")
+	apiRouter.HandleFunc("/regions/store/{id}", regionsHandler.GetStoreRegions).Methods("GET")
+	apiRouter.HandleFunc("/regions/topsize", regionsHandler.GetTopSize).Methods("GET")
+	apiRouter.HandleFunc("/regions/check/pending-peer", regionsHandler.GetPendingPeerRegions).Methods("GET")
+	apiRouter.HandleFunc("/regions/check/down-peer", regionsHandler.GetDownPeerRegions).Methods("GET")
+
+	operatorHandler := newOperatorHandler(handler, rd)
+	apiRouter.HandleFunc("/operators", operatorHandler.List).Methods("GET")
+	apiRouter.HandleFunc("/operators", operatorHandler.Post).Methods("POST")
+	apiRouter.HandleFunc("/operators/history", operatorHandler.History).Methods("GET")
+	apiRouter.HandleFunc("/operators/{region_id}", operatorHandler.Get).Methods("GET")
+	apiRouter.HandleFunc("/operators/{region_id}", operatorHandler.Delete).Methods("DELETE")
+
+	schedulerHandler := newSchedulerHandler(handler, rd)
+	apiRouter.HandleFunc("/schedulers", schedulerHandler.List).Methods("GET")
+	apiRouter.HandleFunc("/schedulers", schedulerHandler.Post).Methods("POST")
+	apiRouter.HandleFunc("/schedulers/{name}", schedulerHandler.Delete).Methods("DELETE")
+	apiRouter.HandleFunc("/schedulers/{name}", schedulerHandler.PauseOrResume).Methods("POST")
+
+	labelHandler := newLabelHandler(handler, rd)
+	apiRouter.HandleFunc("/schedule/labels", labelHandler.List).Methods("GET")
+	apiRouter.HandleFunc("/schedule/labels", labelHandler.Post).Methods("POST")
+	apiRouter.HandleFunc("/schedule/labels/{id}", labelHandler.Get).Methods("GET")
+	apiRouter.HandleFunc("/schedule/labels/{id}", labelHandler.Delete).Methods("DELETE")
+
+	memberHandler := newMemberHandler(svr, rd)
+	apiRouter.HandleFunc("/members", memberHandler.List).Methods("GET")
+
+	confHandler := newConfHandler(svr, rd)
+	apiRouter.HandleFunc("/config", confHandler.Get).Methods("GET")
+	apiRouter.HandleFunc("/config/schedule", confHandler.GetSchedule).Methods("GET")
+	apiRouter.HandleFunc("/config/schedule", confHandler.SetSchedule).Methods("POST")
+	apiRouter.HandleFunc("/config/replicate", confHandler.GetReplication).Methods("GET")
+	apiRouter.HandleFunc("/config/replicate", confHandler.SetReplication).Methods("POST")
+
+	// The health check of the members pings each of them, so it is never redirected.
+	rootRouter.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+	// The failpoints belong to the member serving the request, so they are not redirected either.
+	rootRouter.PathPrefix("/failpoints").Handler(http.StripPrefix(prefix+"/failpoints", failpoint.Handler()))
+
+	return rootRouter
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/apiutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+type schedulerHandler struct {
+	*server.Handler
+	r *render.Render
+}
+
+func newSchedulerHandler(handler *server.Handler, r *render.Render) *schedulerHandler {
+	return &schedulerHandler{
+		Handler: handler,
+		r:       r,
+	}
+}
+
+// List returns the names of the schedulers, or only the paused ones with
+// ?status=paused.
+func (h *schedulerHandler) List(w http.ResponseWriter, r *http.Request) {
+	var (
+		schedulers []string
+		err        error
+	)
+	switch status := r.URL.Query().Get("status"); status {
+	case "":
+		schedulers, err = h.GetSchedulers()
+	case "paused":
+		schedulers, err = h.GetPausedSchedulers()
+	default:
+		h.r.JSON(w, http.StatusBadRequest, "unknown status: "+status)
+		return
+	}
+	if err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+	sort.Strings(schedulers)
+	h.r.JSON(w, http.StatusOK, schedulers)
+}
+
+// Post adds a scheduler, e.g. {"name": "balance-leader"}. The name may be
+// either the type or the name of the scheduler, args are passed to the
+// scheduler as in the schedulers section of the config file.
+func (h *schedulerHandler) Post(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Name string   `json:"name"`
+		Args []string `json:"args"`
+	}
+	if err := readJSON(r.Body, &input); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	typ := schedule.FindSchedulerTypeByName(input.Name)
+	if typ == "" {
+		h.r.JSON(w, http.StatusBadRequest, "unknown scheduler: "+input.Name)
+		return
+	}
+
+	if err := h.AddScheduler(typ, input.Args...); err != nil {
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, nil)
+}
+
+func (h *schedulerHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+	if err := h.RemoveScheduler(name); err != nil {
+		if errors.Cause(err) == server.ErrSchedulerNotFound {
+			h.r.JSON(w, http.StatusNotFound, err.Error())
+			return
+		}
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, nil)
+}
+
+// PauseOrResume pauses the scheduler for {"delay": seconds}, a delay of 0
+// resumes it. The name "all" applies to all schedulers.
+func (h *schedulerHandler) PauseOrResume(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Delay *int64 `json:"delay"`
+	}
+	if err := readJSON(r.Body, &input); err != nil {
+		h.r.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if input.Delay == nil {
+		h.r.JSON(w, http.StatusBadRequest, "missing pause time")
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+	if err := h.PauseOrResumeScheduler(name, *input.Delay); err != nil {
+		if errors.Cause(err) == server.ErrSchedulerNotFound {
+			h.r.JSON(w, http.StatusNotFound, err.Error())
+			return
+		}
+		apiutil.ErrorResp(h.r, w, err)
+		return
+	}
+
+	h.r.JSON(w, http.StatusOK, nil)
+}
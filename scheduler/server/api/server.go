@@ -0,0 +1,33 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+)
+
+const apiPrefix = "/pd"
+
+// NewHandler creates a HTTP handler for API. Requests to /pd/api/ received by a
+// follower are forwarded to the leader, the other requests are served locally.
+func NewHandler(svr *server.Server) http.Handler {
+	router := mux.NewRouter()
+	apiRouter := createRouter(apiPrefix, svr)
+	router.PathPrefix(apiPrefix + "/api/").Handler(newRedirector(svr, apiRouter))
+	router.PathPrefix(apiPrefix).Handler(apiRouter)
+	return router
+}
@@ -0,0 +1,207 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/failpoint"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+
+	// Register schedulers.
+	_ "github.com/pingcap-incubator/tinykv/scheduler/server/schedulers"
+)
+
+func TestAPIServer(t *testing.T) {
+	server.EnableZap = true
+	TestingT(t)
+}
+
+var dialClient = &http.Client{
+	Transport: &http.Transport{
+		DisableKeepAlives: true,
+	},
+}
+
+type cleanUpFunc func()
+
+func mustNewServer(c *C) (*server.Server, cleanUpFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := server.NewTestSingleConfig(c)
+	svr, err := server.CreateServer(cfg, NewHandler)
+	c.Assert(err, IsNil)
+	c.Assert(svr.Run(ctx), IsNil)
+
+	testutil.WaitUntil(c, func(c *C) bool {
+		return svr.IsLeader()
+	})
+
+	return svr, func() {
+		cancel()
+		svr.Close()
+		testutil.CleanServer(cfg)
+	}
+}
+
+func mustBootstrapCluster(c *C, svr *server.Server, stores ...*metapb.Store) {
+	resp, err := svr.Bootstrap(context.Background(), &pdpb.BootstrapRequest{
+		Header: testutil.NewRequestHeader(svr.ClusterID()),
+		Store:  stores[0],
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp.GetHeader().GetError(), IsNil)
+
+	for _, store := range stores[1:] {
+		mustPutStore(c, svr, store)
+	}
+}
+
+func mustPutStore(c *C, svr *server.Server, store *metapb.Store) {
+	resp, err := svr.PutStore(context.Background(), &pdpb.PutStoreRequest{
+		Header: testutil.NewRequestHeader(svr.ClusterID()),
+		Store:  store,
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp.GetHeader().GetError(), IsNil)
+}
+
+func mustRegionHeartbeat(c *C, svr *server.Server, region *core.RegionInfo) {
+	err := svr.GetRaftCluster().HandleRegionHeartbeat(region)
+	c.Assert(err, IsNil)
+}
+
+func newTestStores(n uint64) []*metapb.Store {
+	stores := make([]*metapb.Store, 0, n)
+	for i := uint64(1); i <= n; i++ {
+		stores = append(stores, &metapb.Store{
+			Id:      i,
+			Address: fmt.Sprintf("mock://tikv-%d", i),
+			State:   metapb.StoreState_Up,
+		})
+	}
+	return stores
+}
+
+func newTestRegion(regionID uint64, start, end string, size int64, storeIDs ...uint64) *core.RegionInfo {
+	peers := make([]*metapb.Peer, 0, len(storeIDs))
+	for _, storeID := range storeIDs {
+		peers = append(peers, &metapb.Peer{Id: regionID*100 + storeID, StoreId: storeID})
+	}
+	meta := &metapb.Region{
+		Id:          regionID,
+		StartKey:    []byte(start),
+		EndKey:      []byte(end),
+		Peers:       peers,
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+	}
+	return core.NewRegionInfo(meta, peers[0], core.SetApproximateSize(size))
+}
+
+func apiURL(svr *server.Server, path string) string {
+	return svr.GetAddr() + apiPrefix + "/api/v1" + path
+}
+
+func readJSONWithURL(url string, data interface{}) error {
+	resp, err := dialClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("[%d] %s", resp.StatusCode, b)
+	}
+	return readJSON(resp.Body, data)
+}
+
+// doRequest sends a request with data encoded as JSON in the body and returns
+// the status code.
+func doRequest(c *C, method, url string, data interface{}) int {
+	var body []byte
+	if data != nil {
+		var err error
+		body, err = json.Marshal(data)
+		c.Assert(err, IsNil)
+	}
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	c.Assert(err, IsNil)
+	resp, err := dialClient.Do(req)
+	c.Assert(err, IsNil)
+	defer resp.Body.Close()
+	_, err = ioutil.ReadAll(resp.Body)
+	c.Assert(err, IsNil)
+	return resp.StatusCode
+}
+
+var _ = Suite(&testServerSuite{})
+
+type testServerSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testServerSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+}
+
+func (s *testServerSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func (s *testServerSuite) TestPing(c *C) {
+	resp, err := dialClient.Get(s.svr.GetAddr() + apiPrefix + "/ping")
+	c.Assert(err, IsNil)
+	resp.Body.Close()
+	c.Assert(resp.StatusCode, Equals, http.StatusOK)
+}
+
+func (s *testServerSuite) TestFailpoints(c *C) {
+	url := s.svr.GetAddr() + apiPrefix + "/failpoints/api/test"
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader("return(injected)"))
+	c.Assert(err, IsNil)
+	resp, err := dialClient.Do(req)
+	c.Assert(err, IsNil)
+	resp.Body.Close()
+	c.Assert(resp.StatusCode, Equals, http.StatusNoContent)
+	c.Assert(failpoint.Eval("api/test"), ErrorMatches, ".*injected")
+	c.Assert(doRequest(c, http.MethodGet, url, nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodDelete, url, nil), Equals, http.StatusNoContent)
+	c.Assert(failpoint.Eval("api/test"), IsNil)
+}
+
+func (s *testServerSuite) TestNotBootstrapped(c *C) {
+	c.Assert(doRequest(c, http.MethodGet, apiURL(s.svr, "/stores"), nil), Equals, http.StatusInternalServerError)
+	c.Assert(doRequest(c, http.MethodGet, apiURL(s.svr, "/schedulers"), nil), Equals, http.StatusInternalServerError)
+}
+
+func (s *testServerSuite) TestMembers(c *C) {
+	var info MembersInfo
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/members"), &info), IsNil)
+	c.Assert(info.Members, HasLen, 1)
+	c.Assert(info.Members[0].GetName(), Equals, s.svr.Name())
+	c.Assert(info.Leader.GetName(), Equals, s.svr.Name())
+	c.Assert(info.EtcdLeader.GetName(), Equals, s.svr.Name())
+}
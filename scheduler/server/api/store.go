@@ -0,0 +1,401 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/apiutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+)
+
+const (
+	disconnectedName = "Disconnected"
+	downStateName    = "Down"
+)
+
+// MetaStore contains meta information about a store.
+type MetaStore struct {
+	*metapb.Store
+	StateName string `json:"state_name"`
+}
+
+// StoreStatus contains status about a store.
+type StoreStatus struct {
+	Capacity           typeutil.ByteSize  `json:"capacity"`
+	Available          typeutil.ByteSize  `json:"available"`
+	LeaderCount        int                `json:"leader_count"`
+	LeaderWeight       float64            `json:"leader_weight"`
+	LeaderScore        float64            `json:"leader_score"`
+	LeaderSize         int64              `json:"leader_size"`
+	RegionCount        int                `json:"region_count"`
+	RegionWeight       float64            `json:"region_weight"`
+	RegionScore        float64            `json:"region_score"`
+	RegionSize         int64              `json:"region_size"`
+	PendingPeerCount   int                `json:"pending_peer_count"`
+	SendingSnapCount   uint32             `json:"sending_snap_count,omitempty"`
+	ReceivingSnapCount uint32             `json:"receiving_snap_count,omitempty"`
+	ApplyingSnapCount  uint32             `json:"applying_snap_count,omitempty"`
+	IsBusy             bool               `json:"is_busy,omitempty"`
+	StartTS            *time.Time         `json:"start_ts,omitempty"`
+	LastHeartbeatTS    *time.Time         `json:"last_heartbeat_ts,omitempty"`
+	Uptime             *typeutil.Duration `json:"uptime,omitempty"`
+}
+
+// StoreInfo contains information about a store.
+type StoreInfo struct {
+	Store  *MetaStore   `json:"store"`
+	Status *StoreStatus `json:"status"`
+}
+
+func newStoreInfo(opt opt.Options, store *core.StoreInfo) *StoreInfo {
+	s := &StoreInfo{
+		Store: &MetaStore{
+			Store:     store.GetMeta(),
+			StateName: store.GetState().String(),
+		},
+		Status: &StoreStatus{
+			Capacity:           typeutil.ByteSize(store.GetCapacity()),
+			Available:          typeutil.ByteSize(store.GetAvailable()),
+			LeaderCount:        store.GetLeaderCount(),
+			LeaderWeight:       store.GetLeaderWeight(),
+			LeaderScore:        store.LeaderScore(0),
+			LeaderSize:         store.GetLeaderSize(),
+			RegionCount:        store.GetRegionCount(),
+			RegionWeight:       store.GetRegionWeight(),
+			RegionScore:        store.RegionScore(opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio()),
+			RegionSize:         store.GetRegionSize(),
+			PendingPeerCount:   store.GetPendingPeerCount(),
+			SendingSnapCount:   store.GetSendingSnapCount(),
+			ReceivingSnapCount: store.GetReceivingSnapCount(),
+			ApplyingSnapCount:  store.GetApplyingSnapCount(),
+			IsBusy:             store.IsBusy(),
+		},
+	}
+
+	if store.GetStoreStats() != nil {
+		startTS := store.GetStartTS()
+		s.Status.StartTS = &startTS
+	}
+	if lastHeartbeat := store.GetLastHeartbeatTS(); !lastHeartbeat.IsZero() {
+		s.Status.LastHeartbeatTS = &lastHeartbeat
+	}
+	if upTime := store.GetUptime(); upTime > 0 {
+		duration := typeutil.NewDuration(upTime)
+		s.Status.Uptime = &duration
+	}
+
+	if store.GetState() == metapb.StoreState_Up {
+		if store.DownTime() > opt.GetMaxStoreDownTime() {
+			s.Store.StateName = downStateName
+		} else if store.IsDisconnected() {
+			s.Store.StateName = disconnectedName
+		}
+	}
+	return s
+}
+
+// StoresInfo records stores' info.
+type StoresInfo struct {
+	Count  int          `json:"count"`
+	Stores []*StoreInfo `json:"stores"`
+}
+
+type storeHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newStoreHandler(svr *server.Server, rd *render.Render) *storeHandler {
+	return &storeHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+func (h *storeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	store := cluster.GetStore(storeID)
+	if store == nil {
+		apiutil.ErrorResp(h.rd, w, core.NewStoreNotFoundErr(storeID))
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, newStoreInfo(cluster, store))
+}
+
+// Delete makes the store offline, its regions are moved to other stores before
+// it becomes tombstone. With the force option the store becomes tombstone
+// immediately, which is only safe if it will never come back.
+func (h *storeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("force") == "true" {
+		err = cluster.BuryStore(storeID, true)
+	} else {
+		err = cluster.RemoveStore(storeID)
+	}
+	if err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+// SetState changes the state of the store to the state query parameter:
+// Up brings an offline store back, Offline is the same as Delete and
+// Tombstone buries an offline store.
+func (h *storeHandler) SetState(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stateStr := r.URL.Query().Get("state")
+	state, ok := metapb.StoreState_value[stateStr]
+	if !ok {
+		h.rd.JSON(w, http.StatusBadRequest, "invalid state: "+stateStr)
+		return
+	}
+
+	switch metapb.StoreState(state) {
+	case metapb.StoreState_Up:
+		if store := cluster.GetStore(storeID); store != nil && store.IsTombstone() {
+			err = errors.New("can not bring a tombstone store up")
+		} else {
+			err = cluster.SetStoreState(storeID, metapb.StoreState_Up)
+		}
+	case metapb.StoreState_Offline:
+		err = cluster.RemoveStore(storeID)
+	case metapb.StoreState_Tombstone:
+		err = cluster.BuryStore(storeID, false)
+	}
+	if err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+// SetWeight sets the leader and region balance weights of the store, e.g.
+// {"leader": 1, "region": 2}.
+func (h *storeHandler) SetWeight(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var input struct {
+		Leader *float64 `json:"leader"`
+		Region *float64 `json:"region"`
+	}
+	if err := readJSON(r.Body, &input); err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if input.Leader == nil || input.Region == nil {
+		h.rd.JSON(w, http.StatusBadRequest, "both leader and region weights are required")
+		return
+	}
+	if *input.Leader < 0 || *input.Region < 0 {
+		h.rd.JSON(w, http.StatusBadRequest, "weight can not be negative")
+		return
+	}
+
+	if err := cluster.SetStoreWeight(storeID, *input.Leader, *input.Region); err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+// SetLimit overrides the number of peers can be added to or removed from the
+// store per minute, e.g. {"rate": 30, "type": "remove-peer"} to move the
+// regions out of a store being decommissioned faster. Both types are set if
+// the type is omitted.
+func (h *storeHandler) SetLimit(w http.ResponseWriter, r *http.Request) {
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var input struct {
+		Rate *float64 `json:"rate"`
+		Type string   `json:"type"`
+	}
+	if err := readJSON(r.Body, &input); err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if input.Rate == nil || *input.Rate <= 0 {
+		h.rd.JSON(w, http.StatusBadRequest, "rate should be greater than 0")
+		return
+	}
+	var typs []storelimit.Type
+	if input.Type != "" {
+		typ, ok := storelimit.TypeNameValue[input.Type]
+		if !ok {
+			h.rd.JSON(w, http.StatusBadRequest, "unknown type: "+input.Type)
+			return
+		}
+		typs = append(typs, typ)
+	}
+
+	if err := h.svr.GetHandler().SetStoreLimit(storeID, *input.Rate, typs...); err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+// RemoveLimit removes the limit override of the store.
+func (h *storeHandler) RemoveLimit(w http.ResponseWriter, r *http.Request) {
+	storeID, err := parseUint64Var(r, "id")
+	if err != nil {
+		h.rd.JSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svr.GetHandler().RemoveStoreLimit(storeID); err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
+
+type storesHandler struct {
+	svr *server.Server
+	rd  *render.Render
+}
+
+func newStoresHandler(svr *server.Server, rd *render.Render) *storesHandler {
+	return &storesHandler{
+		svr: svr,
+		rd:  rd,
+	}
+}
+
+// List lists the stores in the states given by the state query parameters,
+// e.g. ?state=Up&state=Offline, which is also the default.
+func (h *storesHandler) List(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	states := r.URL.Query()["state"]
+	if len(states) == 0 {
+		states = []string{metapb.StoreState_Up.String(), metapb.StoreState_Offline.String()}
+	}
+	accepted := make(map[metapb.StoreState]struct{}, len(states))
+	for _, stateStr := range states {
+		state, ok := metapb.StoreState_value[stateStr]
+		if !ok {
+			h.rd.JSON(w, http.StatusBadRequest, "invalid state: "+stateStr)
+			return
+		}
+		accepted[metapb.StoreState(state)] = struct{}{}
+	}
+
+	stores := cluster.GetStores()
+	storesInfo := &StoresInfo{
+		Stores: make([]*StoreInfo, 0, len(stores)),
+	}
+	for _, s := range stores {
+		if _, ok := accepted[s.GetState()]; !ok {
+			continue
+		}
+		storesInfo.Stores = append(storesInfo.Stores, newStoreInfo(cluster, s))
+	}
+	storesInfo.Count = len(storesInfo.Stores)
+
+	h.rd.JSON(w, http.StatusOK, storesInfo)
+}
+
+// GetAllLimit returns the limits of the stores.
+func (h *storesHandler) GetAllLimit(w http.ResponseWriter, r *http.Request) {
+	limits, err := h.svr.GetHandler().GetAllStoresLimit()
+	if err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, limits)
+}
+
+// RemoveTombStone removes the records of the tombstone stores.
+func (h *storesHandler) RemoveTombStone(w http.ResponseWriter, r *http.Request) {
+	cluster := h.svr.GetRaftCluster()
+	if cluster == nil {
+		h.rd.JSON(w, http.StatusInternalServerError, server.ErrNotBootstrapped.Error())
+		return
+	}
+
+	if err := cluster.RemoveTombStoneRecords(); err != nil {
+		apiutil.ErrorResp(h.rd, w, err)
+		return
+	}
+
+	h.rd.JSON(w, http.StatusOK, nil)
+}
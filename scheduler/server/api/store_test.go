@@ -0,0 +1,123 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testStoreSuite{})
+
+type testStoreSuite struct {
+	svr     *server.Server
+	cleanup cleanUpFunc
+}
+
+func (s *testStoreSuite) SetUpSuite(c *C) {
+	s.svr, s.cleanup = mustNewServer(c)
+	mustBootstrapCluster(c, s.svr, newTestStores(4)...)
+}
+
+func (s *testStoreSuite) TearDownSuite(c *C) {
+	s.cleanup()
+}
+
+func (s *testStoreSuite) storeURL(storeID uint64, suffix string) string {
+	return apiURL(s.svr, fmt.Sprintf("/store/%d%s", storeID, suffix))
+}
+
+func (s *testStoreSuite) TestStoreGet(c *C) {
+	info := &StoreInfo{}
+	c.Assert(readJSONWithURL(s.storeURL(1, ""), info), IsNil)
+	c.Assert(info.Store.GetId(), Equals, uint64(1))
+	c.Assert(info.Store.GetAddress(), Equals, "mock://tikv-1")
+	// The mock stores never send a heartbeat.
+	c.Assert(info.Store.StateName, Equals, downStateName)
+
+	c.Assert(doRequest(c, http.MethodGet, s.storeURL(100, ""), nil), Equals, http.StatusNotFound)
+	c.Assert(doRequest(c, http.MethodGet, apiURL(s.svr, "/store/abc"), nil), Equals, http.StatusBadRequest)
+}
+
+func (s *testStoreSuite) TestStoreWeight(c *C) {
+	url := s.storeURL(2, "/weight")
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"leader": 2, "region": 3}), Equals, http.StatusOK)
+	info := &StoreInfo{}
+	c.Assert(readJSONWithURL(s.storeURL(2, ""), info), IsNil)
+	c.Assert(info.Status.LeaderWeight, Equals, 2.0)
+	c.Assert(info.Status.RegionWeight, Equals, 3.0)
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"leader": 2}), Equals, http.StatusBadRequest)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"leader": -1, "region": 1}), Equals, http.StatusBadRequest)
+}
+
+func (s *testStoreSuite) TestStoreLimit(c *C) {
+	url := s.storeURL(1, "/limit")
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"rate": 30, "type": "remove-peer"}), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(2, "/limit"), map[string]interface{}{"rate": 10}), Equals, http.StatusOK)
+	limits := make(map[uint64]config.StoreLimitConfig)
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/stores/limit"), &limits), IsNil)
+	c.Assert(limits, HasLen, 4)
+	c.Assert(limits[1], Equals, config.StoreLimitConfig{AddPeer: 15, RemovePeer: 30})
+	c.Assert(limits[2], Equals, config.StoreLimitConfig{AddPeer: 10, RemovePeer: 10})
+	c.Assert(limits[3], Equals, config.StoreLimitConfig{AddPeer: 15, RemovePeer: 15})
+	c.Assert(s.svr.GetScheduleConfig().StoreLimit, HasLen, 2)
+
+	c.Assert(doRequest(c, http.MethodDelete, url, nil), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/stores/limit"), &limits), IsNil)
+	c.Assert(limits[1], Equals, config.StoreLimitConfig{AddPeer: 15, RemovePeer: 15})
+	c.Assert(doRequest(c, http.MethodDelete, s.storeURL(2, "/limit"), nil), Equals, http.StatusOK)
+
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"rate": 0}), Equals, http.StatusBadRequest)
+	c.Assert(doRequest(c, http.MethodPost, url, map[string]interface{}{"rate": 1, "type": "unknown"}), Equals, http.StatusBadRequest)
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(100, "/limit"), map[string]interface{}{"rate": 1}), Equals, http.StatusNotFound)
+}
+
+func (s *testStoreSuite) TestStoreOfflineAndBury(c *C) {
+	// Burying a store which is up needs force.
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(3, "/state?state=Tombstone"), nil), Equals, http.StatusInternalServerError)
+
+	c.Assert(doRequest(c, http.MethodDelete, s.storeURL(3, ""), nil), Equals, http.StatusOK)
+	c.Assert(s.svr.GetRaftCluster().GetStore(3).GetState(), Equals, metapb.StoreState_Offline)
+
+	// An offline store can be brought back.
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(3, "/state?state=Up"), nil), Equals, http.StatusOK)
+	c.Assert(s.svr.GetRaftCluster().GetStore(3).GetState(), Equals, metapb.StoreState_Up)
+
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(3, "/state?state=Offline"), nil), Equals, http.StatusOK)
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(3, "/state?state=Tombstone"), nil), Equals, http.StatusOK)
+	c.Assert(s.svr.GetRaftCluster().GetStore(3).GetState(), Equals, metapb.StoreState_Tombstone)
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(3, "/state?state=Up"), nil), Equals, http.StatusInternalServerError)
+
+	c.Assert(doRequest(c, http.MethodDelete, s.storeURL(4, "?force=true"), nil), Equals, http.StatusOK)
+	c.Assert(s.svr.GetRaftCluster().GetStore(4).GetState(), Equals, metapb.StoreState_Tombstone)
+
+	c.Assert(doRequest(c, http.MethodPost, s.storeURL(1, "/state?state=Unknown"), nil), Equals, http.StatusBadRequest)
+
+	// Tombstone stores are only listed on demand.
+	stores := &StoresInfo{}
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/stores"), stores), IsNil)
+	c.Assert(stores.Count, Equals, 2)
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/stores?state=Tombstone"), stores), IsNil)
+	c.Assert(stores.Count, Equals, 2)
+
+	c.Assert(doRequest(c, http.MethodDelete, apiURL(s.svr, "/stores/remove-tombstone"), nil), Equals, http.StatusOK)
+	c.Assert(readJSONWithURL(apiURL(s.svr, "/stores?state=Up&state=Offline&state=Tombstone"), stores), IsNil)
+	c.Assert(stores.Count, Equals, 2)
+}
@@ -0,0 +1,93 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+func readJSON(r io.ReadCloser, data interface{}) error {
+	defer r.Close()
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = json.Unmarshal(b, data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// parseUint64Var parses the uint64 path variable name of r.
+func parseUint64Var(r *http.Request, name string) (uint64, error) {
+	v, err := strconv.ParseUint(mux.Vars(r)[name], 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s: %s", name, mux.Vars(r)[name])
+	}
+	return v, nil
+}
+
+// parseLimit parses the limit query parameter of r, which must be in (0, max].
+func parseLimit(r *http.Request, def, max int) (int, error) {
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		return def, nil
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		return 0, errors.Errorf("invalid limit: %s", limitStr)
+	}
+	if limit > max {
+		limit = max
+	}
+	return limit, nil
+}
+
+// parseUint64Query parses the uint64 query parameter name of r, it returns 0
+// if the parameter is not given.
+func parseUint64Query(r *http.Request, name string) (uint64, error) {
+	str := r.URL.Query().Get(name)
+	if str == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s: %s", name, str)
+	}
+	return v, nil
+}
+
+// parseInt64Query parses the int64 query parameter name of r, it returns 0 if
+// the parameter is not given.
+func parseInt64Query(r *http.Request, name string) (int64, error) {
+	str := r.URL.Query().Get(name)
+	if str == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s: %s", name, str)
+	}
+	return v, nil
+}
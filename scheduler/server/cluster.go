@@ -28,7 +28,10 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/id"
+	syncer "github.com/pingcap-incubator/tinykv/scheduler/server/region_syncer"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
 	"github.com/pingcap/errcode"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
@@ -65,8 +68,14 @@ type RaftCluster struct {
 	storage *core.Storage
 	id      id.Allocator
 
+	regionLabeler *labeler.RegionLabeler
+
 	coordinator *coordinator
 
+	// regionSyncer broadcasts changedRegions to the follower schedulers.
+	regionSyncer   *syncer.RegionSyncer
+	changedRegions chan *core.RegionInfo
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -79,11 +88,12 @@ type ClusterStatus struct {
 
 func newRaftCluster(ctx context.Context, s *Server, clusterID uint64) *RaftCluster {
 	return &RaftCluster{
-		ctx:         ctx,
-		s:           s,
-		running:     false,
-		clusterID:   clusterID,
-		clusterRoot: s.getClusterRootPath(),
+		ctx:          ctx,
+		s:            s,
+		running:      false,
+		clusterID:    clusterID,
+		clusterRoot:  s.getClusterRootPath(),
+		regionSyncer: syncer.NewRegionSyncer(s),
 	}
 }
 
@@ -132,6 +142,7 @@ func (c *RaftCluster) initCluster(id id.Allocator, opt *config.ScheduleOption, s
 	c.opt = opt
 	c.storage = storage
 	c.id = id
+	c.regionLabeler = labeler.NewRegionLabeler(storage)
 }
 
 func (c *RaftCluster) start() error {
@@ -153,11 +164,13 @@ func (c *RaftCluster) start() error {
 	}
 
 	c.coordinator = newCoordinator(c.ctx, cluster, c.s.hbStreams)
+	c.changedRegions = make(chan *core.RegionInfo, defaultChangedRegionsLimit)
 	c.quit = make(chan struct{})
 
-	c.wg.Add(2)
+	c.wg.Add(3)
 	go c.runCoordinator()
 	go c.runBackgroundJobs(backgroundJobInterval)
+	go c.syncRegions()
 	c.running = true
 
 	return nil
@@ -182,9 +195,34 @@ func (c *RaftCluster) loadClusterInfo() (*RaftCluster, error) {
 		zap.Int("count", c.getStoreCount()),
 		zap.Duration("cost", time.Since(start)),
 	)
+
+	if err := c.regionLabeler.LoadRules(); err != nil {
+		return nil, err
+	}
+
+	start = time.Now()
+	if err := c.storage.LoadRegions(c.putSyncedRegion); err != nil {
+		return nil, err
+	}
+	log.Info("load regions",
+		zap.Int("count", c.core.GetRegionCount()),
+		zap.Duration("cost", time.Since(start)),
+	)
+	for _, store := range c.core.GetStores() {
+		c.updateStoreStatusLocked(store.GetID())
+	}
 	return c, nil
 }
 
+// putSyncedRegion puts a region loaded from storage into the cache, with the
+// leader synced from the previous leader if there is one.
+func (c *RaftCluster) putSyncedRegion(region *core.RegionInfo) []*core.RegionInfo {
+	if leader := c.regionSyncer.GetRegionLeader(region.GetID()); leader != nil {
+		region = region.Clone(core.WithLeader(leader))
+	}
+	return c.core.PutRegion(region)
+}
+
 func (c *RaftCluster) runBackgroundJobs(interval time.Duration) {
 	defer logutil.LogPanic()
 	defer c.wg.Done()
@@ -203,6 +241,12 @@ func (c *RaftCluster) runBackgroundJobs(interval time.Duration) {
 	}
 }
 
+func (c *RaftCluster) syncRegions() {
+	defer logutil.LogPanic()
+	defer c.wg.Done()
+	c.regionSyncer.RunServer(c.changedRegions, c.quit)
+}
+
 func (c *RaftCluster) runCoordinator() {
 	defer logutil.LogPanic()
 	defer c.wg.Done()
@@ -251,6 +295,16 @@ func (c *RaftCluster) GetHeartbeatStreams() *heartbeatStreams {
 	return c.coordinator.hbStreams
 }
 
+// GetRegionLabeler returns the labeler of the key ranges.
+func (c *RaftCluster) GetRegionLabeler() *labeler.RegionLabeler {
+	return c.regionLabeler
+}
+
+// GetRegionSyncer returns the region syncer.
+func (c *RaftCluster) GetRegionSyncer() *syncer.RegionSyncer {
+	return c.regionSyncer
+}
+
 // GetCoordinator returns the coordinator.
 func (c *RaftCluster) GetCoordinator() *coordinator {
 	c.RLock()
@@ -290,13 +344,13 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 	// Save to storage if meta is updated.
 	// Save to cache if meta or leader is updated, or contains any down/pending peer.
 	// Mark isNew if the region in cache does not have leader.
-	var saveCache bool
+	var saveKV, saveCache, needSync bool
 	if origin == nil {
 		log.Debug("insert new region",
 			zap.Uint64("region-id", region.GetID()),
 			zap.Stringer("meta-region", core.RegionToHexMeta(region.GetMeta())),
 		)
-		saveCache = true
+		saveKV, saveCache = true, true
 	} else {
 		r := region.GetRegionEpoch()
 		o := origin.GetRegionEpoch()
@@ -311,7 +365,7 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 				zap.Uint64("old-version", o.GetVersion()),
 				zap.Uint64("new-version", r.GetVersion()),
 			)
-			saveCache = true
+			saveKV, saveCache = true, true
 		}
 		if r.GetConfVer() > o.GetConfVer() {
 			log.Info("region ConfVer changed",
@@ -320,7 +374,7 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 				zap.Uint64("old-confver", o.GetConfVer()),
 				zap.Uint64("new-confver", r.GetConfVer()),
 			)
-			saveCache = true
+			saveKV, saveCache = true, true
 		}
 		if region.GetLeader().GetId() != origin.GetLeader().GetId() {
 			if origin.GetLeader().GetId() != 0 {
@@ -330,9 +384,12 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 					zap.Uint64("to", region.GetLeader().GetStoreId()),
 				)
 			}
+			saveCache, needSync = true, true
+		}
+		if len(region.GetDownPeers()) > 0 || len(region.GetPendingPeers()) > 0 {
 			saveCache = true
 		}
-		if len(region.GetPendingPeers()) > 0 {
+		if len(origin.GetDownPeers()) > 0 {
 			saveCache = true
 		}
 		if len(origin.GetPendingPeers()) > 0 {
@@ -352,7 +409,17 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 		c.Lock()
 		defer c.Unlock()
 
-		c.core.PutRegion(region)
+		overlaps := c.core.PutRegion(region)
+		if c.storage != nil {
+			for _, item := range overlaps {
+				if err := c.storage.DeleteRegion(item.GetMeta()); err != nil {
+					log.Error("failed to delete region from storage",
+						zap.Uint64("region-id", item.GetID()),
+						zap.Stringer("region-meta", core.RegionToHexMeta(item.GetMeta())),
+						zap.Error(err))
+				}
+			}
+		}
 
 		// Update related stores.
 		if origin != nil {
@@ -365,6 +432,23 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 		}
 	}
 
+	if saveKV && c.storage != nil {
+		if err := c.storage.SaveRegion(region.GetMeta()); err != nil {
+			// Not successfully saved to storage is not fatal, it only leads to longer warm-up
+			// after restart. Here we only log the error then go on updating cache.
+			log.Error("failed to save region to storage",
+				zap.Uint64("region-id", region.GetID()),
+				zap.Stringer("region-meta", core.RegionToHexMeta(region.GetMeta())),
+				zap.Error(err))
+		}
+	}
+	if saveKV || needSync {
+		select {
+		case c.changedRegions <- region:
+		default:
+		}
+	}
+
 	return nil
 }
 
@@ -855,6 +939,29 @@ func (c *RaftCluster) GetReplicaScheduleLimit() uint64 {
 	return c.opt.GetReplicaScheduleLimit()
 }
 
+// GetStoreLimitByType returns the number of the given type of operators of the
+// store allowed per minute.
+func (c *RaftCluster) GetStoreLimitByType(storeID uint64, typ storelimit.Type) float64 {
+	return c.opt.GetStoreLimitByType(storeID, typ)
+}
+
+// GetHighSpaceRatio returns the used ratio of a store's disk below which the
+// region score only depends on the region size.
+func (c *RaftCluster) GetHighSpaceRatio() float64 {
+	return c.opt.GetHighSpaceRatio()
+}
+
+// GetLowSpaceRatio returns the used ratio of a store's disk above which the
+// store is considered lack of space.
+func (c *RaftCluster) GetLowSpaceRatio() float64 {
+	return c.opt.GetLowSpaceRatio()
+}
+
+// GetRegionScoreFormulaVersion returns the formula of the region score.
+func (c *RaftCluster) GetRegionScoreFormulaVersion() string {
+	return c.opt.GetRegionScoreFormulaVersion()
+}
+
 // GetPatrolRegionInterval returns the interval of patroling region.
 func (c *RaftCluster) GetPatrolRegionInterval() time.Duration {
 	return c.opt.GetPatrolRegionInterval()
@@ -873,6 +980,11 @@ func (c *RaftCluster) GetMaxReplicas() int {
 func (c *RaftCluster) putRegion(region *core.RegionInfo) error {
 	c.Lock()
 	defer c.Unlock()
+	if c.storage != nil {
+		if err := c.storage.SaveRegion(region.GetMeta()); err != nil {
+			return err
+		}
+	}
 	c.core.PutRegion(region)
 	return nil
 }
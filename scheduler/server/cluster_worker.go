@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -51,6 +52,9 @@ func (c *RaftCluster) handleAskSplit(request *pdpb.AskSplitRequest) (*pdpb.AskSp
 	if err != nil {
 		return nil, err
 	}
+	if c.regionLabeler.KeyRangeHasLabel(reqRegion.GetStartKey(), reqRegion.GetEndKey(), labeler.NoSplit) {
+		return nil, errors.Errorf("region %d is labeled %s", reqRegion.GetId(), labeler.NoSplit)
+	}
 
 	newRegionID, err := c.s.idAllocator.Alloc()
 	if err != nil {
@@ -95,13 +99,16 @@ func (c *RaftCluster) handleAskBatchSplit(request *pdpb.AskBatchSplitRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	if c.regionLabeler.KeyRangeHasLabel(reqRegion.GetStartKey(), reqRegion.GetEndKey(), labeler.NoSplit) {
+		return nil, errors.Errorf("region %d is labeled %s", reqRegion.GetId(), labeler.NoSplit)
+	}
 	splitIDs := make([]*pdpb.SplitID, 0, splitCount)
 	recordRegions := make([]uint64, 0, splitCount+1)
 
 	for i := 0; i < int(splitCount); i++ {
 		newRegionID, err := c.s.idAllocator.Alloc()
 		if err != nil {
-			return nil, errSchedulerNotFound
+			return nil, ErrSchedulerNotFound
 		}
 
 		peerIDs := make([]uint64, len(request.Region.Peers))
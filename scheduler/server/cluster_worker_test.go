@@ -18,6 +18,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	. "github.com/pingcap/check"
 )
 
@@ -131,4 +132,15 @@ func (s *testClusterWorkerSuite) TestAskSplit(c *C) {
 
 	_, err = cluster.handleAskBatchSplit(req1)
 	c.Assert(err, IsNil)
+
+	// Splits are rejected once the region is labeled no-split.
+	rule := &labeler.LabelRule{ID: "import", StartKey: "00", Labels: []labeler.Label{labeler.NoSplit}}
+	c.Assert(cluster.GetRegionLabeler().SetLabelRule(rule), IsNil)
+	_, err = cluster.handleAskSplit(req)
+	c.Assert(err, NotNil)
+	_, err = cluster.handleAskBatchSplit(req1)
+	c.Assert(err, NotNil)
+	c.Assert(cluster.GetRegionLabeler().DeleteLabelRule("import"), IsNil)
+	_, err = cluster.handleAskSplit(req)
+	c.Assert(err, IsNil)
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
@@ -193,6 +194,12 @@ func adjustInt64(v *int64, defValue int64) {
 	}
 }
 
+func adjustFloat64(v *float64, defValue float64) {
+	if *v == 0 {
+		*v = defValue
+	}
+}
+
 func adjustDuration(v *typeutil.Duration, defValue time.Duration) {
 	if v.Duration == 0 {
 		v.Duration = defValue
@@ -201,7 +208,8 @@ func adjustDuration(v *typeutil.Duration, defValue time.Duration) {
 
 func adjustSchedulers(v *SchedulerConfigs, defValue SchedulerConfigs) {
 	if len(*v) == 0 {
-		*v = defValue
+		// Copy the default value so that modifying the result doesn't change it.
+		*v = append(defValue[:0:0], defValue...)
 	}
 }
 
@@ -423,6 +431,25 @@ type ScheduleConfig struct {
 	RegionScheduleLimit uint64 `toml:"region-schedule-limit,omitempty" json:"region-schedule-limit"`
 	// ReplicaScheduleLimit is the max coexist replica schedules.
 	ReplicaScheduleLimit uint64 `toml:"replica-schedule-limit,omitempty" json:"replica-schedule-limit"`
+	// StoreBalanceRate is the maximum number of peers added to or removed
+	// from a store per minute, for each of the two directions.
+	StoreBalanceRate float64 `toml:"store-balance-rate,omitempty" json:"store-balance-rate"`
+	// StoreLimit overrides StoreBalanceRate for the stores, e.g. to speed up
+	// moving the regions out of a store being decommissioned. It is only set
+	// through the API since the store IDs are unknown in the config file.
+	StoreLimit map[uint64]StoreLimitConfig `toml:"-" json:"store-limit"`
+	// HighSpaceRatio is the used ratio of a store's disk below which the
+	// region score only depends on the region size.
+	HighSpaceRatio float64 `toml:"high-space-ratio,omitempty" json:"high-space-ratio"`
+	// LowSpaceRatio is the used ratio of a store's disk above which the store
+	// is considered lack of space, no region will be moved to it.
+	LowSpaceRatio float64 `toml:"low-space-ratio,omitempty" json:"low-space-ratio"`
+	// RegionScoreFormulaVersion is the formula of the region score, "v1"
+	// only uses the region size while "v2" takes the disk usage into account.
+	RegionScoreFormulaVersion string `toml:"region-score-formula-version,omitempty" json:"region-score-formula-version"`
+	// OperatorHistoryRetention is how long the records of the finished
+	// operators are persisted, 0 means they are only kept in memory.
+	OperatorHistoryRetention typeutil.Duration `toml:"operator-history-retention,omitempty" json:"operator-history-retention"`
 
 	// Schedulers support for loading customized schedulers
 	Schedulers SchedulerConfigs `toml:"schedulers,omitempty" json:"schedulers-v2"` // json v2 is for the sake of compatible upgrade
@@ -435,13 +462,23 @@ type ScheduleConfig struct {
 func (c *ScheduleConfig) Clone() *ScheduleConfig {
 	schedulers := make(SchedulerConfigs, len(c.Schedulers))
 	copy(schedulers, c.Schedulers)
+	storeLimit := make(map[uint64]StoreLimitConfig, len(c.StoreLimit))
+	for k, v := range c.StoreLimit {
+		storeLimit[k] = v
+	}
 	return &ScheduleConfig{
-		PatrolRegionInterval: c.PatrolRegionInterval,
-		MaxStoreDownTime:     c.MaxStoreDownTime,
-		LeaderScheduleLimit:  c.LeaderScheduleLimit,
-		RegionScheduleLimit:  c.RegionScheduleLimit,
-		ReplicaScheduleLimit: c.ReplicaScheduleLimit,
-		Schedulers:           schedulers,
+		PatrolRegionInterval:      c.PatrolRegionInterval,
+		MaxStoreDownTime:          c.MaxStoreDownTime,
+		LeaderScheduleLimit:       c.LeaderScheduleLimit,
+		RegionScheduleLimit:       c.RegionScheduleLimit,
+		ReplicaScheduleLimit:      c.ReplicaScheduleLimit,
+		StoreBalanceRate:          c.StoreBalanceRate,
+		StoreLimit:                storeLimit,
+		HighSpaceRatio:            c.HighSpaceRatio,
+		LowSpaceRatio:             c.LowSpaceRatio,
+		RegionScoreFormulaVersion: c.RegionScoreFormulaVersion,
+		OperatorHistoryRetention:  c.OperatorHistoryRetention,
+		Schedulers:                schedulers,
 	}
 }
 
@@ -452,6 +489,9 @@ const (
 	defaultLeaderScheduleLimit  = 4
 	defaultRegionScheduleLimit  = 2048
 	defaultReplicaScheduleLimit = 64
+	defaultStoreBalanceRate     = 15
+	defaultHighSpaceRatio       = 0.7
+	defaultLowSpaceRatio        = 0.8
 )
 
 func (c *ScheduleConfig) adjust(meta *configMetaData) error {
@@ -466,6 +506,13 @@ func (c *ScheduleConfig) adjust(meta *configMetaData) error {
 	if !meta.IsDefined("replica-schedule-limit") {
 		adjustUint64(&c.ReplicaScheduleLimit, defaultReplicaScheduleLimit)
 	}
+	adjustFloat64(&c.StoreBalanceRate, defaultStoreBalanceRate)
+	adjustFloat64(&c.HighSpaceRatio, defaultHighSpaceRatio)
+	adjustFloat64(&c.LowSpaceRatio, defaultLowSpaceRatio)
+	adjustString(&c.RegionScoreFormulaVersion, core.RegionScoreFormulaV2)
+	if c.StoreLimit == nil {
+		c.StoreLimit = make(map[uint64]StoreLimitConfig)
+	}
 	adjustSchedulers(&c.Schedulers, defaultSchedulers)
 
 	return c.Validate()
@@ -473,6 +520,29 @@ func (c *ScheduleConfig) adjust(meta *configMetaData) error {
 
 // Validate is used to validate if some scheduling configurations are right.
 func (c *ScheduleConfig) Validate() error {
+	if c.StoreBalanceRate <= 0 {
+		return errors.New("store-balance-rate should be greater than 0")
+	}
+	if c.HighSpaceRatio <= 0 || c.HighSpaceRatio >= 1 {
+		return errors.New("high-space-ratio should be in (0, 1)")
+	}
+	if c.LowSpaceRatio <= 0 || c.LowSpaceRatio >= 1 {
+		return errors.New("low-space-ratio should be in (0, 1)")
+	}
+	if c.LowSpaceRatio < c.HighSpaceRatio {
+		return errors.New("low-space-ratio should not be less than high-space-ratio")
+	}
+	if c.RegionScoreFormulaVersion != core.RegionScoreFormulaV1 && c.RegionScoreFormulaVersion != core.RegionScoreFormulaV2 {
+		return errors.Errorf("region-score-formula-version should be %s or %s", core.RegionScoreFormulaV1, core.RegionScoreFormulaV2)
+	}
+	if c.OperatorHistoryRetention.Duration < 0 {
+		return errors.New("operator-history-retention should not be negative")
+	}
+	for storeID, limit := range c.StoreLimit {
+		if limit.AddPeer <= 0 || limit.RemovePeer <= 0 {
+			return errors.Errorf("store-limit of store %d should be greater than 0", storeID)
+		}
+	}
 	for _, scheduleConfig := range c.Schedulers {
 		if !schedule.IsSchedulerRegistered(scheduleConfig.Type) {
 			return errors.Errorf("create func of %v is not registered, maybe misspelled", scheduleConfig.Type)
@@ -481,6 +551,13 @@ func (c *ScheduleConfig) Validate() error {
 	return nil
 }
 
+// StoreLimitConfig is the number of peers can be added to or removed from a
+// store per minute.
+type StoreLimitConfig struct {
+	AddPeer    float64 `toml:"add-peer" json:"add-peer"`
+	RemovePeer float64 `toml:"remove-peer" json:"remove-peer"`
+}
+
 // SchedulerConfigs is a slice of customized scheduler configuration.
 type SchedulerConfigs []SchedulerConfig
 
@@ -519,6 +596,14 @@ func (c *ReplicationConfig) clone() *ReplicationConfig {
 	}
 }
 
+// Validate is used to validate if some replication configurations are right.
+func (c *ReplicationConfig) Validate() error {
+	if c.MaxReplicas == 0 {
+		return errors.New("max-replicas should be greater than 0")
+	}
+	return nil
+}
+
 func (c *ReplicationConfig) adjust() error {
 	adjustUint64(&c.MaxReplicas, defaultMaxReplicas)
 
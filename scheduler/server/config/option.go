@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
 )
 
 // ScheduleOption is a wrapper to access the configuration safely.
@@ -90,6 +91,85 @@ func (o *ScheduleOption) GetReplicaScheduleLimit() uint64 {
 	return o.Load().ReplicaScheduleLimit
 }
 
+// GetStoreBalanceRate returns the default number of peers can be added to or
+// removed from a store per minute.
+func (o *ScheduleOption) GetStoreBalanceRate() float64 {
+	return o.Load().StoreBalanceRate
+}
+
+// GetHighSpaceRatio returns the used ratio of a store's disk below which the
+// region score only depends on the region size.
+func (o *ScheduleOption) GetHighSpaceRatio() float64 {
+	return o.Load().HighSpaceRatio
+}
+
+// GetLowSpaceRatio returns the used ratio of a store's disk above which the
+// store is considered lack of space.
+func (o *ScheduleOption) GetLowSpaceRatio() float64 {
+	return o.Load().LowSpaceRatio
+}
+
+// GetRegionScoreFormulaVersion returns the formula of the region score.
+func (o *ScheduleOption) GetRegionScoreFormulaVersion() string {
+	return o.Load().RegionScoreFormulaVersion
+}
+
+// GetOperatorHistoryRetention returns how long the records of the finished
+// operators are persisted.
+func (o *ScheduleOption) GetOperatorHistoryRetention() time.Duration {
+	return o.Load().OperatorHistoryRetention.Duration
+}
+
+// GetStoreLimit returns the limit of the store, the store-limit override is
+// used if there is one.
+func (o *ScheduleOption) GetStoreLimit(storeID uint64) StoreLimitConfig {
+	c := o.Load()
+	if limit, ok := c.StoreLimit[storeID]; ok {
+		return limit
+	}
+	return StoreLimitConfig{AddPeer: c.StoreBalanceRate, RemovePeer: c.StoreBalanceRate}
+}
+
+// GetStoreLimitByType returns the limit of the store with the given type.
+func (o *ScheduleOption) GetStoreLimitByType(storeID uint64, typ storelimit.Type) float64 {
+	limit := o.GetStoreLimit(storeID)
+	switch typ {
+	case storelimit.AddPeer:
+		return limit.AddPeer
+	case storelimit.RemovePeer:
+		return limit.RemovePeer
+	default:
+		panic("invalid store limit type")
+	}
+}
+
+// SetStoreLimit overrides the limit of the store with the given types, or
+// both types if none is given.
+func (o *ScheduleOption) SetStoreLimit(storeID uint64, rate float64, typs ...storelimit.Type) {
+	v := o.Load().Clone()
+	limit := o.GetStoreLimit(storeID)
+	if len(typs) == 0 {
+		typs = []storelimit.Type{storelimit.AddPeer, storelimit.RemovePeer}
+	}
+	for _, typ := range typs {
+		switch typ {
+		case storelimit.AddPeer:
+			limit.AddPeer = rate
+		case storelimit.RemovePeer:
+			limit.RemovePeer = rate
+		}
+	}
+	v.StoreLimit[storeID] = limit
+	o.Store(v)
+}
+
+// RemoveStoreLimit removes the store-limit override of the store.
+func (o *ScheduleOption) RemoveStoreLimit(storeID uint64) {
+	v := o.Load().Clone()
+	delete(v.StoreLimit, storeID)
+	o.Store(v)
+}
+
 // GetSchedulers gets the scheduler configurations.
 func (o *ScheduleOption) GetSchedulers() SchedulerConfigs {
 	return o.Load().Schedulers
@@ -148,6 +228,36 @@ func (o *ScheduleOption) LoadPDServerConfig() *PDServerConfig {
 	return o.pdServerConfig.Load().(*PDServerConfig)
 }
 
+// Persist saves the configuration to the storage.
+func (o *ScheduleOption) Persist(storage *core.Storage) error {
+	cfg := &Config{
+		Schedule:    *o.Load(),
+		Replication: *o.replication.Load(),
+		PDServerCfg: *o.LoadPDServerConfig(),
+	}
+	return storage.SaveConfig(cfg)
+}
+
+// Reload reloads the configuration from the storage. The configuration is
+// kept unchanged if nothing has been persisted yet.
+func (o *ScheduleOption) Reload(storage *core.Storage) error {
+	cfg := &Config{
+		Schedule:    *o.Load().Clone(),
+		Replication: *o.replication.Load(),
+		PDServerCfg: *o.LoadPDServerConfig(),
+	}
+	isExist, err := storage.LoadConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if isExist {
+		o.Store(&cfg.Schedule)
+		o.replication.Store(&cfg.Replication)
+		o.pdServerConfig.Store(&cfg.PDServerCfg)
+	}
+	return nil
+}
+
 // Replication provides some help to do replication.
 type Replication struct {
 	replicateCfg atomic.Value
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
@@ -40,36 +41,41 @@ const (
 )
 
 var (
-	errSchedulerExisted  = errors.New("scheduler existed")
-	errSchedulerNotFound = errors.New("scheduler not found")
+	// ErrSchedulerExisted is error info for adding a scheduler which is already running.
+	ErrSchedulerExisted = errors.New("scheduler existed")
+	// ErrSchedulerNotFound is error info for scheduler not found.
+	ErrSchedulerNotFound = errors.New("scheduler not found")
 )
 
 // coordinator is used to manage all schedulers and checkers to decide if the region needs to be scheduled.
 type coordinator struct {
 	sync.RWMutex
 
-	wg           sync.WaitGroup
-	ctx          context.Context
-	cancel       context.CancelFunc
-	cluster      *RaftCluster
-	checkers     *schedule.CheckerController
-	schedulers   map[string]*scheduleController
-	opController *schedule.OperatorController
-	hbStreams    *heartbeatStreams
+	wg              sync.WaitGroup
+	ctx             context.Context
+	cancel          context.CancelFunc
+	cluster         *RaftCluster
+	checkers        *schedule.CheckerController
+	regionScatterer *schedule.RegionScatterer
+	schedulers      map[string]*scheduleController
+	opController    *schedule.OperatorController
+	hbStreams       *heartbeatStreams
 }
 
 // newCoordinator creates a new coordinator.
 func newCoordinator(ctx context.Context, cluster *RaftCluster, hbStreams *heartbeatStreams) *coordinator {
 	ctx, cancel := context.WithCancel(ctx)
 	opController := schedule.NewOperatorController(ctx, cluster, hbStreams)
+	opController.SetHistoryStorage(cluster.storage, cluster.opt.GetOperatorHistoryRetention)
 	return &coordinator{
-		ctx:          ctx,
-		cancel:       cancel,
-		cluster:      cluster,
-		checkers:     schedule.NewCheckerController(ctx, cluster, opController),
-		schedulers:   make(map[string]*scheduleController),
-		opController: opController,
-		hbStreams:    hbStreams,
+		ctx:             ctx,
+		cancel:          cancel,
+		cluster:         cluster,
+		checkers:        schedule.NewCheckerController(ctx, cluster, opController),
+		regionScatterer: schedule.NewRegionScatterer(cluster),
+		schedulers:      make(map[string]*scheduleController),
+		opController:    opController,
+		hbStreams:       hbStreams,
 	}
 }
 
@@ -188,7 +194,7 @@ func (c *coordinator) run() {
 		}
 
 		log.Info("create scheduler", zap.String("scheduler-name", s.GetName()))
-		if err = c.addScheduler(s, schedulerCfg.Args...); err != nil && err != errSchedulerExisted {
+		if err = c.addScheduler(s, schedulerCfg.Args...); err != nil && err != ErrSchedulerExisted {
 			log.Error("can not add scheduler", zap.String("scheduler-name", s.GetName()), zap.Error(err))
 		} else {
 			// Only records the valid scheduler config.
@@ -200,6 +206,9 @@ func (c *coordinator) run() {
 	// Removes the invalid scheduler config and persist.
 	scheduleCfg.Schedulers = scheduleCfg.Schedulers[:k]
 	c.cluster.opt.Store(scheduleCfg)
+	if err := c.cluster.opt.Persist(c.cluster.storage); err != nil {
+		log.Error("cannot persist schedule config", zap.Error(err))
+	}
 
 	c.wg.Add(1)
 	// Starts to patrol regions.
@@ -226,7 +235,7 @@ func (c *coordinator) addScheduler(scheduler schedule.Scheduler, args ...string)
 	defer c.Unlock()
 
 	if _, ok := c.schedulers[scheduler.GetName()]; ok {
-		return errSchedulerExisted
+		return ErrSchedulerExisted
 	}
 
 	s := newScheduleController(c, scheduler)
@@ -250,7 +259,7 @@ func (c *coordinator) removeScheduler(name string) error {
 	}
 	s, ok := c.schedulers[name]
 	if !ok {
-		return errSchedulerNotFound
+		return ErrSchedulerNotFound
 	}
 
 	s.Stop()
@@ -260,6 +269,8 @@ func (c *coordinator) removeScheduler(name string) error {
 	opt := c.cluster.opt
 	if err = opt.RemoveSchedulerCfg(s.Ctx(), name); err != nil {
 		log.Error("can not remove scheduler", zap.String("scheduler-name", name), zap.Error(err))
+	} else if err = opt.Persist(c.cluster.storage); err != nil {
+		log.Error("the option can not persist scheduler config", zap.Error(err))
 	} else {
 		err = c.cluster.storage.RemoveScheduleConfig(name)
 		if err != nil {
@@ -269,6 +280,49 @@ func (c *coordinator) removeScheduler(name string) error {
 	return err
 }
 
+// pauseOrResumeScheduler pauses the scheduler for t seconds, or resumes it if t
+// is not positive. The name "all" applies to all schedulers.
+func (c *coordinator) pauseOrResumeScheduler(name string, t int64) error {
+	c.Lock()
+	defer c.Unlock()
+	if c.cluster == nil {
+		return ErrNotBootstrapped
+	}
+	var s []*scheduleController
+	if name != "all" {
+		sc, ok := c.schedulers[name]
+		if !ok {
+			return ErrSchedulerNotFound
+		}
+		s = append(s, sc)
+	} else {
+		for _, sc := range c.schedulers {
+			s = append(s, sc)
+		}
+	}
+	var delayUntil int64
+	if t > 0 {
+		delayUntil = time.Now().Unix() + t
+	}
+	for _, sc := range s {
+		atomic.StoreInt64(&sc.delayUntil, delayUntil)
+	}
+	return nil
+}
+
+func (c *coordinator) getPausedSchedulers() []string {
+	c.RLock()
+	defer c.RUnlock()
+
+	var names []string
+	for name, sc := range c.schedulers {
+		if sc.IsPaused() {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func (c *coordinator) runScheduler(s *scheduleController) {
 	defer logutil.LogPanic()
 	defer c.wg.Done()
@@ -305,6 +359,8 @@ type scheduleController struct {
 	nextInterval time.Duration
 	ctx          context.Context
 	cancel       context.CancelFunc
+	// delayUntil is the unix time in seconds until which the scheduler is paused.
+	delayUntil int64
 }
 
 // newScheduleController creates a new scheduleController.
@@ -347,5 +403,10 @@ func (s *scheduleController) GetInterval() time.Duration {
 
 // AllowSchedule returns if a scheduler is allowed to schedule.
 func (s *scheduleController) AllowSchedule() bool {
-	return s.Scheduler.IsScheduleAllowed(s.cluster)
+	return s.Scheduler.IsScheduleAllowed(s.cluster) && !s.IsPaused()
+}
+
+// IsPaused returns if a scheduler is paused.
+func (s *scheduleController) IsPaused() bool {
+	return time.Now().Unix() < atomic.LoadInt64(&s.delayUntil)
 }
@@ -415,6 +415,60 @@ func (s *testCoordinatorSuite) TestRemoveScheduler(c *C) {
 	co.wg.Wait()
 }
 
+func (s *testCoordinatorSuite) TestPersistScheduler(c *C) {
+	_, opt, err := newTestScheduleConfig()
+	c.Assert(err, IsNil)
+	tc := newTestCluster(opt)
+	hbStreams, cleanup := getHeartBeatStreams(s.ctx, c, tc)
+	defer cleanup()
+	defer hbStreams.Close()
+
+	// Add stores 1,2
+	c.Assert(tc.addLeaderStore(1, 1), IsNil)
+	c.Assert(tc.addLeaderStore(2, 1), IsNil)
+
+	co := newCoordinator(s.ctx, tc.RaftCluster, hbStreams)
+	co.run()
+	storage := tc.RaftCluster.storage
+	args := []string{"1"}
+	evict, err := schedule.CreateScheduler(schedulers.EvictLeaderType, co.opController, storage, schedule.ConfigSliceDecoder(schedulers.EvictLeaderType, args))
+	c.Assert(err, IsNil)
+	c.Assert(co.addScheduler(evict, args...), IsNil)
+	c.Assert(co.schedulers, HasLen, 3)
+	c.Assert(tc.GetStore(1).IsBlocked(), IsTrue)
+	sches, _, err := storage.LoadAllScheduleConfig()
+	c.Assert(err, IsNil)
+	c.Assert(sches, HasLen, 3)
+	newOpt := co.cluster.opt
+	co.stop()
+	co.wg.Wait()
+	c.Assert(tc.GetStore(1).IsBlocked(), IsFalse)
+
+	// suppose restart PD again
+	tc.RaftCluster.opt = newOpt
+	co = newCoordinator(s.ctx, tc.RaftCluster, hbStreams)
+	co.run()
+	c.Assert(co.schedulers, HasLen, 3)
+	c.Assert(co.schedulers, HasKey, "evict-leader-scheduler-1")
+	c.Assert(tc.GetStore(1).IsBlocked(), IsTrue)
+
+	// remove the scheduler and restart again
+	c.Assert(co.removeScheduler("evict-leader-scheduler-1"), IsNil)
+	testutil.WaitUntil(c, func(c *C) bool {
+		return !tc.GetStore(1).IsBlocked()
+	})
+	newOpt = co.cluster.opt
+	co.stop()
+	co.wg.Wait()
+
+	tc.RaftCluster.opt = newOpt
+	co = newCoordinator(s.ctx, tc.RaftCluster, hbStreams)
+	co.run()
+	c.Assert(co.schedulers, HasLen, 2)
+	co.stop()
+	co.wg.Wait()
+}
+
 func (s *testCoordinatorSuite) TestRestart(c *C) {
 	// Turn off balance, we test add replica only.
 	cfg, opt, err := newTestScheduleConfig()
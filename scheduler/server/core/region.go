@@ -32,6 +32,7 @@ type RegionInfo struct {
 	learners        []*metapb.Peer
 	voters          []*metapb.Peer
 	leader          *metapb.Peer
+	downPeers       []*pdpb.PeerStats
 	pendingPeers    []*metapb.Peer
 	approximateSize int64
 	approximateKeys int64
@@ -77,6 +78,7 @@ func RegionFromHeartbeat(heartbeat *pdpb.RegionHeartbeatRequest) *RegionInfo {
 	region := &RegionInfo{
 		meta:            heartbeat.GetRegion(),
 		leader:          heartbeat.GetLeader(),
+		downPeers:       heartbeat.GetDownPeers(),
 		pendingPeers:    heartbeat.GetPendingPeers(),
 		approximateSize: int64(regionSize),
 		approximateKeys: int64(heartbeat.GetApproximateKeys()),
@@ -89,6 +91,10 @@ func RegionFromHeartbeat(heartbeat *pdpb.RegionHeartbeatRequest) *RegionInfo {
 
 // Clone returns a copy of current regionInfo.
 func (r *RegionInfo) Clone(opts ...RegionCreateOption) *RegionInfo {
+	downPeers := make([]*pdpb.PeerStats, 0, len(r.downPeers))
+	for _, peer := range r.downPeers {
+		downPeers = append(downPeers, proto.Clone(peer).(*pdpb.PeerStats))
+	}
 	pendingPeers := make([]*metapb.Peer, 0, len(r.pendingPeers))
 	for _, peer := range r.pendingPeers {
 		pendingPeers = append(pendingPeers, proto.Clone(peer).(*metapb.Peer))
@@ -97,6 +103,7 @@ func (r *RegionInfo) Clone(opts ...RegionCreateOption) *RegionInfo {
 	region := &RegionInfo{
 		meta:            proto.Clone(r.meta).(*metapb.Region),
 		leader:          proto.Clone(r.leader).(*metapb.Peer),
+		downPeers:       downPeers,
 		pendingPeers:    pendingPeers,
 		approximateSize: r.approximateSize,
 		approximateKeys: r.approximateKeys,
@@ -130,6 +137,16 @@ func (r *RegionInfo) GetPeer(peerID uint64) *metapb.Peer {
 	return nil
 }
 
+// GetDownPeer returns the down peer with specified peer id.
+func (r *RegionInfo) GetDownPeer(peerID uint64) *metapb.Peer {
+	for _, down := range r.downPeers {
+		if down.GetPeer().GetId() == peerID {
+			return down.GetPeer()
+		}
+	}
+	return nil
+}
+
 // GetDownLearner returns the down learner with soecified peer id.
 func (r *RegionInfo) GetDownLearner(peerID uint64) *metapb.Peer {
 	return nil
@@ -266,6 +283,11 @@ func (r *RegionInfo) GetInterval() *pdpb.TimeInterval {
 	return r.interval
 }
 
+// GetDownPeers returns the down peers of the region.
+func (r *RegionInfo) GetDownPeers() []*pdpb.PeerStats {
+	return r.downPeers
+}
+
 // GetPendingPeers returns the pending peers of the region.
 func (r *RegionInfo) GetPendingPeers() []*metapb.Peer {
 	return r.pendingPeers
@@ -388,21 +410,23 @@ func (rst *regionSubTree) scanRanges() []*RegionInfo {
 }
 
 func (rst *regionSubTree) update(region *RegionInfo) {
-	if r := rst.find(region); r != nil {
-		rst.totalSize += region.approximateSize - r.region.approximateSize
-		rst.totalKeys += region.approximateKeys - r.region.approximateKeys
-		r.region = region
-		return
-	}
+	overlaps := rst.regionTree.update(region)
 	rst.totalSize += region.approximateSize
 	rst.totalKeys += region.approximateKeys
-	rst.regionTree.update(region)
+	for _, r := range overlaps {
+		rst.totalSize -= r.approximateSize
+		rst.totalKeys -= r.approximateKeys
+	}
 }
 
 func (rst *regionSubTree) remove(region *RegionInfo) {
 	if rst.length() == 0 {
 		return
 	}
+	if r := rst.find(region); r != nil && r.region.GetID() == region.GetID() {
+		rst.totalSize -= r.region.approximateSize
+		rst.totalKeys -= r.region.approximateKeys
+	}
 	rst.regionTree.remove(region)
 }
 
@@ -15,6 +15,7 @@ package core
 
 import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 )
 
 // RegionOption is used to select region.
@@ -23,20 +24,27 @@ type RegionOption func(region *RegionInfo) bool
 // HealthRegion checks if the region is healthy.
 func HealthRegion() RegionOption {
 	return func(region *RegionInfo) bool {
-		return len(region.pendingPeers) == 0 && len(region.learners) == 0
+		return len(region.downPeers) == 0 && len(region.pendingPeers) == 0 && len(region.learners) == 0
 	}
 }
 
 // HealthRegionAllowPending checks if the region is healthy with allowing the pending peer.
 func HealthRegionAllowPending() RegionOption {
 	return func(region *RegionInfo) bool {
-		return len(region.learners) == 0
+		return len(region.downPeers) == 0 && len(region.learners) == 0
 	}
 }
 
 // RegionCreateOption used to create region.
 type RegionCreateOption func(region *RegionInfo)
 
+// WithDownPeers sets the down peers for the region.
+func WithDownPeers(downPeers []*pdpb.PeerStats) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.downPeers = downPeers
+	}
+}
+
 // WithPendingPeers sets the pending peers for the region.
 func WithPendingPeers(pengdingPeers []*metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
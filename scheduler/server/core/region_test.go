@@ -131,6 +131,34 @@ func (*testRegionKey) TestRegionKey(c *C) {
 	}
 }
 
+var _ = Suite(&testRegionsInfoSuite{})
+
+type testRegionsInfoSuite struct{}
+
+func (*testRegionsInfoSuite) TestStoreRegionSize(c *C) {
+	regions := NewRegionsInfo()
+	peers := []*metapb.Peer{{Id: 11, StoreId: 1}, {Id: 12, StoreId: 2}}
+	region := NewRegionInfo(&metapb.Region{Id: 1, EndKey: []byte("b"), Peers: peers}, peers[0], SetApproximateSize(10))
+	regions.SetRegion(region)
+	regions.SetRegion(NewRegionInfo(&metapb.Region{Id: 2, StartKey: []byte("b"), Peers: peers}, peers[0], SetApproximateSize(20)))
+	c.Assert(regions.GetStoreRegionSize(1), Equals, int64(30))
+	c.Assert(regions.GetStoreRegionSize(2), Equals, int64(30))
+
+	// The size is updated, and the peer moved from store 2 to 3 is removed
+	// from the size of store 2.
+	peer := &metapb.Peer{Id: 13, StoreId: 3}
+	regions.SetRegion(region.Clone(WithRemoveStorePeer(2), WithAddPeer(peer), SetApproximateSize(15)))
+	c.Assert(regions.GetStoreRegionSize(1), Equals, int64(35))
+	c.Assert(regions.GetStoreRegionSize(2), Equals, int64(20))
+	c.Assert(regions.GetStoreRegionSize(3), Equals, int64(15))
+
+	// The region covering both regions replaces them.
+	regions.SetRegion(NewRegionInfo(&metapb.Region{Id: 3, Peers: peers}, peers[0], SetApproximateSize(40)))
+	c.Assert(regions.GetStoreRegionSize(1), Equals, int64(40))
+	c.Assert(regions.GetStoreRegionSize(2), Equals, int64(40))
+	c.Assert(regions.GetStoreRegionSize(3), Equals, int64(0))
+}
+
 func BenchmarkRandomRegion(b *testing.B) {
 	regions := NewRegionsInfo()
 	for i := 0; i < 5000000; i++ {
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	. "github.com/pingcap/check"
 )
 
@@ -40,11 +41,12 @@ func (s *testRegionSuite) TestRegionInfo(c *C) {
 	region := &metapb.Region{
 		Peers: peers,
 	}
-	pendingPeer := peers[1]
+	downPeer, pendingPeer := peers[0], peers[1]
 
 	info := NewRegionInfo(
 		region,
 		peers[0],
+		WithDownPeers([]*pdpb.PeerStats{{Peer: downPeer}}),
 		WithPendingPeers([]*metapb.Peer{pendingPeer}))
 
 	r := info.Clone()
@@ -54,6 +56,8 @@ func (s *testRegionSuite) TestRegionInfo(c *C) {
 		c.Assert(r.GetPeer(i), Equals, r.meta.Peers[i])
 	}
 	c.Assert(r.GetPeer(n), IsNil)
+	c.Assert(r.GetDownPeer(n), IsNil)
+	c.Assert(r.GetDownPeer(downPeer.GetId()), DeepEquals, downPeer)
 	c.Assert(r.GetPendingPeer(n), IsNil)
 	c.Assert(r.GetPendingPeer(pendingPeer.GetId()), DeepEquals, pendingPeer)
 
@@ -14,6 +14,7 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"path"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pkg/errors"
 	"go.etcd.io/etcd/clientv3"
@@ -29,9 +31,13 @@ import (
 
 const (
 	clusterPath  = "raft"
+	configPath   = "config"
 	schedulePath = "schedule"
 	gcPath       = "gc"
 
+	operatorHistoryPath = "operator_history"
+	regionLabelPath     = "region_label"
+
 	customScheduleConfigPath = "scheduler_config"
 )
 
@@ -43,6 +49,7 @@ const (
 // Storage wraps all kv operations, keep it stateless.
 type Storage struct {
 	kv.Base
+	regionStorage *RegionStorage
 }
 
 // NewStorage creates Storage instance with Base.
@@ -52,6 +59,18 @@ func NewStorage(base kv.Base) *Storage {
 	}
 }
 
+// SetRegionStorage sets the storage that regions are saved to instead of
+// the base kv.
+func (s *Storage) SetRegionStorage(regionStorage *RegionStorage) *Storage {
+	s.regionStorage = regionStorage
+	return s
+}
+
+// GetRegionStorage gets the region storage.
+func (s *Storage) GetRegionStorage() *RegionStorage {
+	return s.regionStorage
+}
+
 func (s *Storage) storePath(storeID uint64) string {
 	return path.Join(clusterPath, "s", fmt.Sprintf("%020d", storeID))
 }
@@ -91,6 +110,31 @@ func (s *Storage) LoadScheduleConfig(scheduleName string) (string, error) {
 	return s.Load(configPath)
 }
 
+// SaveConfig stores marshalable cfg to the configPath.
+func (s *Storage) SaveConfig(cfg interface{}) error {
+	value, err := json.Marshal(cfg)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.Save(configPath, string(value))
+}
+
+// LoadConfig loads config from configPath then unmarshal it to cfg.
+func (s *Storage) LoadConfig(cfg interface{}) (bool, error) {
+	value, err := s.Load(configPath)
+	if err != nil {
+		return false, err
+	}
+	if value == "" {
+		return false, nil
+	}
+	err = json.Unmarshal([]byte(value), cfg)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
 // LoadMeta loads cluster meta from storage.
 func (s *Storage) LoadMeta(meta *metapb.Cluster) (bool, error) {
 	return loadProto(s.Base, clusterPath, meta)
@@ -150,6 +194,38 @@ func (s *Storage) LoadStores(f func(store *StoreInfo)) error {
 	}
 }
 
+// LoadRegion loads one region from storage.
+func (s *Storage) LoadRegion(regionID uint64, region *metapb.Region) (bool, error) {
+	if s.regionStorage != nil {
+		return loadProto(s.regionStorage, regionPath(regionID), region)
+	}
+	return loadProto(s.Base, regionPath(regionID), region)
+}
+
+// LoadRegions loads all regions from storage to RegionsInfo.
+func (s *Storage) LoadRegions(f func(region *RegionInfo) []*RegionInfo) error {
+	if s.regionStorage != nil {
+		return s.regionStorage.LoadRegions(f)
+	}
+	return loadRegions(s.Base, f)
+}
+
+// SaveRegion saves one region to storage.
+func (s *Storage) SaveRegion(region *metapb.Region) error {
+	if s.regionStorage != nil {
+		return s.regionStorage.SaveRegion(region)
+	}
+	return saveProto(s.Base, regionPath(region.GetId()), region)
+}
+
+// DeleteRegion deletes one region from storage.
+func (s *Storage) DeleteRegion(region *metapb.Region) error {
+	if s.regionStorage != nil {
+		return s.regionStorage.DeleteRegion(region)
+	}
+	return deleteRegion(s.Base, region)
+}
+
 // SaveStoreWeight saves a store's leader and region weight to storage.
 func (s *Storage) SaveStoreWeight(storeID uint64, leader, region float64) error {
 	leaderValue := strconv.FormatFloat(leader, 'f', -1, 64)
@@ -177,11 +253,17 @@ func (s *Storage) loadFloatWithDefaultValue(path string, def float64) (float64,
 
 // Flush flushes the dirty region to storage.
 func (s *Storage) Flush() error {
+	if s.regionStorage != nil {
+		return s.regionStorage.FlushRegion()
+	}
 	return nil
 }
 
 // Close closes the s.
 func (s *Storage) Close() error {
+	if s.regionStorage != nil {
+		return s.regionStorage.Close()
+	}
 	return nil
 }
 
@@ -218,6 +300,83 @@ func (s *Storage) LoadAllScheduleConfig() ([]string, []string, error) {
 	return keys, values, err
 }
 
+func operatorRecordPath(finishTime int64, regionID uint64) string {
+	return path.Join(operatorHistoryPath, fmt.Sprintf("%020d", finishTime), fmt.Sprintf("%020d", regionID))
+}
+
+// SaveOperatorRecord saves the record of a finished operator, the records are
+// ordered by their finish time.
+func (s *Storage) SaveOperatorRecord(record *pdpb.OperatorRecord) error {
+	return saveProto(s.Base, operatorRecordPath(record.GetFinishTime(), record.GetRegionId()), record)
+}
+
+// LoadOperatorRecords loads the records of the operators finished in
+// [start, end) in the order of finish time, until f returns false.
+func (s *Storage) LoadOperatorRecords(start, end int64, f func(record *pdpb.OperatorRecord) bool) error {
+	key := operatorRecordPath(start, 0)
+	endKey := operatorRecordPath(end, 0)
+	for {
+		keys, res, err := s.LoadRange(key, endKey, minKVRangeLimit)
+		if err != nil {
+			return err
+		}
+		for _, str := range res {
+			record := &pdpb.OperatorRecord{}
+			if err := record.Unmarshal([]byte(str)); err != nil {
+				return errors.WithStack(err)
+			}
+			if !f(record) {
+				return nil
+			}
+		}
+		if len(res) < minKVRangeLimit {
+			return nil
+		}
+		key = keys[len(keys)-1] + "\x00"
+	}
+}
+
+// DeleteOperatorRecords deletes the records of the operators finished before
+// the given time.
+func (s *Storage) DeleteOperatorRecords(before int64) error {
+	return s.RemoveRange(operatorRecordPath(0, 0), operatorRecordPath(before, 0))
+}
+
+func regionRulePath(id string) string {
+	return path.Join(regionLabelPath, id)
+}
+
+// SaveRegionRule saves the encoded label rule of a key range.
+func (s *Storage) SaveRegionRule(id string, data []byte) error {
+	return s.Save(regionRulePath(id), string(data))
+}
+
+// DeleteRegionRule deletes the label rule of a key range.
+func (s *Storage) DeleteRegionRule(id string) error {
+	return s.Remove(regionRulePath(id))
+}
+
+// LoadRegionRules loads the encoded label rules of all key ranges.
+func (s *Storage) LoadRegionRules(f func(data []byte) error) error {
+	key := regionLabelPath + "/"
+	endKey := clientv3.GetPrefixRangeEnd(key)
+	for {
+		keys, res, err := s.LoadRange(key, endKey, minKVRangeLimit)
+		if err != nil {
+			return err
+		}
+		for _, str := range res {
+			if err := f([]byte(str)); err != nil {
+				return err
+			}
+		}
+		if len(res) < minKVRangeLimit {
+			return nil
+		}
+		key = keys[len(keys)-1] + "\x00"
+	}
+}
+
 func loadProto(s kv.Base, key string, msg proto.Message) (bool, error) {
 	value, err := s.Load(key)
 	if err != nil {
@@ -14,9 +14,13 @@
 package core
 
 import (
+	"context"
+	"io/ioutil"
 	"math"
+	"os"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	. "github.com/pingcap/check"
 )
@@ -83,6 +87,74 @@ func (s *testKVSuite) TestLoadStores(c *C) {
 	}
 }
 
+func mustSaveRegions(c *C, s *Storage, n int) []*metapb.Region {
+	regions := make([]*metapb.Region, 0, n)
+	for i := 0; i < n; i++ {
+		region := &metapb.Region{
+			Id:          uint64(i),
+			StartKey:    []byte{byte(i)},
+			EndKey:      []byte{byte(i + 1)},
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+		}
+		regions = append(regions, region)
+	}
+
+	for _, region := range regions {
+		c.Assert(s.SaveRegion(region), IsNil)
+	}
+
+	return regions
+}
+
+func (s *testKVSuite) TestLoadRegions(c *C) {
+	storage := NewStorage(kv.NewMemoryKV())
+	cache := NewRegionsInfo()
+
+	n := 10
+	regions := mustSaveRegions(c, storage, n)
+	c.Assert(storage.LoadRegions(cache.SetRegion), IsNil)
+
+	c.Assert(cache.GetRegionCount(), Equals, n)
+	for _, region := range cache.GetMetaRegions() {
+		c.Assert(region, DeepEquals, regions[region.GetId()])
+	}
+}
+
+func (s *testKVSuite) TestRegionStorage(c *C) {
+	dir, err := ioutil.TempDir("", "region_storage")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	regionStorage, err := NewRegionStorage(context.Background(), dir)
+	c.Assert(err, IsNil)
+	storage := NewStorage(kv.NewMemoryKV()).SetRegionStorage(regionStorage)
+
+	n := 10
+	regions := mustSaveRegions(c, storage, n)
+	// The regions are buffered until flushed.
+	ok, err := storage.LoadRegion(1, &metapb.Region{})
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+	c.Assert(storage.Flush(), IsNil)
+	region := &metapb.Region{}
+	ok, err = storage.LoadRegion(1, region)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(region, DeepEquals, regions[1])
+
+	c.Assert(storage.DeleteRegion(regions[1]), IsNil)
+	c.Assert(storage.Close(), IsNil)
+
+	// Reopen the region storage and load the regions back.
+	regionStorage, err = NewRegionStorage(context.Background(), dir)
+	c.Assert(err, IsNil)
+	storage = NewStorage(kv.NewMemoryKV()).SetRegionStorage(regionStorage)
+	defer storage.Close()
+	cache := NewRegionsInfo()
+	c.Assert(storage.LoadRegions(cache.SetRegion), IsNil)
+	c.Assert(cache.GetRegionCount(), Equals, n-1)
+	c.Assert(cache.GetRegion(1), IsNil)
+}
+
 func (s *testKVSuite) TestStoreWeight(c *C) {
 	storage := NewStorage(kv.NewMemoryKV())
 	cache := NewStoresInfo()
@@ -115,3 +187,43 @@ func (s *testKVSuite) TestLoadGCSafePoint(c *C) {
 		c.Assert(safePoint, Equals, safePoint1)
 	}
 }
+
+func (s *testKVSuite) TestOperatorRecords(c *C) {
+	storage := NewStorage(kv.NewMemoryKV())
+	n := minKVRangeLimit*2 + 10
+	for i := 1; i <= n; i++ {
+		// Two records finished at the same time.
+		record := &pdpb.OperatorRecord{RegionId: uint64(i), FinishTime: int64((i + 1) / 2)}
+		c.Assert(storage.SaveOperatorRecord(record), IsNil)
+	}
+
+	loadRecords := func(start, end int64) []*pdpb.OperatorRecord {
+		var records []*pdpb.OperatorRecord
+		c.Assert(storage.LoadOperatorRecords(start, end, func(record *pdpb.OperatorRecord) bool {
+			records = append(records, record)
+			return true
+		}), IsNil)
+		return records
+	}
+	records := loadRecords(0, math.MaxInt64)
+	c.Assert(records, HasLen, n)
+	for i, record := range records {
+		c.Assert(record.GetRegionId(), Equals, uint64(i+1))
+	}
+	records = loadRecords(2, 4)
+	c.Assert(records, HasLen, 4)
+	c.Assert(records[0].GetRegionId(), Equals, uint64(3))
+
+	// Stop loading once f returns false.
+	count := 0
+	c.Assert(storage.LoadOperatorRecords(0, math.MaxInt64, func(record *pdpb.OperatorRecord) bool {
+		count++
+		return count < 3
+	}), IsNil)
+	c.Assert(count, Equals, 3)
+
+	c.Assert(storage.DeleteOperatorRecords(int64(n/2)), IsNil)
+	records = loadRecords(0, math.MaxInt64)
+	c.Assert(records, HasLen, n-(n/2-1)*2)
+	c.Assert(records[0].GetFinishTime(), Equals, int64(n/2))
+}
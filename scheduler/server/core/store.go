@@ -219,16 +219,78 @@ func (s *StoreInfo) GetLastHeartbeatTS() time.Time {
 	return s.lastHeartbeatTS
 }
 
-const minWeight = 1e-6
+const (
+	minWeight = 1e-6
+	// maxScore is the score of a store running out of space, it is larger
+	// than the region size of any store in MB.
+	maxScore = 1024 * 1024 * 1024
+)
+
+// The formulas of the region score.
+const (
+	// RegionScoreFormulaV1 scores a store by its region size.
+	RegionScoreFormulaV1 = "v1"
+	// RegionScoreFormulaV2 scores a store by its region size and the
+	// available space of its disk.
+	RegionScoreFormulaV2 = "v2"
+)
 
 // LeaderScore returns the store's leader score.
 func (s *StoreInfo) LeaderScore(delta int64) float64 {
 	return float64(int64(s.GetLeaderCount())+delta) / math.Max(s.GetLeaderWeight(), minWeight)
 }
 
-// RegionScore returns the store's region score.
-func (s *StoreInfo) RegionScore() float64 {
-	return float64(s.GetRegionSize()) / math.Max(s.GetRegionWeight(), minWeight)
+// RegionScore returns the store's region score. See regionScoreV2 for the
+// "v2" formula, any other version is treated as "v1".
+func (s *StoreInfo) RegionScore(version string, highSpaceRatio, lowSpaceRatio float64) float64 {
+	var score float64
+	// The capacity is unknown before the store reports it.
+	if version == RegionScoreFormulaV2 && s.GetCapacity() != 0 {
+		score = s.regionScoreV2(highSpaceRatio, lowSpaceRatio)
+	} else {
+		score = float64(s.GetRegionSize())
+	}
+	return score / math.Max(s.GetRegionWeight(), minWeight)
+}
+
+// regionScoreV2 divides a store into three stages by its available space. In
+// the high space stage, where the used ratio is below highSpaceRatio, the score
+// is the region size just like "v1". In the low space stage, where the used
+// ratio is above lowSpaceRatio, the score is maxScore minus the available
+// space, so that the stores with less space are always scored higher than the
+// others. In between, the score grows linearly from the high space watermark
+// to the low space watermark to keep it continuous. All the sizes are in MB.
+func (s *StoreInfo) regionScoreV2(highSpaceRatio, lowSpaceRatio float64) float64 {
+	available := float64(s.GetAvailable()) / (1 << 20)
+	used := float64(s.GetUsedSize()) / (1 << 20)
+	capacity := float64(s.GetCapacity()) / (1 << 20)
+
+	// The region size is larger than the used size because of the
+	// compression of the storage engine.
+	amplification := 1.0
+	if s.GetRegionSize() != 0 && used != 0 {
+		amplification = float64(s.GetRegionSize()) / used
+	}
+
+	// highSpaceBound is the lower bound of the available space in the high
+	// space stage, and lowSpaceBound is the upper bound in the low space stage.
+	highSpaceBound := (1 - highSpaceRatio) * capacity
+	lowSpaceBound := (1 - lowSpaceRatio) * capacity
+	size := float64(s.GetRegionSize())
+	switch {
+	case available >= highSpaceBound:
+		return size
+	case available <= lowSpaceBound:
+		return maxScore - available
+	default:
+		// Other files may occupy the disk as well, they are regarded as
+		// fixed, so the region size when the available space reaches a
+		// bound is (used + available - bound) * amplification.
+		x1, y1 := (used+available-highSpaceBound)*amplification, (used+available-highSpaceBound)*amplification
+		x2, y2 := (used+available-lowSpaceBound)*amplification, maxScore-lowSpaceBound
+		k := (y2 - y1) / (x2 - x1)
+		return k*(size-x1) + y1
+	}
 }
 
 // StorageSize returns store's used storage size reported from tikv.
@@ -244,9 +306,10 @@ func (s *StoreInfo) AvailableRatio() float64 {
 	return float64(s.GetAvailable()) / float64(s.GetCapacity())
 }
 
-// IsLowSpace checks if the store is lack of space.
+// IsLowSpace checks if the store is lack of space. A store is not considered
+// lack of space before it reports its capacity.
 func (s *StoreInfo) IsLowSpace(lowSpaceRatio float64) bool {
-	return s.GetStoreStats() != nil && s.AvailableRatio() < 1-lowSpaceRatio
+	return s.GetCapacity() != 0 && s.AvailableRatio() < 1-lowSpaceRatio
 }
 
 // ResourceCount returns count of leader/region in the store.
@@ -273,13 +336,15 @@ func (s *StoreInfo) ResourceSize(kind ResourceKind) int64 {
 	}
 }
 
-// ResourceScore returns score of leader/region in the store.
-func (s *StoreInfo) ResourceScore(scheduleKind ScheduleKind, delta int64) float64 {
+// ResourceScore returns score of leader/region in the store, the version and
+// the space ratios tune the formula of the region score. The delta only
+// applies to the leader score.
+func (s *StoreInfo) ResourceScore(scheduleKind ScheduleKind, version string, highSpaceRatio, lowSpaceRatio float64, delta int64) float64 {
 	switch scheduleKind.Resource {
 	case LeaderKind:
 		return s.LeaderScore(delta)
 	case RegionKind:
-		return s.RegionScore()
+		return s.RegionScore(version, highSpaceRatio, lowSpaceRatio)
 	default:
 		return 0
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -601,35 +602,31 @@ func (s *Server) PutClusterConfig(ctx context.Context, request *pdpb.PutClusterC
 
 // ScatterRegion implements gRPC PDServer.
 func (s *Server) ScatterRegion(ctx context.Context, request *pdpb.ScatterRegionRequest) (*pdpb.ScatterRegionResponse, error) {
-	// if err := s.validateRequest(request.GetHeader()); err != nil {
-	// 	return nil, err
-	// }
-
-	// cluster := s.GetRaftCluster()
-	// if cluster == nil {
-	// 	return &pdpb.ScatterRegionResponse{Header: s.notBootstrappedHeader()}, nil
-	// }
-
-	// region := cluster.GetRegion(request.GetRegionId())
-	// if region == nil {
-	// 	if request.GetRegion() == nil {
-	// 		return nil, errors.Errorf("region %d not found", request.GetRegionId())
-	// 	}
-	// 	region = core.NewRegionInfo(request.GetRegion(), request.GetLeader())
-	// }
-
-	// if cluster.IsRegionHot(region) {
-	// 	return nil, errors.Errorf("region %d is a hot region", region.GetID())
-	// }
-
-	// co := cluster.GetCoordinator()
-	// op, err := co.regionScatterer.Scatter(region)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// if op != nil {
-	// 	co.opController.AddOperator(op)
-	// }
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.ScatterRegionResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+
+	region := cluster.GetRegion(request.GetRegionId())
+	if region == nil {
+		if request.GetRegion() == nil {
+			return nil, errors.Errorf("region %d not found", request.GetRegionId())
+		}
+		region = core.NewRegionInfo(request.GetRegion(), request.GetLeader())
+	}
+
+	co := cluster.GetCoordinator()
+	op, err := co.regionScatterer.Scatter(region)
+	if err != nil {
+		return nil, err
+	}
+	if op != nil && !co.opController.AddOperator(op) {
+		return nil, errors.Errorf("failed to add the scatter operator of region %d", region.GetID())
+	}
 
 	return &pdpb.ScatterRegionResponse{
 		Header: s.header(),
@@ -727,6 +724,45 @@ func (s *Server) GetOperator(ctx context.Context, request *pdpb.GetOperatorReque
 	}, nil
 }
 
+// GetOperatorHistory gets the records of the finished operators.
+func (s *Server) GetOperatorHistory(ctx context.Context, request *pdpb.GetOperatorHistoryRequest) (*pdpb.GetOperatorHistoryResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.GetOperatorHistoryResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+
+	records, err := cluster.coordinator.opController.GetHistory(&schedule.OperatorHistoryFilter{
+		RegionID:  request.GetRegionId(),
+		StoreID:   request.GetStoreId(),
+		StartTime: request.GetStartTime(),
+		EndTime:   request.GetEndTime(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, err.Error())
+	}
+
+	return &pdpb.GetOperatorHistoryResponse{
+		Header:  s.header(),
+		Records: records,
+	}, nil
+}
+
+// SyncRegions syncs the regions to a follower scheduler.
+func (s *Server) SyncRegions(stream pdpb.PD_SyncRegionsServer) error {
+	if s.IsClosed() || !s.member.IsLeader() {
+		return errors.WithStack(notLeaderError)
+	}
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return ErrNotBootstrapped
+	}
+	return cluster.GetRegionSyncer().Sync(stream)
+}
+
 // validateRequest checks if Server is leader and clusterID is matched.
 // TODO: Call it in gRPC intercepter.
 func (s *Server) validateRequest(header *pdpb.RequestHeader) error {
@@ -0,0 +1,381 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Handler is a helper to export methods to handle API/RPC requests.
+type Handler struct {
+	s   *Server
+	opt *config.ScheduleOption
+}
+
+func newHandler(s *Server) *Handler {
+	return &Handler{s: s, opt: s.scheduleOpt}
+}
+
+// GetHandler returns the handler for API.
+func (s *Server) GetHandler() *Handler {
+	return newHandler(s)
+}
+
+// IsLeader returns whether the server is the leader of the PD cluster.
+func (s *Server) IsLeader() bool {
+	return !s.IsClosed() && s.member.IsLeader()
+}
+
+func (h *Handler) getCoordinator() (*coordinator, error) {
+	cluster := h.s.GetRaftCluster()
+	if cluster == nil {
+		return nil, errors.WithStack(ErrNotBootstrapped)
+	}
+	return cluster.coordinator, nil
+}
+
+// GetSchedulers returns all names of schedulers.
+func (h *Handler) GetSchedulers() ([]string, error) {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	return c.getSchedulers(), nil
+}
+
+// GetPausedSchedulers returns the names of the paused schedulers.
+func (h *Handler) GetPausedSchedulers() ([]string, error) {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	return c.getPausedSchedulers(), nil
+}
+
+// PauseOrResumeScheduler pauses a scheduler for t seconds or resumes a paused
+// scheduler if t is not positive. The name "all" applies to all schedulers.
+func (h *Handler) PauseOrResumeScheduler(name string, t int64) error {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return err
+	}
+	if err = c.pauseOrResumeScheduler(name, t); err != nil {
+		if t > 0 {
+			log.Error("can not pause scheduler", zap.String("scheduler-name", name), zap.Error(err))
+		} else {
+			log.Error("can not resume scheduler", zap.String("scheduler-name", name), zap.Error(err))
+		}
+	}
+	return err
+}
+
+// AddScheduler adds a scheduler of type name and persists it in the schedule config.
+func (h *Handler) AddScheduler(name string, args ...string) error {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return err
+	}
+	s, err := schedule.CreateScheduler(name, c.opController, c.cluster.storage, schedule.ConfigSliceDecoder(name, args))
+	if err != nil {
+		return err
+	}
+	log.Info("create scheduler", zap.String("scheduler-name", s.GetName()))
+	if err = c.addScheduler(s, args...); err != nil {
+		log.Error("can not add scheduler", zap.String("scheduler-name", s.GetName()), zap.Error(err))
+	} else if err = h.opt.Persist(c.cluster.storage); err != nil {
+		log.Error("can not persist scheduler config", zap.Error(err))
+	}
+	return err
+}
+
+// RemoveScheduler removes a scheduler by name.
+func (h *Handler) RemoveScheduler(name string) error {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return err
+	}
+	if err = c.removeScheduler(name); err != nil {
+		log.Error("can not remove scheduler", zap.String("scheduler-name", name), zap.Error(err))
+	}
+	return err
+}
+
+// GetOperatorController returns OperatorController.
+func (h *Handler) GetOperatorController() (*schedule.OperatorController, error) {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	return c.opController, nil
+}
+
+// GetRegionLabeler returns the labeler of the key ranges.
+func (h *Handler) GetRegionLabeler() (*labeler.RegionLabeler, error) {
+	cluster := h.s.GetRaftCluster()
+	if cluster == nil {
+		return nil, errors.WithStack(ErrNotBootstrapped)
+	}
+	return cluster.GetRegionLabeler(), nil
+}
+
+// GetOperator returns the region operator.
+func (h *Handler) GetOperator(regionID uint64) (*operator.Operator, error) {
+	c, err := h.GetOperatorController()
+	if err != nil {
+		return nil, err
+	}
+
+	op := c.GetOperator(regionID)
+	if op == nil {
+		return nil, ErrOperatorNotFound
+	}
+
+	return op, nil
+}
+
+// GetOperatorStatus returns the status of the region operator.
+func (h *Handler) GetOperatorStatus(regionID uint64) (*schedule.OperatorWithStatus, error) {
+	c, err := h.GetOperatorController()
+	if err != nil {
+		return nil, err
+	}
+
+	op := c.GetOperatorStatus(regionID)
+	if op == nil {
+		return nil, ErrOperatorNotFound
+	}
+
+	return op, nil
+}
+
+// RemoveOperator removes the region operator.
+func (h *Handler) RemoveOperator(regionID uint64) error {
+	c, err := h.GetOperatorController()
+	if err != nil {
+		return err
+	}
+
+	op := c.GetOperator(regionID)
+	if op == nil {
+		return ErrOperatorNotFound
+	}
+
+	_ = c.CancelOperator(op, "removed by admin")
+	return nil
+}
+
+// GetOperatorHistory returns the records of the finished operators matching
+// the filter.
+func (h *Handler) GetOperatorHistory(f *schedule.OperatorHistoryFilter) ([]*pdpb.OperatorRecord, error) {
+	c, err := h.GetOperatorController()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetHistory(f)
+}
+
+// GetOperators returns the running operators.
+func (h *Handler) GetOperators() ([]*operator.Operator, error) {
+	c, err := h.GetOperatorController()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetOperators(), nil
+}
+
+// GetOperatorsOfKind returns the running operators of the kind.
+func (h *Handler) GetOperatorsOfKind(mask operator.OpKind) ([]*operator.Operator, error) {
+	ops, err := h.GetOperators()
+	if err != nil {
+		return nil, err
+	}
+	var results []*operator.Operator
+	for _, op := range ops {
+		if op.Kind()&mask != 0 {
+			results = append(results, op)
+		}
+	}
+	return results, nil
+}
+
+// AddTransferLeaderOperator adds an operator to transfer leader to the store.
+func (h *Handler) AddTransferLeaderOperator(regionID uint64, storeID uint64) error {
+	c, region, err := h.getRegion(regionID)
+	if err != nil {
+		return err
+	}
+
+	newLeader := region.GetStoreVoter(storeID)
+	if newLeader == nil {
+		return errors.Errorf("region has no voter in store %v", storeID)
+	}
+
+	op := operator.CreateTransferLeaderOperator("admin-transfer-leader", region, region.GetLeader().GetStoreId(), newLeader.GetStoreId(), operator.OpAdmin)
+	if ok := c.opController.AddOperator(op); !ok {
+		return errors.WithStack(ErrAddOperator)
+	}
+	return nil
+}
+
+// AddTransferPeerOperator adds an operator to transfer peer.
+func (h *Handler) AddTransferPeerOperator(regionID uint64, fromStoreID, toStoreID uint64) error {
+	c, region, err := h.getRegion(regionID)
+	if err != nil {
+		return err
+	}
+
+	if region.GetStorePeer(fromStoreID) == nil {
+		return errors.Errorf("region has no peer in store %v", fromStoreID)
+	}
+	if err = h.checkTargetStore(c, region, toStoreID); err != nil {
+		return err
+	}
+
+	newPeer, err := c.cluster.AllocPeer(toStoreID)
+	if err != nil {
+		return err
+	}
+
+	op, err := operator.CreateMovePeerOperator("admin-move-peer", c.cluster, region, operator.OpAdmin, fromStoreID, toStoreID, newPeer.GetId())
+	if err != nil {
+		return err
+	}
+	if ok := c.opController.AddOperator(op); !ok {
+		return errors.WithStack(ErrAddOperator)
+	}
+	return nil
+}
+
+// AddAddPeerOperator adds an operator to add peer.
+func (h *Handler) AddAddPeerOperator(regionID uint64, toStoreID uint64) error {
+	c, region, err := h.getRegion(regionID)
+	if err != nil {
+		return err
+	}
+	if err = h.checkTargetStore(c, region, toStoreID); err != nil {
+		return err
+	}
+
+	newPeer, err := c.cluster.AllocPeer(toStoreID)
+	if err != nil {
+		return err
+	}
+
+	op := operator.CreateAddPeerOperator("admin-add-peer", region, newPeer.GetId(), toStoreID, operator.OpAdmin)
+	if ok := c.opController.AddOperator(op); !ok {
+		return errors.WithStack(ErrAddOperator)
+	}
+	return nil
+}
+
+// AddRemovePeerOperator adds an operator to remove peer.
+func (h *Handler) AddRemovePeerOperator(regionID uint64, fromStoreID uint64) error {
+	c, region, err := h.getRegion(regionID)
+	if err != nil {
+		return err
+	}
+
+	if region.GetStorePeer(fromStoreID) == nil {
+		return errors.Errorf("region has no peer in store %v", fromStoreID)
+	}
+
+	op, err := operator.CreateRemovePeerOperator("admin-remove-peer", c.cluster, operator.OpAdmin, region, fromStoreID)
+	if err != nil {
+		return err
+	}
+	if ok := c.opController.AddOperator(op); !ok {
+		return errors.WithStack(ErrAddOperator)
+	}
+	return nil
+}
+
+// GetAllStoresLimit returns the limits of the stores that are not tombstone.
+func (h *Handler) GetAllStoresLimit() (map[uint64]config.StoreLimitConfig, error) {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return nil, err
+	}
+	limits := make(map[uint64]config.StoreLimitConfig)
+	for _, store := range c.cluster.GetStores() {
+		if !store.IsTombstone() {
+			limits[store.GetID()] = h.opt.GetStoreLimit(store.GetID())
+		}
+	}
+	return limits, nil
+}
+
+// SetStoreLimit overrides the limit of the store with the given types, or
+// both types if none is given, and persists it in the schedule config.
+func (h *Handler) SetStoreLimit(storeID uint64, rate float64, typs ...storelimit.Type) error {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return err
+	}
+	if c.cluster.GetStore(storeID) == nil {
+		return core.NewStoreNotFoundErr(storeID)
+	}
+	if rate <= 0 {
+		return errors.Errorf("invalid store limit rate: %v", rate)
+	}
+	h.opt.SetStoreLimit(storeID, rate, typs...)
+	if err = h.opt.Persist(c.cluster.storage); err != nil {
+		log.Error("can not persist store limit", zap.Uint64("store-id", storeID), zap.Error(err))
+	}
+	return err
+}
+
+// RemoveStoreLimit removes the store-limit override of the store, then the
+// store uses the store-balance-rate.
+func (h *Handler) RemoveStoreLimit(storeID uint64) error {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return err
+	}
+	h.opt.RemoveStoreLimit(storeID)
+	if err = h.opt.Persist(c.cluster.storage); err != nil {
+		log.Error("can not persist store limit", zap.Uint64("store-id", storeID), zap.Error(err))
+	}
+	return err
+}
+
+func (h *Handler) getRegion(regionID uint64) (*coordinator, *core.RegionInfo, error) {
+	c, err := h.getCoordinator()
+	if err != nil {
+		return nil, nil, err
+	}
+	region := c.cluster.GetRegion(regionID)
+	if region == nil {
+		return nil, nil, ErrRegionNotFound(regionID)
+	}
+	return c, region, nil
+}
+
+func (h *Handler) checkTargetStore(c *coordinator, region *core.RegionInfo, toStoreID uint64) error {
+	if c.cluster.GetStore(toStoreID) == nil {
+		return core.NewStoreNotFoundErr(toStoreID)
+	}
+	if region.GetStorePeer(toStoreID) != nil {
+		return errors.Errorf("region already has peer in store %v", toStoreID)
+	}
+	return nil
+}
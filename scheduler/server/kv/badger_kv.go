@@ -0,0 +1,168 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Connor1996/badger"
+	"github.com/pkg/errors"
+)
+
+// removeRangeBatchSize is the number of keys deleted in a transaction by
+// RemoveRange.
+const removeRangeBatchSize = 1000
+
+// BadgerKV is a kv store on the local disk, it stores the data which is too
+// large or changes too often to be saved to etcd.
+type BadgerKV struct {
+	db *badger.DB
+}
+
+// NewBadgerKV opens (creating it if necessary) a badger kv in path.
+func NewBadgerKV(path string) (*BadgerKV, error) {
+	opts := badger.DefaultOptions
+	// The kv holds the region metadata only, which is small. The default
+	// options are sized for the data of a store, e.g. the block cache alone
+	// allocates hundreds of megabytes on open.
+	opts.MaxTableSize = 4 << 20
+	opts.NumMemtables = 2
+	opts.LevelOneSize = 16 << 20
+	opts.ValueLogFileSize = 16 << 20
+	opts.MaxCacheSize = 8 << 20
+	opts.NumCompactors = 1
+	opts.MaxSubCompaction = 1
+	opts.Dir = filepath.Clean(path)
+	opts.ValueDir = opts.Dir
+	if err := os.MkdirAll(opts.Dir, os.ModePerm); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &BadgerKV{db: db}, nil
+}
+
+// Load gets a value for a given key.
+func (kv *BadgerKV) Load(key string) (string, error) {
+	var value []byte
+	err := kv.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(value), nil
+}
+
+// LoadRange gets a range of value for a given key range.
+func (kv *BadgerKV) LoadRange(startKey, endKey string, limit int) ([]string, []string, error) {
+	keys := make([]string, 0, limit)
+	values := make([]string, 0, limit)
+	err := kv.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iter.Close()
+		for iter.Seek([]byte(startKey)); iter.Valid() && len(keys) < limit; iter.Next() {
+			item := iter.Item()
+			if string(item.Key()) >= endKey {
+				break
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, string(item.KeyCopy(nil)))
+			values = append(values, string(value))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return keys, values, nil
+}
+
+// Save stores a key-value pair.
+func (kv *BadgerKV) Save(key, value string) error {
+	return kv.SaveBatch(map[string]string{key: value})
+}
+
+// SaveBatch stores the key-value pairs in one transaction.
+func (kv *BadgerKV) SaveBatch(kvs map[string]string) error {
+	err := kv.db.Update(func(txn *badger.Txn) error {
+		for key, value := range kvs {
+			if err := txn.Set([]byte(key), []byte(value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+// Remove deletes a key-value pair for a given key.
+func (kv *BadgerKV) Remove(key string) error {
+	err := kv.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(key))
+	})
+	return errors.WithStack(err)
+}
+
+// RemoveRange deletes the key-value pairs in [key, endKey). The pairs are
+// deleted in batches, so a large range doesn't exceed the transaction limit.
+func (kv *BadgerKV) RemoveRange(key, endKey string) error {
+	for {
+		var deleted int
+		err := kv.db.Update(func(txn *badger.Txn) error {
+			iter := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer iter.Close()
+			var keys [][]byte
+			for iter.Seek([]byte(key)); iter.Valid() && len(keys) < removeRangeBatchSize; iter.Next() {
+				k := iter.Item().KeyCopy(nil)
+				if string(k) >= endKey {
+					break
+				}
+				keys = append(keys, k)
+			}
+			for _, k := range keys {
+				if err := txn.Delete(k); err != nil {
+					return err
+				}
+			}
+			deleted = len(keys)
+			return nil
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if deleted < removeRangeBatchSize {
+			return nil
+		}
+	}
+}
+
+// Close closes the kv.
+func (kv *BadgerKV) Close() error {
+	return errors.WithStack(kv.db.Close())
+}
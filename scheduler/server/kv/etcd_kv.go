@@ -114,6 +114,22 @@ func (kv *etcdKVBase) Remove(key string) error {
 	return nil
 }
 
+func (kv *etcdKVBase) RemoveRange(key, endKey string) error {
+	key = path.Join(kv.rootPath, key)
+	endKey = path.Join(kv.rootPath, endKey)
+
+	txn := NewSlowLogTxn(kv.client)
+	resp, err := txn.Then(clientv3.OpDelete(key, clientv3.WithRange(endKey))).Commit()
+	if err != nil {
+		log.Error("remove range from etcd meet error", zap.Error(err))
+		return errors.WithStack(err)
+	}
+	if !resp.Succeeded {
+		return errors.WithStack(errTxnFailed)
+	}
+	return nil
+}
+
 // SlowLogTxn wraps etcd transaction and log slow one.
 type SlowLogTxn struct {
 	clientv3.Txn
@@ -87,10 +87,33 @@ func (s *testEtcdKVSuite) TestEtcdKV(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(v, Equals, "")
 
+	c.Assert(kv.RemoveRange(keys[0], keys[3]), IsNil)
+	ks, _, err = kv.LoadRange(keys[0], "test/zzz", 100)
+	c.Assert(err, IsNil)
+	c.Assert(ks, DeepEquals, keys[3:])
+
 	etcd.Close()
 	cleanConfig(cfg)
 }
 
+func (s *testEtcdKVSuite) TestBadgerKVRemoveRange(c *C) {
+	dir, err := ioutil.TempDir("/tmp", "test_badger")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	kv, err := NewBadgerKV(dir)
+	c.Assert(err, IsNil)
+	defer kv.Close()
+
+	n := removeRangeBatchSize*2 + 1
+	for i := 0; i < n; i++ {
+		c.Assert(kv.Save(fmt.Sprintf("test/%08d", i), "val"), IsNil)
+	}
+	c.Assert(kv.RemoveRange("test/", fmt.Sprintf("test/%08d", n-1)), IsNil)
+	ks, _, err := kv.LoadRange("test/", "test/zzz", n)
+	c.Assert(err, IsNil)
+	c.Assert(ks, DeepEquals, []string{fmt.Sprintf("test/%08d", n-1)})
+}
+
 func newTestSingleConfig() *embed.Config {
 	cfg := embed.NewConfig()
 	cfg.Name = "test_etcd"
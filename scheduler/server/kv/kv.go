@@ -19,4 +19,6 @@ type Base interface {
 	LoadRange(key, endKey string, limit int) (keys []string, values []string, err error)
 	Save(key, value string) error
 	Remove(key string) error
+	// RemoveRange deletes the key-value pairs in [key, endKey).
+	RemoveRange(key, endKey string) error
 }
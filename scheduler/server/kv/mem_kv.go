@@ -76,3 +76,18 @@ func (kv *memoryKV) Remove(key string) error {
 	kv.tree.Delete(memoryKVItem{key, ""})
 	return nil
 }
+
+func (kv *memoryKV) RemoveRange(key, endKey string) error {
+	kv.Lock()
+	defer kv.Unlock()
+
+	var items []btree.Item
+	kv.tree.AscendRange(memoryKVItem{key, ""}, memoryKVItem{endKey, ""}, func(item btree.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	for _, item := range items {
+		kv.tree.Delete(item)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/grpcutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const retryInterval = time.Second
+
+// StopSyncWithLeader stops to sync the region with leader.
+func (s *RegionSyncer) StopSyncWithLeader() {
+	s.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *RegionSyncer) establish(ctx context.Context, conn *grpc.ClientConn, startIndex uint64) (ClientStream, error) {
+	client, err := pdpb.NewPDClient(conn).SyncRegions(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = client.Send(&pdpb.SyncRegionRequest{
+		Header:     &pdpb.RequestHeader{ClusterId: s.server.ClusterID()},
+		Member:     s.server.GetMemberInfo(),
+		StartIndex: startIndex,
+	})
+	if err != nil {
+		client.CloseSend()
+		return nil, errors.WithStack(err)
+	}
+	return client, nil
+}
+
+// GetRegionLeader returns the leader of the region last received from the
+// leader, or nil if it is unknown.
+func (s *RegionSyncer) GetRegionLeader(regionID uint64) *metapb.Peer {
+	s.RLock()
+	defer s.RUnlock()
+	return s.regionLeaders[regionID]
+}
+
+func (s *RegionSyncer) setRegionLeader(regionID uint64, leader *metapb.Peer) {
+	s.Lock()
+	defer s.Unlock()
+	if leader.GetId() == 0 {
+		delete(s.regionLeaders, regionID)
+		return
+	}
+	s.regionLeaders[regionID] = leader
+}
+
+// resetRegionLeaders forgets the leaders received before, e.g. the regions
+// may have been merged since this server last synced with a leader.
+func (s *RegionSyncer) resetRegionLeaders() {
+	s.Lock()
+	defer s.Unlock()
+	s.regionLeaders = make(map[uint64]*metapb.Peer)
+}
+
+// StartSyncWithLeader starts to sync with leader. The received regions are
+// saved to the storage, which is flushed once the sync is stopped.
+func (s *RegionSyncer) StartSyncWithLeader(addr string) {
+	s.resetRegionLeaders()
+	s.Lock()
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.wg.Done()
+		defer func() {
+			if err := s.server.GetStorage().Flush(); err != nil {
+				log.Error("failed to flush the synced regions", zap.Error(err))
+			}
+		}()
+
+		// used to load region from kv storage to cache storage.
+		securityConf := s.server.GetSecurityConfig()
+		var conn *grpc.ClientConn
+		for {
+			var err error
+			conn, err = grpcutil.GetClientConn(addr, securityConf.CAPath, securityConf.CertPath, securityConf.KeyPath)
+			if err == nil {
+				break
+			}
+			log.Error("failed to connect to the leader", zap.String("leader", addr), zap.Error(err))
+			select {
+			case <-ctx.Done():
+				log.Info("stop synchronizing with leader")
+				return
+			case <-time.After(retryInterval):
+			}
+		}
+		defer conn.Close()
+
+		var nextIndex uint64
+		for {
+			stream, err := s.establish(ctx, conn, nextIndex)
+			if err != nil {
+				if ctx.Err() != nil {
+					log.Info("stop synchronizing with leader")
+					return
+				}
+				log.Error("server failed to establish sync stream with leader",
+					zap.String("server", s.server.Name()),
+					zap.String("leader", addr),
+					zap.Error(err))
+				select {
+				case <-ctx.Done():
+					log.Info("stop synchronizing with leader")
+					return
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+			nextIndex = s.receive(ctx, stream, nextIndex)
+			stream.CloseSend()
+			select {
+			case <-ctx.Done():
+				log.Info("stop synchronizing with leader")
+				return
+			case <-time.After(retryInterval):
+			}
+		}
+	}()
+}
+
+// receive saves the regions from stream until it fails or a gap in the
+// history is detected, and returns the index to resume from.
+func (s *RegionSyncer) receive(ctx context.Context, stream ClientStream, nextIndex uint64) uint64 {
+	storage := s.server.GetStorage()
+	// A full sync sends the regions with a zero start index and ends with an
+	// empty response carrying the index, it replaces the leaders received
+	// before. The other empty responses are the keep-alives.
+	fullSync := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error("region sync with leader meet error", zap.Error(err))
+			}
+			return nextIndex
+		}
+		startIndex := resp.GetStartIndex()
+		switch {
+		case startIndex == 0:
+			if !fullSync {
+				s.resetRegionLeaders()
+				fullSync = true
+			}
+		case fullSync:
+			// The index which ends a full sync is where the history resumes.
+			fullSync = false
+		case nextIndex != 0 && startIndex > nextIndex:
+			log.Warn("region sync with leader has a gap, resync",
+				zap.Uint64("expected-index", nextIndex),
+				zap.Uint64("start-index", startIndex))
+			return nextIndex
+		}
+		leaders := resp.GetRegionLeaders()
+		for i, r := range resp.GetRegions() {
+			if err := storage.SaveRegion(r); err != nil {
+				log.Error("failed to save region", zap.Uint64("region-id", r.GetId()), zap.Error(err))
+				return nextIndex
+			}
+			if i < len(leaders) {
+				s.setRegionLeader(r.GetId(), leaders[i])
+			}
+		}
+		if startIndex != 0 {
+			nextIndex = startIndex + uint64(len(resp.GetRegions()))
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"io"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testRegionSyncerClient{})
+
+type testRegionSyncerClient struct{}
+
+type mockServer struct {
+	storage *core.Storage
+}
+
+func (s *mockServer) ClusterID() uint64                         { return 1 }
+func (s *mockServer) GetMemberInfo() *pdpb.Member               { return &pdpb.Member{Name: "follower"} }
+func (s *mockServer) GetStorage() *core.Storage                 { return s.storage }
+func (s *mockServer) Name() string                              { return "follower" }
+func (s *mockServer) GetRegions() []*core.RegionInfo            { return nil }
+func (s *mockServer) GetSecurityConfig() *config.SecurityConfig { return &config.SecurityConfig{} }
+
+// mockClientStream returns the responses and then io.EOF.
+type mockClientStream struct {
+	resps []*pdpb.SyncRegionResponse
+}
+
+func (s *mockClientStream) Recv() (*pdpb.SyncRegionResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+func (s *mockClientStream) CloseSend() error { return nil }
+
+func newSyncResponse(startIndex uint64, regionIDs ...uint64) *pdpb.SyncRegionResponse {
+	resp := &pdpb.SyncRegionResponse{StartIndex: startIndex}
+	for _, id := range regionIDs {
+		resp.Regions = append(resp.Regions, &metapb.Region{Id: id})
+		resp.RegionLeaders = append(resp.RegionLeaders, &metapb.Peer{Id: id * 10, StoreId: 1})
+	}
+	return resp
+}
+
+func (t *testRegionSyncerClient) TestFullSyncResetsLeaders(c *C) {
+	s := NewRegionSyncer(&mockServer{storage: core.NewStorage(kv.NewMemoryKV())})
+	// The keep-alive between the batches does not reset the leaders.
+	stream := &mockClientStream{resps: []*pdpb.SyncRegionResponse{
+		newSyncResponse(1, 1, 2),
+		newSyncResponse(3),
+		newSyncResponse(3, 3),
+		newSyncResponse(4),
+	}}
+	c.Assert(s.receive(context.Background(), stream, 0), Equals, uint64(4))
+	for _, id := range []uint64{1, 2, 3} {
+		c.Assert(s.GetRegionLeader(id).GetId(), Equals, id*10)
+	}
+
+	// Region 2 is merged away, the full sync replaces the leaders.
+	stream = &mockClientStream{resps: []*pdpb.SyncRegionResponse{
+		newSyncResponse(0, 1),
+		newSyncResponse(0, 3),
+		newSyncResponse(10),
+		newSyncResponse(10, 4),
+	}}
+	c.Assert(s.receive(context.Background(), stream, 4), Equals, uint64(11))
+	c.Assert(s.GetRegionLeader(1).GetId(), Equals, uint64(10))
+	c.Assert(s.GetRegionLeader(2), IsNil)
+	c.Assert(s.GetRegionLeader(3).GetId(), Equals, uint64(30))
+	c.Assert(s.GetRegionLeader(4).GetId(), Equals, uint64(40))
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+)
+
+// historyBuffer is a ring buffer of the most recent region changes. The
+// index of a record grows monotonically from 1, index 0 means no record.
+type historyBuffer struct {
+	sync.RWMutex
+	index   uint64
+	records []*core.RegionInfo
+	head    int
+	tail    int
+	size    int
+}
+
+func newHistoryBuffer(size int) *historyBuffer {
+	// use an empty space to simplify operation
+	size++
+	if size < 2 {
+		size = 2
+	}
+	return &historyBuffer{
+		index:   1,
+		records: make([]*core.RegionInfo, size),
+		size:    size,
+	}
+}
+
+func (h *historyBuffer) len() int {
+	if h.tail < h.head {
+		return h.tail + h.size - h.head
+	}
+	return h.tail - h.head
+}
+
+// nextIndex returns the index of the next record to be added.
+func (h *historyBuffer) nextIndex() uint64 {
+	h.RLock()
+	defer h.RUnlock()
+	return h.index
+}
+
+// firstIndex returns the index of the oldest record in the buffer.
+func (h *historyBuffer) firstIndex() uint64 {
+	h.RLock()
+	defer h.RUnlock()
+	return h.index - uint64(h.len())
+}
+
+// record adds a region change to the buffer and returns its index.
+func (h *historyBuffer) record(r *core.RegionInfo) uint64 {
+	h.Lock()
+	defer h.Unlock()
+	h.records[h.tail] = r
+	h.tail = (h.tail + 1) % h.size
+	if h.tail == h.head {
+		h.records[h.head] = nil
+		h.head = (h.head + 1) % h.size
+	}
+	index := h.index
+	h.index++
+	return index
+}
+
+// recordsFrom returns the records starting at index. The second return
+// value is false if the records since index are no longer in the buffer.
+func (h *historyBuffer) recordsFrom(index uint64) ([]*core.RegionInfo, bool) {
+	h.RLock()
+	defer h.RUnlock()
+	first := h.index - uint64(h.len())
+	if index < first || index > h.index {
+		return nil, false
+	}
+	var records []*core.RegionInfo
+	for i := (h.head + int(index-first)) % h.size; i != h.tail; i = (i + 1) % h.size {
+		records = append(records, h.records[i])
+	}
+	return records, true
+}
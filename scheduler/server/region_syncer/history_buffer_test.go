@@ -0,0 +1,81 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testHistoryBuffer{})
+
+type testHistoryBuffer struct{}
+
+func (t *testHistoryBuffer) TestBufferSize(c *C) {
+	var regions []*core.RegionInfo
+	for i := 0; i <= 100; i++ {
+		regions = append(regions, core.NewRegionInfo(&metapb.Region{Id: uint64(i)}, nil))
+	}
+
+	// size equals 1
+	h := newHistoryBuffer(1)
+	c.Assert(h.len(), Equals, 0)
+	for _, r := range regions {
+		h.record(r)
+	}
+	c.Assert(h.len(), Equals, 1)
+	c.Assert(h.nextIndex(), Equals, uint64(102))
+	c.Assert(h.firstIndex(), Equals, uint64(101))
+	records, ok := h.recordsFrom(101)
+	c.Assert(ok, IsTrue)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0], Equals, regions[100])
+	_, ok = h.recordsFrom(100)
+	c.Assert(ok, IsFalse)
+
+	// size equals 100
+	h = newHistoryBuffer(100)
+	for i := 0; i < 6; i++ {
+		h.record(regions[i])
+	}
+	c.Assert(h.len(), Equals, 6)
+	c.Assert(h.firstIndex(), Equals, uint64(1))
+	records, ok = h.recordsFrom(3)
+	c.Assert(ok, IsTrue)
+	c.Assert(records, HasLen, 4)
+	c.Assert(records[0], Equals, regions[2])
+	records, ok = h.recordsFrom(h.nextIndex())
+	c.Assert(ok, IsTrue)
+	c.Assert(records, HasLen, 0)
+
+	for _, r := range regions {
+		h.record(r)
+	}
+	c.Assert(h.len(), Equals, 100)
+	c.Assert(h.firstIndex(), Equals, uint64(8))
+	records, ok = h.recordsFrom(8)
+	c.Assert(ok, IsTrue)
+	c.Assert(records, HasLen, 100)
+	c.Assert(records[0], Equals, regions[1])
+	c.Assert(records[99], Equals, regions[100])
+	_, ok = h.recordsFrom(7)
+	c.Assert(ok, IsFalse)
+}
@@ -0,0 +1,260 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxSyncRegionBatchSize   = 100
+	syncerKeepAliveInterval  = 10 * time.Second
+	defaultHistoryBufferSize = 10000
+)
+
+// ClientStream is the client side of the region syncer.
+type ClientStream interface {
+	Recv() (*pdpb.SyncRegionResponse, error)
+	CloseSend() error
+}
+
+// ServerStream is the server side of the region syncer.
+type ServerStream interface {
+	Send(regions *pdpb.SyncRegionResponse) error
+}
+
+// Server is the abstraction of the syncer storage server.
+type Server interface {
+	ClusterID() uint64
+	GetMemberInfo() *pdpb.Member
+	GetStorage() *core.Storage
+	Name() string
+	GetRegions() []*core.RegionInfo
+	GetSecurityConfig() *config.SecurityConfig
+}
+
+// RegionSyncer is used to sync the region information without raft.
+// The leader broadcasts the changed regions to the followers, and the
+// followers persist them so that a new leader starts with a warm region
+// tree.
+type RegionSyncer struct {
+	sync.RWMutex
+	streams map[string]ServerStream
+	server  Server
+	history *historyBuffer
+
+	// regionLeaders are the leaders of the regions received from the leader.
+	regionLeaders map[uint64]*metapb.Peer
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRegionSyncer returns a region syncer.
+func NewRegionSyncer(s Server) *RegionSyncer {
+	return &RegionSyncer{
+		streams:       make(map[string]ServerStream),
+		server:        s,
+		history:       newHistoryBuffer(defaultHistoryBufferSize),
+		regionLeaders: make(map[uint64]*metapb.Peer),
+	}
+}
+
+// RunServer runs the server of the region syncer. It batches the regions
+// from regionNotifier and broadcasts them to all bound followers.
+func (s *RegionSyncer) RunServer(regionNotifier <-chan *core.RegionInfo, quit chan struct{}) {
+	var requests []*metapb.Region
+	var leaders []*metapb.Peer
+	ticker := time.NewTicker(syncerKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			log.Info("region syncer has been stopped")
+			return
+		case first := <-regionNotifier:
+			startIndex := s.history.record(first)
+			requests = append(requests, first.GetMeta())
+			leaders = append(leaders, regionLeader(first))
+			pending := len(regionNotifier)
+			for i := 0; i < pending && i < maxSyncRegionBatchSize; i++ {
+				region := <-regionNotifier
+				s.history.record(region)
+				requests = append(requests, region.GetMeta())
+				leaders = append(leaders, regionLeader(region))
+			}
+			regions := &pdpb.SyncRegionResponse{
+				Header:        &pdpb.ResponseHeader{ClusterId: s.server.ClusterID()},
+				Regions:       requests,
+				StartIndex:    startIndex,
+				RegionLeaders: leaders,
+			}
+			s.broadcast(regions)
+			requests = requests[:0:0]
+			leaders = leaders[:0:0]
+		case <-ticker.C:
+			alive := &pdpb.SyncRegionResponse{
+				Header:     &pdpb.ResponseHeader{ClusterId: s.server.ClusterID()},
+				StartIndex: s.history.nextIndex(),
+			}
+			s.broadcast(alive)
+		}
+	}
+}
+
+// Sync firstly tries to sync the history records to the client, then
+// binds the stream to receive the following changes.
+func (s *RegionSyncer) Sync(stream pdpb.PD_SyncRegionsServer) error {
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		clusterID := request.GetHeader().GetClusterId()
+		if clusterID != s.server.ClusterID() {
+			return status.Errorf(codes.FailedPrecondition, "mismatch cluster id, need %d but got %d", s.server.ClusterID(), clusterID)
+		}
+		log.Info("establish sync region stream",
+			zap.String("requested-server", request.GetMember().GetName()),
+			zap.Strings("url", request.GetMember().GetClientUrls()))
+
+		if err := s.syncHistoryRegion(request, stream); err != nil {
+			return err
+		}
+		s.bindStream(request.GetMember().GetName(), stream)
+	}
+}
+
+func (s *RegionSyncer) syncHistoryRegion(request *pdpb.SyncRegionRequest, stream pdpb.PD_SyncRegionsServer) error {
+	startIndex := request.GetStartIndex()
+	name := request.GetMember().GetName()
+	if startIndex != 0 {
+		if records, ok := s.history.recordsFrom(startIndex); ok {
+			log.Info("requested server has already in sync with server",
+				zap.String("requested-server", name),
+				zap.String("server", s.server.Name()),
+				zap.Uint64("start-index", startIndex),
+				zap.Int("records", len(records)))
+			for len(records) > 0 {
+				n := len(records)
+				if n > maxSyncRegionBatchSize {
+					n = maxSyncRegionBatchSize
+				}
+				resp := s.makeResponse(records[:n], startIndex)
+				if err := stream.Send(resp); err != nil {
+					log.Error("failed to send sync region response", zap.Error(err))
+					return err
+				}
+				records = records[n:]
+				startIndex += uint64(n)
+			}
+			return nil
+		}
+	}
+
+	// The history records are not enough, send all the regions. The regions
+	// are sent with a zero start index, which is followed by an empty
+	// response carrying the index they are consistent with.
+	lastIndex := s.history.nextIndex()
+	regions := s.server.GetRegions()
+	log.Info("sync the whole regions with server",
+		zap.String("requested-server", name),
+		zap.String("server", s.server.Name()),
+		zap.Int("regions", len(regions)),
+		zap.Uint64("last-index", lastIndex))
+	start := time.Now()
+	for len(regions) > 0 {
+		n := len(regions)
+		if n > maxSyncRegionBatchSize {
+			n = maxSyncRegionBatchSize
+		}
+		resp := s.makeResponse(regions[:n], 0)
+		if err := stream.Send(resp); err != nil {
+			log.Error("failed to send sync region response", zap.Error(err))
+			return err
+		}
+		regions = regions[n:]
+	}
+	log.Info("requested server has completed full synchronization with server",
+		zap.String("requested-server", name),
+		zap.String("server", s.server.Name()),
+		zap.Duration("cost", time.Since(start)))
+	return stream.Send(s.makeResponse(nil, lastIndex))
+}
+
+func (s *RegionSyncer) makeResponse(records []*core.RegionInfo, startIndex uint64) *pdpb.SyncRegionResponse {
+	metas := make([]*metapb.Region, 0, len(records))
+	leaders := make([]*metapb.Peer, 0, len(records))
+	for _, r := range records {
+		metas = append(metas, r.GetMeta())
+		leaders = append(leaders, regionLeader(r))
+	}
+	return &pdpb.SyncRegionResponse{
+		Header:        &pdpb.ResponseHeader{ClusterId: s.server.ClusterID()},
+		Regions:       metas,
+		StartIndex:    startIndex,
+		RegionLeaders: leaders,
+	}
+}
+
+// bindStream binds the established server stream.
+func (s *RegionSyncer) bindStream(name string, stream ServerStream) {
+	s.Lock()
+	defer s.Unlock()
+	s.streams[name] = stream
+}
+
+func (s *RegionSyncer) broadcast(regions *pdpb.SyncRegionResponse) {
+	var failed []string
+	s.RLock()
+	for name, sender := range s.streams {
+		err := sender.Send(regions)
+		if err != nil {
+			log.Error("region syncer send data meet error", zap.Error(err))
+			failed = append(failed, name)
+		}
+	}
+	s.RUnlock()
+	if len(failed) > 0 {
+		s.Lock()
+		for _, name := range failed {
+			delete(s.streams, name)
+			log.Info("region syncer delete the stream", zap.String("stream", name))
+		}
+		s.Unlock()
+	}
+}
+
+func regionLeader(region *core.RegionInfo) *metapb.Peer {
+	if leader := region.GetLeader(); leader != nil {
+		return leader
+	}
+	return &metapb.Peer{}
+}
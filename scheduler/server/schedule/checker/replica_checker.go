@@ -52,6 +52,7 @@ func NewReplicaChecker(cluster opt.Cluster, n ...string) *ReplicaChecker {
 	}
 	filters := []filter.Filter{
 		filter.NewHealthFilter(name),
+		filter.NewLowSpaceFilter(name),
 	}
 
 	return &ReplicaChecker{
@@ -63,6 +64,11 @@ func NewReplicaChecker(cluster opt.Cluster, n ...string) *ReplicaChecker {
 
 // Check verifies a region's replicas, creating an operator.Operator if need.
 func (r *ReplicaChecker) Check(region *core.RegionInfo) *operator.Operator {
+	if op := r.checkDownPeer(region); op != nil {
+		op.SetPriorityLevel(core.HighPriority)
+		return op
+	}
+
 	if op := r.checkOfflinePeer(region); op != nil {
 		op.SetPriorityLevel(core.HighPriority)
 		return op
@@ -146,6 +152,27 @@ func (r *ReplicaChecker) selectWorstPeer(region *core.RegionInfo) *metapb.Peer {
 	return region.GetStorePeer(worstStore.GetID())
 }
 
+func (r *ReplicaChecker) checkDownPeer(region *core.RegionInfo) *operator.Operator {
+	maxDownTime := r.cluster.GetMaxStoreDownTime()
+	for _, stats := range region.GetDownPeers() {
+		peer := stats.GetPeer()
+		if peer == nil {
+			continue
+		}
+		store := r.cluster.GetStore(peer.GetStoreId())
+		if store == nil {
+			log.Warn("lost the store, maybe you are recovering the PD cluster", zap.Uint64("store-id", peer.GetStoreId()))
+			return nil
+		}
+		if stats.GetDownSeconds() < uint64(maxDownTime.Seconds()) {
+			continue
+		}
+
+		return r.fixPeer(region, peer, downStatus)
+	}
+	return nil
+}
+
 func (r *ReplicaChecker) checkOfflinePeer(region *core.RegionInfo) *operator.Operator {
 	// just skip learner
 	if len(region.GetLearners()) != 0 {
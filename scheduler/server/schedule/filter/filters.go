@@ -131,6 +131,30 @@ func (f *stateFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
 	return !store.IsUp()
 }
 
+type lowSpaceFilter struct{ scope string }
+
+// NewLowSpaceFilter creates a Filter that filters the stores whose used ratio
+// of the disk is above the low-space-ratio from being the target.
+func NewLowSpaceFilter(scope string) Filter {
+	return &lowSpaceFilter{scope: scope}
+}
+
+func (f *lowSpaceFilter) Scope() string {
+	return f.scope
+}
+
+func (f *lowSpaceFilter) Type() string {
+	return "low-space-filter"
+}
+
+func (f *lowSpaceFilter) Source(opt opt.Options, store *core.StoreInfo) bool {
+	return false
+}
+
+func (f *lowSpaceFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
+	return store.IsLowSpace(opt.GetLowSpaceRatio())
+}
+
 type healthFilter struct{ scope string }
 
 // NewHealthFilter creates a Filter that filters all stores that are Busy or Down.
@@ -0,0 +1,30 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+)
+
+// RegionNoLabel returns a region option which only allows the regions not
+// overlapping a key range with the scheduling label, it is shared by the
+// schedulers and the region scatterer to skip the paused regions before
+// creating operators.
+func RegionNoLabel(cluster opt.Cluster, label labeler.Label) core.RegionOption {
+	return func(region *core.RegionInfo) bool {
+		return !cluster.GetRegionLabeler().RegionHasLabel(region, label)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ErrLabelRuleNotFound is returned when the label rule to delete does not
+// exist.
+var ErrLabelRuleNotFound = errors.New("label rule not found")
+
+// Label is a scheduling label of a key range, which pauses a kind of
+// scheduling on the regions overlapping the key range.
+type Label string
+
+const (
+	// NoBalance pauses moving the leaders and peers of the regions to balance
+	// the stores.
+	NoBalance Label = "no-balance"
+	// NoMerge pauses merging the regions.
+	NoMerge Label = "no-merge"
+	// NoSplit pauses splitting the regions.
+	NoSplit Label = "no-split"
+	// NoScatter pauses scattering the regions.
+	NoScatter Label = "no-scatter"
+)
+
+// validLabels are the labels the scheduler enforces, a rule with any other
+// label is rejected rather than silently ignored.
+var validLabels = map[Label]struct{}{
+	NoBalance: {},
+	NoMerge:   {},
+	NoSplit:   {},
+	NoScatter: {},
+}
+
+// LabelRule attaches the scheduling labels to the key range
+// [StartKey, EndKey), the keys are hex encoded and an empty EndKey means the
+// end of the key space. The rule expires after TTL if TTL is set.
+type LabelRule struct {
+	ID         string     `json:"id"`
+	StartKey   string     `json:"start_key"`
+	EndKey     string     `json:"end_key"`
+	Labels     []Label    `json:"labels"`
+	TTL        string     `json:"ttl,omitempty"`
+	ExpireTime *time.Time `json:"expire_time,omitempty"`
+
+	start, end []byte
+}
+
+// adjust validates the rule and decodes its key range.
+func (r *LabelRule) adjust() error {
+	if r.ID == "" {
+		return errors.New("rule id is empty")
+	}
+	if len(r.Labels) == 0 {
+		return errors.New("rule has no label")
+	}
+	for _, label := range r.Labels {
+		if _, ok := validLabels[label]; !ok {
+			return errors.Errorf("unknown label %q", label)
+		}
+	}
+	var err error
+	if r.start, err = hex.DecodeString(r.StartKey); err != nil {
+		return errors.Wrap(err, "start key is not hex encoded")
+	}
+	if r.end, err = hex.DecodeString(r.EndKey); err != nil {
+		return errors.Wrap(err, "end key is not hex encoded")
+	}
+	if len(r.end) > 0 && bytes.Compare(r.start, r.end) >= 0 {
+		return errors.New("start key must be less than end key")
+	}
+	return nil
+}
+
+func (r *LabelRule) expired(now time.Time) bool {
+	return r.ExpireTime != nil && !now.Before(*r.ExpireTime)
+}
+
+func (r *LabelRule) hasLabel(label Label) bool {
+	for _, l := range r.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// overlaps returns true if the key range [start, end) overlaps the rule.
+func (r *LabelRule) overlaps(start, end []byte) bool {
+	return (len(end) == 0 || bytes.Compare(r.start, end) < 0) &&
+		(len(r.end) == 0 || bytes.Compare(start, r.end) < 0)
+}
+
+// RegionLabeler keeps the label rules of the key ranges, and persists them to
+// the storage. The expired rules are ignored, and are deleted on the next
+// update.
+type RegionLabeler struct {
+	sync.RWMutex
+	storage *core.Storage
+	rules   map[string]*LabelRule
+}
+
+// NewRegionLabeler creates a RegionLabeler which persists the rules to the
+// storage.
+func NewRegionLabeler(storage *core.Storage) *RegionLabeler {
+	return &RegionLabeler{
+		storage: storage,
+		rules:   make(map[string]*LabelRule),
+	}
+}
+
+// LoadRules loads the rules from the storage, the rules in memory are
+// replaced.
+func (l *RegionLabeler) LoadRules() error {
+	rules := make(map[string]*LabelRule)
+	err := l.storage.LoadRegionRules(func(data []byte) error {
+		rule := &LabelRule{}
+		if err := json.Unmarshal(data, rule); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := rule.adjust(); err != nil {
+			return err
+		}
+		rules[rule.ID] = rule
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.rules = rules
+	return nil
+}
+
+// SetLabelRule adds a rule or replaces the rule with the same ID.
+func (l *RegionLabeler) SetLabelRule(rule *LabelRule) error {
+	if err := rule.adjust(); err != nil {
+		return err
+	}
+	rule.ExpireTime = nil
+	if rule.TTL != "" {
+		ttl, err := time.ParseDuration(rule.TTL)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if ttl <= 0 {
+			return errors.New("ttl must be positive")
+		}
+		expireTime := time.Now().Add(ttl)
+		rule.ExpireTime = &expireTime
+	}
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.deleteExpiredLocked()
+	if err := l.storage.SaveRegionRule(rule.ID, data); err != nil {
+		return err
+	}
+	l.rules[rule.ID] = rule
+	log.Info("label rule is set", zap.String("id", rule.ID), zap.Reflect("rule", rule))
+	return nil
+}
+
+// DeleteLabelRule deletes the rule with the ID.
+func (l *RegionLabeler) DeleteLabelRule(id string) error {
+	l.Lock()
+	defer l.Unlock()
+	l.deleteExpiredLocked()
+	if _, ok := l.rules[id]; !ok {
+		return errors.Wrap(ErrLabelRuleNotFound, id)
+	}
+	if err := l.storage.DeleteRegionRule(id); err != nil {
+		return err
+	}
+	delete(l.rules, id)
+	log.Info("label rule is deleted", zap.String("id", id))
+	return nil
+}
+
+func (l *RegionLabeler) deleteExpiredLocked() {
+	now := time.Now()
+	for id, rule := range l.rules {
+		if !rule.expired(now) {
+			continue
+		}
+		if err := l.storage.DeleteRegionRule(id); err != nil {
+			log.Error("failed to delete expired label rule", zap.String("id", id), zap.Error(err))
+			continue
+		}
+		delete(l.rules, id)
+	}
+}
+
+// GetLabelRule returns the rule with the ID, or nil if it does not exist or
+// is expired.
+func (l *RegionLabeler) GetLabelRule(id string) *LabelRule {
+	l.RLock()
+	defer l.RUnlock()
+	rule, ok := l.rules[id]
+	if !ok || rule.expired(time.Now()) {
+		return nil
+	}
+	return rule
+}
+
+// GetAllLabelRules returns the rules which are not expired, ordered by ID.
+func (l *RegionLabeler) GetAllLabelRules() []*LabelRule {
+	l.RLock()
+	defer l.RUnlock()
+	now := time.Now()
+	rules := make([]*LabelRule, 0, len(l.rules))
+	for _, rule := range l.rules {
+		if !rule.expired(now) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// KeyRangeHasLabel returns true if the key range [start, end) overlaps a rule
+// with the label.
+func (l *RegionLabeler) KeyRangeHasLabel(start, end []byte, label Label) bool {
+	l.RLock()
+	defer l.RUnlock()
+	now := time.Now()
+	for _, rule := range l.rules {
+		if !rule.expired(now) && rule.hasLabel(label) && rule.overlaps(start, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegionHasLabel returns true if the region overlaps a rule with the label.
+func (l *RegionLabeler) RegionHasLabel(region *core.RegionInfo, label Label) bool {
+	return l.KeyRangeHasLabel(region.GetStartKey(), region.GetEndKey(), label)
+}
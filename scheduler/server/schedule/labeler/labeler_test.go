@@ -0,0 +1,100 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package labeler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testLabelerSuite{})
+
+type testLabelerSuite struct{}
+
+func (s *testLabelerSuite) TestKeyRange(c *C) {
+	l := NewRegionLabeler(core.NewStorage(kv.NewMemoryKV()))
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "1", StartKey: "62", EndKey: "64", Labels: []Label{NoBalance, NoSplit}}), IsNil)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "2", StartKey: "66", Labels: []Label{NoMerge}}), IsNil)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "3", StartKey: "70", EndKey: "72", Labels: []Label{NoScatter}}), IsNil)
+
+	testCases := []struct {
+		start, end string
+		label      Label
+		expect     bool
+	}{
+		{"", "", NoBalance, true},
+		{"a", "b", NoBalance, false},
+		{"a", "bb", NoBalance, true},
+		{"c", "d", NoSplit, true},
+		{"d", "f", NoSplit, false},
+		{"c", "d", NoMerge, false},
+		{"e", "f", NoMerge, false},
+		{"e", "", NoMerge, true},
+		{"z", "", NoMerge, true},
+		{"z", "", NoBalance, false},
+		{"p", "q", NoScatter, true},
+		{"q", "r", NoScatter, true},
+		{"r", "s", NoScatter, false},
+		{"c", "d", NoScatter, false},
+	}
+	for _, t := range testCases {
+		c.Assert(l.KeyRangeHasLabel([]byte(t.start), []byte(t.end), t.label), Equals, t.expect, Commentf("%+v", t))
+	}
+}
+
+func (s *testLabelerSuite) TestPersist(c *C) {
+	storage := core.NewStorage(kv.NewMemoryKV())
+	l := NewRegionLabeler(storage)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "1", StartKey: "61", EndKey: "62", Labels: []Label{NoBalance}}), IsNil)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "2", StartKey: "63", EndKey: "64", Labels: []Label{NoSplit}, TTL: "1h"}), IsNil)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "3", StartKey: "65", EndKey: "66", Labels: []Label{NoMerge}}), IsNil)
+	c.Assert(l.DeleteLabelRule("3"), IsNil)
+
+	l = NewRegionLabeler(storage)
+	c.Assert(l.LoadRules(), IsNil)
+	rules := l.GetAllLabelRules()
+	c.Assert(rules, HasLen, 2)
+	c.Assert(rules[0].ID, Equals, "1")
+	c.Assert(rules[1].ID, Equals, "2")
+	c.Assert(rules[1].ExpireTime, NotNil)
+	c.Assert(l.KeyRangeHasLabel([]byte("c"), []byte("d"), NoSplit), IsTrue)
+}
+
+func (s *testLabelerSuite) TestTTL(c *C) {
+	storage := core.NewStorage(kv.NewMemoryKV())
+	l := NewRegionLabeler(storage)
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "1", Labels: []Label{NoBalance}, TTL: "100ms"}), IsNil)
+	c.Assert(l.KeyRangeHasLabel(nil, nil, NoBalance), IsTrue)
+	c.Assert(l.GetLabelRule("1"), NotNil)
+
+	time.Sleep(150 * time.Millisecond)
+	c.Assert(l.KeyRangeHasLabel(nil, nil, NoBalance), IsFalse)
+	c.Assert(l.GetLabelRule("1"), IsNil)
+	c.Assert(l.GetAllLabelRules(), HasLen, 0)
+
+	// The expired rule is deleted from the storage on the next update.
+	c.Assert(l.SetLabelRule(&LabelRule{ID: "2", Labels: []Label{NoMerge}}), IsNil)
+	l = NewRegionLabeler(storage)
+	c.Assert(l.LoadRules(), IsNil)
+	c.Assert(l.GetAllLabelRules(), HasLen, 1)
+	c.Assert(l.rules, HasLen, 1)
+}
@@ -16,6 +16,7 @@ package operator
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -127,6 +128,8 @@ type Operator struct {
 	// startTime is used to record the start time of an operator which is added into running operators.
 	startTime time.Time
 	stepTime  int64
+	// stepsTime records the finish time of each step in unix nanoseconds.
+	stepsTime []int64
 	level     core.PriorityLevel
 }
 
@@ -145,6 +148,7 @@ func NewOperator(desc, brief string, regionID uint64, regionEpoch *metapb.Region
 		steps:       steps,
 		createTime:  time.Now(),
 		stepTime:    time.Now().UnixNano(),
+		stepsTime:   make([]int64, len(steps)),
 		level:       level,
 	}
 }
@@ -174,6 +178,11 @@ func (o *Operator) Desc() string {
 	return o.desc
 }
 
+// Brief returns the operator's brief description.
+func (o *Operator) Brief() string {
+	return o.brief
+}
+
 // SetDesc sets the description for the operator.
 func (o *Operator) SetDesc(desc string) {
 	o.desc = desc
@@ -219,6 +228,23 @@ func (o *Operator) GetStartTime() time.Time {
 	return o.startTime
 }
 
+// GetCreateTime gets the create time for operator.
+func (o *Operator) GetCreateTime() time.Time {
+	return o.createTime
+}
+
+// StepFinishTime returns the time when the i-th step is finished, it returns
+// zero time if the step is not finished yet.
+func (o *Operator) StepFinishTime(i int) time.Time {
+	if i < 0 || i >= len(o.stepsTime) {
+		return time.Time{}
+	}
+	if t := atomic.LoadInt64(&o.stepsTime[i]); t != 0 {
+		return time.Unix(0, t)
+	}
+	return time.Time{}
+}
+
 // Len returns the operator's steps count.
 func (o *Operator) Len() int {
 	return len(o.steps)
@@ -237,8 +263,10 @@ func (o *Operator) Step(i int) OpStep {
 func (o *Operator) Check(region *core.RegionInfo) OpStep {
 	for step := atomic.LoadInt32(&o.currentStep); int(step) < len(o.steps); step++ {
 		if o.steps[int(step)].IsFinish(region) {
+			now := time.Now().UnixNano()
+			atomic.CompareAndSwapInt64(&o.stepsTime[step], 0, now)
 			atomic.StoreInt32(&o.currentStep, step+1)
-			atomic.StoreInt64(&o.stepTime, time.Now().UnixNano())
+			atomic.StoreInt64(&o.stepTime, now)
 		} else {
 			return o.steps[int(step)]
 		}
@@ -379,6 +407,40 @@ func CreateOfflinePeerOperator(desc string, cluster Cluster, region *core.Region
 	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpRegion, steps...), nil
 }
 
+// CreateScatterRegionOperator creates an operator that moves the peers of the
+// region to the target peers, the new peers are added before the old ones are
+// removed. It returns nil if the region already has the target peers.
+func CreateScatterRegionOperator(desc string, cluster Cluster, region *core.RegionInfo, targetPeers map[uint64]*metapb.Peer) (*Operator, error) {
+	var (
+		kind     OpKind
+		steps    []OpStep
+		storeIDs []uint64
+	)
+	for storeID, peer := range targetPeers {
+		if region.GetStorePeer(storeID) == nil {
+			steps = append(steps, CreateAddPeerSteps(storeID, peer.GetId())...)
+		}
+		storeIDs = append(storeIDs, storeID)
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+	for _, peer := range region.GetPeers() {
+		if _, ok := targetPeers[peer.GetStoreId()]; ok {
+			continue
+		}
+		k, st, err := removePeerSteps(cluster, region, peer.GetStoreId(), storeIDs)
+		if err != nil {
+			return nil, err
+		}
+		kind |= k
+		steps = append(steps, st...)
+	}
+	brief := fmt.Sprintf("scatter peers: stores %v", storeIDs)
+	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpScatter|OpRegion, steps...), nil
+}
+
 func getRegionFollowerIDs(region *core.RegionInfo) []uint64 {
 	var ids []uint64
 	for id := range region.GetFollowers() {
@@ -32,6 +32,7 @@ const (
 	OpBalance                     // Initiated by balancers.
 	OpMerge                       // Initiated by merge checkers or merge schedulers.
 	OpRange                       // Initiated by range scheduler.
+	OpScatter                     // Initiated by scatter region.
 	opMax
 )
 
@@ -44,6 +45,7 @@ var flagToName = map[OpKind]string{
 	OpBalance:  "balance",
 	OpMerge:    "merge",
 	OpRange:    "range",
+	OpScatter:  "scatter",
 }
 
 var nameToFlag = map[string]OpKind{
@@ -55,6 +57,7 @@ var nameToFlag = map[string]OpKind{
 	"balance":  OpBalance,
 	"merge":    OpMerge,
 	"range":    OpRange,
+	"scatter":  OpScatter,
 }
 
 func (k OpKind) String() string {
@@ -20,13 +20,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/cache"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 )
@@ -57,7 +60,9 @@ type OperatorController struct {
 	hbStreams       HeartbeatStreams
 	counts          map[operator.OpKind]uint64
 	opRecords       *OperatorRecords
+	history         *OperatorHistory
 	opNotifierQueue operatorQueue
+	storesLimit     map[uint64]map[storelimit.Type]*storelimit.StoreLimit
 }
 
 // NewOperatorController creates a OperatorController.
@@ -69,7 +74,9 @@ func NewOperatorController(ctx context.Context, cluster opt.Cluster, hbStreams H
 		hbStreams:       hbStreams,
 		counts:          make(map[operator.OpKind]uint64),
 		opRecords:       NewOperatorRecords(ctx),
+		history:         NewOperatorHistory(ctx, operatorHistoryCapacity),
 		opNotifierQueue: make(operatorQueue, 0),
+		storesLimit:     make(map[uint64]map[storelimit.Type]*storelimit.StoreLimit),
 	}
 }
 
@@ -98,7 +105,7 @@ func (oc *OperatorController) Dispatch(region *core.RegionInfo, source string) {
 				if oc.RemoveOperator(op) {
 					log.Info("stale operator", zap.Uint64("region-id", region.GetID()), zap.Duration("takes", op.RunningTime()),
 						zap.Reflect("operator", op), zap.Uint64("diff", changes))
-					oc.opRecords.Put(op, pdpb.OperatorStatus_CANCEL)
+					oc.recordOperator(op, pdpb.OperatorStatus_CANCEL, "stale operator")
 				}
 
 				return
@@ -109,10 +116,10 @@ func (oc *OperatorController) Dispatch(region *core.RegionInfo, source string) {
 		}
 		if op.IsFinish() && oc.RemoveOperator(op) {
 			log.Info("operator finish", zap.Uint64("region-id", region.GetID()), zap.Duration("takes", op.RunningTime()), zap.Reflect("operator", op))
-			oc.opRecords.Put(op, pdpb.OperatorStatus_SUCCESS)
+			oc.recordOperator(op, pdpb.OperatorStatus_SUCCESS, "")
 		} else if timeout && oc.RemoveOperator(op) {
 			log.Info("operator timeout", zap.Uint64("region-id", region.GetID()), zap.Duration("takes", op.RunningTime()), zap.Reflect("operator", op))
-			oc.opRecords.Put(op, pdpb.OperatorStatus_TIMEOUT)
+			oc.recordOperator(op, pdpb.OperatorStatus_TIMEOUT, timeoutReason(op))
 		}
 	}
 }
@@ -131,9 +138,9 @@ func (oc *OperatorController) AddOperator(ops ...*operator.Operator) bool {
 	oc.Lock()
 	defer oc.Unlock()
 
-	if !oc.checkAddOperator(ops...) {
+	if ok, reason := oc.checkAddOperator(ops...); !ok {
 		for _, op := range ops {
-			oc.opRecords.Put(op, pdpb.OperatorStatus_CANCEL)
+			oc.recordOperator(op, pdpb.OperatorStatus_CANCEL, reason)
 		}
 		return false
 	}
@@ -143,28 +150,113 @@ func (oc *OperatorController) AddOperator(ops ...*operator.Operator) bool {
 	return true
 }
 
+// operatorPauseLabels are the scheduling labels which pause the operators of
+// the kinds, the schedulers and the region scatterer skip the labeled regions
+// with filter.RegionNoLabel, and the operators created by others are rejected
+// here. The replica checker is not paused, since it repairs the regions.
+var operatorPauseLabels = []struct {
+	kind  operator.OpKind
+	label labeler.Label
+}{
+	{operator.OpBalance, labeler.NoBalance},
+	{operator.OpMerge, labeler.NoMerge},
+	{operator.OpScatter, labeler.NoScatter},
+}
+
 // checkAddOperator checks if the operator can be added.
 // There are several situations that cannot be added:
 // - There is no such region in the cluster
 // - The epoch of the operator and the epoch of the corresponding region are no longer consistent.
 // - The region already has a higher priority or same priority operator.
-func (oc *OperatorController) checkAddOperator(ops ...*operator.Operator) bool {
+// - The operators exceed the limit of a store.
+// The reason is returned if the operators cannot be added.
+func (oc *OperatorController) checkAddOperator(ops ...*operator.Operator) (bool, string) {
+	if err := failpoint.Eval("schedule/add-operator"); err != nil {
+		return false, err.Error()
+	}
 	for _, op := range ops {
 		region := oc.cluster.GetRegion(op.RegionID())
 		if region == nil {
 			log.Debug("region not found, cancel add operator", zap.Uint64("region-id", op.RegionID()))
-			return false
+			return false, "region not found"
 		}
 		if region.GetRegionEpoch().GetVersion() != op.RegionEpoch().GetVersion() || region.GetRegionEpoch().GetConfVer() != op.RegionEpoch().GetConfVer() {
 			log.Debug("region epoch not match, cancel add operator", zap.Uint64("region-id", op.RegionID()), zap.Reflect("old", region.GetRegionEpoch()), zap.Reflect("new", op.RegionEpoch()))
-			return false
+			return false, "region epoch not match"
 		}
 		if old := oc.operators[op.RegionID()]; old != nil && !isHigherPriorityOperator(op, old) {
 			log.Debug("already have operator, cancel add operator", zap.Uint64("region-id", op.RegionID()), zap.Reflect("old", old))
-			return false
+			return false, "already have operator with higher or same priority"
+		}
+		for _, pause := range operatorPauseLabels {
+			if op.Kind()&pause.kind != 0 && oc.cluster.GetRegionLabeler().RegionHasLabel(region, pause.label) {
+				log.Debug("key range is labeled, cancel add operator", zap.Uint64("region-id", op.RegionID()), zap.String("label", string(pause.label)))
+				return false, "key range is labeled " + string(pause.label)
+			}
+		}
+	}
+	if oc.exceedStoreLimit(ops...) {
+		return false, "exceed store limit"
+	}
+	return true, ""
+}
+
+// exceedStoreLimit returns true if the store limit of any store is exceeded
+// by the operators. The admin operators are not limited, but their costs are
+// still taken from the limits once they are added. The operators created by the
+// checkers are limited whatever their priority, so replacing the peers of a
+// down or offline store does not flood the other stores.
+func (oc *OperatorController) exceedStoreLimit(ops ...*operator.Operator) bool {
+	costs := make(map[uint64]map[storelimit.Type]int64)
+	for _, op := range ops {
+		if op.Kind()&operator.OpAdmin != 0 {
+			continue
+		}
+		addStoreCosts(costs, op)
+	}
+	for storeID, typeCosts := range costs {
+		for typ, cost := range typeCosts {
+			if oc.getOrCreateStoreLimit(storeID, typ).Available() < cost {
+				log.Debug("exceed store limit, cancel add operator", zap.Uint64("store-id", storeID), zap.Stringer("type", typ))
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addStoreCosts adds the number of peers the operator adds to or removes from
+// each store to the costs.
+func addStoreCosts(costs map[uint64]map[storelimit.Type]int64, op *operator.Operator) {
+	add := func(storeID uint64, typ storelimit.Type) {
+		if _, ok := costs[storeID]; !ok {
+			costs[storeID] = make(map[storelimit.Type]int64)
+		}
+		costs[storeID][typ]++
+	}
+	for i := 0; i < op.Len(); i++ {
+		switch st := op.Step(i).(type) {
+		case operator.AddPeer:
+			add(st.ToStore, storelimit.AddPeer)
+		case operator.RemovePeer:
+			add(st.FromStore, storelimit.RemovePeer)
 		}
 	}
-	return true
+}
+
+// getOrCreateStoreLimit returns the limit of the store, the limit is recreated
+// once the rate is changed in the config.
+func (oc *OperatorController) getOrCreateStoreLimit(storeID uint64, typ storelimit.Type) *storelimit.StoreLimit {
+	rate := oc.cluster.GetStoreLimitByType(storeID, typ)
+	if _, ok := oc.storesLimit[storeID]; !ok {
+		oc.storesLimit[storeID] = make(map[storelimit.Type]*storelimit.StoreLimit)
+	}
+	limit, ok := oc.storesLimit[storeID][typ]
+	if !ok || limit.Rate() != rate {
+		limit = storelimit.NewStoreLimit(rate)
+		oc.storesLimit[storeID][typ] = limit
+	}
+	return limit
 }
 
 func isHigherPriorityOperator(new, old *operator.Operator) bool {
@@ -181,13 +273,21 @@ func (oc *OperatorController) addOperatorLocked(op *operator.Operator) bool {
 	if old, ok := oc.operators[regionID]; ok {
 		_ = oc.removeOperatorLocked(old)
 		log.Info("replace old operator", zap.Uint64("region-id", regionID), zap.Duration("takes", old.RunningTime()), zap.Reflect("operator", old))
-		oc.opRecords.Put(old, pdpb.OperatorStatus_REPLACE)
+		oc.recordOperator(old, pdpb.OperatorStatus_REPLACE, "replaced by "+op.Desc())
 	}
 
 	oc.operators[regionID] = op
 	op.SetStartTime(time.Now())
 	oc.updateCounts(oc.operators)
 
+	costs := make(map[uint64]map[storelimit.Type]int64)
+	addStoreCosts(costs, op)
+	for storeID, typeCosts := range costs {
+		for typ, cost := range typeCosts {
+			oc.getOrCreateStoreLimit(storeID, typ).Take(cost)
+		}
+	}
+
 	var step operator.OpStep
 	if region := oc.cluster.GetRegion(op.RegionID()); region != nil {
 		if step = op.Check(region); step != nil {
@@ -206,6 +306,36 @@ func (oc *OperatorController) RemoveOperator(op *operator.Operator) (found bool)
 	return oc.removeOperatorLocked(op)
 }
 
+// CancelOperator removes a operator from the running operators and records it
+// as canceled with the reason.
+func (oc *OperatorController) CancelOperator(op *operator.Operator, reason string) (found bool) {
+	oc.Lock()
+	defer oc.Unlock()
+	if !oc.removeOperatorLocked(op) {
+		return false
+	}
+	oc.recordOperator(op, pdpb.OperatorStatus_CANCEL, reason)
+	return true
+}
+
+// recordOperator records the finished operator with the status, the reason
+// tells why it is not finished successfully.
+func (oc *OperatorController) recordOperator(op *operator.Operator, status pdpb.OperatorStatus, reason string) {
+	oc.opRecords.Put(op, status)
+	oc.history.Put(newOperatorRecord(op, status, reason))
+}
+
+// SetHistoryStorage persists the operator history to the storage when the
+// retention is greater than 0.
+func (oc *OperatorController) SetHistoryStorage(storage *core.Storage, retention func() time.Duration) {
+	oc.history.SetStorage(storage, retention)
+}
+
+// GetHistory returns the records of the finished operators matching the filter.
+func (oc *OperatorController) GetHistory(f *OperatorHistoryFilter) ([]*pdpb.OperatorRecord, error) {
+	return oc.history.Get(f)
+}
+
 // GetOperatorStatus gets the operator and its status with the specify id.
 func (oc *OperatorController) GetOperatorStatus(id uint64) *OperatorWithStatus {
 	oc.Lock()
@@ -252,6 +382,11 @@ func (oc *OperatorController) GetOperators() []*operator.Operator {
 // SendScheduleCommand sends a command to the region.
 func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step operator.OpStep, source string) {
 	log.Info("send schedule command", zap.Uint64("region-id", region.GetID()), zap.Stringer("step", step), zap.String("source", source))
+	// The command may be lost like it is sent to a crashed leader, the operator retries or times out then.
+	if err := failpoint.Eval("schedule/send-schedule-command"); err != nil {
+		log.Warn("failed to send schedule command", zap.Uint64("region-id", region.GetID()), zap.Error(err))
+		return
+	}
 	switch st := step.(type) {
 	case operator.TransferLeader:
 		cmd := &pdpb.RegionHeartbeatResponse{
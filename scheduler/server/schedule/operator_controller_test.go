@@ -15,14 +15,19 @@ package schedule
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/failpoint"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockhbstream"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	. "github.com/pingcap/check"
 )
@@ -132,3 +137,182 @@ func (t *testOperatorControllerSuite) TestDispatchOutdatedRegion(c *C) {
 	// no new step
 	c.Assert(len(stream.MsgCh()), Equals, 3)
 }
+
+func (t *testOperatorControllerSuite) TestStoreLimit(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := NewOperatorController(t.ctx, tc, mockhbstream.NewHeartbeatStream())
+	tc.AddLeaderStore(1, 0)
+	tc.AddLeaderStore(2, 0)
+	tc.AddLeaderStore(3, 0)
+	for i := uint64(1); i <= 4; i++ {
+		tc.AddLeaderRegion(i, 1)
+	}
+
+	// One operator per second for each store.
+	opt.StoreBalanceRate = 60
+	op := operator.CreateAddPeerOperator("test", tc.GetRegion(1), 10, 2, operator.OpRegion)
+	c.Assert(oc.AddOperator(op), IsTrue)
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(2), 11, 2, operator.OpRegion)
+	c.Assert(oc.AddOperator(op), IsFalse)
+	// The admin operators are not limited.
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(2), 11, 2, operator.OpAdmin)
+	c.Assert(oc.AddOperator(op), IsTrue)
+	c.Assert(oc.RemoveOperator(op), IsTrue)
+	// The high priority operators of the checkers are limited.
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(2), 11, 2, operator.OpReplica)
+	op.SetPriorityLevel(core.HighPriority)
+	c.Assert(oc.AddOperator(op), IsFalse)
+	// The other stores are not limited.
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(2), 11, 3, operator.OpRegion)
+	c.Assert(oc.AddOperator(op), IsTrue)
+	// Adding and removing peers are limited separately.
+	tc.AddLeaderRegion(5, 1, 2)
+	op, err := operator.CreateRemovePeerOperator("test", tc, operator.OpRegion, tc.GetRegion(5), 2)
+	c.Assert(err, IsNil)
+	c.Assert(oc.AddOperator(op), IsTrue)
+
+	// The limit is recreated once the rate is changed.
+	opt.StoreBalanceRate = 60 * 60
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(3), 12, 2, operator.OpRegion)
+	c.Assert(oc.AddOperator(op), IsTrue)
+	op = operator.CreateAddPeerOperator("test", tc.GetRegion(4), 13, 2, operator.OpRegion)
+	c.Assert(oc.AddOperator(op), IsTrue)
+}
+
+func (t *testOperatorControllerSuite) TestLabeledRegion(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := NewOperatorController(t.ctx, tc, mockhbstream.NewHeartbeatStream())
+	tc.AddLeaderStore(1, 0)
+	tc.AddLeaderStore(2, 0)
+	tc.AddLeaderRegionWithRange(1, "a", "b", 1, 2)
+	rule := &labeler.LabelRule{ID: "import", StartKey: "61", EndKey: "62", Labels: []labeler.Label{labeler.NoBalance}}
+	c.Assert(tc.RegionLabeler.SetLabelRule(rule), IsNil)
+
+	// The balance operators are rejected, but not the others.
+	op := operator.CreateTransferLeaderOperator("test", tc.GetRegion(1), 1, 2, operator.OpBalance)
+	c.Assert(oc.AddOperator(op), IsFalse)
+	records, err := oc.GetHistory(&OperatorHistoryFilter{RegionID: 1})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].GetReason(), Equals, "key range is labeled no-balance")
+	op = operator.CreateTransferLeaderOperator("test", tc.GetRegion(1), 1, 2, operator.OpAdmin)
+	c.Assert(oc.AddOperator(op), IsTrue)
+}
+
+func (t *testOperatorControllerSuite) TestFailpoint(c *C) {
+	cluster := mockcluster.NewCluster(mockoption.NewScheduleOptions())
+	stream := mockhbstream.NewHeartbeatStreams(cluster.ID)
+	oc := NewOperatorController(t.ctx, cluster, stream)
+	cluster.AddLeaderStore(1, 1)
+	cluster.AddLeaderStore(2, 0)
+	cluster.AddLeaderRegion(1, 1, 2)
+
+	c.Assert(failpoint.Enable("schedule/add-operator", "1*return(injected)"), IsNil)
+	defer failpoint.Disable("schedule/add-operator")
+	op := operator.CreateTransferLeaderOperator("test", cluster.GetRegion(1), 1, 2, operator.OpAdmin)
+	c.Assert(oc.AddOperator(op), IsFalse)
+	records, err := oc.GetHistory(&OperatorHistoryFilter{RegionID: 1})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].GetReason(), Equals, "failpoint schedule/add-operator: injected")
+
+	// The lost command is sent again by the next heartbeat.
+	c.Assert(failpoint.Enable("schedule/send-schedule-command", "return"), IsNil)
+	op = operator.CreateTransferLeaderOperator("test", cluster.GetRegion(1), 1, 2, operator.OpAdmin)
+	c.Assert(oc.AddOperator(op), IsTrue)
+	c.Assert(stream.MsgCh(), HasLen, 0)
+	c.Assert(failpoint.Disable("schedule/send-schedule-command"), IsNil)
+	oc.Dispatch(cluster.GetRegion(1), DispatchFromHeartBeat)
+	c.Assert(stream.MsgCh(), HasLen, 1)
+}
+
+func (t *testOperatorControllerSuite) TestOperatorHistory(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := NewOperatorController(t.ctx, tc, mockhbstream.NewHeartbeatStream())
+	tc.AddLeaderStore(1, 1)
+	tc.AddLeaderStore(2, 1)
+	tc.AddLeaderStore(3, 0)
+	tc.AddLeaderRegion(1, 1, 2)
+	tc.AddLeaderRegion(2, 2, 1)
+
+	// Finished successfully.
+	start := time.Now().UnixNano()
+	op1 := operator.CreateTransferLeaderOperator("test-leader", tc.GetRegion(1), 1, 2, operator.OpLeader)
+	c.Assert(oc.AddOperator(op1), IsTrue)
+	ApplyOperator(tc, op1)
+	oc.Dispatch(tc.GetRegion(1), DispatchFromHeartBeat)
+	// Canceled when adding.
+	op2 := operator.NewOperator("test-canceled", "test", 2, &metapb.RegionEpoch{ConfVer: 10}, operator.OpRegion,
+		operator.AddPeer{ToStore: 3, PeerID: 10})
+	c.Assert(oc.AddOperator(op2), IsFalse)
+	// Timeout.
+	op3 := operator.CreateAddPeerOperator("test-region", tc.GetRegion(2), 10, 3, operator.OpRegion)
+	c.Assert(oc.AddOperator(op3), IsTrue)
+	op3.SetStartTime(time.Now().Add(-operator.RegionOperatorWaitTime - time.Second))
+	oc.Dispatch(tc.GetRegion(2), DispatchFromHeartBeat)
+
+	records, err := oc.GetHistory(&OperatorHistoryFilter{})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 3)
+	c.Assert(records[0].GetDesc(), Equals, "test-leader")
+	c.Assert(records[0].GetStatus(), Equals, pdpb.OperatorStatus_SUCCESS)
+	c.Assert(records[0].GetStoreIds(), DeepEquals, []uint64{1, 2})
+	c.Assert(records[0].GetSteps()[0].GetFinishTime(), Greater, start)
+	c.Assert(records[0].GetFinishTime() >= records[0].GetSteps()[0].GetFinishTime(), IsTrue)
+	c.Assert(records[1].GetStatus(), Equals, pdpb.OperatorStatus_CANCEL)
+	c.Assert(records[1].GetReason(), Equals, "region epoch not match")
+	c.Assert(records[1].GetStartTime(), Equals, int64(0))
+	c.Assert(records[2].GetStatus(), Equals, pdpb.OperatorStatus_TIMEOUT)
+	c.Assert(records[2].GetReason(), Matches, "timeout on step: add peer 10 on store 3.*")
+	c.Assert(records[2].GetSteps()[0].GetFinishTime(), Equals, int64(0))
+
+	records, err = oc.GetHistory(&OperatorHistoryFilter{RegionID: 2})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	records, err = oc.GetHistory(&OperatorHistoryFilter{StoreID: 1})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	records, err = oc.GetHistory(&OperatorHistoryFilter{StartTime: start, EndTime: start})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 0)
+
+	// The records are persisted once the retention is set.
+	storage := core.NewStorage(kv.NewMemoryKV())
+	oc.SetHistoryStorage(storage, func() time.Duration { return time.Hour })
+	op4 := operator.CreateAddPeerOperator("test-region", tc.GetRegion(2), 11, 3, operator.OpRegion)
+	c.Assert(oc.AddOperator(op4), IsTrue)
+	c.Assert(oc.CancelOperator(op4, "removed by admin"), IsTrue)
+	records, err = oc.GetHistory(&OperatorHistoryFilter{RegionID: 2, StartTime: start})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].GetReason(), Equals, "removed by admin")
+	// The records are persisted in the background.
+	var loaded []*pdpb.OperatorRecord
+	for i := 0; i < 100 && len(loaded) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		c.Assert(storage.LoadOperatorRecords(0, math.MaxInt64, func(record *pdpb.OperatorRecord) bool {
+			loaded = append(loaded, record)
+			return true
+		}), IsNil)
+	}
+	c.Assert(loaded, DeepEquals, records)
+	records, err = oc.GetHistory(&OperatorHistoryFilter{RegionID: 2, StartTime: start})
+	c.Assert(err, IsNil)
+	c.Assert(records, DeepEquals, loaded)
+}
+
+func (t *testOperatorControllerSuite) TestOperatorHistoryCapacity(c *C) {
+	h := NewOperatorHistory(t.ctx, 3)
+	for i := uint64(1); i <= 5; i++ {
+		h.Put(&pdpb.OperatorRecord{RegionId: i, FinishTime: int64(i)})
+	}
+	records, err := h.Get(&OperatorHistoryFilter{})
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 3)
+	for i, record := range records {
+		c.Assert(record.GetRegionId(), Equals, uint64(i+3))
+	}
+}
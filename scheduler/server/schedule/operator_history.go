@@ -0,0 +1,297 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// operatorHistoryCapacity is the number of the operators kept in memory.
+	operatorHistoryCapacity = 1024
+	// operatorHistoryPruneInterval is the interval of deleting the expired
+	// records from the storage.
+	operatorHistoryPruneInterval = 10 * time.Minute
+)
+
+// OperatorHistoryFilter filters the operator records, the zero value of a
+// field means no filter.
+type OperatorHistoryFilter struct {
+	RegionID uint64
+	StoreID  uint64
+	// StartTime and EndTime are the time window [StartTime, EndTime) of the
+	// finish time in unix nanoseconds.
+	StartTime int64
+	EndTime   int64
+}
+
+func (f *OperatorHistoryFilter) match(record *pdpb.OperatorRecord) bool {
+	if f.RegionID != 0 && record.GetRegionId() != f.RegionID {
+		return false
+	}
+	if f.StoreID != 0 {
+		found := false
+		for _, id := range record.GetStoreIds() {
+			if id == f.StoreID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.StartTime != 0 && record.GetFinishTime() < f.StartTime {
+		return false
+	}
+	if f.EndTime != 0 && record.GetFinishTime() >= f.EndTime {
+		return false
+	}
+	return true
+}
+
+// OperatorHistory keeps the records of the recently finished operators in a
+// ring buffer, and persists them to the storage if the retention is set. The
+// records are persisted and the expired ones are deleted in the background,
+// so Put never waits for the storage.
+type OperatorHistory struct {
+	sync.RWMutex
+	ctx       context.Context
+	records   []*pdpb.OperatorRecord
+	next      int
+	storage   *core.Storage
+	retention func() time.Duration
+	// pending are the records to be persisted, in the order of Put.
+	pending []*pdpb.OperatorRecord
+	// persistCh notifies the background goroutine of the pending records.
+	persistCh chan struct{}
+}
+
+// NewOperatorHistory creates an OperatorHistory which keeps at most capacity
+// records in memory. It persists the records until ctx is done.
+func NewOperatorHistory(ctx context.Context, capacity int) *OperatorHistory {
+	h := &OperatorHistory{
+		ctx:       ctx,
+		records:   make([]*pdpb.OperatorRecord, 0, capacity),
+		persistCh: make(chan struct{}, 1),
+	}
+	go h.persistLoop()
+	return h
+}
+
+// SetStorage sets the storage the records are persisted to. The records are
+// only persisted when the retention is greater than 0, and are deleted from
+// the storage after the retention.
+func (h *OperatorHistory) SetStorage(storage *core.Storage, retention func() time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+	h.storage = storage
+	h.retention = retention
+}
+
+func (h *OperatorHistory) persistent() bool {
+	return h.storage != nil && h.retention() > 0
+}
+
+// Put adds the record of a finished operator.
+func (h *OperatorHistory) Put(record *pdpb.OperatorRecord) {
+	h.Lock()
+	defer h.Unlock()
+	if len(h.records) < cap(h.records) {
+		h.records = append(h.records, record)
+	} else {
+		h.records[h.next] = record
+	}
+	h.next = (h.next + 1) % cap(h.records)
+
+	if !h.persistent() {
+		return
+	}
+	// The storage falls behind, drop the record rather than block the caller.
+	if len(h.pending) >= cap(h.records) {
+		log.Warn("too many operator records to persist, drop the record", zap.Uint64("region-id", record.GetRegionId()))
+		return
+	}
+	h.pending = append(h.pending, record)
+	select {
+	case h.persistCh <- struct{}{}:
+	default:
+	}
+}
+
+func (h *OperatorHistory) persistLoop() {
+	ticker := time.NewTicker(operatorHistoryPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.persistCh:
+			h.persist()
+		case <-ticker.C:
+			h.prune()
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+// persist saves the pending records, they are removed from the pending
+// records only after they are saved, so Get always finds them.
+func (h *OperatorHistory) persist() {
+	h.RLock()
+	storage := h.storage
+	records := h.pending
+	h.RUnlock()
+	for _, record := range records {
+		if err := storage.SaveOperatorRecord(record); err != nil {
+			log.Error("failed to save operator record", zap.Uint64("region-id", record.GetRegionId()), zap.Error(err))
+		}
+	}
+	h.Lock()
+	h.pending = h.pending[len(records):]
+	h.Unlock()
+}
+
+// prune deletes the expired records from the storage.
+func (h *OperatorHistory) prune() {
+	h.RLock()
+	persistent, storage := h.persistent(), h.storage
+	var before int64
+	if persistent {
+		before = time.Now().Add(-h.retention()).UnixNano()
+	}
+	h.RUnlock()
+	if !persistent {
+		return
+	}
+	if err := storage.DeleteOperatorRecords(before); err != nil {
+		log.Error("failed to delete expired operator records", zap.Error(err))
+	}
+}
+
+// Get returns the records matching the filter in the order of finish time.
+// The records are loaded from the storage if they are persisted, otherwise
+// only the records in memory are returned.
+func (h *OperatorHistory) Get(f *OperatorHistoryFilter) ([]*pdpb.OperatorRecord, error) {
+	h.RLock()
+	if !h.persistent() {
+		defer h.RUnlock()
+		var records []*pdpb.OperatorRecord
+		// The oldest record is at h.next once the buffer is full.
+		for i := 0; i < len(h.records); i++ {
+			record := h.records[(h.next+i)%len(h.records)]
+			if f.match(record) {
+				records = append(records, record)
+			}
+		}
+		return records, nil
+	}
+	// The pending records are taken before loading, so a record is either
+	// loaded or pending even if it is saved in the meantime.
+	storage, pending := h.storage, h.pending
+	h.RUnlock()
+
+	end := f.EndTime
+	if end == 0 {
+		end = time.Now().UnixNano() + 1
+	}
+	type recordKey struct {
+		finishTime int64
+		regionID   uint64
+	}
+	var records []*pdpb.OperatorRecord
+	loaded := make(map[recordKey]struct{})
+	err := storage.LoadOperatorRecords(f.StartTime, end, func(record *pdpb.OperatorRecord) bool {
+		if f.match(record) {
+			records = append(records, record)
+			loaded[recordKey{record.GetFinishTime(), record.GetRegionId()}] = struct{}{}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range pending {
+		if _, ok := loaded[recordKey{record.GetFinishTime(), record.GetRegionId()}]; !ok && f.match(record) {
+			records = append(records, record)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetFinishTime() < records[j].GetFinishTime()
+	})
+	return records, nil
+}
+
+// newOperatorRecord creates the record of an operator finished with the status.
+func newOperatorRecord(op *operator.Operator, status pdpb.OperatorStatus, reason string) *pdpb.OperatorRecord {
+	record := &pdpb.OperatorRecord{
+		RegionId:   op.RegionID(),
+		Desc:       op.Desc(),
+		Brief:      op.Brief(),
+		Kind:       op.Kind().String(),
+		Status:     status,
+		Reason:     reason,
+		CreateTime: op.GetCreateTime().UnixNano(),
+		FinishTime: time.Now().UnixNano(),
+	}
+	if !op.GetStartTime().IsZero() {
+		record.StartTime = op.GetStartTime().UnixNano()
+	}
+	stores := make(map[uint64]struct{})
+	for i := 0; i < op.Len(); i++ {
+		step := &pdpb.OperatorStepRecord{Step: op.Step(i).String()}
+		if t := op.StepFinishTime(i); !t.IsZero() {
+			step.FinishTime = t.UnixNano()
+		}
+		record.Steps = append(record.Steps, step)
+		for _, id := range stepStores(op.Step(i)) {
+			if _, ok := stores[id]; !ok {
+				stores[id] = struct{}{}
+				record.StoreIds = append(record.StoreIds, id)
+			}
+		}
+	}
+	return record
+}
+
+// stepStores returns the stores involved in the step.
+func stepStores(step operator.OpStep) []uint64 {
+	switch st := step.(type) {
+	case operator.TransferLeader:
+		return []uint64{st.FromStore, st.ToStore}
+	case operator.AddPeer:
+		return []uint64{st.ToStore}
+	case operator.RemovePeer:
+		return []uint64{st.FromStore}
+	}
+	return nil
+}
+
+// timeoutReason describes the step an operator is timeout on.
+func timeoutReason(op *operator.Operator) string {
+	for i := 0; i < op.Len(); i++ {
+		if op.StepFinishTime(i).IsZero() {
+			return "timeout on step: " + op.Step(i).String() + ", running " + op.RunningTime().String()
+		}
+	}
+	return "timeout after running " + op.RunningTime().String()
+}
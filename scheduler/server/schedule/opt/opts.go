@@ -18,6 +18,8 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/storelimit"
 )
 
 // Options for schedulers.
@@ -25,9 +27,14 @@ type Options interface {
 	GetLeaderScheduleLimit() uint64
 	GetRegionScheduleLimit() uint64
 	GetReplicaScheduleLimit() uint64
+	GetStoreLimitByType(storeID uint64, typ storelimit.Type) float64
 
 	GetMaxStoreDownTime() time.Duration
 
+	GetHighSpaceRatio() float64
+	GetLowSpaceRatio() float64
+	GetRegionScoreFormulaVersion() string
+
 	GetMaxReplicas() int
 }
 
@@ -40,6 +47,8 @@ type Cluster interface {
 
 	Options
 
+	GetRegionLabeler() *labeler.RegionLabeler
+
 	// TODO: it should be removed. Schedulers don't need to know anything
 	// about peers.
 	AllocPeer(storeID uint64) (*metapb.Peer, error)
@@ -0,0 +1,171 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pkg/errors"
+)
+
+const regionScatterName = "region-scatter"
+
+// selectedStores records the stores picked by the recent scatters, a store is
+// not picked again until every available store has been picked once.
+type selectedStores struct {
+	mu     sync.Mutex
+	stores map[uint64]struct{}
+}
+
+func newSelectedStores() *selectedStores {
+	return &selectedStores{
+		stores: make(map[uint64]struct{}),
+	}
+}
+
+// put marks the store as selected, it returns false if the store has been
+// selected already.
+func (s *selectedStores) put(id uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.stores[id]; ok {
+		return false
+	}
+	s.stores[id] = struct{}{}
+	return true
+}
+
+func (s *selectedStores) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stores = make(map[uint64]struct{})
+}
+
+func (s *selectedStores) newFilter(scope string) filter.Filter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cloned := make(map[uint64]struct{}, len(s.stores))
+	for id := range s.stores {
+		cloned[id] = struct{}{}
+	}
+	return filter.NewExcludedFilter(scope, nil, cloned)
+}
+
+// RegionScatterer scatters the peers of the regions over the stores, it is
+// used to spread the regions created by splitting a region, e.g. before
+// restoring or importing data into them.
+type RegionScatterer struct {
+	name     string
+	cluster  opt.Cluster
+	filters  []filter.Filter
+	selected *selectedStores
+}
+
+// NewRegionScatterer creates a region scatterer.
+func NewRegionScatterer(cluster opt.Cluster) *RegionScatterer {
+	return &RegionScatterer{
+		name:    regionScatterName,
+		cluster: cluster,
+		filters: []filter.Filter{
+			filter.NewStateFilter(regionScatterName),
+			filter.NewHealthFilter(regionScatterName),
+			filter.NewLowSpaceFilter(regionScatterName),
+		},
+		selected: newSelectedStores(),
+	}
+}
+
+// Scatter creates an operator which moves the peers of the region to the
+// stores picked least recently. It returns nil if the peers need not move.
+func (r *RegionScatterer) Scatter(region *core.RegionInfo) (*operator.Operator, error) {
+	if len(region.GetPeers()) != r.cluster.GetMaxReplicas() {
+		return nil, errors.Errorf("region %d is not fully replicated", region.GetID())
+	}
+	if region.GetLeader() == nil {
+		return nil, errors.Errorf("region %d has no leader", region.GetID())
+	}
+	if !filter.RegionNoLabel(r.cluster, labeler.NoScatter)(region) {
+		return nil, errors.Errorf("region %d is labeled %s", region.GetID(), labeler.NoScatter)
+	}
+	return r.scatterRegion(region)
+}
+
+func (r *RegionScatterer) scatterRegion(region *core.RegionInfo) (*operator.Operator, error) {
+	stores := r.collectAvailableStores(region)
+	targetPeers := make(map[uint64]*metapb.Peer)
+	for _, peer := range region.GetPeers() {
+		if len(stores) == 0 {
+			// All the stores have been picked, start another round.
+			r.selected.reset()
+			for id := range targetPeers {
+				r.selected.put(id)
+			}
+			stores = r.collectAvailableStores(region)
+		}
+		if r.selected.put(peer.GetStoreId()) {
+			delete(stores, peer.GetStoreId())
+			targetPeers[peer.GetStoreId()] = peer
+			continue
+		}
+		newPeer, err := r.selectPeerToReplace(stores)
+		if err != nil {
+			return nil, err
+		}
+		if newPeer == nil {
+			targetPeers[peer.GetStoreId()] = peer
+			continue
+		}
+		delete(stores, newPeer.GetStoreId())
+		r.selected.put(newPeer.GetStoreId())
+		targetPeers[newPeer.GetStoreId()] = newPeer
+	}
+	return operator.CreateScatterRegionOperator("scatter-region", r.cluster, region, targetPeers)
+}
+
+// selectPeerToReplace allocates a peer on a random store of the candidates,
+// it returns nil if there is no candidate.
+func (r *RegionScatterer) selectPeerToReplace(stores map[uint64]*core.StoreInfo) (*metapb.Peer, error) {
+	if len(stores) == 0 {
+		return nil, nil
+	}
+	candidates := make([]uint64, 0, len(stores))
+	for id := range stores {
+		candidates = append(candidates, id)
+	}
+	return r.cluster.AllocPeer(candidates[rand.Intn(len(candidates))])
+}
+
+// collectAvailableStores returns the stores which can take a new peer of the
+// region and have not been picked in this round.
+func (r *RegionScatterer) collectAvailableStores(region *core.RegionInfo) map[uint64]*core.StoreInfo {
+	filters := []filter.Filter{
+		r.selected.newFilter(r.name),
+		filter.NewExcludedFilter(r.name, nil, region.GetStoreIds()),
+	}
+	filters = append(filters, r.filters...)
+
+	stores := filter.SelectTargetStores(r.cluster.GetStores(), filters, r.cluster)
+	targets := make(map[uint64]*core.StoreInfo, len(stores))
+	for _, store := range stores {
+		targets[store.GetID()] = store
+	}
+	return targets
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testScatterRegionSuite{})
+
+type testScatterRegionSuite struct{}
+
+func (s *testScatterRegionSuite) TestScatterSplitRegions(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	const numStores, numRegions = 6, 30
+	for i := uint64(1); i <= numStores; i++ {
+		tc.AddRegionStore(i, 0)
+	}
+	// The regions split from one region all have their peers on its stores.
+	for i := uint64(1); i <= numRegions; i++ {
+		tc.AddLeaderRegionWithRange(i, fmt.Sprintf("%03d", i), fmt.Sprintf("%03d", i+1), 1, 2, 3)
+	}
+
+	scatterer := NewRegionScatterer(tc)
+	for i := uint64(1); i <= numRegions; i++ {
+		op, err := scatterer.Scatter(tc.GetRegion(i))
+		c.Assert(err, IsNil)
+		if op != nil {
+			c.Assert(op.Kind()&operator.OpScatter, Not(Equals), operator.OpKind(0))
+			ApplyOperator(tc, op)
+		}
+	}
+
+	peerCount := make(map[uint64]int)
+	for i := uint64(1); i <= numRegions; i++ {
+		region := tc.GetRegion(i)
+		c.Assert(region.GetPeers(), HasLen, opt.GetMaxReplicas())
+		for _, peer := range region.GetPeers() {
+			peerCount[peer.GetStoreId()]++
+		}
+	}
+	c.Assert(peerCount, HasLen, numStores)
+	for storeID, count := range peerCount {
+		c.Assert(count, Equals, numRegions*opt.GetMaxReplicas()/numStores, Commentf("store %d", storeID))
+	}
+}
+
+func (s *testScatterRegionSuite) TestScatterNotReplicated(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	for i := uint64(1); i <= 4; i++ {
+		tc.AddRegionStore(i, 0)
+	}
+	tc.AddLeaderRegion(1, 1, 2)
+
+	_, err := NewRegionScatterer(tc).Scatter(tc.GetRegion(1))
+	c.Assert(err, NotNil)
+}
+
+func (s *testScatterRegionSuite) TestScatterLabeledRegion(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	for i := uint64(1); i <= 6; i++ {
+		tc.AddRegionStore(i, 0)
+	}
+	tc.AddLeaderRegionWithRange(1, "a", "b", 1, 2, 3)
+	tc.AddLeaderRegionWithRange(2, "b", "c", 1, 2, 3)
+	rule := &labeler.LabelRule{ID: "restore", StartKey: "61", EndKey: "62", Labels: []labeler.Label{labeler.NoScatter}}
+	c.Assert(tc.RegionLabeler.SetLabelRule(rule), IsNil)
+
+	scatterer := NewRegionScatterer(tc)
+	_, err := scatterer.Scatter(tc.GetRegion(1))
+	c.Assert(err, ErrorMatches, ".*labeled no-scatter.*")
+	// The labeled region picks no stores, so the next region keeps its peers.
+	op, err := scatterer.Scatter(tc.GetRegion(2))
+	c.Assert(err, IsNil)
+	c.Assert(op, IsNil)
+}
@@ -46,8 +46,8 @@ func (s *BalanceSelector) SelectSource(opt opt.Options, stores []*core.StoreInfo
 			continue
 		}
 		if result == nil ||
-			result.ResourceScore(s.kind, 0) <
-				store.ResourceScore(s.kind, 0) {
+			result.ResourceScore(s.kind, opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio(), 0) <
+				store.ResourceScore(s.kind, opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio(), 0) {
 			result = store
 		}
 	}
@@ -64,8 +64,8 @@ func (s *BalanceSelector) SelectTarget(opt opt.Options, stores []*core.StoreInfo
 			continue
 		}
 		if result == nil ||
-			result.ResourceScore(s.kind, 0) >
-				store.ResourceScore(s.kind, 0) {
+			result.ResourceScore(s.kind, opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio(), 0) >
+				store.ResourceScore(s.kind, opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio(), 0) {
 			result = store
 		}
 	}
@@ -94,7 +94,7 @@ func (s *ReplicaSelector) SelectSource(opt opt.Options, stores []*core.StoreInfo
 		best *core.StoreInfo
 	)
 	for _, store := range stores {
-		if best == nil || compareStoreScore(store, best) < 0 {
+		if best == nil || compareStoreScore(opt, store, best) < 0 {
 			best = store
 		}
 	}
@@ -114,7 +114,7 @@ func (s *ReplicaSelector) SelectTarget(opt opt.Options, stores []*core.StoreInfo
 		if filter.Target(opt, store, filters) {
 			continue
 		}
-		if best == nil || compareStoreScore(store, best) > 0 {
+		if best == nil || compareStoreScore(opt, store, best) > 0 {
 			best = store
 		}
 	}
@@ -128,14 +128,14 @@ func (s *ReplicaSelector) SelectTarget(opt opt.Options, stores []*core.StoreInfo
 // Returns 0 if store A is as good as store B.
 // Returns 1 if store A is better than store B.
 // Returns -1 if store B is better than store A.
-func compareStoreScore(storeA *core.StoreInfo, storeB *core.StoreInfo) int {
+func compareStoreScore(opt opt.Options, storeA *core.StoreInfo, storeB *core.StoreInfo) int {
 	// The store with lower region score is better.
-	if storeA.RegionScore() <
-		storeB.RegionScore() {
+	scoreA := storeA.RegionScore(opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio())
+	scoreB := storeB.RegionScore(opt.GetRegionScoreFormulaVersion(), opt.GetHighSpaceRatio(), opt.GetLowSpaceRatio())
+	if scoreA < scoreB {
 		return 1
 	}
-	if storeA.RegionScore() >
-		storeB.RegionScore() {
+	if scoreA > scoreB {
 		return -1
 	}
 	return 0
@@ -146,6 +146,11 @@ type RandomSelector struct {
 	filters []filter.Filter
 }
 
+// NewRandomSelector creates a RandomSelector instance.
+func NewRandomSelector(filters []filter.Filter) *RandomSelector {
+	return &RandomSelector{filters: filters}
+}
+
 func (s *RandomSelector) randStore(stores []*core.StoreInfo) *core.StoreInfo {
 	if len(stores) == 0 {
 		return nil
@@ -0,0 +1,104 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Type indicates the type of store limit.
+type Type int
+
+const (
+	// AddPeer indicates the type of store limit that limits the adding peer rate.
+	AddPeer Type = iota
+	// RemovePeer indicates the type of store limit that limits the removing peer rate.
+	RemovePeer
+)
+
+// TypeNameValue indicates the name of store limit type and the enum value.
+var TypeNameValue = map[string]Type{
+	"add-peer":    AddPeer,
+	"remove-peer": RemovePeer,
+}
+
+// String returns the representation of the Type.
+func (t Type) String() string {
+	for n, v := range TypeNameValue {
+		if v == t {
+			return n
+		}
+	}
+	return ""
+}
+
+// StoreLimit limits the operators of a store with a token bucket, a token is
+// taken for each peer added to or removed from the store.
+type StoreLimit struct {
+	mu sync.Mutex
+	// rate is the number of operators per minute.
+	rate      float64
+	capacity  float64
+	available float64
+	last      time.Time
+}
+
+// NewStoreLimit returns a StoreLimit that allows rate operators per minute.
+// The bucket holds the operators of one second, but at least one, so the
+// operators of a minute are not sent to the store at once.
+func NewStoreLimit(rate float64) *StoreLimit {
+	capacity := rate / 60
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &StoreLimit{
+		rate:      rate,
+		capacity:  capacity,
+		available: capacity,
+		last:      time.Now(),
+	}
+}
+
+// Available returns the number of operators can be added now.
+func (l *StoreLimit) Available() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.adjust(time.Now())
+	return int64(l.available)
+}
+
+// Rate returns the number of operators allowed per minute.
+func (l *StoreLimit) Rate() float64 {
+	return l.rate
+}
+
+// Take takes count tokens from the bucket, the bucket may be overdrawn.
+func (l *StoreLimit) Take(count int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.adjust(time.Now())
+	l.available -= float64(count)
+}
+
+// adjust fills the bucket with the tokens produced since the last adjust.
+func (l *StoreLimit) adjust(now time.Time) {
+	if now.After(l.last) {
+		l.available += now.Sub(l.last).Minutes() * l.rate
+		if l.available > l.capacity {
+			l.available = l.capacity
+		}
+	}
+	l.last = now
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/checker"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
 	"github.com/pingcap/log"
@@ -87,23 +88,25 @@ func (s *balanceRegionScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
 func (s *balanceRegionScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
 	stores := cluster.GetStores()
 	stores = filter.SelectSourceStores(stores, s.filters, cluster)
+	version, highSpaceRatio, lowSpaceRatio := cluster.GetRegionScoreFormulaVersion(), cluster.GetHighSpaceRatio(), cluster.GetLowSpaceRatio()
 	sort.Slice(stores, func(i, j int) bool {
-		return stores[i].RegionScore() > stores[j].RegionScore()
+		return stores[i].RegionScore(version, highSpaceRatio, lowSpaceRatio) > stores[j].RegionScore(version, highSpaceRatio, lowSpaceRatio)
 	})
+	noBalance := filter.RegionNoLabel(cluster, labeler.NoBalance)
 	for _, source := range stores {
 		sourceID := source.GetID()
 
 		for i := 0; i < balanceRegionRetryLimit; i++ {
 			// Priority picks the region that has a pending peer.
 			// Pending region may means the disk is overload, remove the pending region firstly.
-			region := cluster.RandPendingRegion(sourceID, core.HealthRegionAllowPending())
+			region := cluster.RandPendingRegion(sourceID, core.HealthRegionAllowPending(), noBalance)
 			if region == nil {
 				// Then picks the region that has a follower in the source store.
-				region = cluster.RandFollowerRegion(sourceID, core.HealthRegion())
+				region = cluster.RandFollowerRegion(sourceID, core.HealthRegion(), noBalance)
 			}
 			if region == nil {
 				// Last, picks the region has the leader in the source store.
-				region = cluster.RandLeaderRegion(sourceID, core.HealthRegion())
+				region = cluster.RandLeaderRegion(sourceID, core.HealthRegion(), noBalance)
 			}
 			if region == nil {
 				continue
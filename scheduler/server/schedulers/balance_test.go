@@ -16,6 +16,7 @@ package schedulers
 import (
 	"context"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/checker"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/labeler"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	. "github.com/pingcap/check"
 )
@@ -216,6 +218,66 @@ func (s *testBalanceRegionSchedulerSuite) TestReplacePendingRegion3C(c *C) {
 	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 4)
 }
 
+func (s *testBalanceRegionSchedulerSuite) TestLabeledRegion(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := schedule.NewOperatorController(s.ctx, nil, nil)
+
+	sb, err := schedule.CreateScheduler("balance-region", oc, core.NewStorage(kv.NewMemoryKV()), nil)
+	c.Assert(err, IsNil)
+
+	opt.SetMaxReplicas(1)
+	tc.AddRegionStore(1, 6)
+	tc.AddRegionStore(2, 16)
+	tc.AddLeaderRegionWithRange(1, "a", "b", 2)
+	c.Assert(sb.Schedule(tc), NotNil)
+
+	rule := &labeler.LabelRule{ID: "ddl", Labels: []labeler.Label{labeler.NoBalance}, TTL: "1h"}
+	c.Assert(tc.RegionLabeler.SetLabelRule(rule), IsNil)
+	c.Assert(sb.Schedule(tc), IsNil)
+
+	c.Assert(tc.RegionLabeler.DeleteLabelRule("ddl"), IsNil)
+	c.Assert(sb.Schedule(tc), NotNil)
+}
+
+func (s *testBalanceRegionSchedulerSuite) TestLowSpace(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := schedule.NewOperatorController(s.ctx, nil, nil)
+
+	sb, err := schedule.CreateScheduler("balance-region", oc, core.NewStorage(kv.NewMemoryKV()), nil)
+	c.Assert(err, IsNil)
+	opt.SetMaxReplicas(1)
+
+	tc.AddRegionStore(1, 20)
+	tc.AddRegionStore(2, 10)
+	tc.AddRegionStore(3, 5)
+	tc.AddLeaderRegion(1, 1)
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 3)
+
+	// Store 3 is lack of space, it is not a target whatever the formula is.
+	tc.UpdateStorageRatio(3, 0.9, 0.1)
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 2)
+	opt.RegionScoreFormulaVersion = core.RegionScoreFormulaV1
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 2)
+
+	// The regions are moved out of store 3 first since its score is the
+	// highest with the "v2" formula.
+	opt.RegionScoreFormulaVersion = core.RegionScoreFormulaV2
+	tc.AddRegionStore(4, 0)
+	tc.AddLeaderRegion(2, 3)
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 3, 4)
+
+	// The watermark is tunable.
+	opt.LowSpaceRatio = 0.95
+	tc.AddLeaderRegion(2, 2)
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 4)
+	tc.SetStoreDown(4)
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 2)
+	opt.HighSpaceRatio = 0.92
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 3)
+}
+
 var _ = Suite(&testBalanceSpeedSuite{})
 
 type testBalanceSpeedSuite struct{}
@@ -273,6 +335,42 @@ func (s *testBalanceSpeedSuite) TestShouldBalance3C(c *C) {
 	}
 }
 
+func (s *testBalanceSpeedSuite) TestRegionScore(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	regionScore := func(storeID uint64) float64 {
+		return tc.GetStore(storeID).RegionScore(opt.RegionScoreFormulaVersion, opt.HighSpaceRatio, opt.LowSpaceRatio)
+	}
+
+	// The stores are in the high space, transition and low space stage.
+	usedRatios := []float64{0.5, 0.65, 0.75, 0.79, 0.9, 0.95}
+	for i, usedRatio := range usedRatios {
+		storeID := uint64(i + 1)
+		tc.AddRegionStore(storeID, 10)
+		tc.UpdateStorageRatio(storeID, usedRatio, 1-usedRatio)
+	}
+	c.Assert(regionScore(1), Equals, float64(100))
+	c.Assert(regionScore(2), Equals, float64(100))
+	for i := 2; i < len(usedRatios); i++ {
+		c.Assert(regionScore(uint64(i+1)) > regionScore(uint64(i)), IsTrue)
+	}
+	// The stores in the low space stage are scored higher than any store with
+	// more space.
+	tc.AddRegionStore(10, 10000)
+	c.Assert(regionScore(5) > regionScore(10), IsTrue)
+
+	// The space is ignored with the "v1" formula.
+	opt.RegionScoreFormulaVersion = core.RegionScoreFormulaV1
+	for i := range usedRatios {
+		c.Assert(regionScore(uint64(i+1)), Equals, float64(100))
+	}
+
+	// The weight still applies.
+	opt.RegionScoreFormulaVersion = core.RegionScoreFormulaV2
+	tc.UpdateStoreRegionWeight(1, 2)
+	c.Assert(regionScore(1), Equals, float64(50))
+}
+
 func (s *testBalanceSpeedSuite) TestTolerantRatio3C(c *C) {
 	opt := mockoption.NewScheduleOptions()
 	tc := mockcluster.NewCluster(opt)
@@ -347,6 +445,33 @@ func (s *testBalanceLeaderSchedulerSuite) TestBalanceLimit(c *C) {
 	c.Check(s.schedule(), NotNil)
 }
 
+func (s *testBalanceLeaderSchedulerSuite) TestLabeledRegion(c *C) {
+	// Stores:     1    2    3    4
+	// Leaders:    16   0    0    0
+	// Region1:    L    F    F    F
+	s.tc.AddLeaderStore(1, 16)
+	s.tc.AddLeaderStore(2, 0)
+	s.tc.AddLeaderStore(3, 0)
+	s.tc.AddLeaderStore(4, 0)
+	s.tc.AddLeaderRegionWithRange(1, "b", "d", 1, 2, 3, 4)
+	c.Check(s.schedule(), NotNil)
+
+	// The region overlaps the key range labeled no-balance.
+	rule := &labeler.LabelRule{ID: "import", StartKey: "63", EndKey: "65", Labels: []labeler.Label{labeler.NoBalance}}
+	c.Assert(s.tc.RegionLabeler.SetLabelRule(rule), IsNil)
+	c.Check(s.schedule(), IsNil)
+
+	// Other labels do not pause balancing.
+	rule = &labeler.LabelRule{ID: "import", StartKey: "63", EndKey: "65", Labels: []labeler.Label{labeler.NoMerge, labeler.NoSplit}}
+	c.Assert(s.tc.RegionLabeler.SetLabelRule(rule), IsNil)
+	c.Check(s.schedule(), NotNil)
+
+	// The key range does not overlap the region.
+	rule = &labeler.LabelRule{ID: "import", StartKey: "64", Labels: []labeler.Label{labeler.NoBalance}}
+	c.Assert(s.tc.RegionLabeler.SetLabelRule(rule), IsNil)
+	c.Check(s.schedule(), NotNil)
+}
+
 func (s *testBalanceLeaderSchedulerSuite) TestBalanceLeaderScheduleStrategy(c *C) {
 	// Stores:			1    	2    	3    	4
 	// Leader Count:		10    	10    	10    	10
@@ -557,6 +682,42 @@ func (s *testReplicaCheckerSuite) TestLostStore(c *C) {
 	c.Assert(op, IsNil)
 }
 
+func (s *testReplicaCheckerSuite) TestDownPeer(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+
+	newTestReplication(opt, 3)
+
+	rc := checker.NewReplicaChecker(tc)
+
+	tc.AddRegionStore(1, 1)
+	tc.AddRegionStore(2, 2)
+	tc.AddRegionStore(3, 3)
+	tc.AddRegionStore(4, 4)
+
+	tc.AddLeaderRegion(1, 1, 2, 3)
+	region := tc.GetRegion(1)
+	c.Assert(rc.Check(region), IsNil)
+
+	// The peer has not been down for long enough.
+	downPeer := &pdpb.PeerStats{
+		Peer:        region.GetStorePeer(3),
+		DownSeconds: uint64(opt.MaxStoreDownTime.Seconds()) - 1,
+	}
+	region = region.Clone(core.WithDownPeers([]*pdpb.PeerStats{downPeer}))
+	c.Assert(rc.Check(region), IsNil)
+
+	// Replace the down peer although its store is up.
+	downPeer.DownSeconds = uint64(opt.MaxStoreDownTime.Seconds())
+	region = region.Clone(core.WithDownPeers([]*pdpb.PeerStats{downPeer}))
+	testutil.CheckTransferPeer(c, rc.Check(region), operator.OpReplica, 3, 4)
+
+	// Remove the down peer if there are redundant replicas.
+	peer4, _ := tc.AllocPeer(4)
+	region = region.Clone(core.WithAddPeer(peer4))
+	testutil.CheckRemovePeer(c, rc.Check(region), 3)
+}
+
 func (s *testReplicaCheckerSuite) TestOffline(c *C) {
 	opt := mockoption.NewScheduleOptions()
 	tc := mockcluster.NewCluster(opt)
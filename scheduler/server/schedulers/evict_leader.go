@@ -0,0 +1,102 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/selector"
+)
+
+const (
+	// EvictLeaderName is evict leader scheduler name prefix, the store IDs are appended.
+	EvictLeaderName = "evict-leader-scheduler"
+	// EvictLeaderType is evict leader scheduler type.
+	EvictLeaderType = "evict-leader"
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder(EvictLeaderType, storeIDsSliceDecoder)
+
+	schedule.RegisterScheduler(EvictLeaderType, func(opController *schedule.OperatorController, storage *core.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		conf := &storeIDsConfig{}
+		if err := decoder(conf); err != nil {
+			return nil, err
+		}
+		if len(conf.StoreIDs) == 0 {
+			return nil, ErrScheduleConfigNotExist
+		}
+		return newEvictLeaderScheduler(opController, conf), nil
+	})
+}
+
+type evictLeaderScheduler struct {
+	*baseScheduler
+	conf     *storeIDsConfig
+	selector *selector.RandomSelector
+}
+
+// newEvictLeaderScheduler creates an admin scheduler that transfers all leaders
+// out of the given stores.
+func newEvictLeaderScheduler(opController *schedule.OperatorController, conf *storeIDsConfig) schedule.Scheduler {
+	filters := []filter.Filter{filter.StoreStateFilter{ActionScope: EvictLeaderName, TransferLeader: true}}
+	return &evictLeaderScheduler{
+		baseScheduler: newBaseScheduler(opController),
+		conf:          conf,
+		selector:      selector.NewRandomSelector(filters),
+	}
+}
+
+func (s *evictLeaderScheduler) GetName() string {
+	return s.conf.schedulerName(EvictLeaderName)
+}
+
+func (s *evictLeaderScheduler) GetType() string {
+	return EvictLeaderType
+}
+
+func (s *evictLeaderScheduler) EncodeConfig() ([]byte, error) {
+	return schedule.EncodeConfig(s.conf)
+}
+
+// Prepare blocks the stores so that no other scheduler moves leaders back in.
+func (s *evictLeaderScheduler) Prepare(cluster opt.Cluster) error {
+	return blockStores(cluster, s.conf.StoreIDs)
+}
+
+func (s *evictLeaderScheduler) Cleanup(cluster opt.Cluster) {
+	unblockStores(cluster, s.conf.StoreIDs)
+}
+
+func (s *evictLeaderScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	return s.opController.OperatorCount(operator.OpLeader) < cluster.GetLeaderScheduleLimit()
+}
+
+func (s *evictLeaderScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
+	for _, storeID := range s.conf.StoreIDs {
+		region := cluster.RandLeaderRegion(storeID, core.HealthRegion())
+		if region == nil {
+			continue
+		}
+		target := s.selector.SelectTarget(cluster, cluster.GetFollowerStores(region))
+		if target == nil {
+			continue
+		}
+		return operator.CreateTransferLeaderOperator(EvictLeaderType, region, storeID, target.GetID(), operator.OpAdmin)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+)
+
+const (
+	// GrantLeaderName is grant leader scheduler name prefix, the store IDs are appended.
+	GrantLeaderName = "grant-leader-scheduler"
+	// GrantLeaderType is grant leader scheduler type.
+	GrantLeaderType = "grant-leader"
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder(GrantLeaderType, storeIDsSliceDecoder)
+
+	schedule.RegisterScheduler(GrantLeaderType, func(opController *schedule.OperatorController, storage *core.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		conf := &storeIDsConfig{}
+		if err := decoder(conf); err != nil {
+			return nil, err
+		}
+		if len(conf.StoreIDs) == 0 {
+			return nil, ErrScheduleConfigNotExist
+		}
+		return newGrantLeaderScheduler(opController, conf), nil
+	})
+}
+
+type grantLeaderScheduler struct {
+	*baseScheduler
+	conf    *storeIDsConfig
+	filters []filter.Filter
+}
+
+// newGrantLeaderScheduler creates an admin scheduler that transfers all leaders
+// to the given stores.
+func newGrantLeaderScheduler(opController *schedule.OperatorController, conf *storeIDsConfig) schedule.Scheduler {
+	return &grantLeaderScheduler{
+		baseScheduler: newBaseScheduler(opController),
+		conf:          conf,
+		filters:       []filter.Filter{filter.StoreStateFilter{ActionScope: GrantLeaderName}},
+	}
+}
+
+func (s *grantLeaderScheduler) GetName() string {
+	return s.conf.schedulerName(GrantLeaderName)
+}
+
+func (s *grantLeaderScheduler) GetType() string {
+	return GrantLeaderType
+}
+
+func (s *grantLeaderScheduler) EncodeConfig() ([]byte, error) {
+	return schedule.EncodeConfig(s.conf)
+}
+
+// Prepare blocks the stores so that no other scheduler moves leaders out.
+func (s *grantLeaderScheduler) Prepare(cluster opt.Cluster) error {
+	return blockStores(cluster, s.conf.StoreIDs)
+}
+
+func (s *grantLeaderScheduler) Cleanup(cluster opt.Cluster) {
+	unblockStores(cluster, s.conf.StoreIDs)
+}
+
+func (s *grantLeaderScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	return s.opController.OperatorCount(operator.OpLeader) < cluster.GetLeaderScheduleLimit()
+}
+
+func (s *grantLeaderScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
+	for _, storeID := range s.conf.StoreIDs {
+		store := cluster.GetStore(storeID)
+		if store == nil || filter.Target(cluster, store, s.filters) {
+			continue
+		}
+		for i := 0; i < balanceLeaderRetryLimit; i++ {
+			region := cluster.RandFollowerRegion(storeID, core.HealthRegion())
+			if region == nil {
+				break
+			}
+			if s.isGranted(region.GetLeader().GetStoreId()) {
+				continue
+			}
+			return operator.CreateTransferLeaderOperator(GrantLeaderType, region, region.GetLeader().GetStoreId(), storeID, operator.OpAdmin)
+		}
+	}
+	return nil
+}
+
+// isGranted returns true if the store is one of the stores to grant leaders,
+// the leaders on it should not be moved to other granted stores.
+func (s *grantLeaderScheduler) isGranted(storeID uint64) bool {
+	for _, id := range s.conf.StoreIDs {
+		if id == storeID {
+			return true
+		}
+	}
+	return false
+}
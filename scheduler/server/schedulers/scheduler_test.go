@@ -0,0 +1,154 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testAdminSchedulerSuite{})
+
+type testAdminSchedulerSuite struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	tc     *mockcluster.Cluster
+	oc     *schedule.OperatorController
+}
+
+func (s *testAdminSchedulerSuite) SetUpTest(c *C) {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.tc = mockcluster.NewCluster(mockoption.NewScheduleOptions())
+	s.oc = schedule.NewOperatorController(s.ctx, nil, nil)
+}
+
+func (s *testAdminSchedulerSuite) TearDownTest(c *C) {
+	s.cancel()
+}
+
+func (s *testAdminSchedulerSuite) TestEvictLeader(c *C) {
+	// Add stores 1, 2, 3
+	s.tc.AddLeaderStore(1, 0)
+	s.tc.AddLeaderStore(2, 0)
+	s.tc.AddLeaderStore(3, 0)
+	// Add regions 1, 2, 3 with leaders in stores 1, 2, 3
+	s.tc.AddLeaderRegion(1, 1, 2)
+	s.tc.AddLeaderRegion(2, 2, 1)
+	s.tc.AddLeaderRegion(3, 3, 1)
+
+	sl, err := schedule.CreateScheduler(EvictLeaderType, s.oc, core.NewStorage(kv.NewMemoryKV()), schedule.ConfigSliceDecoder(EvictLeaderType, []string{"1"}))
+	c.Assert(err, IsNil)
+	c.Assert(sl.GetName(), Equals, "evict-leader-scheduler-1")
+	c.Assert(sl.Prepare(s.tc), IsNil)
+	c.Assert(s.tc.GetStore(1).IsBlocked(), IsTrue)
+	c.Assert(sl.IsScheduleAllowed(s.tc), IsTrue)
+	testutil.CheckTransferLeader(c, sl.Schedule(s.tc), operator.OpAdmin, 1, 2)
+
+	sl.Cleanup(s.tc)
+	c.Assert(s.tc.GetStore(1).IsBlocked(), IsFalse)
+}
+
+func (s *testAdminSchedulerSuite) TestGrantLeader(c *C) {
+	// Add stores 1, 2, 3
+	s.tc.AddLeaderStore(1, 0)
+	s.tc.AddLeaderStore(2, 0)
+	s.tc.AddLeaderStore(3, 0)
+	// Add region 1 with leader in store 2 and follower in store 1, region 2
+	// with leader in store 3 and follower in store 2.
+	s.tc.AddLeaderRegion(1, 2, 1)
+	s.tc.AddLeaderRegion(2, 3, 2)
+
+	sl, err := schedule.CreateScheduler(GrantLeaderType, s.oc, core.NewStorage(kv.NewMemoryKV()), schedule.ConfigSliceDecoder(GrantLeaderType, []string{"1", "2"}))
+	c.Assert(err, IsNil)
+	c.Assert(sl.GetName(), Equals, "grant-leader-scheduler-1-2")
+	c.Assert(sl.Prepare(s.tc), IsNil)
+	defer sl.Cleanup(s.tc)
+	// The leader in store 2 is granted already, only moves the one in store 3.
+	testutil.CheckTransferLeader(c, sl.Schedule(s.tc), operator.OpAdmin, 3, 2)
+}
+
+func (s *testAdminSchedulerSuite) TestStoreIDsArgs(c *C) {
+	storage := core.NewStorage(kv.NewMemoryKV())
+	for _, args := range [][]string{nil, {"a"}, {"-1"}} {
+		_, err := schedule.CreateScheduler(EvictLeaderType, s.oc, storage, schedule.ConfigSliceDecoder(EvictLeaderType, args))
+		c.Assert(err, NotNil)
+	}
+
+	// The config is persisted and can be used to recreate the scheduler.
+	sl, err := schedule.CreateScheduler(EvictLeaderType, s.oc, storage, schedule.ConfigSliceDecoder(EvictLeaderType, []string{"3", "1", "3"}))
+	c.Assert(err, IsNil)
+	c.Assert(sl.GetName(), Equals, "evict-leader-scheduler-3-1")
+	names, configs, err := storage.LoadAllScheduleConfig()
+	c.Assert(err, IsNil)
+	c.Assert(names, DeepEquals, []string{sl.GetName()})
+	c.Assert(schedule.FindSchedulerTypeByName(names[0]), Equals, EvictLeaderType)
+	sl, err = schedule.CreateScheduler(EvictLeaderType, s.oc, storage, schedule.ConfigJSONDecoder([]byte(configs[0])))
+	c.Assert(err, IsNil)
+	c.Assert(sl.(*evictLeaderScheduler).conf.StoreIDs, DeepEquals, []uint64{3, 1})
+}
+
+func (s *testAdminSchedulerSuite) TestShuffleLeader(c *C) {
+	sl, err := schedule.CreateScheduler(ShuffleLeaderType, s.oc, core.NewStorage(kv.NewMemoryKV()), schedule.ConfigSliceDecoder(ShuffleLeaderType, []string{}))
+	c.Assert(err, IsNil)
+	c.Assert(sl.Schedule(s.tc), IsNil)
+
+	// Add stores 1,2,3,4
+	s.tc.AddLeaderStore(1, 6)
+	s.tc.AddLeaderStore(2, 7)
+	s.tc.AddLeaderStore(3, 8)
+	s.tc.AddLeaderStore(4, 9)
+	// Add regions 1,2,3,4 with leaders in stores 1,2,3,4
+	s.tc.AddLeaderRegion(1, 1, 2, 3, 4)
+	s.tc.AddLeaderRegion(2, 2, 3, 4, 1)
+	s.tc.AddLeaderRegion(3, 3, 4, 1, 2)
+	s.tc.AddLeaderRegion(4, 4, 1, 2, 3)
+
+	for i := 0; i < 4; i++ {
+		op := sl.Schedule(s.tc)
+		c.Assert(op, NotNil)
+		c.Assert(op.Kind(), Equals, operator.OpLeader|operator.OpAdmin)
+	}
+}
+
+func (s *testAdminSchedulerSuite) TestShuffleRegion(c *C) {
+	sl, err := schedule.CreateScheduler(ShuffleRegionType, s.oc, core.NewStorage(kv.NewMemoryKV()), schedule.ConfigSliceDecoder(ShuffleRegionType, []string{}))
+	c.Assert(err, IsNil)
+	c.Assert(sl.IsScheduleAllowed(s.tc), IsTrue)
+	c.Assert(sl.Schedule(s.tc), IsNil)
+
+	// Add stores 1, 2, 3, 4
+	s.tc.AddRegionStore(1, 6)
+	s.tc.AddRegionStore(2, 7)
+	s.tc.AddRegionStore(3, 8)
+	s.tc.AddRegionStore(4, 9)
+	// Add regions 1, 2, 3, 4 with leaders in stores 1,2,3,4
+	s.tc.AddLeaderRegion(1, 1, 2, 3)
+	s.tc.AddLeaderRegion(2, 2, 3, 4)
+	s.tc.AddLeaderRegion(3, 3, 4, 1)
+	s.tc.AddLeaderRegion(4, 4, 1, 2)
+
+	for i := 0; i < 4; i++ {
+		op := sl.Schedule(s.tc)
+		c.Assert(op, NotNil)
+		c.Assert(op.Kind()&(operator.OpRegion|operator.OpAdmin), Equals, operator.OpRegion|operator.OpAdmin)
+	}
+}
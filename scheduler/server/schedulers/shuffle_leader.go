@@ -0,0 +1,84 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/selector"
+)
+
+const (
+	// ShuffleLeaderName is shuffle leader scheduler name.
+	ShuffleLeaderName = "shuffle-leader-scheduler"
+	// ShuffleLeaderType is shuffle leader scheduler type.
+	ShuffleLeaderType = "shuffle-leader"
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder(ShuffleLeaderType, func(args []string) schedule.ConfigDecoder {
+		return func(v interface{}) error {
+			return nil
+		}
+	})
+
+	schedule.RegisterScheduler(ShuffleLeaderType, func(opController *schedule.OperatorController, storage *core.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		return newShuffleLeaderScheduler(opController), nil
+	})
+}
+
+type shuffleLeaderScheduler struct {
+	*baseScheduler
+	selector *selector.RandomSelector
+}
+
+// newShuffleLeaderScheduler creates an admin scheduler that shuffles leaders
+// between stores, it is used for chaos testing.
+func newShuffleLeaderScheduler(opController *schedule.OperatorController) schedule.Scheduler {
+	filters := []filter.Filter{filter.StoreStateFilter{ActionScope: ShuffleLeaderName, TransferLeader: true}}
+	return &shuffleLeaderScheduler{
+		baseScheduler: newBaseScheduler(opController),
+		selector:      selector.NewRandomSelector(filters),
+	}
+}
+
+func (s *shuffleLeaderScheduler) GetName() string {
+	return ShuffleLeaderName
+}
+
+func (s *shuffleLeaderScheduler) GetType() string {
+	return ShuffleLeaderType
+}
+
+func (s *shuffleLeaderScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	return s.opController.OperatorCount(operator.OpLeader) < cluster.GetLeaderScheduleLimit()
+}
+
+func (s *shuffleLeaderScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
+	// We shuffle leaders between stores by:
+	// 1. random select a valid store.
+	// 2. transfer a leader to the store.
+	targetStore := s.selector.SelectTarget(cluster, cluster.GetStores())
+	if targetStore == nil {
+		return nil
+	}
+	region := cluster.RandFollowerRegion(targetStore.GetID(), core.HealthRegion())
+	if region == nil {
+		return nil
+	}
+	return operator.CreateTransferLeaderOperator(ShuffleLeaderType, region, region.GetLeader().GetStoreId(), targetStore.GetID(), operator.OpAdmin)
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/selector"
+)
+
+const (
+	// ShuffleRegionName is shuffle region scheduler name.
+	ShuffleRegionName = "shuffle-region-scheduler"
+	// ShuffleRegionType is shuffle region scheduler type.
+	ShuffleRegionType = "shuffle-region"
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder(ShuffleRegionType, func(args []string) schedule.ConfigDecoder {
+		return func(v interface{}) error {
+			return nil
+		}
+	})
+
+	schedule.RegisterScheduler(ShuffleRegionType, func(opController *schedule.OperatorController, storage *core.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		return newShuffleRegionScheduler(opController), nil
+	})
+}
+
+type shuffleRegionScheduler struct {
+	*baseScheduler
+	selector *selector.RandomSelector
+}
+
+// newShuffleRegionScheduler creates an admin scheduler that shuffles regions
+// between stores, it is used for chaos testing.
+func newShuffleRegionScheduler(opController *schedule.OperatorController) schedule.Scheduler {
+	filters := []filter.Filter{
+		filter.StoreStateFilter{ActionScope: ShuffleRegionName, MoveRegion: true},
+		filter.NewLowSpaceFilter(ShuffleRegionName),
+	}
+	return &shuffleRegionScheduler{
+		baseScheduler: newBaseScheduler(opController),
+		selector:      selector.NewRandomSelector(filters),
+	}
+}
+
+func (s *shuffleRegionScheduler) GetName() string {
+	return ShuffleRegionName
+}
+
+func (s *shuffleRegionScheduler) GetType() string {
+	return ShuffleRegionType
+}
+
+func (s *shuffleRegionScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	return s.opController.OperatorCount(operator.OpRegion) < cluster.GetRegionScheduleLimit()
+}
+
+func (s *shuffleRegionScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
+	region, oldPeer := s.scheduleRemovePeer(cluster)
+	if region == nil {
+		return nil
+	}
+
+	excludedFilter := filter.NewExcludedFilter(s.GetName(), nil, region.GetStoreIds())
+	newPeer := s.scheduleAddPeer(cluster, excludedFilter)
+	if newPeer == nil {
+		return nil
+	}
+
+	op, err := operator.CreateMovePeerOperator(ShuffleRegionType, cluster, region, operator.OpAdmin, oldPeer.GetStoreId(), newPeer.GetStoreId(), newPeer.GetId())
+	if err != nil {
+		return nil
+	}
+	return op
+}
+
+func (s *shuffleRegionScheduler) scheduleRemovePeer(cluster opt.Cluster) (*core.RegionInfo, *metapb.Peer) {
+	source := s.selector.SelectSource(cluster, cluster.GetStores())
+	if source == nil {
+		return nil, nil
+	}
+
+	region := cluster.RandFollowerRegion(source.GetID(), core.HealthRegion())
+	if region == nil {
+		region = cluster.RandLeaderRegion(source.GetID(), core.HealthRegion())
+	}
+	if region == nil {
+		return nil, nil
+	}
+
+	return region, region.GetStorePeer(source.GetID())
+}
+
+func (s *shuffleRegionScheduler) scheduleAddPeer(cluster opt.Cluster, filter filter.Filter) *metapb.Peer {
+	target := s.selector.SelectTarget(cluster, cluster.GetStores(), filter)
+	if target == nil {
+		return nil
+	}
+
+	newPeer, err := cluster.AllocPeer(target.GetID())
+	if err != nil {
+		return nil
+	}
+
+	return newPeer
+}
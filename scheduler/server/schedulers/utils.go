@@ -14,9 +14,11 @@
 package schedulers
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
@@ -65,8 +67,9 @@ func shouldBalance(cluster opt.Cluster, source, target *core.StoreInfo, region *
 	sourceID := source.GetID()
 	targetID := target.GetID()
 	tolerantResource := getTolerantResource(cluster, region, kind)
-	sourceScore := source.ResourceScore(kind, -tolerantResource)
-	targetScore := target.ResourceScore(kind, tolerantResource)
+	version, highSpaceRatio, lowSpaceRatio := cluster.GetRegionScoreFormulaVersion(), cluster.GetHighSpaceRatio(), cluster.GetLowSpaceRatio()
+	sourceScore := source.ResourceScore(kind, version, highSpaceRatio, lowSpaceRatio, -tolerantResource)
+	targetScore := target.ResourceScore(kind, version, highSpaceRatio, lowSpaceRatio, tolerantResource)
 
 	// Make sure after move, source score is still greater than target score.
 	shouldBalance := sourceScore > targetScore
@@ -112,3 +115,63 @@ func getTolerantRatio(cluster opt.Cluster) float64 {
 
 	return tolerantSizeRatio
 }
+
+// storeIDsConfig is the persisted config of the schedulers that work on a
+// set of stores, such as evict-leader and grant-leader.
+type storeIDsConfig struct {
+	StoreIDs []uint64 `json:"store-id-list"`
+}
+
+// schedulerName returns the name of a scheduler working on the stores, so
+// schedulers of the same type on different stores can run together,
+// e.g. evict-leader-scheduler-1-2.
+func (conf *storeIDsConfig) schedulerName(prefix string) string {
+	name := prefix
+	for _, id := range conf.StoreIDs {
+		name += "-" + strconv.FormatUint(id, 10)
+	}
+	return name
+}
+
+// storeIDsSliceDecoder decodes the store IDs passed as scheduler arguments.
+func storeIDsSliceDecoder(args []string) schedule.ConfigDecoder {
+	return func(v interface{}) error {
+		if len(args) == 0 {
+			return errors.New("should specify the store-id")
+		}
+		conf, ok := v.(*storeIDsConfig)
+		if !ok {
+			return ErrScheduleConfigNotExist
+		}
+		seen := make(map[uint64]struct{}, len(args))
+		for _, arg := range args {
+			id, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			conf.StoreIDs = append(conf.StoreIDs, id)
+		}
+		return nil
+	}
+}
+
+// blockStores blocks all the stores, it rolls back if any of them fails.
+func blockStores(cluster opt.Cluster, storeIDs []uint64) error {
+	for i, id := range storeIDs {
+		if err := cluster.BlockStore(id); err != nil {
+			unblockStores(cluster, storeIDs[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func unblockStores(cluster opt.Cluster, storeIDs []uint64) {
+	for _, id := range storeIDs {
+		cluster.UnblockStore(id)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"net/http"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -81,6 +82,7 @@ const (
 	leaderTickInterval = 50 * time.Millisecond
 	// pdRootPath for all pd servers.
 	pdRootPath      = "/pd"
+	pdAPIPrefix     = "/pd/"
 	pdClusterIDPath = "/pd/cluster_id"
 )
 
@@ -124,8 +126,12 @@ type Server struct {
 	logProps *log.ZapProperties
 }
 
+// HandlerBuilder builds a server HTTP handler.
+type HandlerBuilder func(*Server) http.Handler
+
 // CreateServer creates the UNINITIALIZED pd server with given configuration.
-func CreateServer(cfg *config.Config) (*Server, error) {
+// The handlers built by apiBuilders are served under /pd/ on the client urls.
+func CreateServer(cfg *config.Config, apiBuilders ...HandlerBuilder) (*Server, error) {
 	log.Info("PD Config", zap.Reflect("config", cfg))
 	rand.Seed(time.Now().UnixNano())
 
@@ -141,6 +147,13 @@ func CreateServer(cfg *config.Config) (*Server, error) {
 		return nil, err
 	}
 	etcdCfg.ServiceRegister = func(gs *grpc.Server) { pdpb.RegisterPDServer(gs, s) }
+	if len(apiBuilders) != 0 {
+		mux := http.NewServeMux()
+		for _, build := range apiBuilders {
+			mux.Handle(pdAPIPrefix, build(s))
+		}
+		etcdCfg.UserHandlers = map[string]http.Handler{pdAPIPrefix: mux}
+	}
 	s.etcdCfg = etcdCfg
 	if EnableZap {
 		// The etcd master version has removed embed.Config.SetupLogging.
@@ -237,7 +250,12 @@ func (s *Server) startServer(ctx context.Context) error {
 		func() time.Duration { return s.scheduleOpt.LoadPDServerConfig().MaxResetTSGap },
 	)
 	kvBase := kv.NewEtcdKVBase(s.client, s.rootPath)
-	s.storage = core.NewStorage(kvBase)
+	regionStoragePath := filepath.Join(s.cfg.DataDir, "region-meta")
+	regionStorage, err := core.NewRegionStorage(ctx, regionStoragePath)
+	if err != nil {
+		return err
+	}
+	s.storage = core.NewStorage(kvBase).SetRegionStorage(regionStorage)
 	s.cluster = newRaftCluster(ctx, s, s.clusterID)
 	s.hbStreams = newHeartbeatStreams(ctx, s.clusterID, s.cluster)
 	// Server has started.
@@ -478,9 +496,7 @@ func (s *Server) GetConfig() *config.Config {
 
 // GetScheduleConfig gets the balance config information.
 func (s *Server) GetScheduleConfig() *config.ScheduleConfig {
-	cfg := &config.ScheduleConfig{}
-	*cfg = *s.scheduleOpt.Load()
-	return cfg
+	return s.scheduleOpt.Load().Clone()
 }
 
 // GetReplicationConfig get the replication config.
@@ -490,10 +506,40 @@ func (s *Server) GetReplicationConfig() *config.ReplicationConfig {
 	return cfg
 }
 
+// SetScheduleConfig sets the balance config information.
+func (s *Server) SetScheduleConfig(cfg config.ScheduleConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	old := s.scheduleOpt.Load()
+	s.scheduleOpt.Store(&cfg)
+	if err := s.scheduleOpt.Persist(s.storage); err != nil {
+		s.scheduleOpt.Store(old)
+		log.Error("failed to update schedule config",
+			zap.Reflect("new", cfg),
+			zap.Reflect("old", old),
+			zap.Error(err))
+		return err
+	}
+	log.Info("schedule config is updated", zap.Reflect("new", cfg), zap.Reflect("old", old))
+	return nil
+}
+
 // SetReplicationConfig sets the replication config.
 func (s *Server) SetReplicationConfig(cfg config.ReplicationConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
 	old := s.scheduleOpt.GetReplication().Load()
 	s.scheduleOpt.GetReplication().Store(&cfg)
+	if err := s.scheduleOpt.Persist(s.storage); err != nil {
+		s.scheduleOpt.GetReplication().Store(old)
+		log.Error("failed to update replication config",
+			zap.Reflect("new", cfg),
+			zap.Reflect("old", old),
+			zap.Error(err))
+		return err
+	}
 	log.Info("replication config is updated", zap.Reflect("new", cfg), zap.Reflect("old", old))
 	return nil
 }
@@ -533,6 +579,15 @@ func (s *Server) GetMetaRegions() []*metapb.Region {
 	return nil
 }
 
+// GetRegions gets regions from cluster.
+func (s *Server) GetRegions() []*core.RegionInfo {
+	cluster := s.GetRaftCluster()
+	if cluster != nil {
+		return cluster.GetRegions()
+	}
+	return nil
+}
+
 // GetClusterStatus gets cluster status.
 func (s *Server) GetClusterStatus() (*ClusterStatus, error) {
 	s.cluster.Lock()
@@ -582,8 +637,11 @@ func (s *Server) leaderLoop() {
 			continue
 		}
 		if leader != nil {
+			syncer := s.cluster.GetRegionSyncer()
+			syncer.StartSyncWithLeader(leader.GetClientUrls()[0])
 			log.Info("start watch leader", zap.Stringer("leader", leader))
 			s.member.WatchLeader(s.serverLoopCtx, leader, rev)
+			syncer.StopSyncWithLeader()
 			log.Info("leader changed, try to campaign leader")
 		}
 
@@ -626,6 +684,12 @@ func (s *Server) campaignLeader() {
 	}
 	defer s.tso.ResetTimestamp()
 
+	// The config may have been changed through another leader, reload it before scheduling.
+	if err := s.scheduleOpt.Reload(s.storage); err != nil {
+		log.Error("failed to reload configuration", zap.Error(err))
+		return
+	}
+
 	// Try to create raft cluster.
 	err := s.createRaftCluster()
 	if err != nil {
@@ -0,0 +1,278 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/cmd/tinyscheduler-ctl/ctl"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/api"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/tests"
+	. "github.com/pingcap/check"
+
+	// Register schedulers.
+	_ "github.com/pingcap-incubator/tinykv/scheduler/server/schedulers"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&ctlTestSuite{})
+
+type ctlTestSuite struct {
+	cluster *tests.TestCluster
+	leader  *tests.TestServer
+}
+
+func (s *ctlTestSuite) SetUpSuite(c *C) {
+	server.EnableZap = true
+
+	var err error
+	s.cluster, err = tests.NewTestCluster(1)
+	c.Assert(err, IsNil)
+	c.Assert(s.cluster.RunInitialServers(), IsNil)
+	s.leader = s.cluster.GetServer(s.cluster.WaitLeader())
+	c.Assert(s.leader.BootstrapCluster(), IsNil)
+
+	svr := s.leader.GetServer()
+	for _, id := range []uint64{2, 3} {
+		_, err = svr.PutStore(context.Background(), &pdpb.PutStoreRequest{
+			Header: &pdpb.RequestHeader{ClusterId: svr.ClusterID()},
+			Store:  &metapb.Store{Id: id, Address: "mock://" + string('0'+byte(id)), State: metapb.StoreState_Up},
+		})
+		c.Assert(err, IsNil)
+	}
+	peers := []*metapb.Peer{{Id: 11, StoreId: 1}, {Id: 12, StoreId: 2}}
+	region := core.NewRegionInfo(&metapb.Region{
+		Id:          10,
+		StartKey:    []byte("a"),
+		EndKey:      []byte("z"),
+		Peers:       peers,
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+	}, peers[0])
+	c.Assert(svr.GetRaftCluster().HandleRegionHeartbeat(region), IsNil)
+}
+
+func (s *ctlTestSuite) TearDownSuite(c *C) {
+	s.cluster.Destroy()
+}
+
+// execute runs tinyscheduler-ctl with args against the leader and returns
+// its output.
+func (s *ctlTestSuite) execute(args ...string) (string, error) {
+	cmd := ctl.GetRootCmd()
+	var buf bytes.Buffer
+	cmd.SetOutput(&buf)
+	cmd.SetArgs(append(args, "-u", s.leader.GetAddr()))
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func (s *ctlTestSuite) mustExecJSON(c *C, v interface{}, args ...string) {
+	output, err := s.execute(append(args, "--json")...)
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal([]byte(output), v), IsNil, Commentf("output: %s", output))
+}
+
+func (s *ctlTestSuite) mustExecSuccess(c *C, args ...string) {
+	output, err := s.execute(args...)
+	c.Assert(err, IsNil)
+	c.Assert(output, Equals, "Success!\n")
+}
+
+func (s *ctlTestSuite) TestMember(c *C) {
+	var members api.MembersInfo
+	s.mustExecJSON(c, &members, "member")
+	c.Assert(members.Members, HasLen, 1)
+	c.Assert(members.Leader.GetName(), Equals, s.leader.GetServer().Name())
+
+	var leader pdpb.Member
+	s.mustExecJSON(c, &leader, "member", "leader")
+	c.Assert(leader.GetName(), Equals, s.leader.GetServer().Name())
+}
+
+func (s *ctlTestSuite) TestStore(c *C) {
+	var stores api.StoresInfo
+	s.mustExecJSON(c, &stores, "store")
+	c.Assert(stores.Count, Equals, 3)
+
+	var store api.StoreInfo
+	s.mustExecJSON(c, &store, "store", "2")
+	c.Assert(store.Store.GetId(), Equals, uint64(2))
+
+	s.mustExecSuccess(c, "store", "weight", "3", "2", "3")
+	s.mustExecJSON(c, &store, "store", "3")
+	c.Assert(store.Status.LeaderWeight, Equals, 2.0)
+	c.Assert(store.Status.RegionWeight, Equals, 3.0)
+
+	s.mustExecSuccess(c, "store", "limit", "3", "30", "remove-peer")
+	limits := make(map[uint64]config.StoreLimitConfig)
+	s.mustExecJSON(c, &limits, "store", "limit")
+	c.Assert(limits[3], Equals, config.StoreLimitConfig{AddPeer: 15, RemovePeer: 30})
+	s.mustExecSuccess(c, "store", "remove-limit", "3")
+	s.mustExecJSON(c, &limits, "store", "limit")
+	c.Assert(limits[3], Equals, config.StoreLimitConfig{AddPeer: 15, RemovePeer: 15})
+
+	_, err := s.execute("store", "100")
+	c.Assert(err, NotNil)
+	_, err = s.execute("store", "limit", "3")
+	c.Assert(err, NotNil)
+	_, err = s.execute("store", "weight", "3", "-1", "1")
+	c.Assert(err, NotNil)
+	_, err = s.execute("store", "state", "3", "NoSuchState")
+	c.Assert(err, NotNil)
+}
+
+func (s *ctlTestSuite) TestRegion(c *C) {
+	var region api.RegionInfo
+	s.mustExecJSON(c, &region, "region", "10")
+	c.Assert(region.ID, Equals, uint64(10))
+	s.mustExecJSON(c, &region, "region", "key", "b")
+	c.Assert(region.ID, Equals, uint64(10))
+	s.mustExecJSON(c, &region, "region", "key", "--format=hex", "62")
+	c.Assert(region.ID, Equals, uint64(10))
+
+	var regions api.RegionsInfo
+	s.mustExecJSON(c, &regions, "region")
+	c.Assert(regions.Count, Equals, 1)
+	s.mustExecJSON(c, &regions, "region", "scan", "a", "--limit=1")
+	c.Assert(regions.Count, Equals, 1)
+	s.mustExecJSON(c, &regions, "region", "store", "3")
+	c.Assert(regions.Count, Equals, 0)
+	s.mustExecJSON(c, &regions, "region", "check", "pending-peer")
+	c.Assert(regions.Count, Equals, 0)
+
+	_, err := s.execute("region", "check", "no-such-check")
+	c.Assert(err, NotNil)
+}
+
+func (s *ctlTestSuite) TestOperator(c *C) {
+	s.mustExecSuccess(c, "operator", "add", "add-peer", "10", "3")
+	var ops []string
+	s.mustExecJSON(c, &ops, "operator", "show", "admin")
+	c.Assert(ops, HasLen, 1)
+	var op string
+	s.mustExecJSON(c, &op, "operator", "show", "10")
+	c.Assert(strings.Contains(op, "add peer: store 3"), IsTrue)
+
+	s.mustExecSuccess(c, "operator", "cancel", "10")
+	_, err := s.execute("operator", "cancel", "10")
+	c.Assert(err, NotNil)
+
+	s.mustExecSuccess(c, "operator", "add", "transfer-leader", "10", "2")
+	s.mustExecSuccess(c, "operator", "remove", "10")
+	s.mustExecSuccess(c, "operator", "add", "remove-peer", "10", "2")
+	s.mustExecSuccess(c, "operator", "cancel", "10")
+
+	var records []*pdpb.OperatorRecord
+	s.mustExecJSON(c, &records, "operator", "history", "--region", "10", "--since", "1h")
+	c.Assert(records, HasLen, 3)
+	s.mustExecJSON(c, &records, "operator", "history", "--store", "3")
+	c.Assert(records, HasLen, 1)
+	c.Assert(records[0].GetReason(), Equals, "removed by admin")
+}
+
+func (s *ctlTestSuite) TestScheduler(c *C) {
+	var names []string
+	s.mustExecJSON(c, &names, "scheduler", "show")
+	c.Assert(names, DeepEquals, []string{"balance-leader-scheduler", "balance-region-scheduler"})
+
+	s.mustExecSuccess(c, "scheduler", "remove", "balance-leader-scheduler")
+	s.mustExecJSON(c, &names, "scheduler", "show")
+	c.Assert(names, DeepEquals, []string{"balance-region-scheduler"})
+	s.mustExecSuccess(c, "scheduler", "add", "balance-leader")
+
+	s.mustExecSuccess(c, "scheduler", "pause", "balance-region-scheduler", "60")
+	s.mustExecJSON(c, &names, "scheduler", "show", "--status=paused")
+	c.Assert(names, DeepEquals, []string{"balance-region-scheduler"})
+	s.mustExecSuccess(c, "scheduler", "resume", "all")
+	names = nil
+	s.mustExecJSON(c, &names, "scheduler", "show", "--status=paused")
+	c.Assert(names, HasLen, 0)
+
+	_, err := s.execute("scheduler", "pause", "no-such-scheduler", "60")
+	c.Assert(err, NotNil)
+}
+
+func (s *ctlTestSuite) TestLabel(c *C) {
+	s.mustExecSuccess(c, "label", "set", "import", "7480", "", "no-balance", "no-split", "--ttl=1h")
+	s.mustExecSuccess(c, "label", "set", "ddl", "7490", "74a0", "no-merge")
+	var rules []map[string]interface{}
+	s.mustExecJSON(c, &rules, "label", "show")
+	c.Assert(rules, HasLen, 2)
+	c.Assert(rules[0]["id"], Equals, "ddl")
+	c.Assert(rules[1]["labels"], DeepEquals, []interface{}{"no-balance", "no-split"})
+	c.Assert(rules[1]["expire_time"], NotNil)
+
+	var rule map[string]interface{}
+	s.mustExecJSON(c, &rule, "label", "show", "ddl")
+	c.Assert(rule["end_key"], Equals, "74a0")
+	s.mustExecSuccess(c, "label", "delete", "ddl")
+	s.mustExecSuccess(c, "label", "delete", "import")
+	rules = nil
+	s.mustExecJSON(c, &rules, "label", "show")
+	c.Assert(rules, HasLen, 0)
+
+	_, err := s.execute("label", "set", "bad", "zz", "", "no-balance")
+	c.Assert(err, NotNil)
+	_, err = s.execute("label", "delete", "ddl")
+	c.Assert(err, NotNil)
+}
+
+func (s *ctlTestSuite) TestConfig(c *C) {
+	s.mustExecSuccess(c, "config", "set", "leader-schedule-limit", "8")
+	s.mustExecSuccess(c, "config", "set", "max-store-down-time", "10m")
+	var schedule map[string]interface{}
+	s.mustExecJSON(c, &schedule, "config", "show")
+	c.Assert(schedule["leader-schedule-limit"], Equals, 8.0)
+	c.Assert(schedule["max-store-down-time"], Equals, "10m0s")
+
+	s.mustExecSuccess(c, "config", "set", "max-replicas", "5")
+	var replication map[string]interface{}
+	s.mustExecJSON(c, &replication, "config", "show", "replication")
+	c.Assert(replication["max-replicas"], Equals, 5.0)
+
+	_, err := s.execute("config", "set", "max-replicas", "0")
+	c.Assert(err, NotNil)
+}
+
+func (s *ctlTestSuite) TestTSO(c *C) {
+	// The physical time in milliseconds is shifted by the 18 bits of the logical counter.
+	ts := uint64(1584687937210)<<18 + 1
+	physical, _ := tsoutil.ParseTS(ts)
+	output, err := s.execute("tso", strconv.FormatUint(ts, 10))
+	c.Assert(err, IsNil)
+	c.Assert(output, Equals, "system:  "+physical.String()+"\nlogic:  1\n")
+
+	var decoded struct {
+		Logical uint64 `json:"logical"`
+	}
+	s.mustExecJSON(c, &decoded, "tso", strconv.FormatUint(ts, 10))
+	c.Assert(decoded.Logical, Equals, uint64(1))
+
+	_, err = s.execute("tso", "abc")
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,62 @@
+// Copyright 2018 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/api"
+	"github.com/pingcap-incubator/tinykv/scheduler/tests"
+	. "github.com/pingcap/check"
+
+	// Register schedulers.
+	_ "github.com/pingcap-incubator/tinykv/scheduler/server/schedulers"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&serverTestSuite{})
+
+type serverTestSuite struct{}
+
+func (s *serverTestSuite) SetUpSuite(c *C) {
+	server.EnableZap = true
+}
+
+func (s *serverTestSuite) TestRedirectToLeader(c *C) {
+	cluster, err := tests.NewTestCluster(3)
+	defer cluster.Destroy()
+	c.Assert(err, IsNil)
+
+	err = cluster.RunInitialServers()
+	c.Assert(err, IsNil)
+	leader := cluster.GetServer(cluster.WaitLeader())
+	c.Assert(leader.BootstrapCluster(), IsNil)
+
+	for _, svr := range cluster.GetServers() {
+		resp, err := http.Get(svr.GetAddr() + "/pd/api/v1/stores")
+		c.Assert(err, IsNil)
+		c.Assert(resp.StatusCode, Equals, http.StatusOK)
+		stores := &api.StoresInfo{}
+		c.Assert(json.NewDecoder(resp.Body).Decode(stores), IsNil)
+		resp.Body.Close()
+		c.Assert(stores.Count, Equals, 1)
+		c.Assert(stores.Stores[0].Store.GetId(), Equals, uint64(1))
+	}
+}